@@ -0,0 +1,50 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/bellatrix"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// engineVerifier verifies execution payloads by sending them to the execution engine.
+type engineVerifier struct {
+	caller engine.Caller
+}
+
+// VerifyPayload returns false if the execution engine rejects the payload. Payloads the execution
+// engine cannot verify yet, as while it is syncing, are imported as not yet verified.
+func (v *engineVerifier) VerifyPayload(ctx context.Context, payload *enginev1.ExecutionPayload) (bool, error) {
+	status, err := v.caller.NewPayload(ctx, engine.PayloadFromProto(payload))
+	if err != nil {
+		return false, err
+	}
+	switch status.Status {
+	case engine.StatusValid:
+		return true, nil
+	case engine.StatusInvalid, engine.StatusInvalidBlockHash:
+		return false, nil
+	case engine.StatusSyncing, engine.StatusAccepted:
+		notVerifiedPayloadCount.Inc()
+		log.WithFields(logrus.Fields{
+			"blockHash":   fmt.Sprintf("%#x", payload.BlockHash),
+			"blockNumber": payload.BlockNumber,
+			"status":      status.Status,
+		}).Debug("Importing execution payload not yet verified by the execution engine")
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown execution payload status %s", status.Status)
+	}
+}
+
+// payloadVerifier returns the verifier of execution payloads, or nil if no execution engine is
+// configured.
+func (s *Service) payloadVerifier() bellatrix.PayloadVerifier {
+	if s.cfg.ExecutionEngineCaller == nil {
+		return nil
+	}
+	return &engineVerifier{caller: s.cfg.ExecutionEngineCaller}
+}
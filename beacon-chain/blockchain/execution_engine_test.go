@@ -0,0 +1,94 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// mockEngineCaller answers new payloads with a fixed status, and counts them.
+type mockEngineCaller struct {
+	engine.Caller
+	status   string
+	err      error
+	payloads int
+}
+
+func (m *mockEngineCaller) NewPayload(_ context.Context, _ *engine.ExecutionPayload) (*engine.PayloadStatus, error) {
+	m.payloads++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &engine.PayloadStatus{Status: m.status}, nil
+}
+
+func TestEngineVerifier_VerifyPayload(t *testing.T) {
+	payload := testutil.HydrateExecutionPayload(&enginev1.ExecutionPayload{
+		BlockHash: bytesutil.PadTo([]byte("block"), 32),
+	})
+
+	tests := []struct {
+		name    string
+		caller  *mockEngineCaller
+		valid   bool
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			caller: &mockEngineCaller{status: engine.StatusValid},
+			valid:  true,
+		},
+		{
+			name:   "invalid",
+			caller: &mockEngineCaller{status: engine.StatusInvalid},
+		},
+		{
+			name:   "invalid block hash",
+			caller: &mockEngineCaller{status: engine.StatusInvalidBlockHash},
+		},
+		{
+			name:   "syncing",
+			caller: &mockEngineCaller{status: engine.StatusSyncing},
+			valid:  true,
+		},
+		{
+			name:   "accepted",
+			caller: &mockEngineCaller{status: engine.StatusAccepted},
+			valid:  true,
+		},
+		{
+			name:    "unknown status",
+			caller:  &mockEngineCaller{status: "UNKNOWN"},
+			wantErr: "unknown execution payload status",
+		},
+		{
+			name:    "engine error",
+			caller:  &mockEngineCaller{err: errors.New("connection refused")},
+			wantErr: "connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{cfg: &Config{ExecutionEngineCaller: tt.caller}}
+			valid, err := s.payloadVerifier().VerifyPayload(context.Background(), payload)
+			assert.Equal(t, 1, tt.caller.payloads)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+
+	// There is no verifier without an execution engine, so that payloads are not accepted.
+	s := &Service{cfg: &Config{}}
+	assert.Equal(t, nil, s.payloadVerifier())
+}
@@ -29,6 +29,12 @@ type head struct {
 	state state.BeaconState       // current head state.
 }
 
+// UpdateHead determines the head from the fork choice service with the balances of the
+// justified state, and saves its new data to the local service cache.
+func (s *Service) UpdateHead(ctx context.Context) error {
+	return s.updateHead(ctx, s.getJustifiedBalances())
+}
+
 // Determined the head from the fork choice service and saves its new data
 // (head root, head block, and head state) to the local service cache.
 func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
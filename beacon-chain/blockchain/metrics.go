@@ -118,6 +118,10 @@ var (
 		Name: "sync_head_state_hit",
 		Help: "The number of sync head state requests that are not present in the cache.",
 	})
+	notVerifiedPayloadCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_not_verified_execution_payloads_total",
+		Help: "The number of execution payloads imported before the execution engine could verify them",
+	})
 )
 
 // reportSlotMetrics reports slot related metrics.
@@ -231,7 +235,7 @@ func reportEpochMetrics(ctx context.Context, postState, headState state.BeaconSt
 		if err != nil {
 			return err
 		}
-	case version.Altair:
+	case version.Altair, version.Bellatrix:
 		v, b, err = altair.InitializeEpochValidators(ctx, headState)
 		if err != nil {
 			return err
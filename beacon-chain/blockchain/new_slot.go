@@ -0,0 +1,21 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+// NewSlot is called at the start of every slot, as the on_tick handler of the fork choice spec
+// is on a new slot. The proposer boost only lasts for the slot of the boosted block, so it is
+// reset, and removed from the block's weight at the next head computation.
+func (s *Service) NewSlot(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.NewSlot")
+	defer span.End()
+
+	if err := s.cfg.ForkChoiceStore.ResetBoostedProposerRoot(ctx); err != nil {
+		return errors.Wrap(err, "could not reset boosted proposer root in fork choice store")
+	}
+	return nil
+}
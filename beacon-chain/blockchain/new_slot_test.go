@@ -0,0 +1,32 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_NewSlot_ResetsProposerBoost(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	})
+	require.NoError(t, err)
+	service.genesisTime = time.Unix(1606824023, 0)
+
+	root := [32]byte{'a'}
+	arrivalTime := service.genesisTime.Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	require.NoError(t, service.cfg.ForkChoiceStore.BoostProposerRoot(ctx, 1, root, service.genesisTime, arrivalTime))
+	assert.Equal(t, root, service.ProtoArrayStore().ProposerBoostRoot())
+
+	require.NoError(t, service.NewSlot(ctx))
+	assert.Equal(t, [32]byte{}, service.ProtoArrayStore().ProposerBoostRoot())
+}
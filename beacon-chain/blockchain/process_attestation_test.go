@@ -3,6 +3,7 @@ package blockchain
 import (
 	"context"
 	"testing"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -50,6 +51,7 @@ func TestStore_OnAttestation_ErrorConditions(t *testing.T) {
 
 	s, err := testutil.NewBeaconState()
 	require.NoError(t, err)
+	service.genesisTime = time.Unix(int64(s.GenesisTime()), 0)
 	require.NoError(t, s.SetSlot(100*params.BeaconConfig().SlotsPerEpoch))
 	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, s, BlkWithStateBadAttRoot))
 
@@ -10,6 +10,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	core "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -83,7 +84,7 @@ var initialSyncBlockCacheSize = uint64(2 * params.BeaconConfig().SlotsPerEpoch)
 //            ancestor_at_finalized_slot = get_ancestor(store, store.justified_checkpoint.root, finalized_slot)
 //            if ancestor_at_finalized_slot != store.finalized_checkpoint.root:
 //                store.justified_checkpoint = state.current_justified_checkpoint
-func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, blockRoot [32]byte) error {
+func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, blockRoot [32]byte, receivedTime time.Time) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.onBlock")
 	defer span.End()
 
@@ -97,7 +98,7 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 		return err
 	}
 
-	postState, err := core.ExecuteStateTransition(ctx, preState, signed)
+	postState, err := core.ExecuteStateTransition(ctx, preState, signed, s.payloadVerifier())
 	if err != nil {
 		return err
 	}
@@ -106,6 +107,18 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 		return err
 	}
 
+	// Boost the block in fork choice if it arrived in time during its own slot. This is done
+	// before any head update, including the timely one below, so that it accounts for the boost.
+	if err := s.cfg.ForkChoiceStore.BoostProposerRoot(ctx, b.Slot(), blockRoot, s.genesisTime, receivedTime); err != nil {
+		return errors.Wrap(err, "could not boost proposer root in fork choice store")
+	}
+
+	// Feed the block's attestations to the in-process slasher in the background, as
+	// converting them to indexed form is not needed for block processing itself.
+	if s.cfg.SlasherAttestationsFeed != nil {
+		go s.sendBlockAttestationsToSlasher(signed, postState)
+	}
+
 	// Updating next slot state cache can happen in the background. It shouldn't block rest of the process.
 	if featureconfig.Get().EnableNextSlotStateCache {
 		go func() {
@@ -230,7 +243,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []block.SignedBeaconBlo
 	var set *bls.SignatureSet
 	boundaries := make(map[[32]byte]state.BeaconState)
 	for i, b := range blks {
-		set, preState, err = core.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
+		set, preState, err = core.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b, s.payloadVerifier())
 		if err != nil {
 			return nil, nil, err
 		}
@@ -408,3 +421,27 @@ func (s *Service) savePostStateInfo(ctx context.Context, r [32]byte, b block.Sig
 	}
 	return nil
 }
+
+// Converts the attestations of a block to indexed form using the committees of the given
+// state and feeds them to the in-process slasher.
+func (s *Service) sendBlockAttestationsToSlasher(signed block.SignedBeaconBlock, st state.ReadOnlyBeaconState) {
+	// Using a background context, as this runs after the block processing context is done.
+	ctx := context.Background()
+	for _, att := range signed.Block().Body().Attestations() {
+		committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			log.WithError(err).Error("Could not get attestation committee")
+			continue
+		}
+		indexedAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee)
+		if err != nil {
+			log.WithError(err).Error("Could not convert to indexed attestation")
+			continue
+		}
+		// Attestations included in a block were not received over gossip, so
+		// no gossip provenance is attached.
+		s.cfg.SlasherAttestationsFeed.Send(&slashertypes.IndexedAttestationWrapper{
+			IndexedAttestation: indexedAtt,
+		})
+	}
+}
@@ -10,13 +10,16 @@ import (
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	core "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
@@ -26,6 +29,7 @@ import (
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -51,6 +55,7 @@ func TestStore_OnBlock(t *testing.T) {
 	require.NoError(t, err)
 	st, err := testutil.NewBeaconState()
 	require.NoError(t, err)
+	service.genesisTime = time.Unix(int64(st.GenesisTime()), 0)
 	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, st.Copy(), validGenesisRoot))
 	roots, err := blockTree1(t, beaconDB, validGenesisRoot[:])
 	require.NoError(t, err)
@@ -123,7 +128,7 @@ func TestStore_OnBlock(t *testing.T) {
 
 			root, err := tt.blk.Block.HashTreeRoot()
 			assert.NoError(t, err)
-			err = service.onBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(tt.blk), root)
+			err = service.onBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(tt.blk), root, timeutils.Now())
 			assert.ErrorContains(t, tt.wantErrString, err)
 		})
 	}
@@ -161,7 +166,7 @@ func TestStore_OnBlockBatch(t *testing.T) {
 	for i := 1; i < 10; i++ {
 		b, err := testutil.GenerateFullBlock(bState, keys, testutil.DefaultBlockGenConfig(), types.Slot(i))
 		require.NoError(t, err)
-		bState, err = core.ExecuteStateTransition(ctx, bState, wrapper.WrappedPhase0SignedBeaconBlock(b))
+		bState, err = core.ExecuteStateTransition(ctx, bState, wrapper.WrappedPhase0SignedBeaconBlock(b), nil)
 		require.NoError(t, err)
 		if i == 1 {
 			firstState = bState.Copy()
@@ -572,6 +577,16 @@ func TestCurrentSlot_HandlesOverflow(t *testing.T) {
 	slot := svc.CurrentSlot()
 	require.Equal(t, types.Slot(0), slot, "Unexpected slot")
 }
+
+func TestCurrentSlot_Clock(t *testing.T) {
+	genesisTime := time.Unix(1000, 0)
+	now := genesisTime.Add(3 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	svc, err := NewService(context.Background(), &Config{Clock: func() time.Time { return now }})
+	require.NoError(t, err)
+	svc.genesisTime = genesisTime
+	require.Equal(t, types.Slot(3), svc.CurrentSlot())
+	require.Equal(t, now, svc.now())
+}
 func TestAncestorByDB_CtxErr(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	service, err := NewService(ctx, &Config{})
@@ -951,7 +966,7 @@ func TestOnBlock_CanFinalize(t *testing.T) {
 		require.NoError(t, err)
 		r, err := blk.Block.HashTreeRoot()
 		require.NoError(t, err)
-		require.NoError(t, service.onBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk), r))
+		require.NoError(t, service.onBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk), r, timeutils.Now()))
 		testState, err = service.cfg.StateGen.StateByRoot(ctx, r)
 		require.NoError(t, err)
 	}
@@ -1001,3 +1016,39 @@ func TestInsertFinalizedDeposits(t *testing.T) {
 		assert.DeepEqual(t, [][]byte(nil), d.Proof, "Proofs are not empty")
 	}
 }
+
+func TestSendBlockAttestationsToSlasher_SkipsInvalidAttestations(t *testing.T) {
+	validators := make([]*ethpb.Validator, 64)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:        make([]byte, 48),
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+		}
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators))
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+
+	valid := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.NewBitlist(uint64(len(committee)))})
+	valid.AggregationBits.SetBitAt(0, true)
+	b := testutil.NewBeaconBlock()
+	b.Block.Body.Attestations = []*ethpb.Attestation{
+		// The bits of this attestation do not match the committee, so it cannot be converted.
+		testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.NewBitlist(uint64(len(committee)) + 1)}),
+		valid,
+	}
+
+	feed := new(event.Feed)
+	ch := make(chan *slashertypes.IndexedAttestationWrapper, 2)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	s := &Service{cfg: &Config{SlasherAttestationsFeed: feed}}
+	s.sendBlockAttestationsToSlasher(wrapper.WrappedPhase0SignedBeaconBlock(b), st)
+	require.Equal(t, 1, len(ch))
+	indexed := <-ch
+	assert.DeepEqual(t, []uint64{uint64(committee[0])}, indexed.IndexedAttestation.AttestingIndices)
+	assert.Equal(t, (*ethpb.GossipProvenance)(nil), indexed.Provenance)
+}
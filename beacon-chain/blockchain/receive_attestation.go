@@ -48,7 +48,7 @@ func (s *Service) AttestationPreState(ctx context.Context, att *ethpb.Attestatio
 	if err != nil {
 		return nil, err
 	}
-	if err := helpers.ValidateSlotClock(ss, uint64(s.genesisTime.Unix())); err != nil {
+	if err := helpers.ValidateSlotClockAt(ss, uint64(s.genesisTime.Unix()), s.now()); err != nil {
 		return nil, err
 	}
 	return s.getAttPreState(ctx, att.Data.Target)
@@ -119,6 +119,9 @@ func (s *Service) processAttestationsRoutine(subscribedToStateEvents chan<- stru
 		case <-s.ctx.Done():
 			return
 		case <-st.C():
+			if err := s.NewSlot(s.ctx); err != nil {
+				log.WithError(err).Error("Could not process new slot")
+			}
 			// Continue when there's no fork choice attestation, there's nothing to process and update head.
 			// This covers the condition when the node is still initial syncing to the head of the chain.
 			if s.cfg.AttPool.ForkchoiceAttestationCount() == 0 {
@@ -23,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -203,6 +204,56 @@ func TestService_ReceiveBlockUpdateHead(t *testing.T) {
 	assert.Equal(t, 2, len(s.cfg.ForkChoiceStore.Nodes()))
 }
 
+// boostRecordingForkChoice records the boosted proposer root of the store whenever the head
+// is computed.
+type boostRecordingForkChoice struct {
+	*protoarray.ForkChoice
+	boostAtHead [][32]byte
+}
+
+func (f *boostRecordingForkChoice) Head(
+	ctx context.Context, justifiedEpoch types.Epoch, justifiedRoot [32]byte, balances []uint64, finalizedEpoch types.Epoch,
+) ([32]byte, error) {
+	f.boostAtHead = append(f.boostAtHead, f.Store().ProposerBoostRoot())
+	return f.ForkChoice.Head(ctx, justifiedEpoch, justifiedRoot, balances, finalizedEpoch)
+}
+
+func TestService_ReceiveBlock_UpdateHeadTimely_BoostsProposer(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{UpdateHeadTimely: true})
+	defer resetCfg()
+	ctx := context.Background()
+	genesis, keys := testutil.DeterministicGenesisState(t, 64)
+	b, err := testutil.GenerateFullBlock(genesis, keys, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	beaconDB := testDB.SetupDB(t)
+	genesisBlockRoot := bytesutil.ToBytes32(nil)
+	require.NoError(t, beaconDB.SaveState(ctx, genesis, genesisBlockRoot))
+	fc := &boostRecordingForkChoice{ForkChoice: protoarray.New(0, 0, genesisBlockRoot)}
+	s, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		ForkChoiceStore: fc,
+		AttPool:         attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		StateNotifier:   &blockchainTesting.MockStateNotifier{RecordEvents: true},
+		StateGen:        stategen.New(beaconDB),
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.saveGenesisData(ctx, genesis))
+	gBlk, err := s.cfg.BeaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	gRoot, err := gBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	s.finalizedCheckpt = &ethpb.Checkpoint{Root: gRoot[:]}
+	// The block arrives at the start of its slot.
+	s.genesisTime = timeutils.Now().Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.ReceiveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b), root))
+	require.NotEqual(t, 0, len(fc.boostAtHead))
+	assert.Equal(t, root, fc.boostAtHead[0], "Expected the head update of the block to account for its proposer boost")
+}
+
 func TestService_ReceiveBlockBatch(t *testing.T) {
 	ctx := context.Background()
 
@@ -302,7 +353,7 @@ func TestService_ReceiveBlockBatch_UpdateFinalizedCheckpoint(t *testing.T) {
 	for i := types.Slot(1); i < params.BeaconConfig().SlotsPerEpoch*5; i++ {
 		b, err := testutil.GenerateFullBlock(copied, keys, testutil.DefaultBlockGenConfig(), i)
 		assert.NoError(t, err)
-		copied, err = state.ExecuteStateTransition(context.Background(), copied, wrapper.WrappedPhase0SignedBeaconBlock(b))
+		copied, err = state.ExecuteStateTransition(context.Background(), copied, wrapper.WrappedPhase0SignedBeaconBlock(b), nil)
 		assert.NoError(t, err)
 		r, err := b.Block.HashTreeRoot()
 		require.NoError(t, err)
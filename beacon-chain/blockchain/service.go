@@ -3,6 +3,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"runtime"
@@ -25,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
@@ -32,6 +34,7 @@ import (
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/copyutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
@@ -83,6 +86,11 @@ type Config struct {
 	AttService              *attestations.Service
 	StateGen                *stategen.State
 	WeakSubjectivityCheckpt *ethpb.Checkpoint
+	SlasherAttestationsFeed *event.Feed
+	ExecutionEngineCaller   engine.Caller
+	// Clock returns the current time, which the current slot and the timeliness of blocks and
+	// attestations are computed from. It defaults to the local clock.
+	Clock func() time.Time
 }
 
 // NewService instantiates a new block service instance that will
@@ -239,6 +247,30 @@ func (s *Service) Start() {
 	go s.processAttestationsRoutine(attestationProcessorSubscribed)
 }
 
+// StartFromAnchor initializes the chain from an anchor state and block, which are trusted as both
+// the justified and the finalized checkpoint like in the fork choice store of the spec. A genesis
+// anchor initializes the chain as at chain start. The routine which processes fork choice
+// attestations and resets the proposer boost every slot is not started: the caller drives the
+// clock and the fork choice of the chain, as the fork choice spec tests do.
+func (s *Service) StartFromAnchor(
+	ctx context.Context, anchorState state.BeaconState, anchorBlock block.SignedBeaconBlock,
+) error {
+	if anchorBlock == nil || anchorBlock.IsNil() {
+		return errors.New("nil anchor block")
+	}
+	stateRoot, err := anchorState.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash tree root anchor state")
+	}
+	if !bytes.Equal(anchorBlock.Block().StateRoot(), stateRoot[:]) {
+		return errors.New("anchor block state root does not match the anchor state")
+	}
+	if anchorBlock.Block().Slot() == 0 {
+		return s.saveGenesisData(ctx, anchorState)
+	}
+	return s.saveAnchorData(ctx, anchorState, anchorBlock)
+}
+
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
 // deposit contract, initializes the beacon chain's state, and kicks off the beacon chain.
 func (s *Service) processChainStartTime(ctx context.Context, genesisTime time.Time) {
@@ -346,6 +378,7 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState state.Beacon
 	}
 
 	s.genesisRoot = genesisBlkRoot
+	s.genesisTime = time.Unix(int64(genesisState.GenesisTime()), 0)
 	s.cfg.StateGen.SaveFinalizedState(0 /*slot*/, genesisBlkRoot, genesisState)
 
 	// Finalized checkpoint at genesis is a zero hash.
@@ -374,6 +407,65 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState state.Beacon
 	return nil
 }
 
+// This saves a non-genesis anchor state and block in db, and initializes the checkpoints, the fork
+// choice store and the head of the chain with them. The anchor is both justified and finalized at the
+// current epoch of the anchor state.
+func (s *Service) saveAnchorData(
+	ctx context.Context, anchorState state.BeaconState, anchorBlock block.SignedBeaconBlock,
+) error {
+	anchorRoot, err := anchorBlock.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get anchor block root")
+	}
+	if err := s.cfg.BeaconDB.SaveBlock(ctx, anchorBlock); err != nil {
+		return errors.Wrap(err, "could not save anchor block")
+	}
+	// The anchor is the oldest block in db, indexing finalized blocks walks up their ancestry until it.
+	// The genesis root of the service stays unset as the genesis block is not known.
+	if err := s.cfg.BeaconDB.SaveGenesisBlockRoot(ctx, anchorRoot); err != nil {
+		return errors.Wrap(err, "could not save anchor block root")
+	}
+	if err := s.cfg.BeaconDB.SaveState(ctx, anchorState, anchorRoot); err != nil {
+		return errors.Wrap(err, "could not save anchor state")
+	}
+	anchorCheckpoint := &ethpb.Checkpoint{
+		Epoch: helpers.CurrentEpoch(anchorState),
+		Root:  anchorRoot[:],
+	}
+	if err := s.cfg.BeaconDB.SaveJustifiedCheckpoint(ctx, anchorCheckpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.cfg.BeaconDB.SaveFinalizedCheckpoint(ctx, anchorCheckpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+
+	s.genesisTime = time.Unix(int64(anchorState.GenesisTime()), 0)
+	s.cfg.StateGen.SaveFinalizedState(anchorBlock.Block().Slot(), anchorRoot, anchorState)
+
+	s.justifiedCheckpt = copyutil.CopyCheckpoint(anchorCheckpoint)
+	if err := s.cacheJustifiedStateBalances(ctx, anchorRoot); err != nil {
+		return err
+	}
+	s.prevJustifiedCheckpt = copyutil.CopyCheckpoint(anchorCheckpoint)
+	s.bestJustifiedCheckpt = copyutil.CopyCheckpoint(anchorCheckpoint)
+	s.finalizedCheckpt = copyutil.CopyCheckpoint(anchorCheckpoint)
+	s.prevFinalizedCheckpt = copyutil.CopyCheckpoint(anchorCheckpoint)
+
+	s.resumeForkChoice(anchorCheckpoint, anchorCheckpoint)
+	if err := s.cfg.ForkChoiceStore.ProcessBlock(ctx,
+		anchorBlock.Block().Slot(),
+		anchorRoot,
+		bytesutil.ToBytes32(anchorBlock.Block().ParentRoot()),
+		bytesutil.ToBytes32(anchorBlock.Block().Body().Graffiti()),
+		anchorCheckpoint.Epoch,
+		anchorCheckpoint.Epoch); err != nil {
+		return errors.Wrap(err, "could not process anchor block for fork choice")
+	}
+
+	s.setHead(anchorRoot, anchorBlock, anchorState)
+	return nil
+}
+
 // This gets called to initialize chain info variables using the finalized checkpoint stored in DB
 func (s *Service) initializeChainInfo(ctx context.Context) error {
 	genesisBlock, err := s.cfg.BeaconDB.GenesisBlock(ctx)
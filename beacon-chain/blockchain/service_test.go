@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
@@ -522,3 +523,101 @@ func BenchmarkHasBlockForkChoiceStore(b *testing.B) {
 		require.Equal(b, true, s.cfg.ForkChoiceStore.HasNode(r), "Block is not in fork choice store")
 	}
 }
+
+func TestChainService_StartFromAnchor_Genesis(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	chainService, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	})
+	require.NoError(t, err)
+
+	genesisState := anchorTestState(t, 0)
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlk := b.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, chainService.StartFromAnchor(ctx, genesisState, wrapper.WrappedPhase0SignedBeaconBlock(genesisBlk)))
+
+	assert.Equal(t, time.Unix(1606824023, 0), chainService.GenesisTime())
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, genesisRoot))
+	headRoot, err := chainService.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesisRoot[:], headRoot)
+	assert.Equal(t, true, chainService.cfg.ForkChoiceStore.HasNode(genesisRoot))
+	assert.DeepEqual(t, []uint64{params.BeaconConfig().MaxEffectiveBalance}, chainService.getJustifiedBalances())
+}
+
+func TestChainService_StartFromAnchor_NonGenesis(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	chainService, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	})
+	require.NoError(t, err)
+
+	anchorSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	anchorState := anchorTestState(t, anchorSlot)
+	stateRoot, err := anchorState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	anchorBlk := testutil.NewBeaconBlock()
+	anchorBlk.Block.Slot = anchorSlot
+	anchorBlk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	anchorBlk.Block.StateRoot = stateRoot[:]
+	require.NoError(t, chainService.StartFromAnchor(ctx, anchorState, wrapper.WrappedPhase0SignedBeaconBlock(anchorBlk)))
+
+	anchorRoot, err := anchorBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	anchorCheckpoint := &ethpb.Checkpoint{Epoch: 2, Root: anchorRoot[:]}
+	assert.DeepEqual(t, anchorCheckpoint, chainService.CurrentJustifiedCheckpt())
+	assert.DeepEqual(t, anchorCheckpoint, chainService.FinalizedCheckpt())
+	finalized, err := beaconDB.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, anchorCheckpoint, finalized)
+	headRoot, err := chainService.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, anchorRoot[:], headRoot)
+	assert.Equal(t, anchorSlot, chainService.HeadSlot())
+	assert.Equal(t, true, chainService.cfg.ForkChoiceStore.HasNode(anchorRoot))
+	assert.Equal(t, types.Epoch(2), chainService.ProtoArrayStore().JustifiedEpoch())
+	assert.Equal(t, types.Epoch(2), chainService.ProtoArrayStore().FinalizedEpoch())
+	assert.DeepEqual(t, []uint64{params.BeaconConfig().MaxEffectiveBalance}, chainService.getJustifiedBalances())
+}
+
+func TestChainService_StartFromAnchor_StateRootMismatch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	chainService, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	})
+	require.NoError(t, err)
+
+	anchorBlk := testutil.NewBeaconBlock()
+	anchorBlk.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	err = chainService.StartFromAnchor(ctx, anchorTestState(t, anchorBlk.Block.Slot), wrapper.WrappedPhase0SignedBeaconBlock(anchorBlk))
+	assert.ErrorContains(t, "anchor block state root does not match the anchor state", err)
+}
+
+// anchorTestState returns a state at the given slot with a single active validator.
+func anchorTestState(t *testing.T, slot types.Slot) *v1.BeaconState {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetGenesisTime(1606824023))
+	require.NoError(t, st.SetValidators([]*ethpb.Validator{{
+		PublicKey:             make([]byte, 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+		ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+	}}))
+	require.NoError(t, st.SetBalances([]uint64{params.BeaconConfig().MaxEffectiveBalance}))
+	return st
+}
@@ -36,6 +36,7 @@ type DepositFetcher interface {
 	AllDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit
 	DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.Deposit, *big.Int)
 	DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte)
+	DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error)
 	FinalizedDeposits(ctx context.Context) *FinalizedDeposits
 	NonFinalizedDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit
 }
@@ -54,7 +55,10 @@ type DepositCache struct {
 	pendingDeposits   []*dbpb.DepositContainer
 	deposits          []*dbpb.DepositContainer
 	finalizedDeposits *FinalizedDeposits
-	depositsLock      sync.RWMutex
+	// The snapshot the cache was started from, if any. Deposits before the snapshot are not
+	// held by the cache.
+	snapshot     *ethpb.DepositSnapshot
+	depositsLock sync.RWMutex
 }
 
 // New instantiates a new deposit cache
@@ -90,8 +94,8 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if int(index) != len(dc.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(dc.deposits), index)
+	if wanted := dc.firstDepositIndex() + int64(len(dc.deposits)); index != wanted {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wanted, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
@@ -184,9 +188,13 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		// Deposits before the snapshot the cache was started from are only known through the snapshot.
+		if dc.snapshot != nil && blockHeight.Uint64() >= dc.snapshot.ExecutionBlockHeight {
+			return dc.snapshot.DepositCount, bytesutil.ToBytes32(dc.snapshot.DepositRoot)
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return uint64(dc.firstDepositIndex()) + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -252,11 +260,13 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(dc.deposits)) {
-		untilDepositIndex = int64(len(dc.deposits) - 1)
+	// Deposits are stored by index, starting from the first deposit held by the cache.
+	untilPos := untilDepositIndex - dc.firstDepositIndex()
+	if untilPos >= int64(len(dc.deposits)) {
+		untilPos = int64(len(dc.deposits) - 1)
 	}
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := untilPos; i >= 0; i-- {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
@@ -269,3 +279,15 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 
 	return nil
 }
+
+// firstDepositIndex returns the index of the first deposit held by the cache. It is only
+// different from zero if the cache was started from a deposit snapshot.
+func (dc *DepositCache) firstDepositIndex() int64 {
+	if len(dc.deposits) > 0 {
+		return dc.deposits[0].Index
+	}
+	if dc.snapshot != nil {
+		return int64(dc.snapshot.DepositCount)
+	}
+	return 0
+}
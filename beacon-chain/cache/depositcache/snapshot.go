@@ -0,0 +1,88 @@
+package depositcache
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"go.opencensus.io/trace"
+)
+
+// DepositSnapshot returns a snapshot of the finalized deposits trie, or nil if no deposit
+// has been finalized yet.
+func (dc *DepositCache) DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	depositTrie := dc.finalizedDeposits.Deposits
+	count := uint64(depositTrie.NumOfItems())
+	if count == 0 {
+		return nil, nil
+	}
+	finalized, err := depositTrie.FinalizedBranches(count)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute finalized branches")
+	}
+	blockHeight, err := dc.depositBlockHeight(int64(count) - 1)
+	if err != nil {
+		return nil, err
+	}
+	root := depositTrie.HashTreeRoot()
+	return &ethpb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          root[:],
+		DepositCount:         count,
+		ExecutionBlockHeight: blockHeight,
+	}, nil
+}
+
+// InsertDepositSnapshot sets the finalized deposits trie from a snapshot, so that the cache
+// does not need the deposits before it. It must be called before any deposit is finalized.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	if snapshot == nil {
+		return errors.New("nil deposit snapshot")
+	}
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if dc.finalizedDeposits.MerkleTrieIndex >= 0 {
+		return errors.New("deposit cache already has finalized deposits")
+	}
+	depositTrie, err := trieutil.CreateTrieFromSnapshot(snapshot.Finalized, snapshot.DepositCount, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	if root := depositTrie.HashTreeRoot(); !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return errors.Errorf("deposit snapshot has root %#x, but its branches have root %#x", snapshot.DepositRoot, root)
+	}
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        depositTrie,
+		MerkleTrieIndex: int64(snapshot.DepositCount) - 1,
+	}
+	dc.snapshot = &ethpb.DepositSnapshot{
+		Finalized:            bytesutil.Copy2dBytes(snapshot.Finalized),
+		DepositRoot:          bytesutil.SafeCopyBytes(snapshot.DepositRoot),
+		DepositCount:         snapshot.DepositCount,
+		ExecutionBlockHeight: snapshot.ExecutionBlockHeight,
+	}
+	return nil
+}
+
+// depositBlockHeight returns the eth1 block height of the deposit with the given index.
+func (dc *DepositCache) depositBlockHeight(index int64) (uint64, error) {
+	if pos := index - dc.firstDepositIndex(); pos >= 0 && pos < int64(len(dc.deposits)) {
+		return dc.deposits[pos].Eth1BlockHeight, nil
+	}
+	if dc.snapshot != nil && int64(dc.snapshot.DepositCount)-1 == index {
+		return dc.snapshot.ExecutionBlockHeight, nil
+	}
+	return 0, errors.Errorf("no deposit with index %d in the cache", index)
+}
@@ -0,0 +1,116 @@
+package depositcache
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func snapshotTestDeposit(i int) *ethpb.Deposit {
+	return &ethpb.Deposit{
+		Proof: [][]byte{bytesutil.PadTo([]byte{byte(i)}, 32)},
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                32,
+			Signature:             make([]byte, 96),
+		},
+	}
+}
+
+// insertSnapshotTestDeposits inserts deposits from index start to end (exclusive), with deposit i
+// in block 10+i.
+func insertSnapshotTestDeposits(t *testing.T, dc *DepositCache, start, end int) {
+	for i := start; i < end; i++ {
+		root := [32]byte{byte(i)}
+		require.NoError(t, dc.InsertDeposit(context.Background(), snapshotTestDeposit(i), uint64(10+i), int64(i), root))
+	}
+}
+
+func TestDepositSnapshot_NoFinalizedDeposits(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+	insertSnapshotTestDeposits(t, dc, 0, 3)
+
+	snapshot, err := dc.DepositSnapshot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.DepositSnapshot)(nil), snapshot)
+}
+
+func TestDepositSnapshot_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	full, err := New()
+	require.NoError(t, err)
+	insertSnapshotTestDeposits(t, full, 0, 11)
+	full.InsertFinalizedDeposits(ctx, 6)
+
+	snapshot, err := full.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(7), snapshot.DepositCount)
+	assert.Equal(t, 3, len(snapshot.Finalized))
+	assert.Equal(t, uint64(16), snapshot.ExecutionBlockHeight)
+	root := full.FinalizedDeposits(ctx).Deposits.HashTreeRoot()
+	assert.DeepEqual(t, root[:], snapshot.DepositRoot)
+
+	// Start a cache from the snapshot, and give it the deposits after the snapshot.
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertDepositSnapshot(ctx, snapshot))
+	assert.DeepEqual(t, root, dc.FinalizedDeposits(ctx).Deposits.HashTreeRoot())
+	assert.Equal(t, int64(6), dc.FinalizedDeposits(ctx).MerkleTrieIndex)
+	count, depositRoot := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(17))
+	assert.Equal(t, uint64(7), count)
+	assert.Equal(t, root, depositRoot)
+
+	require.ErrorContains(t, "wanted deposit with index 7", dc.InsertDeposit(ctx, snapshotTestDeposit(0), 10, 0, [32]byte{}))
+	insertSnapshotTestDeposits(t, dc, 7, 11)
+	assert.Equal(t, 4, len(dc.NonFinalizedDeposits(ctx, nil)))
+	count, depositRoot = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(18))
+	assert.Equal(t, uint64(9), count)
+	assert.Equal(t, [32]byte{8}, depositRoot)
+
+	// Finalizing more deposits gives the same trie as in the cache with all deposits.
+	full.InsertFinalizedDeposits(ctx, 9)
+	dc.InsertFinalizedDeposits(ctx, 9)
+	assert.Equal(t, full.FinalizedDeposits(ctx).Deposits.HashTreeRoot(), dc.FinalizedDeposits(ctx).Deposits.HashTreeRoot())
+	wanted, err := full.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	got, err := dc.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, got)
+
+	// Proofs are pruned by deposit index.
+	require.NoError(t, dc.PruneProofs(ctx, 8))
+	deposits := dc.AllDeposits(ctx, nil)
+	require.Equal(t, 4, len(deposits))
+	assert.Equal(t, true, deposits[1].Proof == nil)
+	assert.Equal(t, false, deposits[2].Proof == nil)
+}
+
+func TestInsertDepositSnapshot_Invalid(t *testing.T) {
+	ctx := context.Background()
+	full, err := New()
+	require.NoError(t, err)
+	insertSnapshotTestDeposits(t, full, 0, 5)
+	full.InsertFinalizedDeposits(ctx, 4)
+	snapshot, err := full.DepositSnapshot(ctx)
+	require.NoError(t, err)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.ErrorContains(t, "nil deposit snapshot", dc.InsertDepositSnapshot(ctx, nil))
+	wrongRoot := &ethpb.DepositSnapshot{
+		Finalized:    snapshot.Finalized,
+		DepositRoot:  make([]byte, 32),
+		DepositCount: snapshot.DepositCount,
+	}
+	require.ErrorContains(t, "but its branches have root", dc.InsertDepositSnapshot(ctx, wrongRoot))
+
+	require.ErrorContains(t, "already has finalized deposits", full.InsertDepositSnapshot(ctx, snapshot))
+}
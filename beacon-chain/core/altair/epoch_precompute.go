@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"go.opencensus.io/trace"
 )
 
@@ -197,15 +198,19 @@ func AttestationsDelta(state state.BeaconStateAltair, bal *precompute.Balance, v
 	penalties = make([]uint64, numOfVals)
 	prevEpoch := helpers.PrevEpoch(state)
 	finalizedEpoch := state.FinalizedCheckpointEpoch()
+	inactivityPenaltyQuotient := params.BeaconConfig().InactivityPenaltyQuotientAltair
+	if state.Version() == version.Bellatrix {
+		inactivityPenaltyQuotient = params.BeaconConfig().InactivityPenaltyQuotientBellatrix
+	}
 
 	for i, v := range vals {
-		rewards[i], penalties[i] = attestationDelta(bal, v, prevEpoch, finalizedEpoch)
+		rewards[i], penalties[i] = attestationDelta(bal, v, prevEpoch, finalizedEpoch, inactivityPenaltyQuotient)
 	}
 
 	return rewards, penalties, nil
 }
 
-func attestationDelta(bal *precompute.Balance, v *precompute.Validator, prevEpoch, finalizedEpoch types.Epoch) (r, p uint64) {
+func attestationDelta(bal *precompute.Balance, v *precompute.Validator, prevEpoch, finalizedEpoch types.Epoch, inactivityPenaltyQuotient uint64) (r, p uint64) {
 	eligible := v.IsActivePrevEpoch || (v.IsSlashed && !v.IsWithdrawableCurrentEpoch)
 	if !eligible || bal.ActiveCurrentEpoch == 0 {
 		return 0, 0
@@ -249,7 +254,7 @@ func attestationDelta(bal *precompute.Balance, v *precompute.Validator, prevEpoc
 	// Apply an additional penalty to validators that did not vote on the correct target or slashed.
 	if !v.IsPrevEpochTargetAttester || v.IsSlashed {
 		penaltyNumerator := eb * v.InactivityScore
-		penaltyDenominator := params.BeaconConfig().InactivityScoreBias * params.BeaconConfig().InactivityPenaltyQuotientAltair
+		penaltyDenominator := params.BeaconConfig().InactivityScoreBias * inactivityPenaltyQuotient
 		p += penaltyNumerator / penaltyDenominator
 	}
 
@@ -8,6 +8,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 )
 
 // ProcessSyncCommitteeUpdates  processes sync client committee updates for the beacon state.
@@ -63,7 +64,8 @@ func ProcessParticipationFlagUpdates(beaconState state.BeaconStateAltair) (state
 }
 
 // ProcessSlashings processes the slashed validators during epoch processing,
-// The function is modified to use PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR.
+// The function is modified to use PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR, or
+// PROPORTIONAL_SLASHING_MULTIPLIER_BELLATRIX for a Bellatrix state.
 //
 // Spec code:
 //  def process_slashings(state: BeaconState) -> None:
@@ -97,7 +99,11 @@ func ProcessSlashings(state state.BeaconState) (state.BeaconState, error) {
 	// a callback is used here to apply the following actions  to all validators
 	// below equally.
 	increment := params.BeaconConfig().EffectiveBalanceIncrement
-	minSlashing := mathutil.Min(totalSlashing*params.BeaconConfig().ProportionalSlashingMultiplierAltair, totalBalance)
+	proportionalSlashingMultiplier := params.BeaconConfig().ProportionalSlashingMultiplierAltair
+	if state.Version() == version.Bellatrix {
+		proportionalSlashingMultiplier = params.BeaconConfig().ProportionalSlashingMultiplierBellatrix
+	}
+	minSlashing := mathutil.Min(totalSlashing*proportionalSlashingMultiplier, totalBalance)
 	err = state.ApplyToEveryValidator(func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
 		correctEpoch := (currentEpoch + exitLength/2) == val.WithdrawableEpoch
 		if val.Slashed && correctEpoch {
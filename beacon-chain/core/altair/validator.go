@@ -7,10 +7,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 )
 
 // SlashValidator with slashed index.
-// The function  is modified to use MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR and use PROPOSER_WEIGHT when calculating the proposer reward.
+// The function  is modified to use MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR (MIN_SLASHING_PENALTY_QUOTIENT_BELLATRIX for a Bellatrix state) and use PROPOSER_WEIGHT when calculating the proposer reward.
 //
 // def slash_validator(state: BeaconState,
 //                    slashed_index: ValidatorIndex,
@@ -61,7 +62,11 @@ func SlashValidator(state state.BeaconState, slashedIdx types.ValidatorIndex) (s
 	); err != nil {
 		return nil, err
 	}
-	if err := helpers.DecreaseBalance(state, slashedIdx, validator.EffectiveBalance/params.BeaconConfig().MinSlashingPenaltyQuotientAltair); err != nil {
+	minSlashingPenaltyQuotient := params.BeaconConfig().MinSlashingPenaltyQuotientAltair
+	if state.Version() == version.Bellatrix {
+		minSlashingPenaltyQuotient = params.BeaconConfig().MinSlashingPenaltyQuotientBellatrix
+	}
+	if err := helpers.DecreaseBalance(state, slashedIdx, validator.EffectiveBalance/minSlashingPenaltyQuotient); err != nil {
 		return nil, err
 	}
 
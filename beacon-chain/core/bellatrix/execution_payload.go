@@ -0,0 +1,245 @@
+package bellatrix
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ErrInvalidPayload is returned when the payload verifier rejects the execution payload of a block.
+var ErrInvalidPayload = errors.New("execution payload is invalid")
+
+// ErrNoPayloadVerifier is returned when an execution payload has to be verified, but no execution
+// engine is configured.
+var ErrNoPayloadVerifier = errors.New("no execution engine to verify the execution payload")
+
+// PayloadVerifier verifies execution payloads against an execution engine. It stands for the
+// notify_new_payload function of the execution engine in the consensus specs.
+type PayloadVerifier interface {
+	// VerifyPayload returns false if the execution engine considers the payload invalid. Payloads
+	// the execution engine cannot verify yet, as when it is syncing, are not rejected.
+	VerifyPayload(ctx context.Context, payload *enginev1.ExecutionPayload) (bool, error)
+}
+
+// VerifyExecutionPayload checks the execution payload against the verifier, as notify_new_payload
+// does in process_execution_payload. It fails when there is no verifier, so that payloads are never
+// accepted without an execution engine.
+func VerifyExecutionPayload(ctx context.Context, v PayloadVerifier, payload *enginev1.ExecutionPayload) error {
+	if v == nil {
+		return ErrNoPayloadVerifier
+	}
+	valid, err := v.VerifyPayload(ctx, payload)
+	if err != nil {
+		return errors.Wrap(err, "could not verify execution payload")
+	}
+	if !valid {
+		return ErrInvalidPayload
+	}
+	return nil
+}
+
+// IsMergeTransitionComplete returns true if the state holds the header of an execution payload,
+// which means that the merge transition block has been processed.
+//
+// Spec code:
+// def is_merge_transition_complete(state: BeaconState) -> bool:
+//    return state.latest_execution_payload_header != ExecutionPayloadHeader()
+func IsMergeTransitionComplete(st state.BeaconState) (bool, error) {
+	h, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return false, err
+	}
+	return !isEmptyHeader(h), nil
+}
+
+// IsMergeTransitionBlock returns true if the block carries the first execution payload of the
+// chain.
+//
+// Spec code:
+// def is_merge_transition_block(state: BeaconState, body: BeaconBlockBody) -> bool:
+//    return not is_merge_transition_complete(state) and body.execution_payload != ExecutionPayload()
+func IsMergeTransitionBlock(st state.BeaconState, body block.BeaconBlockBody) (bool, error) {
+	complete, err := IsMergeTransitionComplete(st)
+	if err != nil {
+		return false, err
+	}
+	if complete {
+		return false, nil
+	}
+	payload, err := body.ExecutionPayload()
+	if err != nil {
+		return false, err
+	}
+	return !isEmptyPayload(payload), nil
+}
+
+// IsExecutionEnabled returns true if the execution payload of the block has to be processed.
+//
+// Spec code:
+// def is_execution_enabled(state: BeaconState, body: BeaconBlockBody) -> bool:
+//    return is_merge_transition_block(state, body) or is_merge_transition_complete(state)
+func IsExecutionEnabled(st state.BeaconState, body block.BeaconBlockBody) (bool, error) {
+	complete, err := IsMergeTransitionComplete(st)
+	if err != nil {
+		return false, err
+	}
+	if complete {
+		return true, nil
+	}
+	return IsMergeTransitionBlock(st, body)
+}
+
+// ProcessExecutionPayload checks the consistency of the execution payload of the block with the
+// state, verifies it with the payload verifier, and stores its header in the state. It fails if
+// there is no payload verifier.
+//
+// Spec code:
+// def process_execution_payload(state: BeaconState, payload: ExecutionPayload, execution_engine: ExecutionEngine) -> None:
+//    # Verify consistency of the parent hash with respect to the previous execution payload header
+//    if is_merge_transition_complete(state):
+//        assert payload.parent_hash == state.latest_execution_payload_header.block_hash
+//    # Verify prev_randao
+//    assert payload.prev_randao == get_randao_mix(state, get_current_epoch(state))
+//    # Verify timestamp
+//    assert payload.timestamp == compute_timestamp_at_slot(state, state.slot)
+//    # Verify the execution payload is valid
+//    assert execution_engine.notify_new_payload(payload)
+//    # Cache execution payload header
+//    state.latest_execution_payload_header = ExecutionPayloadHeader(
+//        parent_hash=payload.parent_hash,
+//        fee_recipient=payload.fee_recipient,
+//        state_root=payload.state_root,
+//        receipts_root=payload.receipts_root,
+//        logs_bloom=payload.logs_bloom,
+//        prev_randao=payload.prev_randao,
+//        block_number=payload.block_number,
+//        gas_limit=payload.gas_limit,
+//        gas_used=payload.gas_used,
+//        timestamp=payload.timestamp,
+//        extra_data=payload.extra_data,
+//        base_fee_per_gas=payload.base_fee_per_gas,
+//        block_hash=payload.block_hash,
+//        transactions_root=hash_tree_root(payload.transactions),
+//    )
+func ProcessExecutionPayload(
+	ctx context.Context,
+	st state.BeaconState,
+	body block.BeaconBlockBody,
+	payloadVerifier PayloadVerifier,
+) (state.BeaconState, error) {
+	payload, err := body.ExecutionPayload()
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, errors.New("nil execution payload")
+	}
+
+	complete, err := IsMergeTransitionComplete(st)
+	if err != nil {
+		return nil, err
+	}
+	if complete {
+		header, err := st.LatestExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(payload.ParentHash, header.BlockHash) {
+			return nil, fmt.Errorf("payload parent hash %#x does not match latest block hash %#x", payload.ParentHash, header.BlockHash)
+		}
+	}
+
+	mix, err := helpers.RandaoMix(st, helpers.CurrentEpoch(st))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(payload.PrevRandao, mix) {
+		return nil, fmt.Errorf("payload prev randao %#x does not match randao mix %#x", payload.PrevRandao, mix)
+	}
+
+	t, err := helpers.SlotToTime(st.GenesisTime(), st.Slot())
+	if err != nil {
+		return nil, err
+	}
+	if payload.Timestamp != uint64(t.Unix()) {
+		return nil, fmt.Errorf("payload timestamp %d does not match slot time %d", payload.Timestamp, t.Unix())
+	}
+
+	if err := VerifyExecutionPayload(ctx, payloadVerifier, payload); err != nil {
+		return nil, err
+	}
+
+	header, err := PayloadToHeader(payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(header); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// PayloadToHeader converts an execution payload to its header, replacing the transactions with
+// their root.
+func PayloadToHeader(payload *enginev1.ExecutionPayload) (*enginev1.ExecutionPayloadHeader, error) {
+	txRoot, err := enginev1.TransactionsRoot(payload.Transactions)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute transactions root")
+	}
+	return &enginev1.ExecutionPayloadHeader{
+		ParentHash:       bytesutil.SafeCopyBytes(payload.ParentHash),
+		FeeRecipient:     bytesutil.SafeCopyBytes(payload.FeeRecipient),
+		StateRoot:        bytesutil.SafeCopyBytes(payload.StateRoot),
+		ReceiptsRoot:     bytesutil.SafeCopyBytes(payload.ReceiptsRoot),
+		LogsBloom:        bytesutil.SafeCopyBytes(payload.LogsBloom),
+		PrevRandao:       bytesutil.SafeCopyBytes(payload.PrevRandao),
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        bytesutil.SafeCopyBytes(payload.ExtraData),
+		BaseFeePerGas:    bytesutil.SafeCopyBytes(payload.BaseFeePerGas),
+		BlockHash:        bytesutil.SafeCopyBytes(payload.BlockHash),
+		TransactionsRoot: txRoot[:],
+	}, nil
+}
+
+// isEmptyPayload returns true if the payload is the default ExecutionPayload().
+func isEmptyPayload(p *enginev1.ExecutionPayload) bool {
+	if p == nil {
+		return true
+	}
+	return isZero(p.ParentHash) && isZero(p.FeeRecipient) && isZero(p.StateRoot) &&
+		isZero(p.ReceiptsRoot) && isZero(p.LogsBloom) && isZero(p.PrevRandao) &&
+		p.BlockNumber == 0 && p.GasLimit == 0 && p.GasUsed == 0 && p.Timestamp == 0 &&
+		len(p.ExtraData) == 0 && isZero(p.BaseFeePerGas) && isZero(p.BlockHash) &&
+		len(p.Transactions) == 0
+}
+
+// isEmptyHeader returns true if the header is the default ExecutionPayloadHeader().
+func isEmptyHeader(h *enginev1.ExecutionPayloadHeader) bool {
+	if h == nil {
+		return true
+	}
+	return isZero(h.ParentHash) && isZero(h.FeeRecipient) && isZero(h.StateRoot) &&
+		isZero(h.ReceiptsRoot) && isZero(h.LogsBloom) && isZero(h.PrevRandao) &&
+		h.BlockNumber == 0 && h.GasLimit == 0 && h.GasUsed == 0 && h.Timestamp == 0 &&
+		len(h.ExtraData) == 0 && isZero(h.BaseFeePerGas) && isZero(h.BlockHash) &&
+		isZero(h.TransactionsRoot)
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
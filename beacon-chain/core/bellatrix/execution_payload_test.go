@@ -0,0 +1,256 @@
+package bellatrix_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/bellatrix"
+	stateBellatrix "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockVerifier struct {
+	valid bool
+	err   error
+}
+
+func (m *mockVerifier) VerifyPayload(_ context.Context, _ *enginev1.ExecutionPayload) (bool, error) {
+	return m.valid, m.err
+}
+
+const (
+	testGenesisTime = 1000
+	testSlot        = 10
+)
+
+func testState(t *testing.T, header *enginev1.ExecutionPayloadHeader) *stateBellatrix.BeaconState {
+	st, err := testutil.NewBeaconStateBellatrix(func(s *statepb.BeaconStateBellatrix) error {
+		s.GenesisTime = testGenesisTime
+		s.Slot = testSlot
+		s.RandaoMixes[0] = bytesutil.PadTo([]byte("randao"), 32)
+		if header != nil {
+			s.LatestExecutionPayloadHeader = header
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return st
+}
+
+func testPayload() *enginev1.ExecutionPayload {
+	p := testutil.HydrateExecutionPayload(&enginev1.ExecutionPayload{
+		ParentHash:   bytesutil.PadTo([]byte("parent"), 32),
+		PrevRandao:   bytesutil.PadTo([]byte("randao"), 32),
+		BlockNumber:  1,
+		GasLimit:     30000000,
+		Timestamp:    testGenesisTime + testSlot*params.BeaconConfig().SecondsPerSlot,
+		BlockHash:    bytesutil.PadTo([]byte("block"), 32),
+		Transactions: [][]byte{[]byte("tx")},
+	})
+	p.ExtraData = []byte{}
+	return p
+}
+
+func testBody(payload *enginev1.ExecutionPayload) *prysmv2.BeaconBlockBodyBellatrix {
+	return testutil.HydrateBeaconBlockBodyBellatrix(&prysmv2.BeaconBlockBodyBellatrix{ExecutionPayload: payload})
+}
+
+func TestIsExecutionEnabled(t *testing.T) {
+	completeHeader := bellatrix.EmptyPayloadHeader()
+	completeHeader.BlockHash = bytesutil.PadTo([]byte("block"), 32)
+
+	tests := []struct {
+		name       string
+		header     *enginev1.ExecutionPayloadHeader
+		payload    *enginev1.ExecutionPayload
+		complete   bool
+		transition bool
+		enabled    bool
+	}{
+		{
+			name:    "before the merge, empty payload",
+			payload: testutil.HydrateExecutionPayload(nil),
+		},
+		{
+			name:       "merge transition block",
+			payload:    testPayload(),
+			transition: true,
+			enabled:    true,
+		},
+		{
+			name:     "after the merge",
+			header:   completeHeader,
+			payload:  testPayload(),
+			complete: true,
+			enabled:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := testState(t, tt.header)
+			body, err := wrapper.WrappedBellatrixBeaconBlockBody(testBody(tt.payload))
+			require.NoError(t, err)
+
+			complete, err := bellatrix.IsMergeTransitionComplete(st)
+			require.NoError(t, err)
+			assert.Equal(t, tt.complete, complete)
+			transition, err := bellatrix.IsMergeTransitionBlock(st, body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.transition, transition)
+			enabled, err := bellatrix.IsExecutionEnabled(st, body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.enabled, enabled)
+		})
+	}
+}
+
+func TestProcessExecutionPayload(t *testing.T) {
+	completeHeader := bellatrix.EmptyPayloadHeader()
+	completeHeader.BlockHash = bytesutil.PadTo([]byte("parent"), 32)
+
+	tests := []struct {
+		name       string
+		header     *enginev1.ExecutionPayloadHeader
+		payload    func() *enginev1.ExecutionPayload
+		verifier   bellatrix.PayloadVerifier
+		noVerifier bool
+		wantErr    string
+	}{
+		{
+			name:    "merge transition block",
+			payload: testPayload,
+		},
+		{
+			name:     "rejected by the verifier",
+			payload:  testPayload,
+			verifier: &mockVerifier{valid: false},
+			wantErr:  bellatrix.ErrInvalidPayload.Error(),
+		},
+		{
+			name:       "no verifier",
+			payload:    testPayload,
+			noVerifier: true,
+			wantErr:    bellatrix.ErrNoPayloadVerifier.Error(),
+		},
+		{
+			name:    "after the merge",
+			header:  completeHeader,
+			payload: testPayload,
+		},
+		{
+			name:   "wrong parent hash",
+			header: completeHeader,
+			payload: func() *enginev1.ExecutionPayload {
+				p := testPayload()
+				p.ParentHash = bytesutil.PadTo([]byte("other"), 32)
+				return p
+			},
+			wantErr: "does not match latest block hash",
+		},
+		{
+			name: "wrong prev randao",
+			payload: func() *enginev1.ExecutionPayload {
+				p := testPayload()
+				p.PrevRandao = make([]byte, 32)
+				return p
+			},
+			wantErr: "does not match randao mix",
+		},
+		{
+			name: "wrong timestamp",
+			payload: func() *enginev1.ExecutionPayload {
+				p := testPayload()
+				p.Timestamp++
+				return p
+			},
+			wantErr: "does not match slot time",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := testState(t, tt.header)
+			payload := tt.payload()
+			body, err := wrapper.WrappedBellatrixBeaconBlockBody(testBody(payload))
+			require.NoError(t, err)
+
+			var verifier bellatrix.PayloadVerifier = &mockVerifier{valid: true}
+			if tt.verifier != nil || tt.noVerifier {
+				verifier = tt.verifier
+			}
+			_, err = bellatrix.ProcessExecutionPayload(context.Background(), st, body, verifier)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+
+			wanted, err := bellatrix.PayloadToHeader(payload)
+			require.NoError(t, err)
+			header, err := st.LatestExecutionPayloadHeader()
+			require.NoError(t, err)
+			assert.DeepSSZEqual(t, wanted, header)
+			complete, err := bellatrix.IsMergeTransitionComplete(st)
+			require.NoError(t, err)
+			assert.Equal(t, true, complete)
+		})
+	}
+}
+
+func TestVerifyExecutionPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifier bellatrix.PayloadVerifier
+		wantErr  string
+	}{
+		{
+			name:     "valid",
+			verifier: &mockVerifier{valid: true},
+		},
+		{
+			name:     "rejected by the verifier",
+			verifier: &mockVerifier{valid: false},
+			wantErr:  bellatrix.ErrInvalidPayload.Error(),
+		},
+		{
+			name:     "verifier error",
+			verifier: &mockVerifier{err: errors.New("engine offline")},
+			wantErr:  "engine offline",
+		},
+		{
+			name:    "no verifier",
+			wantErr: bellatrix.ErrNoPayloadVerifier.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := bellatrix.VerifyExecutionPayload(context.Background(), tt.verifier, testPayload())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPayloadToHeader(t *testing.T) {
+	payload := testPayload()
+	header, err := bellatrix.PayloadToHeader(payload)
+	require.NoError(t, err)
+
+	// The header of a payload has the same root as the payload.
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, payloadRoot, headerRoot)
+}
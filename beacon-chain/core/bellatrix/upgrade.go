@@ -0,0 +1,103 @@
+package bellatrix
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statebellatrix "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// UpgradeToBellatrix updates input state to return the version Bellatrix state.
+//
+// Spec code:
+// def upgrade_to_bellatrix(pre: altair.BeaconState) -> BeaconState:
+//    epoch = altair.get_current_epoch(pre)
+//    post = BeaconState(
+//        ...
+//        fork=Fork(
+//            previous_version=pre.fork.current_version,
+//            current_version=BELLATRIX_FORK_VERSION,
+//            epoch=epoch,
+//        ),
+//        ...
+//        # Execution-layer
+//        latest_execution_payload_header=ExecutionPayloadHeader(),
+//    )
+//    return post
+func UpgradeToBellatrix(state state.BeaconState) (state.BeaconStateBellatrix, error) {
+	epoch := helpers.CurrentEpoch(state)
+
+	currentEpochParticipation, err := state.CurrentEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	previousEpochParticipation, err := state.PreviousEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	inactivityScores, err := state.InactivityScores()
+	if err != nil {
+		return nil, err
+	}
+	currentSyncCommittee, err := state.CurrentSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+	nextSyncCommittee, err := state.NextSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &statepb.BeaconStateBellatrix{
+		GenesisTime:           state.GenesisTime(),
+		GenesisValidatorsRoot: state.GenesisValidatorRoot(),
+		Slot:                  state.Slot(),
+		Fork: &statepb.Fork{
+			PreviousVersion: state.Fork().CurrentVersion,
+			CurrentVersion:  params.BeaconConfig().BellatrixForkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:            state.LatestBlockHeader(),
+		BlockRoots:                   state.BlockRoots(),
+		StateRoots:                   state.StateRoots(),
+		HistoricalRoots:              state.HistoricalRoots(),
+		Eth1Data:                     state.Eth1Data(),
+		Eth1DataVotes:                state.Eth1DataVotes(),
+		Eth1DepositIndex:             state.Eth1DepositIndex(),
+		Validators:                   state.Validators(),
+		Balances:                     state.Balances(),
+		RandaoMixes:                  state.RandaoMixes(),
+		Slashings:                    state.Slashings(),
+		PreviousEpochParticipation:   previousEpochParticipation,
+		CurrentEpochParticipation:    currentEpochParticipation,
+		JustificationBits:            state.JustificationBits(),
+		PreviousJustifiedCheckpoint:  state.PreviousJustifiedCheckpoint(),
+		CurrentJustifiedCheckpoint:   state.CurrentJustifiedCheckpoint(),
+		FinalizedCheckpoint:          state.FinalizedCheckpoint(),
+		InactivityScores:             inactivityScores,
+		CurrentSyncCommittee:         currentSyncCommittee,
+		NextSyncCommittee:            nextSyncCommittee,
+		LatestExecutionPayloadHeader: EmptyPayloadHeader(),
+	}
+
+	return statebellatrix.InitializeFromProto(s)
+}
+
+// EmptyPayloadHeader returns the default execution payload header, which the state holds until
+// the merge transition block is processed.
+func EmptyPayloadHeader() *enginev1.ExecutionPayloadHeader {
+	return &enginev1.ExecutionPayloadHeader{
+		ParentHash:       make([]byte, 32),
+		FeeRecipient:     make([]byte, 20),
+		StateRoot:        make([]byte, 32),
+		ReceiptsRoot:     make([]byte, 32),
+		LogsBloom:        make([]byte, params.BeaconConfig().BytesPerLogsBloom),
+		PrevRandao:       make([]byte, 32),
+		ExtraData:        make([]byte, 0),
+		BaseFeePerGas:    make([]byte, 32),
+		BlockHash:        make([]byte, 32),
+		TransactionsRoot: make([]byte, 32),
+	}
+}
@@ -0,0 +1,86 @@
+package bellatrix_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/bellatrix"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+func TestUpgradeToBellatrix(t *testing.T) {
+	base, err := testutil.NewBeaconStateBellatrix(func(s *statepb.BeaconStateBellatrix) error {
+		s.Slot = 3 * params.BeaconConfig().SlotsPerEpoch
+		s.Fork.CurrentVersion = params.BeaconConfig().AltairForkVersion
+		s.Balances = []uint64{1, 2, 3}
+		s.InactivityScores = []uint64{4, 5, 6}
+		s.PreviousEpochParticipation = []byte{1, 0, 1}
+		s.CurrentEpochParticipation = []byte{0, 1, 0}
+		return nil
+	})
+	require.NoError(t, err)
+	pb := base.CloneInnerState().(*statepb.BeaconStateBellatrix)
+	preState, err := stateAltair.InitializeFromProto(&statepb.BeaconStateAltair{
+		GenesisTime:                 pb.GenesisTime,
+		GenesisValidatorsRoot:       pb.GenesisValidatorsRoot,
+		Slot:                        pb.Slot,
+		Fork:                        pb.Fork,
+		LatestBlockHeader:           pb.LatestBlockHeader,
+		BlockRoots:                  pb.BlockRoots,
+		StateRoots:                  pb.StateRoots,
+		HistoricalRoots:             pb.HistoricalRoots,
+		Eth1Data:                    pb.Eth1Data,
+		Eth1DataVotes:               pb.Eth1DataVotes,
+		Validators:                  pb.Validators,
+		Balances:                    pb.Balances,
+		RandaoMixes:                 pb.RandaoMixes,
+		Slashings:                   pb.Slashings,
+		PreviousEpochParticipation:  pb.PreviousEpochParticipation,
+		CurrentEpochParticipation:   pb.CurrentEpochParticipation,
+		JustificationBits:           pb.JustificationBits,
+		PreviousJustifiedCheckpoint: pb.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  pb.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         pb.FinalizedCheckpoint,
+		InactivityScores:            pb.InactivityScores,
+		CurrentSyncCommittee:        pb.CurrentSyncCommittee,
+		NextSyncCommittee:           pb.NextSyncCommittee,
+	})
+	require.NoError(t, err)
+
+	st, err := bellatrix.UpgradeToBellatrix(preState)
+	require.NoError(t, err)
+	require.Equal(t, version.Bellatrix, st.Version())
+
+	require.DeepSSZEqual(t, &statepb.Fork{
+		PreviousVersion: params.BeaconConfig().AltairForkVersion,
+		CurrentVersion:  params.BeaconConfig().BellatrixForkVersion,
+		Epoch:           helpers.CurrentEpoch(preState),
+	}, st.Fork())
+	require.DeepSSZEqual(t, preState.Balances(), st.Balances())
+	scores, err := st.InactivityScores()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []uint64{4, 5, 6}, scores)
+	participation, err := st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []byte{1, 0, 1}, participation)
+	participation, err = st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []byte{0, 1, 0}, participation)
+	preCommittee, err := preState.NextSyncCommittee()
+	require.NoError(t, err)
+	committee, err := st.NextSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, preCommittee, committee)
+
+	header, err := st.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, bellatrix.EmptyPayloadHeader(), header)
+	complete, err := bellatrix.IsMergeTransitionComplete(st)
+	require.NoError(t, err)
+	require.Equal(t, false, complete)
+}
@@ -3,6 +3,7 @@
 package block
 
 import (
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
 )
 
@@ -14,4 +15,7 @@ const (
 // ReceivedBlockData is the data sent with ReceivedBlock events.
 type ReceivedBlockData struct {
 	SignedBlock block.SignedBeaconBlock
+	// Provenance is the gossip topic and peer the block was received from,
+	// nil if it was not received over gossip.
+	Provenance *ethpb.GossipProvenance
 }
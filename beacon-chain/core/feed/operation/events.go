@@ -24,12 +24,18 @@ const (
 type UnAggregatedAttReceivedData struct {
 	// Attestation is the unaggregated attestation object.
 	Attestation *ethpb.Attestation
+	// Provenance is the gossip topic and peer the attestation was received from,
+	// nil if it was not received over gossip.
+	Provenance *ethpb.GossipProvenance
 }
 
 // AggregatedAttReceivedData is the data sent with AggregatedAttReceived events.
 type AggregatedAttReceivedData struct {
 	// Attestation is the aggregated attestation object.
 	Attestation *ethpb.AggregateAttestationAndProof
+	// Provenance is the gossip topic and peer the aggregate was received from,
+	// nil if it was not received over gossip.
+	Provenance *ethpb.GossipProvenance
 }
 
 // ExitReceivedData is the data sent with ExitReceived events.
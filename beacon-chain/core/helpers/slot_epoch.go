@@ -116,6 +116,12 @@ func SlotsSinceEpochStarts(slot types.Slot) types.Slot {
 
 // VerifySlotTime validates the input slot is not from the future.
 func VerifySlotTime(genesisTime uint64, slot types.Slot, timeTolerance time.Duration) error {
+	return VerifySlotTimeAt(genesisTime, slot, timeTolerance, timeutils.Now())
+}
+
+// VerifySlotTimeAt validates the input slot is not from the future, as VerifySlotTime does, with
+// the given time as the current time.
+func VerifySlotTimeAt(genesisTime uint64, slot types.Slot, timeTolerance time.Duration, currentTime time.Time) error {
 	slotTime, err := SlotToTime(genesisTime, slot)
 	if err != nil {
 		return err
@@ -123,11 +129,10 @@ func VerifySlotTime(genesisTime uint64, slot types.Slot, timeTolerance time.Dura
 
 	// Defensive check to ensure unreasonable slots are rejected
 	// straight away.
-	if err := ValidateSlotClock(slot, genesisTime); err != nil {
+	if err := ValidateSlotClockAt(slot, genesisTime, currentTime); err != nil {
 		return err
 	}
 
-	currentTime := timeutils.Now()
 	diff := slotTime.Sub(currentTime)
 
 	if diff > timeTolerance {
@@ -157,7 +162,12 @@ func SlotsSince(time time.Time) types.Slot {
 // CurrentSlot returns the current slot as determined by the local clock and
 // provided genesis time.
 func CurrentSlot(genesisTimeSec uint64) types.Slot {
-	now := timeutils.Now().Unix()
+	return CurrentSlotAt(genesisTimeSec, timeutils.Now())
+}
+
+// CurrentSlotAt returns the slot at the given time, from the provided genesis time.
+func CurrentSlotAt(genesisTimeSec uint64, currentTime time.Time) types.Slot {
+	now := currentTime.Unix()
 	genesis := int64(genesisTimeSec)
 	if now < genesis {
 		return 0
@@ -169,7 +179,13 @@ func CurrentSlot(genesisTimeSec uint64) types.Slot {
 // clock to ensure slots that are unreasonable are returned with
 // an error.
 func ValidateSlotClock(slot types.Slot, genesisTimeSec uint64) error {
-	maxPossibleSlot := CurrentSlot(genesisTimeSec).Add(MaxSlotBuffer)
+	return ValidateSlotClockAt(slot, genesisTimeSec, timeutils.Now())
+}
+
+// ValidateSlotClockAt validates a provided slot against the given time, as ValidateSlotClock
+// does against the local clock.
+func ValidateSlotClockAt(slot types.Slot, genesisTimeSec uint64, currentTime time.Time) error {
+	maxPossibleSlot := CurrentSlotAt(genesisTimeSec, currentTime).Add(MaxSlotBuffer)
 	// Defensive check to ensure that we only process slots up to a hard limit
 	// from our local clock.
 	if slot > maxPossibleSlot {
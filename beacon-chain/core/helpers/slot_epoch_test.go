@@ -346,6 +346,18 @@ func TestValidateSlotClock_HandlesBadSlot(t *testing.T) {
 	assert.ErrorContains(t, "which exceeds max allowed value relative to the local clock", ValidateSlotClock(1<<63, uint64(genTime)), "no error from bad slot")
 }
 
+func TestVerifySlotTimeAt(t *testing.T) {
+	genesisTime := uint64(1000)
+	now := time.Unix(int64(genesisTime+5*params.BeaconConfig().SecondsPerSlot), 0)
+	assert.Equal(t, types.Slot(5), CurrentSlotAt(genesisTime, now))
+	assert.Equal(t, types.Slot(0), CurrentSlotAt(genesisTime, time.Unix(0, 0)))
+
+	assert.NoError(t, VerifySlotTimeAt(genesisTime, 5, 0, now))
+	assert.ErrorContains(t, "could not process slot from the future", VerifySlotTimeAt(genesisTime, 6, 0, now))
+	assert.NoError(t, ValidateSlotClockAt(types.Slot(MaxSlotBuffer+5), genesisTime, now))
+	assert.ErrorContains(t, "exceeds max allowed value relative to the local clock", ValidateSlotClockAt(types.Slot(MaxSlotBuffer+6), genesisTime, now))
+}
+
 func TestPrevSlot(t *testing.T) {
 	tests := []struct {
 		name string
@@ -12,6 +12,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/bellatrix"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
@@ -66,6 +67,8 @@ var processingPipeline = []processFunc{
 //
 // Note: This method differs from the spec pseudocode as it uses a batch signature verification.
 // See: ExecuteStateTransitionNoVerifyAnySig
+// The payload verifier stands for the execution engine, which verifies the execution payloads of
+// Bellatrix blocks. Blocks with an execution payload fail to process without one.
 //
 // Spec pseudocode definition:
 //  def state_transition(state: BeaconState, signed_block: SignedBeaconBlock, validate_result: bool=True) -> None:
@@ -84,6 +87,7 @@ func ExecuteStateTransition(
 	ctx context.Context,
 	state state.BeaconState,
 	signed block.SignedBeaconBlock,
+	payloadVerifier bellatrix.PayloadVerifier,
 ) (state.BeaconState, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
@@ -96,7 +100,7 @@ func ExecuteStateTransition(
 	defer span.End()
 	var err error
 
-	set, postState, err := ExecuteStateTransitionNoVerifyAnySig(ctx, state, signed)
+	set, postState, err := ExecuteStateTransitionNoVerifyAnySig(ctx, state, signed, payloadVerifier)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not execute state transition")
 	}
@@ -281,7 +285,7 @@ func ProcessSlots(ctx context.Context, state state.BeaconState, slot types.Slot)
 					traceutil.AnnotateError(span, err)
 					return nil, errors.Wrap(err, "could not process epoch with optimizations")
 				}
-			case version.Altair:
+			case version.Altair, version.Bellatrix:
 				state, err = altair.ProcessEpoch(ctx, state)
 				if err != nil {
 					traceutil.AnnotateError(span, err)
@@ -303,6 +307,14 @@ func ProcessSlots(ctx context.Context, state state.BeaconState, slot types.Slot)
 				return nil, err
 			}
 		}
+
+		// Transition to Bellatrix state.
+		if helpers.IsEpochStart(state.Slot()) && helpers.SlotToEpoch(state.Slot()) == params.BeaconConfig().BellatrixForkEpoch {
+			state, err = bellatrix.UpgradeToBellatrix(state)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if highestSlot < state.Slot() {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/bellatrix"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
@@ -45,6 +46,7 @@ func ExecuteStateTransitionNoVerifyAnySig(
 	ctx context.Context,
 	state state.BeaconState,
 	signed block.SignedBeaconBlock,
+	payloadVerifier bellatrix.PayloadVerifier,
 ) (*bls.SignatureSet, state.BeaconState, error) {
 	if ctx.Err() != nil {
 		return nil, nil, ctx.Err()
@@ -73,7 +75,7 @@ func ExecuteStateTransitionNoVerifyAnySig(
 	}
 
 	// Execute per block transition.
-	set, state, err := ProcessBlockNoVerifyAnySig(ctx, state, signed)
+	set, state, err := ProcessBlockNoVerifyAnySig(ctx, state, signed, payloadVerifier)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not process block")
 	}
@@ -116,6 +118,7 @@ func CalculateStateRoot(
 	ctx context.Context,
 	state state.BeaconState,
 	signed block.SignedBeaconBlock,
+	payloadVerifier bellatrix.PayloadVerifier,
 ) ([32]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.CalculateStateRoot")
 	defer span.End()
@@ -148,11 +151,11 @@ func CalculateStateRoot(
 	}
 
 	// Execute per block transition.
-	state, err = ProcessBlockForStateRoot(ctx, state, signed)
+	state, err = ProcessBlockForStateRoot(ctx, state, signed, payloadVerifier)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not process block")
 	}
-	if signed.Version() == version.Altair {
+	if signed.Version() == version.Altair || signed.Version() == version.Bellatrix {
 		sa, err := signed.Block().Body().SyncAggregate()
 		if err != nil {
 			return [32]byte{}, err
@@ -175,6 +178,8 @@ func CalculateStateRoot(
 //
 //  def process_block(state: BeaconState, block: BeaconBlock) -> None:
 //    process_block_header(state, block)
+//    if is_execution_enabled(state, block.body):
+//        process_execution_payload(state, block.body.execution_payload, EXECUTION_ENGINE)
 //    process_randao(state, block.body)
 //    process_eth1_data(state, block.body)
 //    process_operations(state, block.body)
@@ -182,6 +187,7 @@ func ProcessBlockNoVerifyAnySig(
 	ctx context.Context,
 	state state.BeaconState,
 	signed block.SignedBeaconBlock,
+	payloadVerifier bellatrix.PayloadVerifier,
 ) (*bls.SignatureSet, state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessBlockNoVerifyAnySig")
 	defer span.End()
@@ -190,11 +196,11 @@ func ProcessBlockNoVerifyAnySig(
 	}
 
 	blk := signed.Block()
-	state, err := ProcessBlockForStateRoot(ctx, state, signed)
+	state, err := ProcessBlockForStateRoot(ctx, state, signed, payloadVerifier)
 	if err != nil {
 		return nil, nil, err
 	}
-	if signed.Version() == version.Altair {
+	if signed.Version() == version.Altair || signed.Version() == version.Bellatrix {
 		sa, err := signed.Block().Body().SyncAggregate()
 		if err != nil {
 			return nil, nil, err
@@ -269,7 +275,7 @@ func ProcessOperationsNoVerifyAttsSigs(
 		if err != nil {
 			return nil, err
 		}
-	case version.Altair:
+	case version.Altair, version.Bellatrix:
 		state, err = altairOperations(ctx, state, signedBeaconBlock)
 		if err != nil {
 			return nil, err
@@ -287,11 +293,13 @@ func ProcessOperationsNoVerifyAttsSigs(
 }
 
 // ProcessBlockForStateRoot processes the state for state root computation. It skips proposer signature
-// and randao signature verifications.
+// and randao signature verifications. The execution payload of the block is verified with the
+// payload verifier.
 func ProcessBlockForStateRoot(
 	ctx context.Context,
 	state state.BeaconState,
 	signed block.SignedBeaconBlock,
+	payloadVerifier bellatrix.PayloadVerifier,
 ) (state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessBlockForStateRoot")
 	defer span.End()
@@ -311,6 +319,21 @@ func ProcessBlockForStateRoot(
 		return nil, errors.Wrap(err, "could not process block header")
 	}
 
+	if signed.Version() == version.Bellatrix {
+		enabled, err := bellatrix.IsExecutionEnabled(state, body)
+		if err != nil {
+			traceutil.AnnotateError(span, err)
+			return nil, errors.Wrap(err, "could not check if execution is enabled")
+		}
+		if enabled {
+			state, err = bellatrix.ProcessExecutionPayload(ctx, state, body, payloadVerifier)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return nil, errors.Wrap(err, "could not process execution payload")
+			}
+		}
+	}
+
 	state, err = b.ProcessRandaoNoVerify(state, signed.Block().Body().RandaoReveal())
 	if err != nil {
 		traceutil.AnnotateError(span, err)
@@ -144,6 +144,15 @@ type SlasherDatabase interface {
 	PruneProposals(
 		ctx context.Context, currentEpoch, pruningEpochIncrements, historyLength types.Epoch,
 	) error
+	LastProcessedEpoch(ctx context.Context) (types.Epoch, bool, error)
+	SaveLastProcessedEpoch(ctx context.Context, epoch types.Epoch) error
+	LastEpochWrittenForAllValidators(ctx context.Context) ([]*slashertypes.AttestedEpochForValidator, error)
+	ChunkParameters(ctx context.Context) (*slashertypes.ChunkParameters, bool, error)
+	SaveChunkParameters(ctx context.Context, chunkParams *slashertypes.ChunkParameters) error
+	Storage(ctx context.Context) (*slashertypes.DatabaseStorage, error)
+	ValidatorChunkStorage(
+		ctx context.Context, chunksPerValidatorChunk uint64,
+	) ([]*slashertypes.ValidatorChunkStorage, error)
 	DatabasePath() string
 	ClearDB() error
 }
@@ -0,0 +1,11 @@
+package kv
+
+import "bytes"
+
+// In order for an encoding to be Bellatrix compatible, it must be prefixed with bellatrix key.
+func hasBellatrixKey(enc []byte) bool {
+	if len(bellatrixKey) >= len(enc) {
+		return false
+	}
+	return bytes.Equal(enc[:len(bellatrixKey)], bellatrixKey)
+}
@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStore_BellatrixBlocksCRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	block := testutil.HydrateSignedBeaconBlockBellatrix(&v2.SignedBeaconBlockBellatrix{})
+	block.Block.Slot = 20
+	block.Block.ParentRoot = bytesutil.PadTo([]byte{1, 2, 3}, 32)
+	block.Block.Body.ExecutionPayload.BlockNumber = 10
+	block.Block.Body.ExecutionPayload.Transactions = [][]byte{[]byte("tx")}
+
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	retrievedBlock, err := db.Block(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, nil, retrievedBlock, "Expected nil block")
+	wsb, err := wrapper.WrappedBellatrixSignedBeaconBlock(block)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	assert.Equal(t, true, db.HasBlock(ctx, blockRoot), "Expected block to exist in the db")
+
+	// Read the block from disk rather than from the cache.
+	db.blockCache.Del(string(blockRoot[:]))
+	retrievedBlock, err = db.Block(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, version.Bellatrix, retrievedBlock.Version())
+	assert.Equal(t, true, proto.Equal(block, retrievedBlock.Proto()), "Wanted: %v, received: %v", block, retrievedBlock)
+	require.NoError(t, db.deleteBlock(ctx, blockRoot))
+	assert.Equal(t, false, db.HasBlock(ctx, blockRoot), "Expected block to have been deleted from the db")
+}
@@ -603,6 +603,14 @@ func unmarshalBlock(ctx context.Context, enc []byte) (block.SignedBeaconBlock, e
 			return nil, err
 		}
 		return wrapperv2.WrappedAltairSignedBeaconBlock(rawBlock)
+	case hasBellatrixKey(enc):
+		// Marshal block bytes to bellatrix beacon block.
+		rawBlock := &prysmv2.SignedBeaconBlockBellatrix{}
+		err := rawBlock.UnmarshalSSZ(enc[len(bellatrixKey):])
+		if err != nil {
+			return nil, err
+		}
+		return wrapperv2.WrappedBellatrixSignedBeaconBlock(rawBlock)
 	default:
 		// Marshal block bytes to phase 0 beacon block.
 		rawBlock := &ethpb.SignedBeaconBlock{}
@@ -623,6 +631,8 @@ func marshalBlock(ctx context.Context, blk block.SignedBeaconBlock) ([]byte, err
 	switch blk.Version() {
 	case version.Altair:
 		return snappy.Encode(nil, append(altairKey, obj...)), nil
+	case version.Bellatrix:
+		return snappy.Encode(nil, append(bellatrixKey, obj...)), nil
 	case version.Phase0:
 		return snappy.Encode(nil, obj), nil
 	default:
@@ -46,6 +46,9 @@ var (
 	// Altair key used to identify object is altair compatible.
 	// Objects that are only compatible with altair should be prefixed with such key.
 	altairKey = []byte("altair")
+	// Bellatrix key used to identify object is bellatrix compatible.
+	// Objects that are only compatible with bellatrix should be prefixed with such key.
+	bellatrixKey = []byte("bellatrix")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
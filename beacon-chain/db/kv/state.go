@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/genesis"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
 	v1alpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
@@ -354,6 +355,13 @@ func unmarshalState(ctx context.Context, enc []byte) (state.BeaconState, error)
 			return nil, errors.Wrap(err, "failed to unmarshal encoding for altair")
 		}
 		return v2.InitializeFromProtoUnsafe(protoState)
+	case hasBellatrixKey(enc):
+		// Marshal state bytes to bellatrix beacon state.
+		protoState := &statepb.BeaconStateBellatrix{}
+		if err := protoState.UnmarshalSSZ(enc[len(bellatrixKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for bellatrix")
+		}
+		return v3.InitializeFromProtoUnsafe(protoState)
 	default:
 		// Marshal state bytes to phase 0 beacon state.
 		protoState := &statepb.BeaconState{}
@@ -386,6 +394,19 @@ func marshalState(ctx context.Context, st state.ReadOnlyBeaconState) ([]byte, er
 			return nil, err
 		}
 		return snappy.Encode(nil, append(altairKey, rawObj...)), nil
+	case *statepb.BeaconStateBellatrix:
+		rState, ok := st.InnerStateUnsafe().(*statepb.BeaconStateBellatrix)
+		if !ok {
+			return nil, errors.New("non valid inner state")
+		}
+		if rState == nil {
+			return nil, errors.New("nil state")
+		}
+		rawObj, err := rState.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		return snappy.Encode(nil, append(bellatrixKey, rawObj...)), nil
 	default:
 		return nil, errors.New("invalid inner state")
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -574,6 +575,25 @@ func TestAltairState_CanDelete(t *testing.T) {
 	require.Equal(t, state.ReadOnlyBeaconState(nil), savedS, "Unsaved state should've been nil")
 }
 
+func TestBellatrixState_MarshalUnmarshal(t *testing.T) {
+	ctx := context.Background()
+	st, err := testutil.NewBeaconStateBellatrix()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(100))
+	header, err := st.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	header.BlockNumber = 10
+	header.BlockHash = bytesutil.PadTo([]byte("block"), 32)
+	require.NoError(t, st.SetLatestExecutionPayloadHeader(header))
+
+	enc, err := marshalState(ctx, st)
+	require.NoError(t, err)
+	decoded, err := unmarshalState(ctx, enc)
+	require.NoError(t, err)
+	require.Equal(t, version.Bellatrix, decoded.Version())
+	require.DeepSSZEqual(t, st.InnerStateUnsafe(), decoded.InnerStateUnsafe())
+}
+
 func validators(limit int) []*ethpb.Validator {
 	var vals []*ethpb.Validator
 	for i := 0; i < limit; i++ {
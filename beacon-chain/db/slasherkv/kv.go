@@ -18,6 +18,9 @@ import (
 var _ iface.SlasherDatabase = (*Store)(nil)
 
 const (
+	// SlasherDbDirName is the name of the directory, within the beacon node database
+	// directory, holding the database of the in-process slasher.
+	SlasherDbDirName = "slasherkv"
 	// DatabaseFileName is the name of the beacon node database.
 	DatabaseFileName = "slasher.db"
 	boltAllocSize    = 8 * 1024 * 1024
@@ -80,6 +83,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			attestationDataRootsBucket,
 			proposalRecordsBucket,
 			slasherChunksBucket,
+			slasherCheckpointBucket,
+			slasherMetadataBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -13,4 +13,12 @@ var (
 	attestationDataRootsBucket = []byte("attestation-data-roots")
 	proposalRecordsBucket      = []byte("proposal-records")
 	slasherChunksBucket        = []byte("slasher-chunks")
+	slasherCheckpointBucket    = []byte("slasher-checkpoint")
+	slasherMetadataBucket      = []byte("slasher-metadata")
+
+	// Keys of the slasher checkpoint bucket.
+	lastProcessedEpochKey = []byte("last-processed-epoch")
+
+	// Keys of the slasher metadata bucket.
+	chunkParametersKey = []byte("chunk-parameters")
 )
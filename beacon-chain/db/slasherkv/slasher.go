@@ -58,6 +58,33 @@ func (s *Store) LastEpochWrittenForValidators(
 	return attestedEpochs, err
 }
 
+// LastEpochWrittenForAllValidators returns the latest epoch recorded for every validator
+// slasher has written data for, sorted by validator index.
+func (s *Store) LastEpochWrittenForAllValidators(
+	ctx context.Context,
+) ([]*slashertypes.AttestedEpochForValidator, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastEpochWrittenForAllValidators")
+	defer span.End()
+	attestedEpochs := make([]*slashertypes.AttestedEpochForValidator, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(attestedEpochsByValidator).ForEach(func(k, v []byte) error {
+			var epoch types.Epoch
+			if err := epoch.UnmarshalSSZ(v); err != nil {
+				return err
+			}
+			attestedEpochs = append(attestedEpochs, &slashertypes.AttestedEpochForValidator{
+				ValidatorIndex: decodeValidatorIndex(k),
+				Epoch:          epoch,
+			})
+			return nil
+		})
+	})
+	sort.Slice(attestedEpochs, func(i, j int) bool {
+		return attestedEpochs[i].ValidatorIndex < attestedEpochs[j].ValidatorIndex
+	})
+	return attestedEpochs, err
+}
+
 // SaveLastEpochWrittenForValidators updates the latest epoch a slice
 // of validator indices has attested to.
 func (s *Store) SaveLastEpochWrittenForValidators(
@@ -389,6 +416,38 @@ func (s *Store) HighestAttestations(
 	return history, err
 }
 
+// LastProcessedEpoch retrieves the checkpoint of the last epoch slasher has processed all
+// blocks for, and whether such a checkpoint exists.
+func (s *Store) LastProcessedEpoch(ctx context.Context) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastProcessedEpoch")
+	defer span.End()
+	var epoch types.Epoch
+	var exists bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherCheckpointBucket).Get(lastProcessedEpochKey)
+		if enc == nil {
+			return nil
+		}
+		exists = true
+		return epoch.UnmarshalSSZ(enc)
+	})
+	return epoch, exists, err
+}
+
+// SaveLastProcessedEpoch saves the checkpoint of the last epoch slasher has processed all
+// blocks for, from which detection resumes after a restart.
+func (s *Store) SaveLastProcessedEpoch(ctx context.Context, epoch types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLastProcessedEpoch")
+	defer span.End()
+	enc, err := epoch.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherCheckpointBucket).Put(lastProcessedEpochKey, enc)
+	})
+}
+
 func suffixForAttestationRecordsKey(key, encodedValidatorIndex []byte) bool {
 	encIdx := key[8:]
 	return bytes.Equal(encIdx, encodedValidatorIndex)
@@ -511,3 +570,12 @@ func encodeValidatorIndex(index types.ValidatorIndex) []byte {
 	buf[4] = byte(v >> 32)
 	return buf
 }
+
+// Decodes a validator index encoded with encodeValidatorIndex.
+func decodeValidatorIndex(enc []byte) types.ValidatorIndex {
+	var v uint64
+	for i := len(enc) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(enc[i])
+	}
+	return types.ValidatorIndex(v)
+}
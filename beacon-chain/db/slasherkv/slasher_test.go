@@ -68,6 +68,41 @@ func TestStore_LastEpochWrittenForValidators(t *testing.T) {
 	}
 }
 
+func TestStore_LastEpochWrittenForAllValidators(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	attestedEpochs, err := beaconDB.LastEpochWrittenForAllValidators(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(attestedEpochs))
+
+	// Encoded indices do not sort numerically, 256 is encoded before 1.
+	require.NoError(t, beaconDB.SaveLastEpochWrittenForValidators(ctx, []types.ValidatorIndex{1, 256}, 5))
+	require.NoError(t, beaconDB.SaveLastEpochWrittenForValidators(ctx, []types.ValidatorIndex{2}, 6))
+	attestedEpochs, err = beaconDB.LastEpochWrittenForAllValidators(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*slashertypes.AttestedEpochForValidator{
+		{ValidatorIndex: 1, Epoch: 5},
+		{ValidatorIndex: 2, Epoch: 6},
+		{ValidatorIndex: 256, Epoch: 5},
+	}, attestedEpochs)
+}
+
+func TestStore_LastProcessedEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	_, exists, err := beaconDB.LastProcessedEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	require.NoError(t, beaconDB.SaveLastProcessedEpoch(ctx, 5))
+	require.NoError(t, beaconDB.SaveLastProcessedEpoch(ctx, 7))
+	epoch, exists, err := beaconDB.LastProcessedEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(7), epoch)
+}
+
 func TestStore_CheckAttesterDoubleVotes(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := setupDB(t)
@@ -513,6 +548,7 @@ func Test_encodeValidatorIndex(t *testing.T) {
 			encodedIndex := append(got[:5], 0, 0, 0)
 			decoded := binary.LittleEndian.Uint64(encodedIndex)
 			require.DeepEqual(t, tt.index, types.ValidatorIndex(decoded))
+			require.Equal(t, tt.index, decodeValidatorIndex(got))
 		})
 	}
 }
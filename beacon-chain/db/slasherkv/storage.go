@@ -0,0 +1,218 @@
+package slasherkv
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// The number of keys copied per transaction when copying buckets to another database,
+// keeping the memory used by a single transaction bounded for large buckets.
+const copyBatchSize = 10000
+
+// Chunk parameters are encoded as three little-endian uint64 values.
+const chunkParametersSize = 24
+
+// ChunkParameters retrieves the parameters the span chunks of the database are laid out
+// with, and whether they were saved.
+func (s *Store) ChunkParameters(ctx context.Context) (*slashertypes.ChunkParameters, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ChunkParameters")
+	defer span.End()
+	var chunkParams *slashertypes.ChunkParameters
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherMetadataBucket).Get(chunkParametersKey)
+		if enc == nil {
+			return nil
+		}
+		if len(enc) != chunkParametersSize {
+			return errors.Errorf("chunk parameters have wrong length %d, expected %d", len(enc), chunkParametersSize)
+		}
+		chunkParams = &slashertypes.ChunkParameters{
+			ChunkSize:          ssz.UnmarshallUint64(enc[0:8]),
+			ValidatorChunkSize: ssz.UnmarshallUint64(enc[8:16]),
+			HistoryLength:      types.Epoch(ssz.UnmarshallUint64(enc[16:24])),
+		}
+		return nil
+	})
+	return chunkParams, chunkParams != nil, err
+}
+
+// SaveChunkParameters saves the parameters the span chunks of the database are laid out with.
+func (s *Store) SaveChunkParameters(ctx context.Context, chunkParams *slashertypes.ChunkParameters) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveChunkParameters")
+	defer span.End()
+	if chunkParams == nil {
+		return errors.New("nil chunk parameters")
+	}
+	enc := make([]byte, 0, chunkParametersSize)
+	enc = ssz.MarshalUint64(enc, chunkParams.ChunkSize)
+	enc = ssz.MarshalUint64(enc, chunkParams.ValidatorChunkSize)
+	enc = ssz.MarshalUint64(enc, uint64(chunkParams.HistoryLength))
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherMetadataBucket).Put(chunkParametersKey, enc)
+	})
+}
+
+// Storage reports the size of the database file, the bytes free for reuse within
+// it, and the keys and bytes of each bucket sorted by name.
+func (s *Store) Storage(ctx context.Context) (*slashertypes.DatabaseStorage, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Storage")
+	defer span.End()
+	dbStats := s.db.Stats()
+	storage := &slashertypes.DatabaseStorage{
+		FreeBytes: uint64(dbStats.FreePageN+dbStats.PendingPageN) * uint64(s.db.Info().PageSize),
+		Buckets:   make([]*slashertypes.BucketStorage, 0),
+	}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		storage.FileBytes = uint64(tx.Size())
+		return tx.ForEach(func(name []byte, bkt *bolt.Bucket) error {
+			// Small buckets are stored inline within their parent page, without pages
+			// of their own.
+			stats := bkt.Stats()
+			storage.Buckets = append(storage.Buckets, &slashertypes.BucketStorage{
+				Name:           string(name),
+				NumKeys:        uint64(stats.KeyN),
+				AllocatedBytes: uint64(stats.BranchAlloc + stats.LeafAlloc),
+				UsedBytes:      uint64(stats.BranchInuse + stats.LeafInuse + stats.InlineBucketInuse),
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(storage.Buckets, func(i, j int) bool {
+		return storage.Buckets[i].Name < storage.Buckets[j].Name
+	})
+	return storage, nil
+}
+
+// ValidatorChunkStorage reports the number and size of the min and max span chunks stored
+// for each validator chunk index, sorted by index. Chunks are keyed by their validator
+// chunk index times the number of chunks per validator chunk, that is the history length
+// divided by the chunk size, plus their chunk index.
+func (s *Store) ValidatorChunkStorage(
+	ctx context.Context, chunksPerValidatorChunk uint64,
+) ([]*slashertypes.ValidatorChunkStorage, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorChunkStorage")
+	defer span.End()
+	if chunksPerValidatorChunk == 0 {
+		return nil, errors.New("number of chunks per validator chunk must be positive")
+	}
+	byIndex := make(map[uint64]*slashertypes.ValidatorChunkStorage)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherChunksBucket).ForEach(func(k, v []byte) error {
+			if len(k) != 9 {
+				return errors.Errorf("chunk key has wrong length %d, expected 9", len(k))
+			}
+			validatorChunkIdx := ssz.UnmarshallUint64(k[1:]) / chunksPerValidatorChunk
+			storage, ok := byIndex[validatorChunkIdx]
+			if !ok {
+				storage = &slashertypes.ValidatorChunkStorage{ValidatorChunkIndex: validatorChunkIdx}
+				byIndex[validatorChunkIdx] = storage
+			}
+			size := uint64(len(k) + len(v))
+			switch slashertypes.ChunkKind(k[0]) {
+			case slashertypes.MinSpan:
+				storage.MinSpanChunks++
+				storage.MinSpanBytes += size
+			case slashertypes.MaxSpan:
+				storage.MaxSpanChunks++
+				storage.MaxSpanBytes += size
+			default:
+				return errors.Errorf("chunk kind %d not supported", k[0])
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	storages := make([]*slashertypes.ValidatorChunkStorage, 0, len(byIndex))
+	for _, storage := range byIndex {
+		storages = append(storages, storage)
+	}
+	sort.Slice(storages, func(i, j int) bool {
+		return storages[i].ValidatorChunkIndex < storages[j].ValidatorChunkIndex
+	})
+	return storages, nil
+}
+
+// CopyWithoutChunks copies every bucket of the database, except for the span chunks,
+// into another database, in batches of keys so buckets of any size can be copied.
+// It is used to migrate the span chunks to new chunk parameters, writing them into a
+// fresh database which is then swapped with the original.
+func (s *Store) CopyWithoutChunks(ctx context.Context, dst *Store) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CopyWithoutChunks")
+	defer span.End()
+	var names [][]byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			if !bytes.Equal(name, slasherChunksBucket) {
+				names = append(names, append([]byte{}, name...))
+			}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := s.copyBucket(ctx, dst, name); err != nil {
+			return errors.Wrapf(err, "could not copy bucket %s", name)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyBucket(ctx context.Context, dst *Store, name []byte) error {
+	var lastKey []byte
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		keys := make([][]byte, 0, copyBatchSize)
+		values := make([][]byte, 0, copyBatchSize)
+		if err := s.db.View(func(tx *bolt.Tx) error {
+			c := tx.Bucket(name).Cursor()
+			k, v := c.First()
+			if lastKey != nil {
+				k, v = c.Seek(lastKey)
+				if k != nil && bytes.Equal(k, lastKey) {
+					k, v = c.Next()
+				}
+			}
+			for ; k != nil && len(keys) < copyBatchSize; k, v = c.Next() {
+				keys = append(keys, append([]byte{}, k...))
+				values = append(values, append([]byte{}, v...))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := dst.db.Update(func(tx *bolt.Tx) error {
+			bkt, err := tx.CreateBucketIfNotExists(name)
+			if err != nil {
+				return err
+			}
+			for i := range keys {
+				if err := bkt.Put(keys[i], values[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(keys) < copyBatchSize {
+			return nil
+		}
+		lastKey = keys[len(keys)-1]
+	}
+}
@@ -0,0 +1,117 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ChunkParameters(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	_, exists, err := beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	want := &slashertypes.ChunkParameters{ChunkSize: 16, ValidatorChunkSize: 256, HistoryLength: 4096}
+	require.NoError(t, beaconDB.SaveChunkParameters(ctx, want))
+	got, exists, err := beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.DeepEqual(t, want, got)
+}
+
+func TestStore_Storage(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	require.NoError(t, beaconDB.SaveLastEpochWrittenForValidators(ctx, []types.ValidatorIndex{1, 2, 3}, 5))
+
+	storage, err := beaconDB.Storage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, storage.FileBytes > 0)
+	require.Equal(t, 7, len(storage.Buckets))
+	var attested *slashertypes.BucketStorage
+	for i, bkt := range storage.Buckets {
+		if i > 0 {
+			assert.Equal(t, true, storage.Buckets[i-1].Name < bkt.Name)
+		}
+		if bkt.Name == string(attestedEpochsByValidator) {
+			attested = bkt
+		}
+	}
+	require.NotNil(t, attested)
+	assert.Equal(t, uint64(3), attested.NumKeys)
+	assert.Equal(t, true, attested.UsedBytes > 0)
+}
+
+func TestStore_ValidatorChunkStorage(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	_, err := beaconDB.ValidatorChunkStorage(ctx, 0)
+	require.ErrorContains(t, "must be positive", err)
+
+	// With 4 chunks per validator chunk, flat slice IDs 0 to 3 belong to validator
+	// chunk index 0 and 4 to 7 to validator chunk index 1.
+	key := func(flatSliceID uint64) []byte {
+		return ssz.MarshalUint64(make([]byte, 0), flatSliceID)
+	}
+	chunk := []uint16{1, 2, 3, 4}
+	require.NoError(t, beaconDB.SaveSlasherChunks(
+		ctx, slashertypes.MinSpan, [][]byte{key(0), key(3), key(5)}, [][]uint16{chunk, chunk, chunk},
+	))
+	require.NoError(t, beaconDB.SaveSlasherChunks(
+		ctx, slashertypes.MaxSpan, [][]byte{key(1)}, [][]uint16{chunk},
+	))
+	chunkBytes := uint64(9 + len(encodeSlasherChunk(chunk)))
+
+	storages, err := beaconDB.ValidatorChunkStorage(ctx, 4)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*slashertypes.ValidatorChunkStorage{
+		{
+			ValidatorChunkIndex: 0,
+			MinSpanChunks:       2,
+			MaxSpanChunks:       1,
+			MinSpanBytes:        2 * chunkBytes,
+			MaxSpanBytes:        chunkBytes,
+		},
+		{
+			ValidatorChunkIndex: 1,
+			MinSpanChunks:       1,
+			MinSpanBytes:        chunkBytes,
+		},
+	}, storages)
+}
+
+func TestStore_CopyWithoutChunks(t *testing.T) {
+	ctx := context.Background()
+	src := setupDB(t)
+	dst := setupDB(t)
+	indices := make([]types.ValidatorIndex, copyBatchSize+1)
+	for i := range indices {
+		indices[i] = types.ValidatorIndex(i)
+	}
+	require.NoError(t, src.SaveLastEpochWrittenForValidators(ctx, indices, 5))
+	require.NoError(t, src.SaveLastProcessedEpoch(ctx, 4))
+	require.NoError(t, src.SaveSlasherChunks(
+		ctx, slashertypes.MinSpan, [][]byte{ssz.MarshalUint64(make([]byte, 0), 0)}, [][]uint16{{1}},
+	))
+
+	require.NoError(t, src.CopyWithoutChunks(ctx, dst))
+	attestedEpochs, err := dst.LastEpochWrittenForAllValidators(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(indices), len(attestedEpochs))
+	lastProcessed, exists, err := dst.LastProcessedEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(4), lastProcessed)
+	_, chunkExists, err := dst.LoadSlasherChunks(
+		ctx, slashertypes.MinSpan, [][]byte{ssz.MarshalUint64(make([]byte, 0), 0)},
+	)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []bool{false}, chunkExists)
+}
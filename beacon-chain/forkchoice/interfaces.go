@@ -2,6 +2,7 @@ package forkchoice
 
 import (
 	"context"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -14,6 +15,7 @@ type ForkChoicer interface {
 	AttestationProcessor // to track new attestation for fork choice.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	ProposerBooster      // to boost timely blocks in fork choice.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -31,6 +33,12 @@ type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, types.Epoch)
 }
 
+// ProposerBooster boosts the weight of a timely block in fork choice until the next slot.
+type ProposerBooster interface {
+	BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime, arrivalTime time.Time) error
+	ResetBoostedProposerRoot(ctx context.Context) error
+}
+
 // Pruner prunes the fork choice upon new finalization. This is used to keep fork choice sane.
 type Pruner interface {
 	Prune(context.Context, [32]byte) error
@@ -0,0 +1,78 @@
+package protoarray
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// BoostProposerRoot sets the block root to boost in fork choice if the block is timely, that is
+// if it arrived during its own slot and before the attestation deadline of the slot. The boost is
+// applied by the next head computations, until it is reset at the start of the next slot.
+func (f *ForkChoice) BoostProposerRoot(
+	ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime, arrivalTime time.Time,
+) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.BoostProposerRoot")
+	defer span.End()
+
+	if !isTimely(blockSlot, genesisTime, arrivalTime) {
+		return nil
+	}
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = blockRoot
+	return nil
+}
+
+// ResetBoostedProposerRoot clears the block root to boost in fork choice. It is called at the
+// start of every slot, the boost only lasts for the slot of the block.
+func (f *ForkChoice) ResetBoostedProposerRoot(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ResetBoostedProposerRoot")
+	defer span.End()
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = [32]byte{}
+	return nil
+}
+
+// isTimely returns true if a block of the given slot arrived during that slot, before the end of
+// the first interval of the slot, which is the attestation deadline.
+func isTimely(blockSlot types.Slot, genesisTime, arrivalTime time.Time) bool {
+	sinceGenesis := arrivalTime.Sub(genesisTime)
+	if sinceGenesis < 0 {
+		return false
+	}
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	if types.Slot(sinceGenesis/slotDuration) != blockSlot {
+		return false
+	}
+	attestationDeadline := slotDuration / time.Duration(params.BeaconConfig().IntervalsPerSlot)
+	return sinceGenesis%slotDuration < attestationDeadline
+}
+
+// computeProposerBoostScore computes the weight a timely block is boosted with, which is a
+// percentage of the weight of a committee. The weight of a committee is estimated from the
+// number of active validators and their average balance in the justified state.
+func computeProposerBoostScore(justifiedStateBalances []uint64) uint64 {
+	var totalActiveBalance, numActive uint64
+	for _, balance := range justifiedStateBalances {
+		// Balances of inactive validators are zero in the justified state balances.
+		if balance == 0 {
+			continue
+		}
+		totalActiveBalance += balance
+		numActive++
+	}
+	if numActive == 0 {
+		return 0
+	}
+	avgBalance := totalActiveBalance / numActive
+	committeeSize := numActive / uint64(params.BeaconConfig().SlotsPerEpoch)
+	committeeWeight := committeeSize * avgBalance
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+}
@@ -0,0 +1,307 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// Returns the time at the given duration into a slot.
+func slotTime(genesisTime time.Time, slot types.Slot, intoSlot time.Duration) time.Time {
+	return genesisTime.Add(time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot)*time.Second + intoSlot)
+}
+
+func TestForkChoice_BoostProposerRoot(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+	root := indexToHash(1)
+	tests := []struct {
+		name        string
+		blockSlot   types.Slot
+		arrivalTime time.Time
+		boosted     bool
+	}{
+		{
+			name:        "arrived before genesis",
+			blockSlot:   0,
+			arrivalTime: genesisTime.Add(-time.Second),
+			boosted:     false,
+		},
+		{
+			name:        "arrived at the start of its slot",
+			blockSlot:   2,
+			arrivalTime: slotTime(genesisTime, 2, 0),
+			boosted:     true,
+		},
+		{
+			name:        "arrived before the attestation deadline",
+			blockSlot:   2,
+			arrivalTime: slotTime(genesisTime, 2, 3999*time.Millisecond),
+			boosted:     true,
+		},
+		{
+			name:        "arrived at the attestation deadline",
+			blockSlot:   2,
+			arrivalTime: slotTime(genesisTime, 2, 4*time.Second),
+			boosted:     false,
+		},
+		{
+			name:        "arrived after the attestation deadline",
+			blockSlot:   2,
+			arrivalTime: slotTime(genesisTime, 2, 11*time.Second),
+			boosted:     false,
+		},
+		{
+			name:        "arrived in a later slot",
+			blockSlot:   2,
+			arrivalTime: slotTime(genesisTime, 3, time.Second),
+			boosted:     false,
+		},
+		{
+			name:        "arrived before its slot",
+			blockSlot:   2,
+			arrivalTime: slotTime(genesisTime, 1, time.Second),
+			boosted:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setup(0, 0)
+			require.NoError(t, f.BoostProposerRoot(context.Background(), tt.blockSlot, root, genesisTime, tt.arrivalTime))
+			if tt.boosted {
+				assert.Equal(t, root, f.store.proposerBoostRoot)
+			} else {
+				assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+			}
+		})
+	}
+}
+
+func TestForkChoice_ResetBoostedProposerRoot(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+	f := setup(0, 0)
+	require.NoError(t, f.BoostProposerRoot(context.Background(), 1, indexToHash(1), genesisTime, slotTime(genesisTime, 1, 0)))
+	assert.Equal(t, indexToHash(1), f.store.proposerBoostRoot)
+	require.NoError(t, f.ResetBoostedProposerRoot(context.Background()))
+	assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+}
+
+func TestComputeProposerBoostScore(t *testing.T) {
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	activeBalances := func(n int, balance uint64) []uint64 {
+		balances := make([]uint64, n)
+		for i := range balances {
+			balances[i] = balance
+		}
+		return balances
+	}
+	tests := []struct {
+		name     string
+		balances []uint64
+		want     uint64
+	}{
+		{
+			name:     "no validators",
+			balances: []uint64{},
+			want:     0,
+		},
+		{
+			name:     "no active validators",
+			balances: activeBalances(64, 0),
+			want:     0,
+		},
+		{
+			name:     "fewer active validators than slots",
+			balances: activeBalances(16, maxBalance),
+			want:     0,
+		},
+		{
+			name:     "two validators per committee",
+			balances: activeBalances(64, maxBalance),
+			want:     2 * maxBalance * 70 / 100,
+		},
+		{
+			name:     "inactive validators are not counted",
+			balances: append(activeBalances(64, maxBalance), activeBalances(64, 0)...),
+			want:     2 * maxBalance * 70 / 100,
+		},
+		{
+			name:     "average balance",
+			balances: append(activeBalances(32, maxBalance), activeBalances(32, maxBalance/2)...),
+			want:     2 * (maxBalance * 3 / 4) * 70 / 100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, computeProposerBoostScore(tt.balances))
+		})
+	}
+}
+
+func TestStore_ApplyScoreChanges_ProposerBoost(t *testing.T) {
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	score := computeProposerBoostScore(balances)
+	s := &Store{nodes: []*Node{
+		{parent: NonExistentNode, root: [32]byte{'A'}, bestChild: NonExistentNode, bestDescendant: NonExistentNode},
+		{parent: 0, root: [32]byte{'B'}, bestChild: NonExistentNode, bestDescendant: NonExistentNode},
+		{parent: 0, root: [32]byte{'C'}, bestChild: NonExistentNode, bestDescendant: NonExistentNode},
+	}}
+
+	// The boosted node and its ancestors are given the boost.
+	s.proposerBoostRoot = [32]byte{'B'}
+	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, balances, []int{0, 0, 0}))
+	assert.Equal(t, score, s.nodes[0].weight)
+	assert.Equal(t, score, s.nodes[1].weight)
+	assert.Equal(t, uint64(0), s.nodes[2].weight)
+	assert.Equal(t, uint64(1), s.nodes[0].bestChild)
+	assert.Equal(t, [32]byte{'B'}, s.previousProposerBoostRoot)
+	assert.Equal(t, score, s.previousProposerBoostScore)
+
+	// The boost is applied once, no matter how many times weight changes are applied.
+	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, balances, []int{0, 0, 0}))
+	assert.Equal(t, score, s.nodes[0].weight)
+	assert.Equal(t, score, s.nodes[1].weight)
+
+	// The boost moves to another node.
+	s.proposerBoostRoot = [32]byte{'C'}
+	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, balances, []int{0, 0, 0}))
+	assert.Equal(t, score, s.nodes[0].weight)
+	assert.Equal(t, uint64(0), s.nodes[1].weight)
+	assert.Equal(t, score, s.nodes[2].weight)
+	assert.Equal(t, uint64(2), s.nodes[0].bestChild)
+
+	// The boost is removed once reset.
+	s.proposerBoostRoot = [32]byte{}
+	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, balances, []int{0, 0, 0}))
+	assert.Equal(t, uint64(0), s.nodes[0].weight)
+	assert.Equal(t, uint64(0), s.nodes[1].weight)
+	assert.Equal(t, uint64(0), s.nodes[2].weight)
+	assert.Equal(t, [32]byte{}, s.previousProposerBoostRoot)
+	assert.Equal(t, uint64(0), s.previousProposerBoostScore)
+}
+
+func TestProposerBoost_CanFindHead(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1606824023, 0)
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	f := setup(1, 1)
+
+	// Insert block 1 at slot 1 into the tree, arriving late, and verify head is at 1:
+	//         0
+	//        /
+	//       1 <- head
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.BoostProposerRoot(ctx, 1, indexToHash(1), genesisTime, slotTime(genesisTime, 1, 6*time.Second)))
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with late block")
+	assert.Equal(t, uint64(0), f.Node(indexToHash(1)).weight, "Late block should not be boosted")
+
+	// Add a vote to block 1 and verify head is still at 1:
+	//         0
+	//        /
+	//       1 <- +vote, head
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with vote")
+
+	// Insert block 2 at slot 2 into the tree, arriving in time, and verify the boost
+	// outweighs the vote for block 1:
+	//            0
+	//           / \
+	//   vote-> 1   2 <- boost, new head
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.BoostProposerRoot(ctx, 2, indexToHash(2), genesisTime, slotTime(genesisTime, 2, time.Second)))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with timely block")
+	assert.Equal(t, computeProposerBoostScore(balances), f.Node(indexToHash(2)).weight, "Incorrect boosted weight")
+
+	// Computing head again within the slot does not boost block 2 twice:
+	//            0
+	//           / \
+	//   vote-> 1   2 <- boost, head
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with timely block")
+	assert.Equal(t, computeProposerBoostScore(balances), f.Node(indexToHash(2)).weight, "Incorrect boosted weight")
+
+	// Reset the boost at the start of slot 3 and verify head is switched back to 1:
+	//                  0
+	//                 / \
+	// vote, head ->  1   2
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after boost reset")
+	assert.Equal(t, uint64(0), f.Node(indexToHash(2)).weight, "Boost should be removed")
+
+	// Insert block 3 at slot 3 on top of block 2, arriving after the attestation deadline,
+	// and verify head is still at 1:
+	//                  0
+	//                 / \
+	// vote, head ->  1   2
+	//                    |
+	//                    3
+	require.NoError(t, f.ProcessBlock(ctx, 3, indexToHash(3), indexToHash(2), [32]byte{}, 1, 1))
+	require.NoError(t, f.BoostProposerRoot(ctx, 3, indexToHash(3), genesisTime, slotTime(genesisTime, 3, 5*time.Second)))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with late block")
+
+	// Insert block 4 at slot 4 on top of block 3, arriving in time, and verify the boost
+	// propagates through its ancestors to outweigh the vote for block 1:
+	//            0
+	//           / \
+	//   vote-> 1   2
+	//              |
+	//              3
+	//              |
+	//              4 <- boost, new head
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx))
+	require.NoError(t, f.ProcessBlock(ctx, 4, indexToHash(4), indexToHash(3), [32]byte{}, 1, 1))
+	require.NoError(t, f.BoostProposerRoot(ctx, 4, indexToHash(4), genesisTime, slotTime(genesisTime, 4, 0)))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(4), r, "Incorrect head with timely block")
+
+	// Add a second vote to block 1 and verify the votes of more than a committee's boost
+	// outweigh the boost:
+	//                   0
+	//                  / \
+	// +vote, head ->  1   2
+	//                     |
+	//                     3
+	//                     |
+	//                     4 <- boost
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(1), 2)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with votes outweighing boost")
+
+	// Move both votes to block 3 and verify head is at 4 with the votes and the boost:
+	//            0
+	//           / \
+	//          1   2
+	//              |
+	//              3 <- +2 votes
+	//              |
+	//              4 <- boost, new head
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(3), 3)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(4), r, "Incorrect head with votes and boost")
+	assert.Equal(t, 2*params.BeaconConfig().MaxEffectiveBalance+computeProposerBoostScore(balances), f.Node(indexToHash(3)).weight)
+}
@@ -64,7 +64,7 @@ func (f *ForkChoice) Head(
 	}
 	f.votes = newVotes
 
-	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
+	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, newBalances, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
 	f.balances = newBalances
@@ -227,6 +227,13 @@ func (s *Store) FinalizedEpoch() types.Epoch {
 	return s.finalizedEpoch
 }
 
+// ProposerBoostRoot of fork choice store.
+func (s *Store) ProposerBoostRoot() [32]byte {
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+	return s.proposerBoostRoot
+}
+
 // Nodes of fork choice store.
 func (s *Store) Nodes() []*Node {
 	s.nodesLock.RLock()
@@ -377,9 +384,13 @@ func (s *Store) insert(ctx context.Context,
 
 // applyWeightChanges iterates backwards through the nodes in store. It checks all nodes parent
 // and its best child. For each node, it updates the weight with input delta and
-// back propagate the nodes delta to its parents delta. After scoring changes,
+// back propagate the nodes delta to its parents delta. The boost of the previously boosted
+// proposer root is removed from its delta, and the boost of the current proposer root, computed
+// from the justified state balances, is added to its delta. After scoring changes,
 // the best child is then updated along with best descendant.
-func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalizedEpoch types.Epoch, delta []int) error {
+func (s *Store) applyWeightChanges(
+	ctx context.Context, justifiedEpoch, finalizedEpoch types.Epoch, justifiedStateBalances []uint64, delta []int,
+) error {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.applyWeightChanges")
 	defer span.End()
 
@@ -388,6 +399,13 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		return errInvalidDeltaLength
 	}
 
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+	var proposerScore uint64
+	if s.proposerBoostRoot != params.BeaconConfig().ZeroHash {
+		proposerScore = computeProposerBoostScore(justifiedStateBalances)
+	}
+
 	// Update the justified / finalized epochs in store if necessary.
 	if s.justifiedEpoch != justifiedEpoch || s.finalizedEpoch != finalizedEpoch {
 		s.justifiedEpoch = justifiedEpoch
@@ -406,6 +424,15 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 
 		nodeDelta := delta[i]
 
+		// Remove the boost the node was given in the last weight changes, and boost the
+		// node if it is the timely block of the current slot.
+		if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash && n.root == s.previousProposerBoostRoot {
+			nodeDelta -= int(s.previousProposerBoostScore)
+		}
+		if s.proposerBoostRoot != params.BeaconConfig().ZeroHash && n.root == s.proposerBoostRoot {
+			nodeDelta += int(proposerScore)
+		}
+
 		if nodeDelta < 0 {
 			// A node's weight can not be negative but the delta can be negative.
 			if int(n.weight)+nodeDelta < 0 {
@@ -444,6 +471,9 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		}
 	}
 
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = proposerScore
+
 	return nil
 }
 
@@ -24,6 +24,11 @@ type Store struct {
 	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
 	nodesLock      sync.RWMutex
+
+	proposerBoostRoot          [32]byte // root of the timely block of the current slot, boosted in fork choice.
+	previousProposerBoostRoot  [32]byte // root of the block boosted in the last weight changes.
+	previousProposerBoostScore uint64   // score the block was boosted with in the last weight changes.
+	proposerBoostLock          sync.Mutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -156,6 +156,11 @@ func (s *Service) FinalizedDeposits(_ context.Context) *depositcache.FinalizedDe
 	return nil
 }
 
+// DepositSnapshot mocks out the deposit cache functionality for interop.
+func (s *Service) DepositSnapshot(_ context.Context) (*ethpb.DepositSnapshot, error) {
+	return nil, nil
+}
+
 // NonFinalizedDeposits mocks out the deposit cache functionality for interop.
 func (s *Service) NonFinalizedDeposits(_ context.Context, _ *big.Int) []*ethpb.Deposit {
 	return []*ethpb.Deposit{}
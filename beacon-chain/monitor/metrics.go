@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	validatorBalanceGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitor_balance_gwei",
+			Help: "The balance of a tracked validator at the end of the last epoch.",
+		},
+		[]string{"validator_index"},
+	)
+	validatorBalanceChangeGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitor_balance_change_gwei",
+			Help: "The balance change of a tracked validator over the last epoch.",
+		},
+		[]string{"validator_index"},
+	)
+	attestationInclusionDistanceGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitor_attestation_inclusion_distance_slots",
+			Help: "The inclusion distance of the last attestation of a tracked validator included in a block.",
+		},
+		[]string{"validator_index"},
+	)
+	attestationsIncludedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_attestations_included_total",
+			Help: "The number of attestations of a tracked validator included in blocks.",
+		},
+		[]string{"validator_index"},
+	)
+	correctVotesCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_correct_votes_total",
+			Help: "The number of correct head, target or source votes of a tracked validator included in blocks.",
+		},
+		[]string{"validator_index", "vote"},
+	)
+	proposedBlocksCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_proposed_blocks_total",
+			Help: "The number of processed blocks proposed by a tracked validator.",
+		},
+		[]string{"validator_index"},
+	)
+	slashingsIncludedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_slashings_included_total",
+			Help: "The number of proposer or attester slashings of a tracked validator included in blocks.",
+		},
+		[]string{"validator_index", "type"},
+	)
+	syncCommitteeContributionsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_sync_committee_contributions_total",
+			Help: "The number of sync committee signatures of a tracked validator included in blocks.",
+		},
+		[]string{"validator_index"},
+	)
+)
@@ -0,0 +1,422 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+)
+
+func indexLabel(idx types.ValidatorIndex) string {
+	return fmt.Sprintf("%d", idx)
+}
+
+// processBlock updates the performance of the tracked validators with a processed block,
+// using the post state of the block. The first block of an epoch closes the summary of
+// the previous epoch, whose balances changes are read from that post state. Blocks which
+// touch no tracked validator are skipped without loading their post state.
+func (s *Service) processBlock(ctx context.Context, data *statefeed.BlockProcessedData) error {
+	if data.SignedBlock == nil || data.SignedBlock.IsNil() {
+		return errors.New("nil block")
+	}
+	blk := data.SignedBlock.Block()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.needsState(data.SignedBlock) {
+		return nil
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, data.BlockRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get post state of block %#x", data.BlockRoot)
+	}
+	if st == nil || st.IsNil() {
+		return fmt.Errorf("nil post state of block %#x", data.BlockRoot)
+	}
+
+	epoch := helpers.SlotToEpoch(blk.Slot())
+	if !s.initialized {
+		s.updateBalances(st)
+		s.lastEpoch = epoch
+		s.initialized = true
+	} else if epoch > s.lastEpoch {
+		s.logEpochSummary(st)
+		s.lastEpoch = epoch
+	}
+	if s.trackedCommittees == nil || epoch != s.committeesEpoch {
+		if err := s.updateTrackedCommittees(st, epoch); err != nil {
+			log.WithError(err).Debug("Could not update the committees of the tracked validators")
+		}
+	}
+
+	s.processProposal(st, blk, data.BlockRoot)
+	s.processSlashings(st, blk)
+	for _, att := range blk.Body().Attestations() {
+		if err := s.processAttestation(st, blk.Slot(), att); err != nil {
+			log.WithError(err).WithField("slot", att.Data.Slot).Debug("Could not process included attestation")
+		}
+	}
+	if data.SignedBlock.Version() == version.Altair || data.SignedBlock.Version() == version.Bellatrix {
+		if err := s.processSyncAggregate(st, blk); err != nil {
+			return errors.Wrap(err, "could not process sync aggregate")
+		}
+	}
+	return nil
+}
+
+// needsState returns whether the block touches a tracked validator, or starts a new epoch,
+// so that its post state is needed. Attestations of committees which are not known yet
+// are assumed to touch a tracked validator.
+func (s *Service) needsState(signed block.SignedBeaconBlock) bool {
+	blk := signed.Block()
+	epoch := helpers.SlotToEpoch(blk.Slot())
+	if !s.initialized || epoch > s.lastEpoch {
+		return true
+	}
+	if s.trackedIndices[blk.ProposerIndex()] {
+		return true
+	}
+	for _, slashing := range blk.Body().ProposerSlashings() {
+		if s.trackedIndices[slashing.Header_1.Header.ProposerIndex] {
+			return true
+		}
+	}
+	for _, slashing := range blk.Body().AttesterSlashings() {
+		if len(s.slashedIndices(slashing)) > 0 {
+			return true
+		}
+	}
+	if s.trackedCommittees == nil {
+		return true
+	}
+	for _, att := range blk.Body().Attestations() {
+		attEpoch := helpers.SlotToEpoch(att.Data.Slot)
+		if attEpoch > s.committeesEpoch || attEpoch+1 < s.committeesEpoch {
+			return true
+		}
+		if s.trackedCommittees[committeeKey{slot: att.Data.Slot, index: att.Data.CommitteeIndex}] {
+			return true
+		}
+	}
+	if signed.Version() == version.Altair || signed.Version() == version.Bellatrix {
+		agg, err := blk.Body().SyncAggregate()
+		if err != nil {
+			return true
+		}
+		for _, i := range s.trackedSyncPositions {
+			if agg.SyncCommitteeBits.BitAt(i) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// updateTrackedCommittees finds the committees of the previous and current epochs, and the
+// positions in the current sync committee, of the tracked validators.
+func (s *Service) updateTrackedCommittees(st state.BeaconState, epoch types.Epoch) error {
+	s.trackedCommittees = nil
+	s.trackedSyncPositions = nil
+	committees := make(map[committeeKey]bool)
+	epochs := []types.Epoch{epoch}
+	if epoch > 0 {
+		epochs = append(epochs, epoch-1)
+	}
+	for _, e := range epochs {
+		activeCount, err := helpers.ActiveValidatorCount(st, e)
+		if err != nil {
+			return err
+		}
+		committeesPerSlot := helpers.SlotCommitteeCount(activeCount)
+		startSlot, err := helpers.StartSlot(e)
+		if err != nil {
+			return err
+		}
+		for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+			for i := types.CommitteeIndex(0); uint64(i) < committeesPerSlot; i++ {
+				committee, err := helpers.BeaconCommitteeFromState(st, slot, i)
+				if err != nil {
+					return err
+				}
+				for _, idx := range committee {
+					if s.trackedIndices[idx] {
+						committees[committeeKey{slot: slot, index: i}] = true
+						break
+					}
+				}
+			}
+		}
+	}
+	if st.Version() == version.Altair || st.Version() == version.Bellatrix {
+		committee, err := st.CurrentSyncCommittee()
+		if err != nil {
+			return err
+		}
+		for i, pubKey := range committee.Pubkeys {
+			idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+			if ok && s.trackedIndices[idx] {
+				s.trackedSyncPositions = append(s.trackedSyncPositions, uint64(i))
+			}
+		}
+	}
+	s.trackedCommittees = committees
+	s.committeesEpoch = epoch
+	return nil
+}
+
+func (s *Service) epochPerformance(idx types.ValidatorIndex) *epochPerformance {
+	p, ok := s.performance[idx]
+	if !ok {
+		p = &epochPerformance{}
+		s.performance[idx] = p
+	}
+	return p
+}
+
+func (s *Service) processProposal(st state.BeaconState, blk block.BeaconBlock, root [32]byte) {
+	idx := blk.ProposerIndex()
+	if !s.trackedIndices[idx] {
+		return
+	}
+	s.epochPerformance(idx).proposedBlocks++
+	proposedBlocksCounter.WithLabelValues(indexLabel(idx)).Inc()
+	fields := logrus.Fields{
+		"validatorIndex": idx,
+		"slot":           blk.Slot(),
+		"blockRoot":      fmt.Sprintf("%#x", root),
+	}
+	if balance, err := st.BalanceAtIndex(idx); err == nil {
+		fields["balance"] = balance
+	}
+	log.WithFields(fields).Info("Proposed block was processed")
+}
+
+// processSlashings records the proposer and attester slashings of the tracked validators
+// included in a block.
+func (s *Service) processSlashings(st state.BeaconState, blk block.BeaconBlock) {
+	for _, slashing := range blk.Body().ProposerSlashings() {
+		idx := slashing.Header_1.Header.ProposerIndex
+		if s.trackedIndices[idx] {
+			s.logSlashing(st, blk.Slot(), idx, "proposer")
+		}
+	}
+	for _, slashing := range blk.Body().AttesterSlashings() {
+		for _, idx := range s.slashedIndices(slashing) {
+			s.logSlashing(st, blk.Slot(), idx, "attester")
+		}
+	}
+}
+
+// slashedIndices returns the tracked validators slashed by an attester slashing.
+func (s *Service) slashedIndices(slashing *ethpb.AttesterSlashing) []types.ValidatorIndex {
+	if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+		return nil
+	}
+	var slashed []types.ValidatorIndex
+	for _, i := range sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices) {
+		if idx := types.ValidatorIndex(i); s.trackedIndices[idx] {
+			slashed = append(slashed, idx)
+		}
+	}
+	return slashed
+}
+
+func (s *Service) logSlashing(st state.BeaconState, slot types.Slot, idx types.ValidatorIndex, slashingType string) {
+	slashingsIncludedCounter.WithLabelValues(indexLabel(idx), slashingType).Inc()
+	fields := logrus.Fields{
+		"validatorIndex": idx,
+		"slot":           slot,
+		"slashingType":   slashingType,
+	}
+	if balance, err := st.BalanceAtIndex(idx); err == nil {
+		fields["balance"] = balance
+	}
+	log.WithFields(fields).Warn("Slashing of tracked validator was included")
+}
+
+// processAttestation records the votes of the tracked validators of an attestation
+// included in a block. A vote included more than once, as part of several aggregates,
+// is only counted the first time.
+func (s *Service) processAttestation(st state.BeaconState, slot types.Slot, att *ethpb.Attestation) error {
+	committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return err
+	}
+	indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return err
+	}
+	var correctHead, correctTarget, correctSource bool
+	checked := false
+	for _, i := range indices {
+		idx := types.ValidatorIndex(i)
+		if !s.trackedIndices[idx] {
+			continue
+		}
+		if last, ok := s.lastAttestedSlot[idx]; ok && last >= att.Data.Slot {
+			continue
+		}
+		s.lastAttestedSlot[idx] = att.Data.Slot
+		if !checked {
+			correctHead, correctTarget, correctSource, err = checkVotes(st, att.Data)
+			if err != nil {
+				return err
+			}
+			checked = true
+		}
+
+		distance := slot - att.Data.Slot
+		p := s.epochPerformance(idx)
+		p.includedAttestations++
+		p.totalInclusionDistance += distance
+		label := indexLabel(idx)
+		attestationsIncludedCounter.WithLabelValues(label).Inc()
+		attestationInclusionDistanceGauge.WithLabelValues(label).Set(float64(distance))
+		if correctHead {
+			p.correctHead++
+			correctVotesCounter.WithLabelValues(label, "head").Inc()
+		}
+		if correctTarget {
+			p.correctTarget++
+			correctVotesCounter.WithLabelValues(label, "target").Inc()
+		}
+		if correctSource {
+			p.correctSource++
+			correctVotesCounter.WithLabelValues(label, "source").Inc()
+		}
+		log.WithFields(logrus.Fields{
+			"validatorIndex":    idx,
+			"attestationSlot":   att.Data.Slot,
+			"inclusionSlot":     slot,
+			"inclusionDistance": distance,
+			"correctHead":       correctHead,
+			"correctTarget":     correctTarget,
+			"correctSource":     correctSource,
+		}).Info("Attestation was included")
+	}
+	return nil
+}
+
+// checkVotes returns whether the head, target and source votes of attestation data
+// match the chain of the state the attestation was included in.
+func checkVotes(st state.BeaconState, data *ethpb.AttestationData) (head, target, source bool, err error) {
+	headRoot, err := helpers.BlockRootAtSlot(st, data.Slot)
+	if err != nil {
+		return false, false, false, errors.Wrap(err, "could not get head block root")
+	}
+	targetRoot, err := helpers.BlockRoot(st, data.Target.Epoch)
+	if err != nil {
+		return false, false, false, errors.Wrap(err, "could not get target block root")
+	}
+	if data.Target.Epoch == helpers.CurrentEpoch(st) {
+		source = st.MatchCurrentJustifiedCheckpoint(data.Source)
+	} else {
+		source = st.MatchPreviousJustifiedCheckpoint(data.Source)
+	}
+	return bytes.Equal(headRoot, data.BeaconBlockRoot), bytes.Equal(targetRoot, data.Target.Root), source, nil
+}
+
+// processSyncAggregate records the sync committee signatures of the tracked validators
+// included in a block. A validator may hold several positions in the sync committee.
+func (s *Service) processSyncAggregate(st state.BeaconState, blk block.BeaconBlock) error {
+	agg, err := blk.Body().SyncAggregate()
+	if err != nil {
+		return err
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return err
+	}
+	positions := make(map[[48]byte][]uint64, len(committee.Pubkeys))
+	for i, pubKey := range committee.Pubkeys {
+		var k [48]byte
+		copy(k[:], pubKey)
+		positions[k] = append(positions[k], uint64(i))
+	}
+	for idx := range s.trackedIndices {
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			continue
+		}
+		included := 0
+		for _, i := range positions[st.PubkeyAtIndex(idx)] {
+			if agg.SyncCommitteeBits.BitAt(i) {
+				included++
+			}
+		}
+		if included == 0 {
+			continue
+		}
+		s.epochPerformance(idx).syncContributions += uint64(included)
+		syncCommitteeContributionsCounter.WithLabelValues(indexLabel(idx)).Add(float64(included))
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"slot":           blk.Slot(),
+			"contributions":  included,
+		}).Info("Sync committee contribution was included")
+	}
+	return nil
+}
+
+func (s *Service) updateBalances(st state.BeaconState) {
+	for idx := range s.trackedIndices {
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			continue
+		}
+		s.balances[idx] = balance
+		validatorBalanceGauge.WithLabelValues(indexLabel(idx)).Set(float64(balance))
+	}
+}
+
+// logEpochSummary logs the performance of the tracked validators over the last epoch
+// and resets it.
+func (s *Service) logEpochSummary(st state.BeaconState) {
+	for idx := range s.trackedIndices {
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			log.WithField("validatorIndex", idx).Debug("Tracked validator is not in the state yet")
+			continue
+		}
+		label := indexLabel(idx)
+		previous, ok := s.balances[idx]
+		if !ok {
+			previous = balance
+		}
+		change := int64(balance) - int64(previous)
+		s.balances[idx] = balance
+		validatorBalanceGauge.WithLabelValues(label).Set(float64(balance))
+		validatorBalanceChangeGauge.WithLabelValues(label).Set(float64(change))
+
+		p := s.epochPerformance(idx)
+		fields := logrus.Fields{
+			"validatorIndex":       idx,
+			"epoch":                s.lastEpoch,
+			"balance":              balance,
+			"balanceChange":        change,
+			"includedAttestations": p.includedAttestations,
+			"correctlyVotedHead":   p.correctHead,
+			"correctlyVotedTarget": p.correctTarget,
+			"correctlyVotedSource": p.correctSource,
+			"proposedBlocks":       p.proposedBlocks,
+			"syncContributions":    p.syncContributions,
+		}
+		if p.includedAttestations > 0 {
+			fields["averageInclusionDistance"] = float64(p.totalInclusionDistance) / float64(p.includedAttestations)
+		}
+		log.WithFields(fields).Info("Validator epoch summary")
+		s.performance[idx] = &epochPerformance{}
+	}
+}
@@ -0,0 +1,210 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+const numValidators = 64
+
+// newState returns a state at a slot with active validators, whose block roots at
+// slots 0 to 31 are the slot numbers.
+func newState(t *testing.T, slot types.Slot, balance uint64) state.BeaconState {
+	validators := make([]*ethpb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:        bytesutil.PadTo([]byte{byte(i)}, 48),
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = balance
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	for i := 0; i < 32; i++ {
+		require.NoError(t, st.UpdateBlockRootAtIndex(uint64(i), bytesutil.ToBytes32([]byte{byte(i)})))
+	}
+	return st
+}
+
+func setupService(t *testing.T, tracked ...types.ValidatorIndex) (*Service, *stategen.MockStateManager) {
+	stateGen := stategen.NewMockService()
+	s, err := NewService(context.Background(), &Config{
+		StateGen:          stateGen,
+		TrackedValidators: tracked,
+	})
+	require.NoError(t, err)
+	return s, stateGen
+}
+
+func blockProcessed(slot types.Slot, proposer types.ValidatorIndex, root [32]byte, atts ...*ethpb.Attestation) *statefeed.BlockProcessedData {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ProposerIndex = proposer
+	b.Block.Body.Attestations = atts
+	return &statefeed.BlockProcessedData{
+		Slot:        slot,
+		BlockRoot:   root,
+		SignedBlock: wrapper.WrappedPhase0SignedBeaconBlock(b),
+	}
+}
+
+func TestNewService_NoIndices(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{})
+	assert.ErrorContains(t, "no validator indices", err)
+}
+
+func TestProcessBlock_Proposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s, stateGen := setupService(t, 1)
+	root := [32]byte{'a'}
+	stateGen.AddStateForRoot(newState(t, 10, 32e9), root)
+
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(10, 1, root)))
+	require.LogsContain(t, hook, "Proposed block was processed")
+	assert.Equal(t, uint64(1), s.performance[1].proposedBlocks)
+
+	err := s.processBlock(context.Background(), blockProcessed(11, 1, [32]byte{'b'}))
+	assert.ErrorContains(t, "nil post state", err)
+}
+
+func TestProcessBlock_SkipsUntrackedBlocks(t *testing.T) {
+	st := newState(t, 10, 32e9)
+	committee, err := helpers.BeaconCommitteeFromState(st, 9, 0)
+	require.NoError(t, err)
+	tracked := committee[0]
+	s, stateGen := setupService(t, tracked)
+	stateGen.AddStateForRoot(st, [32]byte{'a'})
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(10, 0, [32]byte{'a'})))
+	require.Equal(t, true, s.trackedCommittees[committeeKey{slot: 9, index: 0}])
+
+	var untracked *ethpb.Attestation
+	for slot := types.Slot(0); slot < 10 && untracked == nil; slot++ {
+		committee, err := helpers.BeaconCommitteeFromState(st, slot, 0)
+		require.NoError(t, err)
+		if s.trackedCommittees[committeeKey{slot: slot, index: 0}] {
+			continue
+		}
+		untracked = testutil.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bitfield.NewBitlist(uint64(len(committee))),
+			Data:            &ethpb.AttestationData{Slot: slot},
+		})
+	}
+	require.NotNil(t, untracked)
+	// The post states of the following blocks are unknown, so processing fails if they are loaded.
+	proposer := tracked + 1
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(11, proposer, [32]byte{'b'}, untracked)))
+
+	trackedAtt := testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.NewBitlist(uint64(len(committee))),
+		Data:            &ethpb.AttestationData{Slot: 9},
+	})
+	err = s.processBlock(context.Background(), blockProcessed(11, proposer, [32]byte{'b'}, trackedAtt))
+	assert.ErrorContains(t, "nil post state", err)
+}
+
+func TestProcessBlock_Slashings(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s, stateGen := setupService(t, 3)
+	stateGen.AddStateForRoot(newState(t, 10, 32e9), [32]byte{'a'})
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(10, 1, [32]byte{'a'})))
+
+	b := blockProcessed(11, 1, [32]byte{'b'})
+	blk := b.SignedBlock.Proto().(*ethpb.SignedBeaconBlock)
+	blk.Block.Body.AttesterSlashings = []*ethpb.AttesterSlashing{{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{2, 3}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{3, 4}},
+	}}
+	err := s.processBlock(context.Background(), b)
+	assert.ErrorContains(t, "nil post state", err, "Expected the post state of a block slashing a tracked validator to be loaded")
+
+	stateGen.AddStateForRoot(newState(t, 11, 31e9), [32]byte{'b'})
+	require.NoError(t, s.processBlock(context.Background(), b))
+	require.LogsContain(t, hook, "Slashing of tracked validator was included")
+	require.LogsContain(t, hook, "slashingType=attester")
+
+	// Slashings of other validators are ignored, without loading the post state.
+	hook.Reset()
+	b = blockProcessed(12, 1, [32]byte{'c'})
+	blk = b.SignedBlock.Proto().(*ethpb.SignedBeaconBlock)
+	blk.Block.Body.AttesterSlashings = []*ethpb.AttesterSlashing{{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{2, 3}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{2, 4}},
+	}}
+	require.NoError(t, s.processBlock(context.Background(), b))
+	require.LogsDoNotContain(t, hook, "Slashing of tracked validator was included")
+}
+
+func TestProcessBlock_Attestation(t *testing.T) {
+	hook := logTest.NewGlobal()
+	st := newState(t, 10, 32e9)
+	committee, err := helpers.BeaconCommitteeFromState(st, 9, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+	tracked := committee[1]
+	s, stateGen := setupService(t, tracked)
+	root := [32]byte{'a'}
+	stateGen.AddStateForRoot(st, root)
+
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(1, true)
+	att := &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            9,
+			BeaconBlockRoot: bytesutil.PadTo([]byte{9}, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			// The target root is wrong, as the root at slot 0 is the zero root.
+			Target: &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'x'}, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(10, 0, root, att)))
+	require.LogsContain(t, hook, "Attestation was included")
+	p := s.performance[tracked]
+	assert.Equal(t, uint64(1), p.includedAttestations)
+	assert.Equal(t, types.Slot(1), p.totalInclusionDistance)
+	assert.Equal(t, uint64(1), p.correctHead)
+	assert.Equal(t, uint64(0), p.correctTarget)
+	assert.Equal(t, uint64(1), p.correctSource)
+
+	// The same vote included again is not counted twice.
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(10, 0, root, att)))
+	assert.Equal(t, uint64(1), s.performance[tracked].includedAttestations)
+}
+
+func TestProcessBlock_EpochSummary(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s, stateGen := setupService(t, 1)
+	stateGen.AddStateForRoot(newState(t, 10, 32e9), [32]byte{'a'})
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(10, 1, [32]byte{'a'})))
+
+	nextEpoch := params.BeaconConfig().SlotsPerEpoch
+	stateGen.AddStateForRoot(newState(t, nextEpoch, 32e9+1000), [32]byte{'b'})
+	require.NoError(t, s.processBlock(context.Background(), blockProcessed(nextEpoch, 2, [32]byte{'b'})))
+	require.LogsContain(t, hook, "Validator epoch summary")
+	require.LogsContain(t, hook, "balanceChange=1000")
+	require.LogsContain(t, hook, "proposedBlocks=1")
+	assert.Equal(t, uint64(32e9+1000), s.balances[1])
+	assert.Equal(t, uint64(0), s.performance[1].proposedBlocks)
+	assert.Equal(t, types.Epoch(1), s.lastEpoch)
+}
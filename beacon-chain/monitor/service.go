@@ -0,0 +1,132 @@
+// Package monitor defines a service which tracks the performance of a set of validator
+// indices from the blocks processed by the beacon node. Unlike the validator client,
+// it does not need access to the keys of the validators, so it can monitor validators
+// run by other operators.
+package monitor
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+)
+
+// epochPerformance of a tracked validator, accumulated from the blocks processed
+// during an epoch.
+type epochPerformance struct {
+	includedAttestations   uint64
+	correctHead            uint64
+	correctTarget          uint64
+	correctSource          uint64
+	totalInclusionDistance types.Slot
+	proposedBlocks         uint64
+	syncContributions      uint64
+}
+
+// Config options for the service.
+type Config struct {
+	StateNotifier     statefeed.Notifier
+	StateGen          stategen.StateManager
+	TrackedValidators []types.ValidatorIndex
+}
+
+// Service tracking the performance of validators from processed blocks.
+type Service struct {
+	cfg            *Config
+	ctx            context.Context
+	cancel         context.CancelFunc
+	trackedIndices map[types.ValidatorIndex]bool
+
+	lock sync.Mutex
+	// initialized is false until the first block is processed, which sets the balances
+	// the first epoch summary is compared with.
+	initialized bool
+	// lastEpoch is the epoch of the last processed block.
+	lastEpoch        types.Epoch
+	balances         map[types.ValidatorIndex]uint64
+	lastAttestedSlot map[types.ValidatorIndex]types.Slot
+	performance      map[types.ValidatorIndex]*epochPerformance
+	// trackedCommittees are the committees of the previous and current epochs of
+	// committeesEpoch which contain tracked validators, and trackedSyncPositions the positions
+	// of the tracked validators in the current sync committee. They are refreshed at every
+	// epoch, so that blocks which touch no tracked validator are skipped without loading
+	// their post state.
+	trackedCommittees    map[committeeKey]bool
+	trackedSyncPositions []uint64
+	committeesEpoch      types.Epoch
+}
+
+// committeeKey identifies the committee of a slot.
+type committeeKey struct {
+	slot  types.Slot
+	index types.CommitteeIndex
+}
+
+// NewService creates a validator monitor service for the configured validator indices.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.TrackedValidators) == 0 {
+		return nil, errors.New("no validator indices to monitor")
+	}
+	trackedIndices := make(map[types.ValidatorIndex]bool, len(cfg.TrackedValidators))
+	for _, idx := range cfg.TrackedValidators {
+		trackedIndices[idx] = true
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:              cfg,
+		ctx:              ctx,
+		cancel:           cancel,
+		trackedIndices:   trackedIndices,
+		balances:         make(map[types.ValidatorIndex]uint64),
+		lastAttestedSlot: make(map[types.ValidatorIndex]types.Slot),
+		performance:      make(map[types.ValidatorIndex]*epochPerformance),
+	}, nil
+}
+
+// Start the validator monitor.
+func (s *Service) Start() {
+	log.WithField("validatorIndices", s.cfg.TrackedValidators).Info("Starting validator monitor")
+	go s.run()
+}
+
+// Stop the validator monitor.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the validator monitor.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Received wrong data over state feed")
+				continue
+			}
+			if err := s.processBlock(s.ctx, data); err != nil {
+				log.WithError(err).Error("Could not monitor processed block")
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state events")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
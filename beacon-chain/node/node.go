@@ -16,15 +16,18 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	gateway2 "github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
@@ -34,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
@@ -78,6 +82,11 @@ type BeaconNode struct {
 	forkChoiceStore   forkchoice.ForkChoicer
 	stateGen          *stategen.State
 	collector         *bcnodeCollector
+	rpcRecorder       *regularsync.RPCRecorder
+	slasherDB         db.SlasherDatabase
+	// Feeds of the in-process slasher, which are only set when slasher is enabled.
+	slasherAttestationsFeed *event.Feed
+	slasherBlockHeadersFeed *event.Feed
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -99,6 +108,9 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 
 	// Initializes any forks here.
 	params.BeaconConfig().InitializeForkSchedule()
+	if err := params.BeaconConfig().VerifySupportedForks(); err != nil {
+		return nil, err
+	}
 
 	registry := shared.NewServiceRegistry()
 
@@ -126,12 +138,24 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.SlasherFlag.Name) {
+		beacon.slasherAttestationsFeed = new(event.Feed)
+		beacon.slasherBlockHeadersFeed = new(event.Feed)
+		if err := beacon.startSlasherDB(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
 		return nil, err
 	}
 
+	if err := beacon.startRPCRecorder(cliCtx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerPOWChainService(); err != nil {
 		return nil, err
 	}
@@ -154,10 +178,22 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.IsSet(flags.MonitorIndices.Name) {
+		if err := beacon.registerValidatorMonitorService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerSyncService(); err != nil {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.SlasherFlag.Name) {
+		if err := beacon.registerSlasherService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -243,6 +279,16 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.slasherDB != nil {
+		if err := b.slasherDB.Close(); err != nil {
+			log.Errorf("Failed to close slasher database: %v", err)
+		}
+	}
+	if b.rpcRecorder != nil {
+		if err := b.rpcRecorder.Close(); err != nil {
+			log.Errorf("Failed to close rpc recording: %v", err)
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
@@ -351,6 +397,49 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
+	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
+	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName, slasherkv.SlasherDbDirName)
+	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
+	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
+
+	log.WithField("database-path", dbPath).Info("Checking slasher DB")
+
+	d, err := slasherkv.NewKVStore(b.ctx, dbPath, &slasherkv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	clearDBConfirmed := false
+	if clearDB && !forceClearDB {
+		actionText := "This will delete your slasher database stored in your data directory. " +
+			"Your database backups will not be removed - do you want to proceed? (Y/N)"
+		deniedText := "Slasher database will not be deleted. No changes have been made."
+		clearDBConfirmed, err = cmd.ConfirmAction(actionText, deniedText)
+		if err != nil {
+			return err
+		}
+	}
+	if clearDBConfirmed || forceClearDB {
+		log.Warning("Removing slasher database")
+		if err := d.Close(); err != nil {
+			return errors.Wrap(err, "could not close slasher db prior to clearing")
+		}
+		if err := d.ClearDB(); err != nil {
+			return errors.Wrap(err, "could not clear slasher database")
+		}
+		d, err = slasherkv.NewKVStore(b.ctx, dbPath, &slasherkv.Config{
+			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not create new slasher database")
+		}
+	}
+	b.slasherDB = d
+	return nil
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
 }
@@ -362,25 +451,28 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootstrapNodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           dataDir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
-		DB:                b.db,
+		NoDiscovery:         cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:         sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		BootstrapNodeAddr:   bootstrapNodeAddrs,
+		RelayNodeAddr:       cliCtx.String(cmd.RelayNode.Name),
+		DataDir:             dataDir,
+		LocalIP:             cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:         cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:             cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:          cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:         cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:             cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:             cliCtx.Uint(cmd.P2PUDPPort.Name),
+		EnableQUIC:          cliCtx.Bool(cmd.P2PQUIC.Name),
+		QUICPort:            cliCtx.Uint(cmd.P2PQUICPort.Name),
+		MaxPeers:            cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:       cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:        sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:          cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:       cliCtx.Bool(flags.DisableDiscv5.Name),
+		GossipScoringConfig: cliCtx.String(flags.GossipScoringConfig.Name),
+		StateNotifier:       b,
+		DB:                  b.db,
 	})
 	if err != nil {
 		return err
@@ -388,7 +480,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	return b.services.RegisterService(svc)
 }
 
-func (b *BeaconNode) fetchP2P() p2p.P2P {
+func (b *BeaconNode) fetchP2P() *p2p.Service {
 	var p *p2p.Service
 	if err := b.services.FetchService(&p); err != nil {
 		panic(err)
@@ -396,6 +488,33 @@ func (b *BeaconNode) fetchP2P() p2p.P2P {
 	return p
 }
 
+// startRPCRecorder starts recording the req/resp exchanges of the sync services, if enabled.
+func (b *BeaconNode) startRPCRecorder(cliCtx *cli.Context) error {
+	path := cliCtx.String(flags.RPCRecorderFile.Name)
+	if path == "" {
+		return nil
+	}
+	recorder, err := regularsync.NewRPCRecorder(&regularsync.RPCRecorderConfig{
+		Path:        path,
+		MaxFileSize: int64(cliCtx.Int(flags.RPCRecorderMaxSize.Name)) << 20,
+	})
+	if err != nil {
+		return err
+	}
+	log.WithField("path", path).Warn("Recording all req/resp exchanges, this is meant for debugging only")
+	b.rpcRecorder = recorder
+	return nil
+}
+
+// fetchSyncP2P returns the p2p service used by the sync services, which records their
+// req/resp exchanges if enabled.
+func (b *BeaconNode) fetchSyncP2P() p2p.P2P {
+	if b.rpcRecorder != nil {
+		return b.rpcRecorder.WrapP2P(b.fetchP2P())
+	}
+	return b.fetchP2P()
+}
+
 func (b *BeaconNode) registerAttestationPool() error {
 	s, err := attestations.NewService(b.ctx, &attestations.Config{
 		Pool: b.attestationPool,
@@ -438,6 +557,8 @@ func (b *BeaconNode) registerBlockchainService() error {
 		AttService:              attService,
 		StateGen:                b.stateGen,
 		WeakSubjectivityCheckpt: wsCheckpt,
+		SlasherAttestationsFeed: b.slasherAttestationsFeed,
+		ExecutionEngineCaller:   web3Service.ExecutionEngineCaller(),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -445,6 +566,26 @@ func (b *BeaconNode) registerBlockchainService() error {
 	return b.services.RegisterService(blockchainService)
 }
 
+func (b *BeaconNode) registerValidatorMonitorService() error {
+	indices := b.cliCtx.IntSlice(flags.MonitorIndices.Name)
+	tracked := make([]types.ValidatorIndex, len(indices))
+	for i, idx := range indices {
+		if idx < 0 {
+			return fmt.Errorf("invalid validator index %d to monitor", idx)
+		}
+		tracked[i] = types.ValidatorIndex(idx)
+	}
+	monitorService, err := monitor.NewService(b.ctx, &monitor.Config{
+		StateNotifier:     b,
+		StateGen:          b.stateGen,
+		TrackedValidators: tracked,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register validator monitor service")
+	}
+	return b.services.RegisterService(monitorService)
+}
+
 func (b *BeaconNode) registerPOWChainService() error {
 	if b.cliCtx.Bool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Service{})
@@ -454,6 +595,14 @@ func (b *BeaconNode) registerPOWChainService() error {
 	if err != nil {
 		return err
 	}
+	engineEndpoint, jwtSecret, err := registration.ExecutionEnginePreregistration(b.cliCtx)
+	if err != nil {
+		return err
+	}
+	depositSnapshot, err := registration.DepositSnapshotPreregistration(b.cliCtx)
+	if err != nil {
+		return err
+	}
 
 	bs, err := powchain.NewPowchainCollector(b.ctx)
 	if err != nil {
@@ -461,14 +610,18 @@ func (b *BeaconNode) registerPOWChainService() error {
 	}
 
 	cfg := &powchain.Web3ServiceConfig{
-		HttpEndpoints:          endpoints,
-		DepositContract:        common.HexToAddress(depAddress),
-		BeaconDB:               b.db,
-		DepositCache:           b.depositCache,
-		StateNotifier:          b,
-		StateGen:               b.stateGen,
-		Eth1HeaderReqLimit:     b.cliCtx.Uint64(flags.Eth1HeaderReqLimit.Name),
-		BeaconNodeStatsUpdater: bs,
+		HttpEndpoints:           endpoints,
+		EndpointHeaders:         b.cliCtx.StringSlice(flags.Web3ProviderHeaderFlag.Name),
+		DepositContract:         common.HexToAddress(depAddress),
+		BeaconDB:                b.db,
+		DepositCache:            b.depositCache,
+		StateNotifier:           b,
+		StateGen:                b.stateGen,
+		Eth1HeaderReqLimit:      b.cliCtx.Uint64(flags.Eth1HeaderReqLimit.Name),
+		BeaconNodeStatsUpdater:  bs,
+		ExecutionEngineEndpoint: engineEndpoint,
+		ExecutionJWTSecret:      jwtSecret,
+		DepositSnapshot:         depositSnapshot,
 	}
 
 	web3Service, err := powchain.NewService(b.ctx, cfg)
@@ -497,7 +650,7 @@ func (b *BeaconNode) registerSyncService() error {
 
 	rs := regularsync.NewService(b.ctx, &regularsync.Config{
 		DB:                b.db,
-		P2P:               b.fetchP2P(),
+		P2P:               b.fetchSyncP2P(),
 		Chain:             chainService,
 		InitialSync:       initSync,
 		StateNotifier:     b,
@@ -508,11 +661,43 @@ func (b *BeaconNode) registerSyncService() error {
 		SlashingPool:      b.slashingsPool,
 		SyncCommsPool:     b.syncCommitteePool,
 		StateGen:          b.stateGen,
+
+		SlasherAttestationsFeed: b.slasherAttestationsFeed,
+		SlasherBlockHeadersFeed: b.slasherBlockHeadersFeed,
 	})
 
 	return b.services.RegisterService(rs)
 }
 
+func (b *BeaconNode) registerSlasherService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var backfillFromEpoch *types.Epoch
+	if b.cliCtx.IsSet(flags.SlasherBackfillFromEpoch.Name) {
+		epoch := types.Epoch(b.cliCtx.Uint64(flags.SlasherBackfillFromEpoch.Name))
+		backfillFromEpoch = &epoch
+	}
+
+	slasherSrv, err := slasher.NewService(b.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: b.slasherAttestationsFeed,
+		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
+		Database:                b.slasherDB,
+		StateNotifier:           b,
+		HeadStateFetcher:        chainService,
+		SlashingPoolInserter:    b.slashingsPool,
+		BeaconDatabase:          b.db,
+		StateGen:                b.stateGen,
+		BackfillFromEpoch:       backfillFromEpoch,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register slasher service")
+	}
+	return b.services.RegisterService(slasherSrv)
+}
+
 func (b *BeaconNode) registerInitialSyncService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -522,7 +707,7 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	is := initialsync.NewService(b.ctx, &initialsync.Config{
 		DB:            b.db,
 		Chain:         chainService,
-		P2P:           b.fetchP2P(),
+		P2P:           b.fetchSyncP2P(),
 		StateNotifier: b,
 		BlockNotifier: b,
 	})
@@ -545,6 +730,11 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var regularSyncService *regularsync.Service
+	if err := b.services.FetchService(&regularSyncService); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -582,6 +772,7 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		GossipScoringProvider:   p2pService,
 		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
@@ -600,6 +791,7 @@ func (b *BeaconNode) registerRPCService() error {
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
+		PendingQueueFetcher:     regularSyncService,
 		DepositFetcher:          depositFetcher,
 		PendingDepositFetcher:   b.depositCache,
 		BlockNotifier:           b,
@@ -608,6 +800,7 @@ func (b *BeaconNode) registerRPCService() error {
 		StateGen:                b.stateGen,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		MaxMsgSize:              maxMsgSize,
+		SlasherDB:               b.slasherDB,
 	})
 
 	return b.services.RegisterService(rpcService)
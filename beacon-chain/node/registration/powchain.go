@@ -4,9 +4,13 @@ import (
 	"errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // PowchainPreregistration prepares data for powchain.Service's registration.
@@ -30,6 +34,41 @@ func PowchainPreregistration(cliCtx *cli.Context) (depositContractAddress string
 	return
 }
 
+// ExecutionEnginePreregistration returns the endpoint of the engine API of the execution client, and
+// the secret authenticating the requests to it, if configured.
+func ExecutionEnginePreregistration(cliCtx *cli.Context) (endpoint string, jwtSecret []byte, err error) {
+	endpoint = cliCtx.String(flags.ExecutionEngineEndpoint.Name)
+	secretPath := cliCtx.String(flags.ExecutionJWTSecretFlag.Name)
+	if secretPath == "" {
+		if endpoint != "" {
+			log.Warn("No jwt secret specified, requests to the engine API of the execution client will not be authenticated")
+		}
+		return endpoint, nil, nil
+	}
+	jwtSecret, err = engine.LoadJWTSecret(secretPath)
+	if err != nil {
+		return "", nil, err
+	}
+	return endpoint, jwtSecret, nil
+}
+
+// DepositSnapshotPreregistration returns the deposit snapshot to start from, if configured.
+func DepositSnapshotPreregistration(cliCtx *cli.Context) (*ethpb.DepositSnapshot, error) {
+	snapshotPath := cliCtx.String(flags.DepositSnapshotFlag.Name)
+	if snapshotPath == "" {
+		return nil, nil
+	}
+	enc, err := fileutil.ReadFileAsBytes(snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &ethpb.DepositSnapshot{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(enc, snapshot); err != nil {
+		return nil, errors.New("could not decode deposit snapshot: " + err.Error())
+	}
+	return snapshot, nil
+}
+
 // DepositContractAddress returns the address of the deposit contract.
 func DepositContractAddress() (string, error) {
 	address := params.BeaconConfig().DepositContractAddress
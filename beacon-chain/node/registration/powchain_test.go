@@ -1,10 +1,15 @@
 package registration
 
 import (
+	"encoding/base64"
 	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -69,3 +74,60 @@ func TestDepositContractAddress_NotHexAddress(t *testing.T) {
 	_, err := DepositContractAddress()
 	assert.ErrorContains(t, "invalid deposit contract address given", err)
 }
+
+func TestExecutionEnginePreregistration(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, ioutil.WriteFile(secretPath, []byte(strings.Repeat("ab", 32)), 0600))
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "http://localhost:8550", "")
+	set.String(flags.ExecutionJWTSecretFlag.Name, secretPath, "")
+	ctx := cli.NewContext(&app, set, nil)
+
+	endpoint, secret, err := ExecutionEnginePreregistration(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8550", endpoint)
+	assert.DeepEqual(t, []byte(strings.Repeat("\xab", 32)), secret)
+}
+
+func TestExecutionEnginePreregistration_InvalidSecret(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, ioutil.WriteFile(secretPath, []byte("abcd"), 0600))
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "http://localhost:8550", "")
+	set.String(flags.ExecutionJWTSecretFlag.Name, secretPath, "")
+	ctx := cli.NewContext(&app, set, nil)
+
+	_, _, err := ExecutionEnginePreregistration(ctx)
+	assert.ErrorContains(t, "jwt secret must be a 32 bytes hex string", err)
+}
+
+func TestDepositSnapshotPreregistration(t *testing.T) {
+	snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+	// The format returned by the gateway, with bytes in base64 and integers as strings.
+	enc := `{"finalized":["` + base64.StdEncoding.EncodeToString([]byte{'a'}) + `"],"depositRoot":"` +
+		base64.StdEncoding.EncodeToString([]byte{'b'}) + `","depositCount":"1","executionBlockHeight":"10","unknown":1}`
+	require.NoError(t, ioutil.WriteFile(snapshotPath, []byte(enc), 0600))
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.DepositSnapshotFlag.Name, snapshotPath, "")
+	ctx := cli.NewContext(&app, set, nil)
+
+	snapshot, err := DepositSnapshotPreregistration(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{{'a'}}, snapshot.Finalized)
+	assert.DeepEqual(t, []byte{'b'}, snapshot.DepositRoot)
+	assert.Equal(t, uint64(1), snapshot.DepositCount)
+	assert.Equal(t, uint64(10), snapshot.ExecutionBlockHeight)
+
+	require.NoError(t, ioutil.WriteFile(snapshotPath, []byte("{"), 0600))
+	_, err = DepositSnapshotPreregistration(ctx)
+	assert.ErrorContains(t, "could not decode deposit snapshot", err)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String(flags.DepositSnapshotFlag.Name, "", "")
+	snapshot, err = DepositSnapshotPreregistration(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.DepositSnapshot)(nil), snapshot)
+}
@@ -14,6 +14,13 @@ import (
 type PoolManager interface {
 	PendingAttesterSlashings(ctx context.Context, state state.ReadOnlyBeaconState, noLimit bool) []*ethpb.AttesterSlashing
 	PendingProposerSlashings(ctx context.Context, state state.ReadOnlyBeaconState, noLimit bool) []*ethpb.ProposerSlashing
+	PoolInserter
+	MarkIncludedAttesterSlashing(as *ethpb.AttesterSlashing)
+	MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing)
+}
+
+// PoolInserter is capable of inserting new slashing objects into the operations pool.
+type PoolInserter interface {
 	InsertAttesterSlashing(
 		ctx context.Context,
 		state state.ReadOnlyBeaconState,
@@ -24,8 +31,6 @@ type PoolManager interface {
 		state state.BeaconState,
 		slashing *ethpb.ProposerSlashing,
 	) error
-	MarkIncludedAttesterSlashing(as *ethpb.AttesterSlashing)
-	MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing)
 }
 
 // Pool is a concrete implementation of PoolManager.
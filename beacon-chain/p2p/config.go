@@ -23,9 +23,12 @@ type Config struct {
 	MetaDataDir         string
 	TCPPort             uint
 	UDPPort             uint
+	EnableQUIC          bool
+	QUICPort            uint
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	GossipScoringConfig string
 	StateNotifier       statefeed.Notifier
 	DB                  db.ReadOnlyDatabase
 }
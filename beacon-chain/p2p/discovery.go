@@ -110,7 +110,7 @@ func (s *Service) listenForNewNodes() {
 			break
 		}
 		node := iterator.Node()
-		peerInfo, _, err := convertToAddrInfo(node)
+		peerInfo, _, err := s.nodeAddrInfo(node)
 		if err != nil {
 			log.WithError(err).Error("Could not convert to peer info")
 			continue
@@ -231,6 +231,9 @@ func (s *Service) createLocalNode(
 	localNode.Set(tcpEntry)
 	localNode.SetFallbackIP(ipAddr)
 	localNode.SetFallbackUDP(udpPort)
+	if s.cfg != nil && s.cfg.EnableQUIC {
+		localNode.Set(quicPort(s.cfg.QUICPort))
+	}
 
 	localNode, err = addForkEntry(localNode, s.genesisTime, s.genesisValidatorsRoot)
 	if err != nil {
@@ -280,7 +283,7 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 		}
 		return false
 	}
-	peerData, multiAddr, err := convertToAddrInfo(node)
+	peerData, multiAddr, err := s.nodeAddrInfo(node)
 	if err != nil {
 		log.WithError(err).Debug("Could not convert to peer data")
 		return false
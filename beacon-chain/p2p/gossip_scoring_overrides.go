@@ -0,0 +1,245 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// gossipScoringReloadPeriod is how often the gossip scoring config file is checked for changes.
+const gossipScoringReloadPeriod = time.Minute
+
+// gossipScoringOverrides holds the gossipsub scoring parameters which override the defaults,
+// as defined in the YAML file provided by the --p2p-gossip-scoring-config flag. A sample
+// file looks like:
+//
+//	thresholds:
+//	  graylist_threshold: -20000
+//	topics:
+//	  beacon_block:
+//	    invalid_message_deliveries_weight: -100
+//	    mesh_message_deliveries_activation: 10m
+//
+// Topic overrides are keyed by a substring of the topic they apply to, in the same manner
+// the default topic parameters are. Thresholds can only be overridden on startup, while
+// topic parameters are reapplied to the joined topics whenever the file changes.
+type gossipScoringOverrides struct {
+	Thresholds *thresholdOverrides             `yaml:"thresholds"`
+	Topics     map[string]*topicScoreOverrides `yaml:"topics"`
+}
+
+type thresholdOverrides struct {
+	GossipThreshold             *float64 `yaml:"gossip_threshold"`
+	PublishThreshold            *float64 `yaml:"publish_threshold"`
+	GraylistThreshold           *float64 `yaml:"graylist_threshold"`
+	AcceptPXThreshold           *float64 `yaml:"accept_px_threshold"`
+	OpportunisticGraftThreshold *float64 `yaml:"opportunistic_graft_threshold"`
+}
+
+type topicScoreOverrides struct {
+	TopicWeight                     *float64       `yaml:"topic_weight"`
+	TimeInMeshWeight                *float64       `yaml:"time_in_mesh_weight"`
+	TimeInMeshQuantum               *time.Duration `yaml:"time_in_mesh_quantum"`
+	TimeInMeshCap                   *float64       `yaml:"time_in_mesh_cap"`
+	FirstMessageDeliveriesWeight    *float64       `yaml:"first_message_deliveries_weight"`
+	FirstMessageDeliveriesDecay     *float64       `yaml:"first_message_deliveries_decay"`
+	FirstMessageDeliveriesCap       *float64       `yaml:"first_message_deliveries_cap"`
+	MeshMessageDeliveriesWeight     *float64       `yaml:"mesh_message_deliveries_weight"`
+	MeshMessageDeliveriesDecay      *float64       `yaml:"mesh_message_deliveries_decay"`
+	MeshMessageDeliveriesCap        *float64       `yaml:"mesh_message_deliveries_cap"`
+	MeshMessageDeliveriesThreshold  *float64       `yaml:"mesh_message_deliveries_threshold"`
+	MeshMessageDeliveriesWindow     *time.Duration `yaml:"mesh_message_deliveries_window"`
+	MeshMessageDeliveriesActivation *time.Duration `yaml:"mesh_message_deliveries_activation"`
+	MeshFailurePenaltyWeight        *float64       `yaml:"mesh_failure_penalty_weight"`
+	MeshFailurePenaltyDecay         *float64       `yaml:"mesh_failure_penalty_decay"`
+	InvalidMessageDeliveriesWeight  *float64       `yaml:"invalid_message_deliveries_weight"`
+	InvalidMessageDeliveriesDecay   *float64       `yaml:"invalid_message_deliveries_decay"`
+}
+
+// loadGossipScoringOverrides reads the gossip scoring overrides from the YAML file at the
+// given path. Unknown fields are rejected so that misspelled parameters are not silently ignored.
+func loadGossipScoringOverrides(path string) (*gossipScoringOverrides, error) {
+	enc, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read gossip scoring config")
+	}
+	overrides := &gossipScoringOverrides{}
+	if err := yaml.UnmarshalStrict(enc, overrides); err != nil {
+		return nil, errors.Wrap(err, "could not parse gossip scoring config")
+	}
+	for key := range overrides.Topics {
+		if key == "" {
+			return nil, errors.New("gossip scoring config contains an empty topic key")
+		}
+	}
+	return overrides, nil
+}
+
+// applyThresholds overrides the provided peer score thresholds.
+func (o *gossipScoringOverrides) applyThresholds(t *pubsub.PeerScoreThresholds) {
+	if o == nil || o.Thresholds == nil {
+		return
+	}
+	setFloat(&t.GossipThreshold, o.Thresholds.GossipThreshold)
+	setFloat(&t.PublishThreshold, o.Thresholds.PublishThreshold)
+	setFloat(&t.GraylistThreshold, o.Thresholds.GraylistThreshold)
+	setFloat(&t.AcceptPXThreshold, o.Thresholds.AcceptPXThreshold)
+	setFloat(&t.OpportunisticGraftThreshold, o.Thresholds.OpportunisticGraftThreshold)
+}
+
+// applyTopic overrides the provided parameters of the given topic. If several keys match
+// the topic, the longest one is used.
+func (o *gossipScoringOverrides) applyTopic(topic string, p *pubsub.TopicScoreParams) {
+	if o == nil {
+		return
+	}
+	var match string
+	for key := range o.Topics {
+		if strings.Contains(topic, key) && len(key) > len(match) {
+			match = key
+		}
+	}
+	if match == "" {
+		return
+	}
+	t := o.Topics[match]
+	if t == nil {
+		return
+	}
+	setFloat(&p.TopicWeight, t.TopicWeight)
+	setFloat(&p.TimeInMeshWeight, t.TimeInMeshWeight)
+	setDuration(&p.TimeInMeshQuantum, t.TimeInMeshQuantum)
+	setFloat(&p.TimeInMeshCap, t.TimeInMeshCap)
+	setFloat(&p.FirstMessageDeliveriesWeight, t.FirstMessageDeliveriesWeight)
+	setFloat(&p.FirstMessageDeliveriesDecay, t.FirstMessageDeliveriesDecay)
+	setFloat(&p.FirstMessageDeliveriesCap, t.FirstMessageDeliveriesCap)
+	setFloat(&p.MeshMessageDeliveriesWeight, t.MeshMessageDeliveriesWeight)
+	setFloat(&p.MeshMessageDeliveriesDecay, t.MeshMessageDeliveriesDecay)
+	setFloat(&p.MeshMessageDeliveriesCap, t.MeshMessageDeliveriesCap)
+	setFloat(&p.MeshMessageDeliveriesThreshold, t.MeshMessageDeliveriesThreshold)
+	setDuration(&p.MeshMessageDeliveriesWindow, t.MeshMessageDeliveriesWindow)
+	setDuration(&p.MeshMessageDeliveriesActivation, t.MeshMessageDeliveriesActivation)
+	setFloat(&p.MeshFailurePenaltyWeight, t.MeshFailurePenaltyWeight)
+	setFloat(&p.MeshFailurePenaltyDecay, t.MeshFailurePenaltyDecay)
+	setFloat(&p.InvalidMessageDeliveriesWeight, t.InvalidMessageDeliveriesWeight)
+	setFloat(&p.InvalidMessageDeliveriesDecay, t.InvalidMessageDeliveriesDecay)
+}
+
+func setFloat(dst *float64, v *float64) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+func setDuration(dst *time.Duration, v *time.Duration) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+// loadGossipScoringConfig loads the gossip scoring config file, if one is provided, and
+// applies its threshold overrides to the provided thresholds.
+func (s *Service) loadGossipScoringConfig(thresholds *pubsub.PeerScoreThresholds) error {
+	s.scoringLock.Lock()
+	defer s.scoringLock.Unlock()
+	s.scoreThresholds = thresholds
+	if s.cfg.GossipScoringConfig == "" {
+		return nil
+	}
+	info, err := os.Stat(s.cfg.GossipScoringConfig)
+	if err != nil {
+		return errors.Wrap(err, "could not read gossip scoring config")
+	}
+	overrides, err := loadGossipScoringOverrides(s.cfg.GossipScoringConfig)
+	if err != nil {
+		return err
+	}
+	overrides.applyThresholds(thresholds)
+	s.scoringOverrides = overrides
+	s.scoringConfigModTime = info.ModTime()
+	return nil
+}
+
+// reloadGossipScoringOverrides reloads the gossip scoring config file if it changed since it
+// was last loaded, and reapplies the topic parameters to every joined topic.
+func (s *Service) reloadGossipScoringOverrides() {
+	path := s.cfg.GossipScoringConfig
+	info, err := os.Stat(path)
+	if err != nil {
+		log.WithError(err).Error("Could not check gossip scoring config")
+		return
+	}
+	s.scoringLock.Lock()
+	if !info.ModTime().After(s.scoringConfigModTime) {
+		s.scoringLock.Unlock()
+		return
+	}
+	s.scoringConfigModTime = info.ModTime()
+	s.scoringLock.Unlock()
+
+	overrides, err := loadGossipScoringOverrides(path)
+	if err != nil {
+		log.WithError(err).Error("Could not reload gossip scoring config, keeping the current parameters")
+		return
+	}
+	s.scoringLock.Lock()
+	s.scoringOverrides = overrides
+	s.scoringLock.Unlock()
+
+	s.joinedTopicsLock.Lock()
+	topics := make(map[string]*pubsub.Topic, len(s.joinedTopics))
+	for t, handle := range s.joinedTopics {
+		topics[t] = handle
+	}
+	s.joinedTopicsLock.Unlock()
+
+	for topic, handle := range topics {
+		scoringParams, err := s.topicScoreParams(topic)
+		if err != nil || scoringParams == nil {
+			continue
+		}
+		if err := handle.SetScoreParams(scoringParams); err != nil {
+			log.WithError(err).WithField("topic", topic).Error("Could not apply gossip scoring parameters")
+			continue
+		}
+		s.setAppliedTopicScoreParams(topic, scoringParams)
+	}
+	log.WithFields(logrus.Fields{
+		"path":   path,
+		"topics": len(topics),
+	}).Info("Reloaded gossip scoring config")
+}
+
+// setAppliedTopicScoreParams records the scoring parameters applied to the topic.
+func (s *Service) setAppliedTopicScoreParams(topic string, p *pubsub.TopicScoreParams) {
+	s.scoringLock.Lock()
+	defer s.scoringLock.Unlock()
+	if s.appliedTopicParams == nil {
+		s.appliedTopicParams = make(map[string]*pubsub.TopicScoreParams)
+	}
+	s.appliedTopicParams[topic] = p
+}
+
+// TopicScoreParamsByTopic returns the scoring parameters applied to each scored topic.
+func (s *Service) TopicScoreParamsByTopic() map[string]*pubsub.TopicScoreParams {
+	s.scoringLock.RLock()
+	defer s.scoringLock.RUnlock()
+	applied := make(map[string]*pubsub.TopicScoreParams, len(s.appliedTopicParams))
+	for t, p := range s.appliedTopicParams {
+		applied[t] = p
+	}
+	return applied
+}
+
+// PeerScoreThresholds returns the thresholds peer gossip scores are checked against.
+func (s *Service) PeerScoreThresholds() *pubsub.PeerScoreThresholds {
+	s.scoringLock.RLock()
+	defer s.scoringLock.RUnlock()
+	return s.scoreThresholds
+}
@@ -0,0 +1,117 @@
+package p2p
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const testScoringConfig = `
+thresholds:
+  graylist_threshold: -20000
+topics:
+  beacon_block:
+    invalid_message_deliveries_weight: -100
+    mesh_message_deliveries_activation: 10m
+  beacon_attestation:
+    topic_weight: 0.5
+`
+
+func writeScoringConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "scoring.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadGossipScoringOverrides(t *testing.T) {
+	overrides, err := loadGossipScoringOverrides(writeScoringConfig(t, testScoringConfig))
+	require.NoError(t, err)
+
+	_, thresholds := peerScoringParams()
+	overrides.applyThresholds(thresholds)
+	assert.Equal(t, float64(-20000), thresholds.GraylistThreshold)
+	assert.Equal(t, float64(-4000), thresholds.GossipThreshold, "Expected threshold without override to be unchanged")
+
+	p := defaultBlockTopicParams()
+	weight := p.TopicWeight
+	overrides.applyTopic("/eth2/b5303f2a/beacon_block/ssz_snappy", p)
+	assert.Equal(t, float64(-100), p.InvalidMessageDeliveriesWeight)
+	assert.Equal(t, 10*time.Minute, p.MeshMessageDeliveriesActivation)
+	assert.Equal(t, weight, p.TopicWeight, "Expected parameter without override to be unchanged")
+}
+
+func TestLoadGossipScoringOverrides_RejectsUnknownFields(t *testing.T) {
+	_, err := loadGossipScoringOverrides(writeScoringConfig(t, "topics:\n  beacon_block:\n    topic_wieght: 1\n"))
+	assert.ErrorContains(t, "could not parse gossip scoring config", err)
+}
+
+func TestGossipScoringOverrides_LongestKeyWins(t *testing.T) {
+	overrides, err := loadGossipScoringOverrides(writeScoringConfig(t, `
+topics:
+  beacon:
+    topic_weight: 0.1
+  beacon_aggregate_and_proof:
+    topic_weight: 0.2
+`))
+	require.NoError(t, err)
+	p := &pubsub.TopicScoreParams{}
+	overrides.applyTopic("/eth2/b5303f2a/beacon_aggregate_and_proof/ssz_snappy", p)
+	assert.Equal(t, 0.2, p.TopicWeight)
+	overrides.applyTopic("/eth2/b5303f2a/beacon_block/ssz_snappy", p)
+	assert.Equal(t, 0.1, p.TopicWeight)
+}
+
+func TestService_TopicScoreParams_AppliesOverrides(t *testing.T) {
+	s := &Service{
+		ctx:                  context.Background(),
+		cfg:                  &Config{GossipScoringConfig: writeScoringConfig(t, testScoringConfig)},
+		activeValidatorCount: 16384,
+	}
+	_, thresholds := peerScoringParams()
+	require.NoError(t, s.loadGossipScoringConfig(thresholds))
+	assert.Equal(t, float64(-20000), s.PeerScoreThresholds().GraylistThreshold)
+
+	p, err := s.topicScoreParams("/eth2/b5303f2a/beacon_block/ssz_snappy")
+	require.NoError(t, err)
+	assert.Equal(t, float64(-100), p.InvalidMessageDeliveriesWeight)
+	p, err = s.topicScoreParams("/eth2/b5303f2a/voluntary_exit/ssz_snappy")
+	require.NoError(t, err)
+	assert.Equal(t, defaultVoluntaryExitTopicParams().InvalidMessageDeliveriesWeight, p.InvalidMessageDeliveriesWeight)
+}
+
+func TestService_ReloadGossipScoringOverrides(t *testing.T) {
+	path := writeScoringConfig(t, testScoringConfig)
+	s := &Service{
+		ctx:                  context.Background(),
+		cfg:                  &Config{GossipScoringConfig: path},
+		activeValidatorCount: 16384,
+	}
+	_, thresholds := peerScoringParams()
+	require.NoError(t, s.loadGossipScoringConfig(thresholds))
+
+	// An invalid config keeps the current parameters.
+	require.NoError(t, ioutil.WriteFile(path, []byte("topics: ["), 0600))
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	s.reloadGossipScoringOverrides()
+	p, err := s.topicScoreParams("/eth2/b5303f2a/beacon_block/ssz_snappy")
+	require.NoError(t, err)
+	assert.Equal(t, float64(-100), p.InvalidMessageDeliveriesWeight)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("topics:\n  beacon_block:\n    invalid_message_deliveries_weight: -50\n"), 0600))
+	modTime = modTime.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	s.reloadGossipScoringOverrides()
+	p, err = s.topicScoreParams("/eth2/b5303f2a/beacon_block/ssz_snappy")
+	require.NoError(t, err)
+	assert.Equal(t, float64(-50), p.InvalidMessageDeliveriesWeight)
+	// Thresholds are only overridden on startup.
+	assert.Equal(t, float64(-20000), s.PeerScoreThresholds().GraylistThreshold)
+}
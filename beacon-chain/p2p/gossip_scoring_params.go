@@ -86,7 +86,20 @@ func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds)
 	return scoreParams, thresholds
 }
 
+// topicScoreParams returns the scoring parameters of the topic, with any overrides
+// from the gossip scoring config applied.
 func (s *Service) topicScoreParams(topic string) (*pubsub.TopicScoreParams, error) {
+	scoringParams, err := s.defaultTopicScoreParams(topic)
+	if err != nil || scoringParams == nil {
+		return scoringParams, err
+	}
+	s.scoringLock.RLock()
+	defer s.scoringLock.RUnlock()
+	s.scoringOverrides.applyTopic(topic, scoringParams)
+	return scoringParams, nil
+}
+
+func (s *Service) defaultTopicScoreParams(topic string) (*pubsub.TopicScoreParams, error) {
 	activeValidators, err := s.retrieveActiveValidators()
 	if err != nil {
 		return nil, err
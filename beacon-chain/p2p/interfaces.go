@@ -97,3 +97,9 @@ type MetadataProvider interface {
 	Metadata() metadata.Metadata
 	MetadataSeq() uint64
 }
+
+// GossipScoringProvider returns the parameters used to score peers on gossipsub.
+type GossipScoringProvider interface {
+	TopicScoreParamsByTopic() map[string]*pubsub.TopicScoreParams
+	PeerScoreThresholds() *pubsub.PeerScoreThresholds
+}
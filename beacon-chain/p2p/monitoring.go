@@ -35,6 +35,11 @@ var (
 		Name: "p2p_sync_committee_subnet_attempted_broadcasts",
 		Help: "The number of sync committee that were attempted to be broadcast.",
 	})
+	subnetPeerCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_subnet_peer_count",
+		Help: "The number of active peers subscribed to a subnet, as advertised in their metadata.",
+	},
+		[]string{"subnet_type", "subnet"})
 )
 
 func (s *Service) updateMetrics() {
@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/peer"
 	noise "github.com/libp2p/go-libp2p-noise"
+	libp2pquic "github.com/libp2p/go-libp2p-quic-transport"
 	"github.com/libp2p/go-tcp-transport"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -30,13 +31,28 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			log.Fatalf("Failed to p2p listen: %v", err)
 		}
 	}
+	listenAddrs := []ma.Multiaddr{listen}
+	if cfg.EnableQUIC {
+		listenIP := ip.String()
+		if cfg.LocalIP != "" {
+			listenIP = cfg.LocalIP
+		}
+		quicListen, err := quicMultiAddressBuilder(listenIP, cfg.QUICPort)
+		if err != nil {
+			log.Fatalf("Failed to p2p listen: %v", err)
+		}
+		listenAddrs = append(listenAddrs, quicListen)
+	}
 	options := []libp2p.Option{
 		privKeyOption(priKey),
-		libp2p.ListenAddrs(listen),
+		libp2p.ListenAddrs(listenAddrs...),
 		libp2p.UserAgent(version.BuildData()),
 		libp2p.ConnectionGater(s),
 		libp2p.Transport(tcp.NewTCPTransport),
 	}
+	if cfg.EnableQUIC {
+		options = append(options, libp2p.Transport(libp2pquic.NewTransport))
+	}
 
 	options = append(options, libp2p.Security(noise.ID, noise.New))
 
@@ -57,6 +73,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if cfg.EnableQUIC {
+				external, err := quicMultiAddressBuilder(cfg.HostAddress, cfg.QUICPort)
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, external)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -68,6 +92,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if cfg.EnableQUIC {
+				external, err := ma.NewMultiaddr(fmt.Sprintf("/dns4/%s/udp/%d/quic", cfg.HostDNS, cfg.QUICPort))
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, external)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -87,6 +119,17 @@ func multiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
 	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/tcp/%d", ipAddr, port))
 }
 
+func quicMultiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
+	parsedIP := net.ParseIP(ipAddr)
+	if parsedIP.To4() == nil && parsedIP.To16() == nil {
+		return nil, errors.Errorf("invalid ip address provided: %s", ipAddr)
+	}
+	if parsedIP.To4() != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/udp/%d/quic", ipAddr, port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/udp/%d/quic", ipAddr, port))
+}
+
 func multiAddressBuilderWithID(ipAddr, protocol string, port uint, id peer.ID) (ma.Multiaddr, error) {
 	parsedIP := net.ParseIP(ipAddr)
 	if parsedIP.To4() == nil && parsedIP.To16() == nil {
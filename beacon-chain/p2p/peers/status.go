@@ -290,6 +290,74 @@ func (p *Status) SubscribedToSubnet(index uint64) []peer.ID {
 	return peers
 }
 
+// SubnetPeerCounts returns the number of active peers subscribed to each attestation subnet and
+// to each sync committee subnet, as advertised in the metadata of the peers.
+func (p *Status) SubnetPeerCounts() (attCounts, syncCounts []int) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.subnetPeerCounts()
+}
+
+// subnetPeerCounts counts the active peers of every subnet. This method assumes the store lock is acquired.
+func (p *Status) subnetPeerCounts() (attCounts, syncCounts []int) {
+	attCounts = make([]int, params.BeaconNetworkConfig().AttestationSubnetCount)
+	syncCounts = make([]int, params.BeaconConfig().SyncCommitteeSubnetCount)
+	for _, peerData := range p.store.Peers() {
+		if peerData.ConnState != PeerConnecting && peerData.ConnState != PeerConnected {
+			continue
+		}
+		attSubnets, syncSubnets := subnetsFromMetadata(peerData.MetaData)
+		for _, idx := range attSubnets {
+			if idx < uint64(len(attCounts)) {
+				attCounts[idx]++
+			}
+		}
+		for _, idx := range syncSubnets {
+			if idx < uint64(len(syncCounts)) {
+				syncCounts[idx]++
+			}
+		}
+	}
+	return attCounts, syncCounts
+}
+
+// subnetRedundancy returns the lowest peer count amongst the subnets advertised in the metadata,
+// which is the number of peers that would be left in the sparsest of these subnets if the peer
+// were not counted. Peers without any subnets are fully redundant.
+func subnetRedundancy(md metadata.Metadata, attCounts, syncCounts []int) int {
+	redundancy := math.MaxInt32
+	attSubnets, syncSubnets := subnetsFromMetadata(md)
+	for _, idx := range attSubnets {
+		if idx < uint64(len(attCounts)) && attCounts[idx]-1 < redundancy {
+			redundancy = attCounts[idx] - 1
+		}
+	}
+	for _, idx := range syncSubnets {
+		if idx < uint64(len(syncCounts)) && syncCounts[idx]-1 < redundancy {
+			redundancy = syncCounts[idx] - 1
+		}
+	}
+	return redundancy
+}
+
+// subnetsFromMetadata returns the attestation and sync committee subnets advertised in the metadata.
+func subnetsFromMetadata(md metadata.Metadata) (attSubnets, syncSubnets []uint64) {
+	if md == nil || md.IsNil() {
+		return nil, nil
+	}
+	if attnets := md.AttnetsBitfield(); len(attnets) > 0 {
+		attSubnets = indicesFromBitfield(attnets)
+	}
+	if v1 := md.MetadataObjV1(); v1 != nil && len(v1.Syncnets) > 0 {
+		for i := uint64(0); i < v1.Syncnets.Len(); i++ {
+			if v1.Syncnets.BitAt(i) {
+				syncSubnets = append(syncSubnets, i)
+			}
+		}
+	}
+	return attSubnets, syncSubnets
+}
+
 // SetConnectionState sets the connection state of the given remote peer.
 func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionState) {
 	p.store.Lock()
@@ -684,8 +752,11 @@ func (p *Status) BestNonFinalized(minPeers int, ourHeadEpoch types.Epoch) (types
 // PeersToPrune selects the most sutiable inbound peers
 // to disconnect the host peer from. As of this moment
 // the pruning relies on simple heuristics such as
-// bad response count. In the future scoring will be used
-// to determine the most suitable peers to take out.
+// bad response count. Amongst peers with the same bad
+// response count, the peers whose subnets are the most
+// overrepresented are pruned first, so that the sparse
+// subnets keep their peers. In the future scoring will
+// be used to determine the most suitable peers to take out.
 func (p *Status) PeersToPrune() []peer.ID {
 	connLimit := p.ConnectedPeerLimit()
 	inBoundLimit := p.InboundLimit()
@@ -700,25 +771,32 @@ func (p *Status) PeersToPrune() []peer.ID {
 	defer p.store.Unlock()
 
 	type peerResp struct {
-		pid     peer.ID
-		badResp int
+		pid        peer.ID
+		badResp    int
+		redundancy int
 	}
+	attCounts, syncCounts := p.subnetPeerCounts()
 	peersToPrune := make([]*peerResp, 0)
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
 			peerData.Direction == network.DirInbound {
 			peersToPrune = append(peersToPrune, &peerResp{
-				pid:     pid,
-				badResp: peerData.BadResponses,
+				pid:        pid,
+				badResp:    peerData.BadResponses,
+				redundancy: subnetRedundancy(peerData.MetaData, attCounts, syncCounts),
 			})
 		}
 	}
 
 	// Sort in descending order to favour pruning peers with a
-	// higher bad response count.
+	// higher bad response count, and then peers whose subnets
+	// have the most peers.
 	sort.Slice(peersToPrune, func(i, j int) bool {
-		return peersToPrune[i].badResp > peersToPrune[j].badResp
+		if peersToPrune[i].badResp != peersToPrune[j].badResp {
+			return peersToPrune[i].badResp > peersToPrune[j].badResp
+		}
+		return peersToPrune[i].redundancy > peersToPrune[j].redundancy
 	})
 
 	// Determine amount of peers to prune using our
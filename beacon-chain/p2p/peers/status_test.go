@@ -990,6 +990,61 @@ func TestOutbound(t *testing.T) {
 	assert.Equal(t, outbound.Pretty(), result[0].Pretty())
 }
 
+func TestSubnetPeerCounts(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(3, true)
+	attnets.SetBitAt(7, true)
+	syncnets := bitfield.Bitvector4{0}
+	syncnets.SetBitAt(1, true)
+	pid := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+	p.SetMetadata(pid, wrapper.WrappedMetadataV1(&pb.MetaDataV1{Attnets: attnets, Syncnets: syncnets}))
+	pid = createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+	p.SetMetadata(pid, wrapper.WrappedMetadataV0(&pb.MetaDataV0{Attnets: attnets}))
+	// Disconnected peers are not counted.
+	pid = createPeer(t, p, nil, network.DirInbound, peers.PeerDisconnected)
+	p.SetMetadata(pid, wrapper.WrappedMetadataV0(&pb.MetaDataV0{Attnets: attnets}))
+
+	attCounts, syncCounts := p.SubnetPeerCounts()
+	require.Equal(t, int(params.BeaconNetworkConfig().AttestationSubnetCount), len(attCounts))
+	require.Equal(t, int(params.BeaconConfig().SyncCommitteeSubnetCount), len(syncCounts))
+	assert.Equal(t, 2, attCounts[3])
+	assert.Equal(t, 2, attCounts[7])
+	assert.Equal(t, 0, attCounts[0])
+	assert.Equal(t, 1, syncCounts[1])
+	assert.Equal(t, 0, syncCounts[0])
+}
+
+func TestPrunePeers_PrefersOverrepresentedSubnets(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	crowded := bitfield.NewBitvector64()
+	crowded.SetBitAt(0, true)
+	sparse := bitfield.NewBitvector64()
+	sparse.SetBitAt(1, true)
+	sparsePeers := make(map[peer.ID]bool)
+	for i := 0; i < 32; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+		attnets := crowded
+		if i%8 == 0 {
+			attnets = sparse
+			sparsePeers[pid] = true
+		}
+		p.SetMetadata(pid, wrapper.WrappedMetadataV0(&pb.MetaDataV0{Attnets: attnets}))
+	}
+
+	peersToPrune := p.PeersToPrune()
+	require.Equal(t, 8, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, sparsePeers[pid], "Expected peers of the sparse subnet to be kept")
+	}
+}
+
 // addPeer is a helper to add a peer with a given connection state)
 func addPeer(t *testing.T, p *peers.Status, state peerdata.PeerConnectionState) peer.ID {
 	// Set up some peers with different states
@@ -115,6 +115,7 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 		if err := topicHandle.SetScoreParams(scoringParams); err != nil {
 			return nil, err
 		}
+		s.setAppliedTopicScoreParams(topic, scoringParams)
 		logGossipParameters(topic, scoringParams)
 	}
 	return topicHandle.Subscribe(opts...)
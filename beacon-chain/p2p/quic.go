@@ -0,0 +1,62 @@
+package p2p
+
+import (
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+)
+
+// quicPort is the ENR entry advertising the udp port a node accepts QUIC connections on.
+type quicPort uint16
+
+// ENRKey returns the key of the entry in the node record.
+func (quicPort) ENRKey() string { return "quic" }
+
+// convertToQUICMultiAddr builds the QUIC multiaddress of the node, which fails if
+// the node does not advertise a QUIC port in its record.
+func convertToQUICMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
+	var port quicPort
+	if err := node.Load(&port); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve quic port")
+	}
+	pubkey := node.Pubkey()
+	assertedKey := convertToInterfacePubkey(pubkey)
+	id, err := peer.IDFromPublicKey(assertedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get peer id")
+	}
+	addr, err := quicMultiAddressBuilder(node.IP().String(), uint(port))
+	if err != nil {
+		return nil, err
+	}
+	idAddr, err := ma.NewMultiaddr("/p2p/" + id.String())
+	if err != nil {
+		return nil, err
+	}
+	return addr.Encapsulate(idAddr), nil
+}
+
+// nodeAddrInfo returns the address info used to dial the node. When QUIC is enabled and the
+// node advertises a QUIC port, the QUIC address is returned and placed ahead of the TCP address,
+// so that QUIC is preferred while TCP remains as a fallback.
+func (s *Service) nodeAddrInfo(node *enode.Node) (*peer.AddrInfo, ma.Multiaddr, error) {
+	info, multiAddr, err := convertToAddrInfo(node)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.cfg == nil || !s.cfg.EnableQUIC {
+		return info, multiAddr, nil
+	}
+	quicAddr, err := convertToQUICMultiAddr(node)
+	if err != nil {
+		// The node does not support QUIC, so it is dialed over TCP.
+		return info, multiAddr, nil
+	}
+	quicInfo, err := peer.AddrInfoFromP2pAddr(quicAddr)
+	if err != nil {
+		return info, multiAddr, nil
+	}
+	info.Addrs = append(quicInfo.Addrs, info.Addrs...)
+	return info, quicAddr, nil
+}
@@ -0,0 +1,79 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCreateLocalNode_QUICEntry(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		cfg:                   &Config{EnableQUIC: true, QUICPort: 4000},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+	localNode, err := s.createLocalNode(pkey, ipAddr, 3000, 3000)
+	require.NoError(t, err)
+	var port quicPort
+	require.NoError(t, localNode.Node().Load(&port))
+	assert.Equal(t, quicPort(4000), port)
+
+	s.cfg.EnableQUIC = false
+	localNode, err = s.createLocalNode(pkey, ipAddr, 3000, 3000)
+	require.NoError(t, err)
+	assert.NotNil(t, localNode.Node().Load(&port), "Expected no quic entry when QUIC is disabled")
+}
+
+func TestNodeAddrInfo_PrefersQUIC(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		cfg:                   &Config{EnableQUIC: true, QUICPort: 4000},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+	localNode, err := s.createLocalNode(pkey, net.ParseIP("192.168.0.1"), 3000, 3000)
+	require.NoError(t, err)
+
+	info, addr, err := s.nodeAddrInfo(localNode.Node())
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.HasPrefix(addr.String(), "/ip4/192.168.0.1/udp/4000/quic/p2p/"), addr.String())
+	require.Equal(t, 2, len(info.Addrs))
+	assert.Equal(t, "/ip4/192.168.0.1/udp/4000/quic", info.Addrs[0].String())
+	assert.Equal(t, "/ip4/192.168.0.1/tcp/3000", info.Addrs[1].String())
+
+	// Nodes are dialed over TCP only when QUIC is disabled locally.
+	s.cfg.EnableQUIC = false
+	info, addr, err = s.nodeAddrInfo(localNode.Node())
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.HasPrefix(addr.String(), "/ip4/192.168.0.1/tcp/3000/p2p/"), addr.String())
+	assert.Equal(t, 1, len(info.Addrs))
+}
+
+func TestNodeAddrInfo_NoQUICEntry(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		cfg:                   &Config{},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+	localNode, err := s.createLocalNode(pkey, net.ParseIP("192.168.0.1"), 3000, 3000)
+	require.NoError(t, err)
+
+	s.cfg.EnableQUIC = true
+	info, _, err := s.nodeAddrInfo(localNode.Node())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(info.Addrs))
+	assert.Equal(t, "/ip4/192.168.0.1/tcp/3000", info.Addrs[0].String())
+}
+
+func TestNewService_QUICPortClash(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{EnableQUIC: true, QUICPort: 13000, UDPPort: 13000})
+	assert.ErrorContains(t, "quic port must differ from the discovery udp port", err)
+}
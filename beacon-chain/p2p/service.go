@@ -79,12 +79,21 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	scoringLock           sync.RWMutex
+	scoringOverrides      *gossipScoringOverrides
+	scoringConfigModTime  time.Time
+	scoreThresholds       *pubsub.PeerScoreThresholds
+	appliedTopicParams    map[string]*pubsub.TopicScoreParams
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
 // connections are made until the Start function is called during the service registry startup.
 func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	var err error
+	if cfg.EnableQUIC && !cfg.DisableDiscv5 && cfg.QUICPort == cfg.UDPPort {
+		return nil, errors.New("quic port must differ from the discovery udp port")
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	_ = cancel // govet fix for lost cancel. Cancel is handled in service.Stop().
 
@@ -130,6 +139,12 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	s.host = h
 	s.host.RemoveStreamHandler(identify.IDDelta)
 
+	scoreParams, thresholds := peerScoringParams()
+	if err := s.loadGossipScoringConfig(thresholds); err != nil {
+		log.WithError(err).Error("Failed to load gossip scoring config")
+		return nil, err
+	}
+
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -141,7 +156,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pubsub.WithSubscriptionFilter(s),
 		pubsub.WithPeerOutboundQueueSize(256),
 		pubsub.WithValidateQueueSize(256),
-		pubsub.WithPeerScore(peerScoringParams()),
+		pubsub.WithPeerScore(scoreParams, s.scoreThresholds),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
 	}
@@ -231,9 +246,13 @@ func (s *Service) Start() {
 	})
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
+	if s.cfg.GossipScoringConfig != "" {
+		runutil.RunEvery(s.ctx, gossipScoringReloadPeriod, s.reloadGossipScoringOverrides)
+	}
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
 	})
+	runutil.RunEvery(s.ctx, subnetBackbonePeriod(), s.maintainSubnetBackbone)
 	runutil.RunEvery(s.ctx, 1*time.Minute, func() {
 		log.WithFields(logrus.Fields{
 			"inbound":     len(s.peers.InboundConnected()),
@@ -0,0 +1,148 @@
+package p2p
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// maxBackboneDialsPerRound caps the number of peers dialed in a single round of
+// subnet backbone maintenance, so that sparse subnets are filled up gradually
+// instead of flooding the node with new connections.
+const maxBackboneDialsPerRound = 16
+
+// subnetBackbonePeriod is how often the number of peers in each subnet is checked.
+func subnetBackbonePeriod() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+}
+
+// maintainSubnetBackbone makes sure that every attestation and sync committee subnet keeps a
+// minimum number of peers over time, regardless of the duties of the attached validators.
+// Subnets with fewer peers than required are searched for proactively, while pruning
+// prefers to disconnect from peers whose subnets are overrepresented.
+func (s *Service) maintainSubnetBackbone() {
+	attCounts, syncCounts := s.peers.SubnetPeerCounts()
+	updateSubnetPeerMetrics(attCounts, syncCounts)
+	if s.dv5Listener == nil {
+		return
+	}
+	// New peers are only dialed into free outbound slots, as dialing past the peer
+	// limit would only have peers pruned again right away.
+	dials := s.backboneDialBudget()
+	if dials == 0 {
+		return
+	}
+	minimum := params.BeaconNetworkConfig().MinimumPeersInSubnet
+	attSubnets := underrepresentedSubnets(attCounts, minimum)
+	syncSubnets := underrepresentedSubnets(syncCounts, minimum)
+	if len(attSubnets) == 0 && len(syncSubnets) == 0 {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"attestationSubnets":   attSubnets,
+		"syncCommitteeSubnets": syncSubnets,
+	}).Debug("Searching for peers in underrepresented subnets")
+
+	ctx, cancel := context.WithTimeout(s.ctx, subnetBackbonePeriod())
+	defer cancel()
+	iterator := filterNodes(ctx, s.dv5Listener.RandomNodes(), s.filterPeerForSubnets(attSubnets, syncSubnets))
+	// The lookup may block while the routing table is empty, so the iterator is
+	// closed once the round is over to unblock it.
+	go func() {
+		<-ctx.Done()
+		iterator.Close()
+	}()
+
+	wg := new(sync.WaitGroup)
+	for _, node := range enode.ReadNodes(iterator, dials) {
+		info, _, err := s.nodeAddrInfo(node)
+		if err != nil {
+			continue
+		}
+		s.Peers().RandomizeBackOff(info.ID)
+		wg.Add(1)
+		go func(info *peer.AddrInfo) {
+			defer wg.Done()
+			if err := s.connectWithPeer(ctx, *info); err != nil {
+				log.WithError(err).Tracef("Could not connect with peer %s", info.String())
+			}
+		}(info)
+	}
+	wg.Wait()
+}
+
+// backboneDialBudget returns the number of peers which can be dialed in a round of subnet
+// backbone maintenance, which is bounded by the free outbound peer slots.
+func (s *Service) backboneDialBudget() int {
+	if s.isPeerAtLimit(false /* inbound */) {
+		return 0
+	}
+	maxPeers := int(s.cfg.MaxPeers)
+	peerCount := len(s.Peers().Active())
+	if numOfConns := len(s.host.Network().Peers()); numOfConns > peerCount {
+		peerCount = numOfConns
+	}
+	budget := maxPeers - peerCount
+	if budget > maxBackboneDialsPerRound {
+		budget = maxBackboneDialsPerRound
+	}
+	return budget
+}
+
+// filterPeerForSubnets returns a filter which accepts valid peers advertising at least
+// one of the provided attestation or sync committee subnets in their record.
+func (s *Service) filterPeerForSubnets(attIndices, syncIndices []uint64) func(node *enode.Node) bool {
+	return func(node *enode.Node) bool {
+		if !s.filterPeer(node) {
+			return false
+		}
+		if len(attIndices) > 0 {
+			if subnets, err := attSubnets(node.Record()); err == nil && containsAny(subnets, attIndices) {
+				return true
+			}
+		}
+		if len(syncIndices) > 0 {
+			if subnets, err := syncSubnets(node.Record()); err == nil && containsAny(subnets, syncIndices) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// underrepresentedSubnets returns the subnets which have fewer peers than the minimum.
+func underrepresentedSubnets(counts []int, minimum uint64) []uint64 {
+	var subnets []uint64
+	for i, count := range counts {
+		if uint64(count) < minimum {
+			subnets = append(subnets, uint64(i))
+		}
+	}
+	return subnets
+}
+
+func containsAny(subnets, indices []uint64) bool {
+	for _, s := range subnets {
+		for _, i := range indices {
+			if s == i {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func updateSubnetPeerMetrics(attCounts, syncCounts []int) {
+	for i, count := range attCounts {
+		subnetPeerCount.WithLabelValues("attestation", strconv.Itoa(i)).Set(float64(count))
+	}
+	for i, count := range syncCounts {
+		subnetPeerCount.WithLabelValues("sync_committee", strconv.Itoa(i)).Set(float64(count))
+	}
+}
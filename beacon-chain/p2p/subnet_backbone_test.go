@@ -0,0 +1,137 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestUnderrepresentedSubnets(t *testing.T) {
+	assert.DeepEqual(t, []uint64{1, 3}, underrepresentedSubnets([]int{4, 3, 6, 0}, 4))
+	assert.Equal(t, 0, len(underrepresentedSubnets([]int{4, 5}, 4)))
+	assert.Equal(t, 0, len(underrepresentedSubnets([]int{0, 0}, 0)), "Expected no subnets without a minimum")
+}
+
+func TestContainsAny(t *testing.T) {
+	assert.Equal(t, true, containsAny([]uint64{1, 5, 9}, []uint64{2, 9}))
+	assert.Equal(t, false, containsAny([]uint64{1, 5, 9}, []uint64{2, 3}))
+	assert.Equal(t, false, containsAny(nil, []uint64{2}))
+}
+
+func TestBackboneDialBudget(t *testing.T) {
+	fakePeer := testp2p.NewTestP2P(t)
+	s := &Service{
+		cfg: &Config{MaxPeers: 30},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: fakePeer.BHost,
+	}
+	assert.Equal(t, maxBackboneDialsPerRound, s.backboneDialBudget())
+
+	for i := 0; i < 20; i++ {
+		_ = addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	assert.Equal(t, 10, s.backboneDialBudget(), "Expected dials to be capped at the free peer slots")
+
+	for i := 0; i < 10; i++ {
+		_ = addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	assert.Equal(t, 0, s.backboneDialBudget(), "Expected no dials at the peer limit")
+}
+
+func TestMaintainSubnetBackbone_AtPeerLimit(t *testing.T) {
+	logrus.SetLevel(logrus.DebugLevel)
+	defer logrus.SetLevel(logrus.InfoLevel)
+	hook := logTest.NewGlobal()
+
+	fakePeer := testp2p.NewTestP2P(t)
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		ctx:                   context.Background(),
+		cfg:                   &Config{MaxPeers: 30, UDPPort: 2500},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: fakePeer.BHost,
+	}
+	listener, err := s.createListener(ipAddr, pkey)
+	require.NoError(t, err)
+	defer listener.Close()
+	s.dv5Listener = listener
+
+	for i := 0; i < 30; i++ {
+		_ = addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	// None of the peers advertise any subnet, so every subnet is underrepresented.
+	s.maintainSubnetBackbone()
+	assert.LogsDoNotContain(t, hook, "Searching for peers in underrepresented subnets")
+}
+
+func TestFilterPeerForSubnets(t *testing.T) {
+	fakePeer := testp2p.NewTestP2P(t)
+	s := &Service{
+		cfg: &Config{MaxPeers: 30},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: fakePeer.BHost,
+	}
+
+	attNode := backboneTestNode(t, []uint64{1}, nil, true)
+	syncNode := backboneTestNode(t, nil, []uint64{2}, true)
+	noTCPNode := backboneTestNode(t, []uint64{1}, nil, false)
+
+	filter := s.filterPeerForSubnets([]uint64{1, 5}, nil)
+	assert.Equal(t, true, filter(attNode), "Expected peer in attestation subnet to be accepted")
+	assert.Equal(t, false, filter(syncNode), "Expected peer in other subnets to be rejected")
+	assert.Equal(t, false, filter(noTCPNode), "Expected peer without tcp port to be rejected")
+
+	filter = s.filterPeerForSubnets([]uint64{3}, []uint64{2})
+	assert.Equal(t, true, filter(syncNode), "Expected peer in sync committee subnet to be accepted")
+	assert.Equal(t, false, filter(backboneTestNode(t, []uint64{1}, []uint64{0}, true)), "Expected peer in other subnets to be rejected")
+
+	assert.Equal(t, false, s.filterPeerForSubnets(nil, nil)(attNode), "Expected no peer to be accepted without subnets")
+}
+
+// backboneTestNode returns a node advertising the given attestation and sync committee subnets.
+func backboneTestNode(t *testing.T, atts, syncs []uint64, withTCP bool) *enode.Node {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, pkey)
+	localNode.Set(enr.IP(ipAddr))
+	localNode.Set(enr.UDP(3000))
+	if withTCP {
+		localNode.Set(enr.TCP(3000))
+	}
+	attBits := bitfield.NewBitvector64()
+	for _, i := range atts {
+		attBits.SetBitAt(i, true)
+	}
+	syncBits := bitfield.Bitvector4{byte(0x00)}
+	for _, i := range syncs {
+		syncBits.SetBitAt(i, true)
+	}
+	localNode.Set(enr.WithEntry(attSubnetEnrKey, &attBits))
+	localNode.Set(enr.WithEntry(syncCommsSubnetEnrKey, &syncBits))
+	return localNode.Node()
+}
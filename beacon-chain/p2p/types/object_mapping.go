@@ -45,12 +45,16 @@ func InitializeDataMaps() {
 		bytesutil.ToBytes4(params.BeaconConfig().AltairForkVersion): func() (block.SignedBeaconBlock, error) {
 			return wrapperv2.WrappedAltairSignedBeaconBlock(&prysmv2.SignedBeaconBlockAltair{Block: &prysmv2.BeaconBlockAltair{}})
 		},
+		bytesutil.ToBytes4(params.BeaconConfig().BellatrixForkVersion): func() (block.SignedBeaconBlock, error) {
+			return wrapperv2.WrappedBellatrixSignedBeaconBlock(&prysmv2.SignedBeaconBlockBellatrix{Block: &prysmv2.BeaconBlockBellatrix{}})
+		},
 	}
 
 	// Reset our state map.
 	StateMap = map[[4]byte]proto.Message{
-		bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion): &statepb.BeaconState{},
-		bytesutil.ToBytes4(params.BeaconConfig().AltairForkVersion):  &statepb.BeaconStateAltair{},
+		bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion):   &statepb.BeaconState{},
+		bytesutil.ToBytes4(params.BeaconConfig().AltairForkVersion):    &statepb.BeaconStateAltair{},
+		bytesutil.ToBytes4(params.BeaconConfig().BellatrixForkVersion): &statepb.BeaconStateBellatrix{},
 	}
 
 	// Reset our metadata map.
@@ -61,5 +65,8 @@ func InitializeDataMaps() {
 		bytesutil.ToBytes4(params.BeaconConfig().AltairForkVersion): func() metadata.Metadata {
 			return wrapperv2.WrappedMetadataV1(&prysmv2.MetaDataV1{})
 		},
+		bytesutil.ToBytes4(params.BeaconConfig().BellatrixForkVersion): func() metadata.Metadata {
+			return wrapperv2.WrappedMetadataV1(&prysmv2.MetaDataV1{})
+		},
 	}
 }
@@ -0,0 +1,494 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/httputils"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	endpointScoreGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_score",
+		Help: "The health score of an eth1 endpoint, from 0 (unusable) to 100",
+	}, []string{"endpoint"})
+	endpointHeadLagGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_head_lag",
+		Help: "The number of blocks the head of an eth1 endpoint is behind the best head of all endpoints",
+	}, []string{"endpoint"})
+	eth1DataDisagreementCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "powchain_eth1_data_disagreements_total",
+		Help: "The number of times an eth1 endpoint disagreed with the current endpoint on the eth1 data at the follow distance",
+	}, []string{"endpoint"})
+)
+
+const (
+	// maxEndpointScore is the score of a synced endpoint at the best head, without errors and latency.
+	maxEndpointScore = 100
+	// unknownEndpointScore is the score of an endpoint which has not been checked yet.
+	unknownEndpointScore = maxEndpointScore / 2
+	// headLagPenalty is subtracted from the score for every block the endpoint is behind the best
+	// head, up to maxHeadLagPenalty.
+	headLagPenalty    = 5
+	maxHeadLagPenalty = 50
+	// errorRatePenalty is subtracted from the score in proportion to the error rate of the endpoint.
+	errorRatePenalty = 30
+	// latencyPenaltyPerSecond is subtracted from the score for every second of latency of the
+	// endpoint, up to maxLatencyPenalty.
+	latencyPenaltyPerSecond = 20
+	maxLatencyPenalty       = 20
+	// endpointSwitchMargin is how much better than the current endpoint another endpoint needs to
+	// score to switch to it, unless it comes first in the configured endpoints.
+	endpointSwitchMargin = 15
+	// healthDecay is the weight of the past in the error rate and latency of an endpoint.
+	healthDecay = 0.8
+	// endpointCheckTimeout bounds the time to check the health of an endpoint.
+	endpointCheckTimeout = 5 * time.Second
+)
+
+// depositContractReader reads the state of the deposit contract.
+type depositContractReader interface {
+	GetDepositCount(opts *bind.CallOpts) ([]byte, error)
+	GetDepositRoot(opts *bind.CallOpts) ([32]byte, error)
+}
+
+// endpointStatus tracks the health of an eth1 endpoint.
+type endpointStatus struct {
+	fetcher        RPCDataFetcher
+	contractReader depositContractReader
+	close          func()
+	checked        bool
+	reachable      bool
+	synced         bool
+	headNumber     uint64
+	errorRate      float64
+	latency        time.Duration
+}
+
+// eth1DataAtHeight is the data an eth1_data vote is built from.
+type eth1DataAtHeight struct {
+	blockHash    common.Hash
+	depositCount []byte
+	depositRoot  [32]byte
+}
+
+// recordSuccess records a successful check of the endpoint, which took the given latency.
+func (es *endpointStatus) recordSuccess(latency time.Duration) {
+	es.checked = true
+	es.reachable = true
+	es.errorRate *= healthDecay
+	if es.latency == 0 {
+		es.latency = latency
+	} else {
+		es.latency = time.Duration(healthDecay*float64(es.latency) + (1-healthDecay)*float64(latency))
+	}
+}
+
+// recordFailure records a failed check of the endpoint, which makes it unusable until the next
+// successful check.
+func (es *endpointStatus) recordFailure() {
+	es.checked = true
+	es.reachable = false
+	es.recordError()
+}
+
+// recordError records a failed request to the endpoint.
+func (es *endpointStatus) recordError() {
+	es.errorRate = healthDecay*es.errorRate + (1 - healthDecay)
+}
+
+// score rates the endpoint from 0 to maxEndpointScore, given the best head of all endpoints.
+func (es *endpointStatus) score(bestHead uint64) float64 {
+	if !es.checked {
+		return unknownEndpointScore
+	}
+	if !es.reachable || !es.synced {
+		return 0
+	}
+	lagPenalty := float64(headLagPenalty * es.headLag(bestHead))
+	if lagPenalty > maxHeadLagPenalty {
+		lagPenalty = maxHeadLagPenalty
+	}
+	latencyPenalty := latencyPenaltyPerSecond * es.latency.Seconds()
+	if latencyPenalty > maxLatencyPenalty {
+		latencyPenalty = maxLatencyPenalty
+	}
+	score := maxEndpointScore - lagPenalty - errorRatePenalty*es.errorRate - latencyPenalty
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+// headLag returns the number of blocks the endpoint is behind the best head.
+func (es *endpointStatus) headLag(bestHead uint64) uint64 {
+	if es.headNumber >= bestHead {
+		return 0
+	}
+	return bestHead - es.headNumber
+}
+
+// endpointStatusFor returns the status of the endpoint, creating it if needed. The caller must
+// hold endpointStatusLock.
+func (s *Service) endpointStatusFor(endpoint httputils.Endpoint) *endpointStatus {
+	if s.endpointStatuses == nil {
+		s.endpointStatuses = make(map[string]*endpointStatus)
+	}
+	es, ok := s.endpointStatuses[endpoint.Url]
+	if !ok {
+		es = &endpointStatus{}
+		s.endpointStatuses[endpoint.Url] = es
+	}
+	return es
+}
+
+// recordEndpointError records a failed request to the current endpoint, which lowers its score.
+func (s *Service) recordEndpointError() {
+	s.endpointStatusLock.Lock()
+	defer s.endpointStatusLock.Unlock()
+	s.endpointStatusFor(s.currHttpEndpoint).recordError()
+}
+
+// endpointScores returns the score of every configured endpoint, in the order of the endpoints.
+func (s *Service) endpointScores() []float64 {
+	s.endpointStatusLock.RLock()
+	defer s.endpointStatusLock.RUnlock()
+	bestHead := uint64(0)
+	for _, es := range s.endpointStatuses {
+		if es.reachable && es.synced && es.headNumber > bestHead {
+			bestHead = es.headNumber
+		}
+	}
+	scores := make([]float64, len(s.httpEndpoints))
+	for i, endpoint := range s.httpEndpoints {
+		es, ok := s.endpointStatuses[endpoint.Url]
+		if !ok {
+			scores[i] = unknownEndpointScore
+			continue
+		}
+		scores[i] = es.score(bestHead)
+		masked := logutil.MaskCredentialsLogging(endpoint.Url)
+		endpointScoreGauge.WithLabelValues(masked).Set(scores[i])
+		endpointHeadLagGauge.WithLabelValues(masked).Set(float64(es.headLag(bestHead)))
+	}
+	return scores
+}
+
+// endpointCheck is a request to check the endpoints, with the state of the main routine the checks
+// depend on.
+type endpointCheck struct {
+	currEndpoint httputils.Endpoint
+	followHeight uint64
+}
+
+// requestEndpointCheck asks the routine checking the endpoints to check them, unless a check is
+// still in progress, and switches to the best endpoint given the scores of the previous checks.
+// It does not wait for the checks, which may time out on unresponsive endpoints. It is only
+// relevant if fallback endpoints are configured.
+func (s *Service) requestEndpointCheck(ctx context.Context) {
+	if len(s.httpEndpoints) < 2 {
+		return
+	}
+	followHeight, err := s.followBlockHeight(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get follow block height to check eth1 endpoints")
+		return
+	}
+	select {
+	case s.endpointChecks <- &endpointCheck{currEndpoint: s.currHttpEndpoint, followHeight: followHeight}:
+	default:
+	}
+	s.switchToBestEndpoint()
+}
+
+// checkEndpointsRoutine checks the endpoints on every request, until the done channel is closed.
+func (s *Service) checkEndpointsRoutine(done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case req := <-s.endpointChecks:
+			s.checkEndpoints(s.ctx, req)
+		}
+	}
+}
+
+// checkEndpoints checks the health of all endpoints, and cross-checks the eth1 data they serve.
+func (s *Service) checkEndpoints(ctx context.Context, req *endpointCheck) {
+	var wg sync.WaitGroup
+	for _, endpoint := range s.httpEndpoints {
+		wg.Add(1)
+		go func(endpoint httputils.Endpoint) {
+			defer wg.Done()
+			s.checkEndpoint(ctx, endpoint)
+		}(endpoint)
+	}
+	wg.Wait()
+	s.crossCheckEth1Data(ctx, req.currEndpoint, req.followHeight)
+}
+
+// checkEndpoint updates the health of the endpoint with its sync status, head and latency.
+func (s *Service) checkEndpoint(ctx context.Context, endpoint httputils.Endpoint) {
+	s.endpointStatusLock.Lock()
+	es := s.endpointStatusFor(endpoint)
+	fetcher := es.fetcher
+	s.endpointStatusLock.Unlock()
+
+	if fetcher == nil {
+		client, err := s.dialEndpointForHealth(ctx, endpoint)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", logutil.MaskCredentialsLogging(endpoint.Url)).Debug("Could not dial eth1 endpoint")
+			s.endpointStatusLock.Lock()
+			es.recordFailure()
+			s.endpointStatusLock.Unlock()
+			return
+		}
+		s.endpointStatusLock.Lock()
+		es.fetcher = client
+		es.contractReader = client.reader
+		es.close = client.Close
+		s.endpointStatusLock.Unlock()
+		fetcher = client
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancel()
+	start := time.Now()
+	syncProg, err := fetcher.SyncProgress(ctx)
+	var headNumber uint64
+	var headTime uint64
+	if err == nil {
+		head, headErr := fetcher.HeaderByNumber(ctx, nil)
+		err = headErr
+		if headErr == nil {
+			headNumber = head.Number.Uint64()
+			headTime = head.Time
+		}
+	}
+	latency := time.Since(start)
+
+	s.endpointStatusLock.Lock()
+	defer s.endpointStatusLock.Unlock()
+	if err != nil {
+		log.WithError(err).WithField("endpoint", logutil.MaskCredentialsLogging(endpoint.Url)).Debug("Could not check health of eth1 endpoint")
+		es.recordFailure()
+		return
+	}
+	es.recordSuccess(latency)
+	es.synced = syncProg == nil && !eth1HeadIsBehind(headTime)
+	es.headNumber = headNumber
+}
+
+// healthClient is a client to an endpoint used for health checks.
+type healthClient struct {
+	*ethclient.Client
+	reader depositContractReader
+}
+
+// dialEndpointForHealth dials an endpoint, and makes sure it serves the configured chain.
+func (s *Service) dialEndpointForHealth(ctx context.Context, endpoint httputils.Endpoint) (*healthClient, error) {
+	rpcClient, err := newRPCClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	client := ethclient.NewClient(rpcClient)
+	ctx, cancel := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancel()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	if chainID.Uint64() != params.BeaconConfig().DepositChainID {
+		client.Close()
+		return nil, errors.Errorf("eth1 node using incorrect chain id, %d != %d", chainID.Uint64(), params.BeaconConfig().DepositChainID)
+	}
+	reader, err := contracts.NewDepositContractCaller(s.cfg.DepositContract, client)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &healthClient{Client: client, reader: reader}, nil
+}
+
+// crossCheckEth1Data compares the eth1 data at the follow height, which eth1_data votes are
+// built from, between the current endpoint and all other synced endpoints. A disagreement means
+// that one of the endpoints serves a different chain, or is lagging behind, and is reported.
+func (s *Service) crossCheckEth1Data(ctx context.Context, currEndpoint httputils.Endpoint, followHeight uint64) {
+	if followHeight == 0 || followHeight == s.lastCrossCheckedHeight {
+		return
+	}
+
+	// The clients are copied while holding the lock, as they are closed and cleared under it.
+	type candidate struct {
+		endpoint       httputils.Endpoint
+		fetcher        RPCDataFetcher
+		contractReader depositContractReader
+	}
+	s.endpointStatusLock.RLock()
+	var reference *candidate
+	others := make([]candidate, 0, len(s.httpEndpoints))
+	for _, endpoint := range s.httpEndpoints {
+		es, ok := s.endpointStatuses[endpoint.Url]
+		if !ok || es.fetcher == nil || es.contractReader == nil || !es.synced || es.headNumber < followHeight {
+			continue
+		}
+		c := candidate{endpoint: endpoint, fetcher: es.fetcher, contractReader: es.contractReader}
+		if endpoint.Equals(currEndpoint) {
+			reference = &c
+			continue
+		}
+		others = append(others, c)
+	}
+	s.endpointStatusLock.RUnlock()
+	if reference == nil || len(others) == 0 {
+		return
+	}
+
+	expected, err := eth1DataAt(ctx, reference.fetcher, reference.contractReader, followHeight)
+	if err != nil {
+		log.WithError(err).Debug("Could not get eth1 data from current endpoint to cross-check")
+		return
+	}
+	s.lastCrossCheckedHeight = followHeight
+	for _, c := range others {
+		got, err := eth1DataAt(ctx, c.fetcher, c.contractReader, followHeight)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", logutil.MaskCredentialsLogging(c.endpoint.Url)).Debug("Could not get eth1 data to cross-check")
+			continue
+		}
+		if got.blockHash == expected.blockHash && bytes.Equal(got.depositCount, expected.depositCount) && got.depositRoot == expected.depositRoot {
+			continue
+		}
+		eth1DataDisagreementCount.WithLabelValues(logutil.MaskCredentialsLogging(c.endpoint.Url)).Inc()
+		log.WithFields(logrus.Fields{
+			"blockNumber":         followHeight,
+			"currentEndpoint":     logutil.MaskCredentialsLogging(reference.endpoint.Url),
+			"otherEndpoint":       logutil.MaskCredentialsLogging(c.endpoint.Url),
+			"currentBlockHash":    expected.blockHash.Hex(),
+			"otherBlockHash":      got.blockHash.Hex(),
+			"currentDepositCount": bytesutil.FromBytes8(expected.depositCount),
+			"otherDepositCount":   bytesutil.FromBytes8(got.depositCount),
+			"currentDepositRoot":  common.Hash(expected.depositRoot).Hex(),
+			"otherDepositRoot":    common.Hash(got.depositRoot).Hex(),
+		}).Error("Eth1 endpoints disagree on the eth1 data used for voting")
+	}
+}
+
+// eth1DataAt returns the block hash and deposit contract state at the given height, as served by
+// the clients of an endpoint.
+func eth1DataAt(
+	ctx context.Context, fetcher RPCDataFetcher, contractReader depositContractReader, height uint64,
+) (*eth1DataAtHeight, error) {
+	ctx, cancel := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancel()
+	blockNumber := new(big.Int).SetUint64(height)
+	header, err := fetcher.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get header")
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: blockNumber}
+	count, err := contractReader.GetDepositCount(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get deposit count")
+	}
+	root, err := contractReader.GetDepositRoot(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get deposit root")
+	}
+	return &eth1DataAtHeight{blockHash: header.Hash(), depositCount: count, depositRoot: root}, nil
+}
+
+// switchToBestEndpoint switches to the best scoring endpoint, as chosen by bestEndpointIndex.
+func (s *Service) switchToBestEndpoint() {
+	scores := s.endpointScores()
+	currIndex := -1
+	for i, endpoint := range s.httpEndpoints {
+		if endpoint.Equals(s.currHttpEndpoint) {
+			currIndex = i
+			break
+		}
+	}
+	bestIndex := bestEndpointIndex(scores, currIndex)
+	if bestIndex == currIndex {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"from":      logutil.MaskCredentialsLogging(s.currHttpEndpoint.Url),
+		"fromScore": scores[currIndex],
+		"to":        logutil.MaskCredentialsLogging(s.httpEndpoints[bestIndex].Url),
+		"toScore":   scores[bestIndex],
+	}).Info("Switching to healthier eth1 endpoint")
+	// Close the current clients, and let our main connection routine
+	// connect to the new endpoint.
+	s.closeClients()
+	s.updateCurrHttpEndpoint(s.httpEndpoints[bestIndex])
+	s.retryETH1Node(nil)
+}
+
+// bestEndpointIndex returns the index of the endpoint to use given the scores of the endpoints.
+// Another endpoint than the current one is used if it scores better by endpointSwitchMargin. An
+// endpoint which comes before the current endpoint in the configured endpoints only needs to score
+// as well as the current endpoint, so that the primary endpoint is used whenever it is healthy.
+func bestEndpointIndex(scores []float64, currIndex int) int {
+	if currIndex < 0 || currIndex >= len(scores) {
+		return currIndex
+	}
+	bestIndex := 0
+	for i := range scores {
+		if scores[i] > scores[bestIndex] {
+			bestIndex = i
+		}
+	}
+	if bestIndex == currIndex || scores[bestIndex] == 0 {
+		return currIndex
+	}
+	if scores[bestIndex] >= scores[currIndex]+endpointSwitchMargin ||
+		(bestIndex < currIndex && scores[bestIndex] >= scores[currIndex]) {
+		return bestIndex
+	}
+	return currIndex
+}
+
+// nextEndpointIndex returns the index of the endpoint to fall back to from the current endpoint:
+// the best scoring other endpoint, preferring the endpoints right after the current one.
+func (s *Service) nextEndpointIndex(currIndex int) int {
+	total := len(s.httpEndpoints)
+	scores := s.endpointScores()
+	next := (currIndex + 1) % total
+	for i := 2; i < total; i++ {
+		candidate := (currIndex + i) % total
+		if scores[candidate] > scores[next] {
+			next = candidate
+		}
+	}
+	return next
+}
+
+// closeEndpointClients closes the clients used for the health checks of the endpoints.
+func (s *Service) closeEndpointClients() {
+	s.endpointStatusLock.Lock()
+	defer s.endpointStatusLock.Unlock()
+	for _, es := range s.endpointStatuses {
+		if es.close != nil {
+			es.close()
+		}
+		es.fetcher = nil
+		es.contractReader = nil
+		es.close = nil
+	}
+}
@@ -0,0 +1,355 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/httputils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// healthFetcher serves a chain of headers up to head, on the given fork.
+type healthFetcher struct {
+	head    uint64
+	fork    byte
+	syncing bool
+	err     error
+}
+
+func (f *healthFetcher) HeaderByNumber(_ context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	// The head is recent, and older headers have a fixed time so that their hash only depends
+	// on the fork.
+	if number == nil {
+		return &gethTypes.Header{
+			Number: new(big.Int).SetUint64(f.head),
+			Time:   uint64(time.Now().Unix()),
+			Extra:  []byte{f.fork},
+		}, nil
+	}
+	return &gethTypes.Header{
+		Number: number,
+		Time:   number.Uint64(),
+		Extra:  []byte{f.fork},
+	}, nil
+}
+
+func (f *healthFetcher) HeaderByHash(_ context.Context, _ common.Hash) (*gethTypes.Header, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *healthFetcher) SyncProgress(_ context.Context) (*ethereum.SyncProgress, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.syncing {
+		return &ethereum.SyncProgress{}, nil
+	}
+	return nil, nil
+}
+
+type healthDepositReader struct {
+	count uint64
+	root  [32]byte
+}
+
+func (r *healthDepositReader) GetDepositCount(_ *bind.CallOpts) ([]byte, error) {
+	return bytesutil.Bytes8(r.count), nil
+}
+
+func (r *healthDepositReader) GetDepositRoot(_ *bind.CallOpts) ([32]byte, error) {
+	return r.root, nil
+}
+
+func TestEndpointStatus_Score(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *endpointStatus
+		score  float64
+	}{
+		{
+			name:   "unchecked",
+			status: &endpointStatus{},
+			score:  unknownEndpointScore,
+		},
+		{
+			name:   "unreachable",
+			status: &endpointStatus{checked: true, synced: true, headNumber: 100},
+			score:  0,
+		},
+		{
+			name:   "syncing",
+			status: &endpointStatus{checked: true, reachable: true, headNumber: 100},
+			score:  0,
+		},
+		{
+			name:   "healthy",
+			status: &endpointStatus{checked: true, reachable: true, synced: true, headNumber: 100},
+			score:  maxEndpointScore,
+		},
+		{
+			name:   "lagging",
+			status: &endpointStatus{checked: true, reachable: true, synced: true, headNumber: 97},
+			score:  maxEndpointScore - 3*headLagPenalty,
+		},
+		{
+			name:   "far behind",
+			status: &endpointStatus{checked: true, reachable: true, synced: true, headNumber: 1},
+			score:  maxEndpointScore - maxHeadLagPenalty,
+		},
+		{
+			name:   "errors and latency",
+			status: &endpointStatus{checked: true, reachable: true, synced: true, headNumber: 100, errorRate: 0.5, latency: 500 * time.Millisecond},
+			score:  maxEndpointScore - errorRatePenalty/2 - latencyPenaltyPerSecond/2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.score, tt.status.score(100))
+		})
+	}
+}
+
+func TestBestEndpointIndex(t *testing.T) {
+	tests := []struct {
+		name      string
+		scores    []float64
+		currIndex int
+		want      int
+	}{
+		{
+			name:      "current is best",
+			scores:    []float64{100, 90},
+			currIndex: 0,
+			want:      0,
+		},
+		{
+			name:      "other is better within margin",
+			scores:    []float64{90, 100},
+			currIndex: 0,
+			want:      0,
+		},
+		{
+			name:      "other is better by margin",
+			scores:    []float64{80, 100},
+			currIndex: 0,
+			want:      1,
+		},
+		{
+			name:      "primary is as good again",
+			scores:    []float64{100, 100},
+			currIndex: 1,
+			want:      0,
+		},
+		{
+			name:      "no usable endpoint",
+			scores:    []float64{0, 0, 0},
+			currIndex: 1,
+			want:      1,
+		},
+		{
+			name:      "unknown current endpoint",
+			scores:    []float64{100, 0},
+			currIndex: -1,
+			want:      -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, bestEndpointIndex(tt.scores, tt.currIndex))
+		})
+	}
+}
+
+func TestCheckEndpoint(t *testing.T) {
+	endpoints := []httputils.Endpoint{{Url: "A"}, {Url: "B"}, {Url: "C"}}
+	s := &Service{httpEndpoints: endpoints, currHttpEndpoint: endpoints[0], bsUpdater: &NopBeaconNodeStatsUpdater{}}
+	s.endpointStatuses = map[string]*endpointStatus{
+		"A": {fetcher: &healthFetcher{head: 90}},
+		"B": {fetcher: &healthFetcher{head: 100}},
+		"C": {fetcher: &healthFetcher{err: errors.New("connection refused")}},
+	}
+	for _, endpoint := range endpoints {
+		s.checkEndpoint(context.Background(), endpoint)
+	}
+	assert.Equal(t, true, s.endpointStatuses["A"].synced)
+	assert.Equal(t, uint64(90), s.endpointStatuses["A"].headNumber)
+	assert.Equal(t, false, s.endpointStatuses["C"].reachable)
+	assert.Equal(t, true, s.endpointStatuses["C"].errorRate > 0)
+
+	scores := s.endpointScores()
+	assert.Equal(t, true, scores[0] <= maxEndpointScore-maxHeadLagPenalty)
+	assert.Equal(t, true, scores[1] > maxEndpointScore-maxLatencyPenalty)
+	assert.Equal(t, float64(0), scores[2])
+	// The lagging primary endpoint is left for the synced one.
+	assert.Equal(t, 1, bestEndpointIndex(scores, 0))
+
+	// Falling back from the current endpoint skips the unreachable endpoint.
+	s.currHttpEndpoint = endpoints[1]
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, "A", s.currHttpEndpoint.Url)
+}
+
+func TestCrossCheckEth1Data(t *testing.T) {
+	hook := logTest.NewGlobal()
+	followHeight := uint64(100)
+	head := followHeight + params.BeaconConfig().Eth1FollowDistance
+	endpoints := []httputils.Endpoint{{Url: "A"}, {Url: "B"}}
+	newService := func(other *endpointStatus) *Service {
+		s := &Service{
+			httpEndpoints:    endpoints,
+			currHttpEndpoint: endpoints[0],
+			latestEth1Data:   &protodb.LatestETH1Data{BlockHeight: head},
+		}
+		s.endpointStatuses = map[string]*endpointStatus{
+			"A": {
+				fetcher:        &healthFetcher{head: head},
+				contractReader: &healthDepositReader{count: 5, root: [32]byte{'a'}},
+				synced:         true,
+				headNumber:     head,
+			},
+			"B": other,
+		}
+		return s
+	}
+
+	s := newService(&endpointStatus{
+		fetcher:        &healthFetcher{head: head},
+		contractReader: &healthDepositReader{count: 5, root: [32]byte{'a'}},
+		synced:         true,
+		headNumber:     head,
+	})
+	s.crossCheckEth1Data(context.Background(), endpoints[0], followHeight)
+	assert.LogsDoNotContain(t, hook, "disagree")
+	assert.Equal(t, followHeight, s.lastCrossCheckedHeight)
+
+	tests := []struct {
+		name  string
+		other *endpointStatus
+	}{
+		{
+			name: "different block",
+			other: &endpointStatus{
+				fetcher:        &healthFetcher{head: head, fork: 1},
+				contractReader: &healthDepositReader{count: 5, root: [32]byte{'a'}},
+				synced:         true,
+				headNumber:     head,
+			},
+		},
+		{
+			name: "different deposits",
+			other: &endpointStatus{
+				fetcher:        &healthFetcher{head: head},
+				contractReader: &healthDepositReader{count: 4, root: [32]byte{'b'}},
+				synced:         true,
+				headNumber:     head,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook.Reset()
+			newService(tt.other).crossCheckEth1Data(context.Background(), endpoints[0], followHeight)
+			assert.LogsContain(t, hook, "Eth1 endpoints disagree on the eth1 data used for voting")
+		})
+	}
+
+	// Endpoints which have not reached the follow height are not cross-checked.
+	hook.Reset()
+	newService(&endpointStatus{
+		fetcher:        &healthFetcher{head: followHeight - 1, fork: 1},
+		contractReader: &healthDepositReader{},
+		synced:         true,
+		headNumber:     followHeight - 1,
+	}).crossCheckEth1Data(context.Background(), endpoints[0], followHeight)
+	assert.LogsDoNotContain(t, hook, "disagree")
+}
+
+// closingFetcher runs onHeader before serving a header.
+type closingFetcher struct {
+	*healthFetcher
+	onHeader func()
+}
+
+func (f *closingFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	f.onHeader()
+	return f.healthFetcher.HeaderByNumber(ctx, number)
+}
+
+func TestCrossCheckEth1Data_ClosedClients(t *testing.T) {
+	followHeight := uint64(100)
+	head := followHeight + params.BeaconConfig().Eth1FollowDistance
+	endpoints := []httputils.Endpoint{{Url: "A"}, {Url: "B"}}
+	s := &Service{httpEndpoints: endpoints, currHttpEndpoint: endpoints[0]}
+	var once sync.Once
+	// The clients are closed in the middle of the cross-check, which must not crash it.
+	closeClients := func() { once.Do(s.closeEndpointClients) }
+	s.endpointStatuses = map[string]*endpointStatus{
+		"A": {
+			fetcher:        &closingFetcher{healthFetcher: &healthFetcher{head: head}, onHeader: closeClients},
+			contractReader: &healthDepositReader{count: 5},
+			synced:         true,
+			headNumber:     head,
+		},
+		"B": {
+			fetcher:        &healthFetcher{head: head},
+			contractReader: &healthDepositReader{count: 5},
+			synced:         true,
+			headNumber:     head,
+		},
+	}
+	s.crossCheckEth1Data(context.Background(), endpoints[0], followHeight)
+	assert.Equal(t, followHeight, s.lastCrossCheckedHeight)
+
+	// Closed endpoints are not cross-checked.
+	s.lastCrossCheckedHeight = 0
+	s.crossCheckEth1Data(context.Background(), endpoints[0], followHeight)
+	assert.Equal(t, uint64(0), s.lastCrossCheckedHeight)
+}
+
+func TestRequestEndpointCheck(t *testing.T) {
+	followHeight := uint64(100)
+	head := followHeight + params.BeaconConfig().Eth1FollowDistance
+	endpoints := []httputils.Endpoint{{Url: "A"}, {Url: "B"}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		ctx:              ctx,
+		httpEndpoints:    endpoints,
+		currHttpEndpoint: endpoints[0],
+		latestEth1Data:   &protodb.LatestETH1Data{BlockHeight: head},
+		bsUpdater:        &NopBeaconNodeStatsUpdater{},
+		endpointChecks:   make(chan *endpointCheck, 1),
+	}
+	s.endpointStatuses = map[string]*endpointStatus{
+		"A": {fetcher: &healthFetcher{head: head}, contractReader: &healthDepositReader{count: 5}},
+		"B": {fetcher: &healthFetcher{head: head}, contractReader: &healthDepositReader{count: 5}},
+	}
+
+	// Requests do not wait for a pending check.
+	s.requestEndpointCheck(ctx)
+	s.requestEndpointCheck(ctx)
+	require.Equal(t, 1, len(s.endpointChecks))
+	req := <-s.endpointChecks
+	assert.Equal(t, followHeight, req.followHeight)
+	assert.Equal(t, true, req.currEndpoint.Equals(endpoints[0]))
+
+	s.checkEndpoints(ctx, req)
+	assert.Equal(t, true, s.endpointStatuses["B"].synced)
+	assert.Equal(t, followHeight, s.lastCrossCheckedHeight)
+}
@@ -0,0 +1,131 @@
+// Package engine defines a client of the engine API, the authenticated JSON-RPC API an execution
+// client exposes to the beacon node to drive its fork choice and to build and process execution
+// payloads.
+package engine
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// NewPayloadMethod is the engine API method to process a new payload.
+	NewPayloadMethod = "engine_newPayloadV1"
+	// ForkchoiceUpdatedMethod is the engine API method to update the fork choice of the execution
+	// engine, and optionally start building a payload.
+	ForkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV1"
+	// GetPayloadMethod is the engine API method to retrieve a payload being built.
+	GetPayloadMethod = "engine_getPayloadV1"
+
+	// defaultTimeout is the timeout of a request to the execution engine, unless configured otherwise.
+	defaultTimeout = 8 * time.Second
+)
+
+// Caller defines the engine API methods the beacon node calls on the execution engine.
+type Caller interface {
+	NewPayload(ctx context.Context, payload *ExecutionPayload) (*PayloadStatus, error)
+	ForkchoiceUpdated(
+		ctx context.Context, state *ForkchoiceState, attrs *PayloadAttributes,
+	) (*ForkchoiceUpdatedResponse, error)
+	GetPayload(ctx context.Context, payloadID PayloadID) (*ExecutionPayload, error)
+}
+
+// Config options for the engine API client.
+type Config struct {
+	// Endpoint of the engine API of the execution client.
+	Endpoint string
+	// JWTSecret is the secret shared with the execution client, which authenticates the requests.
+	// Requests are not authenticated if it is empty.
+	JWTSecret []byte
+	// Timeout of a request to the execution engine.
+	Timeout time.Duration
+}
+
+// Client of the engine API of an execution client.
+type Client struct {
+	cfg *Config
+	rpc *gethRPC.Client
+}
+
+// New creates a client of the engine API at the configured endpoint. Requests are sent over HTTP,
+// authenticated with a token signed with the JWT secret if there is one.
+func New(ctx context.Context, cfg *Config) (*Client, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("no execution engine endpoint provided")
+	}
+	if len(cfg.JWTSecret) != 0 && len(cfg.JWTSecret) != JWTSecretLength {
+		return nil, ErrInvalidJWTSecret
+	}
+	// Copy the config, so that filling in the defaults does not change the config of the caller.
+	cfgCopy := *cfg
+	cfg = &cfgCopy
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	var transport http.RoundTripper = http.DefaultTransport
+	if len(cfg.JWTSecret) != 0 {
+		transport = &jwtTransport{
+			underlyingTransport: http.DefaultTransport,
+			secret:              cfg.JWTSecret,
+		}
+	}
+	rpcClient, err := gethRPC.DialHTTPWithClient(cfg.Endpoint, &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: transport,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not dial execution engine")
+	}
+	return &Client{cfg: cfg, rpc: rpcClient}, nil
+}
+
+// Close the connections to the execution engine.
+func (c *Client) Close() {
+	c.rpc.Close()
+}
+
+// NewPayload sends a new payload to the execution engine, which returns the status of the payload
+// once processed.
+func (c *Client) NewPayload(ctx context.Context, payload *ExecutionPayload) (*PayloadStatus, error) {
+	ctx, span := trace.StartSpan(ctx, "engine.NewPayload")
+	defer span.End()
+
+	result := &PayloadStatus{}
+	if err := c.rpc.CallContext(ctx, result, NewPayloadMethod, payload); err != nil {
+		return nil, handleRPCError(err)
+	}
+	return result, nil
+}
+
+// ForkchoiceUpdated sends the fork choice of the beacon node to the execution engine. If payload
+// attributes are given, the execution engine starts building a payload on top of the head, which
+// can be retrieved with the returned payload ID.
+func (c *Client) ForkchoiceUpdated(
+	ctx context.Context, state *ForkchoiceState, attrs *PayloadAttributes,
+) (*ForkchoiceUpdatedResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "engine.ForkchoiceUpdated")
+	defer span.End()
+
+	result := &ForkchoiceUpdatedResponse{}
+	if err := c.rpc.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, attrs); err != nil {
+		return nil, handleRPCError(err)
+	}
+	return result, nil
+}
+
+// GetPayload retrieves the payload the execution engine built for the given payload ID.
+func (c *Client) GetPayload(ctx context.Context, payloadID PayloadID) (*ExecutionPayload, error) {
+	ctx, span := trace.StartSpan(ctx, "engine.GetPayload")
+	defer span.End()
+
+	result := &ExecutionPayload{}
+	if err := c.rpc.CallContext(ctx, result, GetPayloadMethod, payloadID); err != nil {
+		return nil, handleRPCError(err)
+	}
+	return result, nil
+}
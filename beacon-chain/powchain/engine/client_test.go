@@ -0,0 +1,206 @@
+package engine_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
+	mockEngine "github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var _ engine.Caller = (*engine.Client)(nil)
+
+func setup(t *testing.T, engineSecret, clientSecret []byte) (*mockEngine.MockEngine, *engine.Client) {
+	m, err := mockEngine.NewMockEngine(engineSecret)
+	require.NoError(t, err)
+	t.Cleanup(m.Close)
+	client, err := engine.New(context.Background(), &engine.Config{
+		Endpoint:  m.Endpoint(),
+		JWTSecret: clientSecret,
+	})
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return m, client
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := engine.New(context.Background(), &engine.Config{})
+	assert.ErrorContains(t, "no execution engine endpoint provided", err)
+	_, err = engine.New(context.Background(), &engine.Config{Endpoint: "http://127.0.0.1:8550", JWTSecret: []byte{'a'}})
+	assert.Equal(t, true, errors.Is(err, engine.ErrInvalidJWTSecret))
+}
+
+func TestNew_DoesNotChangeConfig(t *testing.T) {
+	cfg := &engine.Config{Endpoint: "http://127.0.0.1:8550"}
+	client, err := engine.New(context.Background(), cfg)
+	require.NoError(t, err)
+	defer client.Close()
+	assert.Equal(t, time.Duration(0), cfg.Timeout)
+}
+
+func TestClient_BuildAndProcessPayload(t *testing.T) {
+	ctx := context.Background()
+	m, client := setup(t, nil, nil)
+
+	attrs := &engine.PayloadAttributes{
+		Timestamp:             12,
+		PrevRandao:            common.BytesToHash([]byte("randao")),
+		SuggestedFeeRecipient: common.BytesToAddress([]byte("fee recipient")),
+	}
+	resp, err := client.ForkchoiceUpdated(ctx, &engine.ForkchoiceState{HeadBlockHash: m.GenesisHash()}, attrs)
+	require.NoError(t, err)
+	assert.Equal(t, engine.StatusValid, resp.PayloadStatus.Status)
+	require.NotNil(t, resp.PayloadID)
+
+	payload, err := client.GetPayload(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	assert.Equal(t, m.GenesisHash(), payload.ParentHash)
+	assert.Equal(t, uint64(1), payload.BlockNumber)
+	assert.Equal(t, attrs.Timestamp, payload.Timestamp)
+	assert.Equal(t, attrs.PrevRandao, payload.PrevRandao)
+	assert.Equal(t, attrs.SuggestedFeeRecipient, payload.FeeRecipient)
+
+	status, err := client.NewPayload(ctx, payload)
+	require.NoError(t, err)
+	assert.Equal(t, engine.StatusValid, status.Status)
+	require.NotNil(t, status.LatestValidHash)
+	assert.Equal(t, payload.BlockHash, *status.LatestValidHash)
+
+	resp, err = client.ForkchoiceUpdated(ctx, &engine.ForkchoiceState{
+		HeadBlockHash:      payload.BlockHash,
+		SafeBlockHash:      payload.BlockHash,
+		FinalizedBlockHash: m.GenesisHash(),
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, engine.StatusValid, resp.PayloadStatus.Status)
+	assert.Equal(t, true, resp.PayloadID == nil)
+	assert.Equal(t, payload.BlockHash, m.Head())
+}
+
+func TestClient_NewPayload_Status(t *testing.T) {
+	ctx := context.Background()
+	m, client := setup(t, nil, nil)
+	genesis, ok := m.Payload(m.GenesisHash())
+	require.Equal(t, true, ok)
+
+	newPayload := func(parentHash common.Hash, blockNumber, timestamp uint64) *engine.ExecutionPayload {
+		p := &engine.ExecutionPayload{
+			ParentHash:    parentHash,
+			BlockNumber:   blockNumber,
+			GasLimit:      genesis.GasLimit,
+			Timestamp:     timestamp,
+			ExtraData:     []byte{},
+			BaseFeePerGas: genesis.BaseFeePerGas,
+			Transactions:  [][]byte{{'t', 'x'}},
+		}
+		h, err := mockEngine.ComputeBlockHash(p)
+		require.NoError(t, err)
+		p.BlockHash = h
+		return p
+	}
+
+	tests := []struct {
+		name    string
+		payload *engine.ExecutionPayload
+		status  string
+	}{
+		{
+			name:    "valid",
+			payload: newPayload(m.GenesisHash(), 1, 12),
+			status:  engine.StatusValid,
+		},
+		{
+			name:    "unknown parent",
+			payload: newPayload(common.BytesToHash([]byte("unknown")), 1, 12),
+			status:  engine.StatusSyncing,
+		},
+		{
+			name:    "invalid block number",
+			payload: newPayload(m.GenesisHash(), 2, 12),
+			status:  engine.StatusInvalid,
+		},
+		{
+			name: "invalid block hash",
+			payload: func() *engine.ExecutionPayload {
+				p := newPayload(m.GenesisHash(), 1, 12)
+				p.BlockHash = common.BytesToHash([]byte("foo"))
+				return p
+			}(),
+			status: engine.StatusInvalidBlockHash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := client.NewPayload(ctx, tt.payload)
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, status.Status)
+		})
+	}
+}
+
+func TestClient_ForkchoiceUpdated_Errors(t *testing.T) {
+	ctx := context.Background()
+	m, client := setup(t, nil, nil)
+
+	resp, err := client.ForkchoiceUpdated(ctx, &engine.ForkchoiceState{
+		HeadBlockHash: common.BytesToHash([]byte("unknown")),
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, engine.StatusSyncing, resp.PayloadStatus.Status)
+
+	_, err = client.ForkchoiceUpdated(ctx, &engine.ForkchoiceState{
+		HeadBlockHash:      m.GenesisHash(),
+		FinalizedBlockHash: common.BytesToHash([]byte("unknown")),
+	}, nil)
+	assert.Equal(t, true, errors.Is(err, engine.ErrInvalidForkchoiceState), "Unexpected error %v", err)
+
+	_, err = client.ForkchoiceUpdated(ctx, &engine.ForkchoiceState{HeadBlockHash: m.GenesisHash()}, &engine.PayloadAttributes{})
+	assert.Equal(t, true, errors.Is(err, engine.ErrInvalidPayloadAttributes), "Unexpected error %v", err)
+}
+
+func TestClient_GetPayload_Unknown(t *testing.T) {
+	_, client := setup(t, nil, nil)
+	_, err := client.GetPayload(context.Background(), engine.PayloadID{1})
+	assert.Equal(t, true, errors.Is(err, engine.ErrUnknownPayload), "Unexpected error %v", err)
+}
+
+func TestClient_JWTAuthentication(t *testing.T) {
+	secret := bytesutil.PadTo([]byte("secret"), engine.JWTSecretLength)
+	otherSecret := bytesutil.PadTo([]byte("other secret"), engine.JWTSecretLength)
+
+	tests := []struct {
+		name         string
+		clientSecret []byte
+		authorized   bool
+	}{
+		{
+			name:         "same secret",
+			clientSecret: secret,
+			authorized:   true,
+		},
+		{
+			name:         "other secret",
+			clientSecret: otherSecret,
+		},
+		{
+			name: "no secret",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, client := setup(t, secret, tt.clientSecret)
+			_, err := client.ForkchoiceUpdated(context.Background(), &engine.ForkchoiceState{HeadBlockHash: m.GenesisHash()}, nil)
+			if tt.authorized {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, true, errors.Is(err, engine.ErrUnauthorized), "Unexpected error %v", err)
+		})
+	}
+}
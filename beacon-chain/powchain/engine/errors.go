@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"net/http"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// Error codes of the JSON-RPC specification, and of the engine API.
+const (
+	parseErrorCode               = -32700
+	invalidRequestCode           = -32600
+	methodNotFoundCode           = -32601
+	invalidParamsCode            = -32602
+	internalErrorCode            = -32603
+	serverErrorCode              = -32000
+	unknownPayloadCode           = -38001
+	invalidForkchoiceCode        = -38002
+	invalidPayloadAttributesCode = -38003
+)
+
+var (
+	// ErrParse is returned when the execution engine could not parse a request.
+	ErrParse = errors.New("invalid JSON was received by the execution engine")
+	// ErrInvalidRequest is returned when a request is not a valid JSON-RPC request.
+	ErrInvalidRequest = errors.New("JSON sent is not a valid request object")
+	// ErrMethodNotFound is returned when the execution engine does not support a method.
+	ErrMethodNotFound = errors.New("method not found")
+	// ErrInvalidParams is returned when the parameters of a method are invalid.
+	ErrInvalidParams = errors.New("invalid method parameters")
+	// ErrInternal is returned on an internal error of the execution engine.
+	ErrInternal = errors.New("internal JSON-RPC error")
+	// ErrServer is returned when the execution engine failed to process a request.
+	ErrServer = errors.New("client error while processing request")
+	// ErrUnknownPayload is returned when the execution engine does not know of the requested payload.
+	ErrUnknownPayload = errors.New("payload does not exist or is not available")
+	// ErrInvalidForkchoiceState is returned when the fork choice state sent to the execution engine
+	// is inconsistent.
+	ErrInvalidForkchoiceState = errors.New("invalid forkchoice state")
+	// ErrInvalidPayloadAttributes is returned when the execution engine can't build a payload with
+	// the given attributes.
+	ErrInvalidPayloadAttributes = errors.New("invalid payload attributes")
+	// ErrUnauthorized is returned when the execution engine rejects the authentication of a request.
+	ErrUnauthorized = errors.New("request to the execution engine was not authorized")
+)
+
+// handleRPCError maps the errors returned by the execution engine to the errors of the package,
+// keeping the message of the execution engine.
+func handleRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var httpErr gethRPC.HTTPError
+	if errors.As(err, &httpErr) && (httpErr.StatusCode == http.StatusUnauthorized || httpErr.StatusCode == http.StatusForbidden) {
+		return errors.Wrap(ErrUnauthorized, err.Error())
+	}
+	e, ok := err.(gethRPC.Error)
+	if !ok {
+		return errors.Wrap(err, "could not call execution engine")
+	}
+	switch e.ErrorCode() {
+	case parseErrorCode:
+		return errors.Wrap(ErrParse, e.Error())
+	case invalidRequestCode:
+		return errors.Wrap(ErrInvalidRequest, e.Error())
+	case methodNotFoundCode:
+		return errors.Wrap(ErrMethodNotFound, e.Error())
+	case invalidParamsCode:
+		return errors.Wrap(ErrInvalidParams, e.Error())
+	case internalErrorCode:
+		return errors.Wrap(ErrInternal, e.Error())
+	case serverErrorCode:
+		return errors.Wrap(ErrServer, e.Error())
+	case unknownPayloadCode:
+		return errors.Wrap(ErrUnknownPayload, e.Error())
+	case invalidForkchoiceCode:
+		return errors.Wrap(ErrInvalidForkchoiceState, e.Error())
+	case invalidPayloadAttributesCode:
+		return errors.Wrap(ErrInvalidPayloadAttributes, e.Error())
+	default:
+		return errors.Wrapf(err, "unexpected error code %d from execution engine", e.ErrorCode())
+	}
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// JWTSecretLength is the length of the secret shared with the execution engine, which signs the
+// tokens authenticating the requests to the engine API.
+const JWTSecretLength = 32
+
+// ErrInvalidJWTSecret is returned when a secret shared with the execution engine is not a 32 bytes
+// hex string.
+var ErrInvalidJWTSecret = errors.New("jwt secret must be a 32 bytes hex string")
+
+// LoadJWTSecret reads the secret shared with the execution engine from a file, which contains the
+// hex encoding of the secret.
+func LoadJWTSecret(path string) ([]byte, error) {
+	enc, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read jwt secret file")
+	}
+	return ParseJWTSecret(string(enc))
+}
+
+// ParseJWTSecret decodes the hex encoding of the secret shared with the execution engine, with or
+// without the 0x prefix.
+func ParseJWTSecret(enc string) ([]byte, error) {
+	enc = strings.TrimSpace(enc)
+	if !strings.HasPrefix(enc, "0x") {
+		enc = "0x" + enc
+	}
+	secret, err := hexutil.Decode(enc)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidJWTSecret, err.Error())
+	}
+	if len(secret) != JWTSecretLength {
+		return nil, errors.Wrapf(ErrInvalidJWTSecret, "got %d bytes", len(secret))
+	}
+	return secret, nil
+}
+
+// jwtTransport authenticates every request to the execution engine with a new token, signed with
+// the shared secret. A token is only valid for a short time around its issuance, so tokens are not
+// reused.
+type jwtTransport struct {
+	underlyingTransport http.RoundTripper
+	secret              []byte
+}
+
+// RoundTrip adds the authorization header to a copy of the request, as a round tripper must not
+// modify the request it is given.
+func (t *jwtTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := signJWT(t.secret, timeutils.Now())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return t.underlyingTransport.RoundTrip(req)
+}
+
+// signJWT creates a token issued at the given time, signed with the shared secret. The issuance
+// time is the only claim the engine API requires.
+func signJWT(secret []byte, issuedAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
+		IssuedAt: issuedAt.Unix(),
+	})
+	signed, err := token.SignedString(secret)
+	if err != nil {
+		return "", errors.Wrap(err, "could not sign jwt")
+	}
+	return signed, nil
+}
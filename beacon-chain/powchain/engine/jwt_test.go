@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestParseJWTSecret(t *testing.T) {
+	secret := strings.Repeat("ab", JWTSecretLength)
+	tests := []struct {
+		name    string
+		enc     string
+		wantErr bool
+	}{
+		{
+			name: "without prefix",
+			enc:  secret,
+		},
+		{
+			name: "with prefix and whitespace",
+			enc:  "0x" + secret + "\n",
+		},
+		{
+			name:    "too short",
+			enc:     secret[2:],
+			wantErr: true,
+		},
+		{
+			name:    "not hex",
+			enc:     strings.Repeat("zz", JWTSecretLength),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseJWTSecret(tt.enc)
+			if tt.wantErr {
+				assert.Equal(t, true, errors.Is(err, ErrInvalidJWTSecret), "Unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, []byte(strings.Repeat("\xab", JWTSecretLength)), got)
+		})
+	}
+}
+
+func TestLoadJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.Repeat("01", JWTSecretLength)), 0600))
+	secret, err := LoadJWTSecret(path)
+	require.NoError(t, err)
+	assert.Equal(t, JWTSecretLength, len(secret))
+
+	_, err = LoadJWTSecret(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorContains(t, "could not read jwt secret file", err)
+}
+
+func TestJWTTransport_SignsEveryRequest(t *testing.T) {
+	secret := []byte(strings.Repeat("s", JWTSecretLength))
+	var issuedAt []int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := &jwt.StandardClaims{}
+		_, err := jwt.ParseWithClaims(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), claims, func(token *jwt.Token) (interface{}, error) {
+			return secret, nil
+		})
+		require.NoError(t, err)
+		issuedAt = append(issuedAt, claims.IssuedAt)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &jwtTransport{underlyingTransport: http.DefaultTransport, secret: secret}}
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		// The request given to the transport is not modified.
+		assert.Equal(t, "", req.Header.Get("Authorization"))
+	}
+	require.Equal(t, 2, len(issuedAt))
+	for _, iat := range issuedAt {
+		assert.Equal(t, true, time.Since(time.Unix(iat, 0)) < time.Minute)
+	}
+}
@@ -0,0 +1,287 @@
+// Package testing provides an in-process execution engine serving the engine API, to test the
+// engine API client and its callers without an execution client.
+package testing
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const (
+	// Error codes of the engine API returned by the mock engine.
+	unknownPayloadCode           = -38001
+	invalidForkchoiceCode        = -38002
+	invalidPayloadAttributesCode = -38003
+
+	// maxIssuanceDrift is how far the issuance time of a token can be from the current time.
+	maxIssuanceDrift = 60 * time.Second
+	// genesisGasLimit is the gas limit of the genesis payload of the mock engine.
+	genesisGasLimit = 30000000
+	// genesisBaseFeePerGas is the base fee of the genesis payload of the mock engine.
+	genesisBaseFeePerGas = 1000000000
+)
+
+// MockEngine is an execution engine serving the engine API over HTTP. It keeps the payloads it is
+// sent in memory, and builds empty payloads on top of its head. Payloads are valid if they extend
+// a known payload, and if their block hash is the one computed by ComputeBlockHash.
+type MockEngine struct {
+	server    *httptest.Server
+	rpcServer *gethRPC.Server
+	api       *engineAPI
+}
+
+// NewMockEngine starts a mock engine with a genesis payload as its head. Requests must be
+// authenticated with the JWT secret, unless it is empty.
+func NewMockEngine(jwtSecret []byte) (*MockEngine, error) {
+	genesis := &engine.ExecutionPayload{
+		GasLimit:      genesisGasLimit,
+		BaseFeePerGas: big.NewInt(genesisBaseFeePerGas),
+		ExtraData:     []byte{},
+		Transactions:  [][]byte{},
+	}
+	genesisHash, err := ComputeBlockHash(genesis)
+	if err != nil {
+		return nil, err
+	}
+	genesis.BlockHash = genesisHash
+	api := &engineAPI{
+		payloads: map[common.Hash]*engine.ExecutionPayload{genesisHash: genesis},
+		building: make(map[engine.PayloadID]*engine.ExecutionPayload),
+		head:     genesisHash,
+		genesis:  genesisHash,
+	}
+	rpcServer := gethRPC.NewServer()
+	if err := rpcServer.RegisterName("engine", api); err != nil {
+		return nil, errors.Wrap(err, "could not register engine API")
+	}
+	var handler http.Handler = rpcServer
+	if len(jwtSecret) != 0 {
+		handler = authHandler(jwtSecret, rpcServer)
+	}
+	return &MockEngine{
+		server:    httptest.NewServer(handler),
+		rpcServer: rpcServer,
+		api:       api,
+	}, nil
+}
+
+// Endpoint of the engine API of the mock engine.
+func (m *MockEngine) Endpoint() string {
+	return m.server.URL
+}
+
+// Close stops serving the engine API.
+func (m *MockEngine) Close() {
+	m.server.Close()
+	m.rpcServer.Stop()
+}
+
+// GenesisHash returns the block hash of the genesis payload of the mock engine.
+func (m *MockEngine) GenesisHash() common.Hash {
+	return m.api.genesis
+}
+
+// Head returns the block hash of the head of the fork choice of the mock engine.
+func (m *MockEngine) Head() common.Hash {
+	m.api.lock.RLock()
+	defer m.api.lock.RUnlock()
+	return m.api.head
+}
+
+// Payload returns a payload known to the mock engine by its block hash.
+func (m *MockEngine) Payload(blockHash common.Hash) (*engine.ExecutionPayload, bool) {
+	m.api.lock.RLock()
+	defer m.api.lock.RUnlock()
+	p, ok := m.api.payloads[blockHash]
+	return p, ok
+}
+
+// ComputeBlockHash computes the block hash of a payload for the mock engine. Unlike an execution
+// client, it is the hash of the JSON encoding of the payload without its block hash.
+func ComputeBlockHash(payload *engine.ExecutionPayload) (common.Hash, error) {
+	p := *payload
+	p.BlockHash = common.Hash{}
+	enc, err := json.Marshal(&p)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not encode payload")
+	}
+	return common.Hash(hashutil.Hash(enc)), nil
+}
+
+// engineError is an error of the engine API, with its JSON-RPC error code.
+type engineError struct {
+	code int
+	msg  string
+}
+
+// Error returns the message of the error.
+func (e *engineError) Error() string {
+	return e.msg
+}
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *engineError) ErrorCode() int {
+	return e.code
+}
+
+// engineAPI implements the methods of the engine namespace, which are registered with the RPC
+// server by reflection.
+type engineAPI struct {
+	lock          sync.RWMutex
+	payloads      map[common.Hash]*engine.ExecutionPayload
+	building      map[engine.PayloadID]*engine.ExecutionPayload
+	nextPayloadID uint64
+	head          common.Hash
+	genesis       common.Hash
+}
+
+// NewPayloadV1 implements engine_newPayloadV1.
+func (api *engineAPI) NewPayloadV1(payload *engine.ExecutionPayload) (*engine.PayloadStatus, error) {
+	blockHash, err := ComputeBlockHash(payload)
+	if err != nil {
+		return nil, err
+	}
+	if blockHash != payload.BlockHash {
+		return &engine.PayloadStatus{Status: engine.StatusInvalidBlockHash}, nil
+	}
+
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	parent, ok := api.payloads[payload.ParentHash]
+	if !ok {
+		return &engine.PayloadStatus{Status: engine.StatusSyncing}, nil
+	}
+	if payload.BlockNumber != parent.BlockNumber+1 || payload.Timestamp <= parent.Timestamp {
+		validationError := fmt.Sprintf(
+			"payload %d at time %d does not extend parent %d at time %d",
+			payload.BlockNumber, payload.Timestamp, parent.BlockNumber, parent.Timestamp,
+		)
+		return &engine.PayloadStatus{
+			Status:          engine.StatusInvalid,
+			LatestValidHash: &parent.BlockHash,
+			ValidationError: &validationError,
+		}, nil
+	}
+	api.payloads[payload.BlockHash] = payload
+	return &engine.PayloadStatus{Status: engine.StatusValid, LatestValidHash: &payload.BlockHash}, nil
+}
+
+// ForkchoiceUpdatedV1 implements engine_forkchoiceUpdatedV1.
+func (api *engineAPI) ForkchoiceUpdatedV1(
+	state *engine.ForkchoiceState, attrs *engine.PayloadAttributes,
+) (*engine.ForkchoiceUpdatedResponse, error) {
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	head, ok := api.payloads[state.HeadBlockHash]
+	if !ok {
+		return &engine.ForkchoiceUpdatedResponse{
+			PayloadStatus: engine.PayloadStatus{Status: engine.StatusSyncing},
+		}, nil
+	}
+	for _, h := range []common.Hash{state.SafeBlockHash, state.FinalizedBlockHash} {
+		if _, ok := api.payloads[h]; h != (common.Hash{}) && !ok {
+			return nil, &engineError{code: invalidForkchoiceCode, msg: fmt.Sprintf("unknown block %#x", h)}
+		}
+	}
+	api.head = head.BlockHash
+	resp := &engine.ForkchoiceUpdatedResponse{
+		PayloadStatus: engine.PayloadStatus{Status: engine.StatusValid, LatestValidHash: &head.BlockHash},
+	}
+	if attrs == nil {
+		return resp, nil
+	}
+
+	if attrs.Timestamp <= head.Timestamp {
+		return nil, &engineError{
+			code: invalidPayloadAttributesCode,
+			msg:  fmt.Sprintf("timestamp %d is not after the head timestamp %d", attrs.Timestamp, head.Timestamp),
+		}
+	}
+	payload := &engine.ExecutionPayload{
+		ParentHash:    head.BlockHash,
+		FeeRecipient:  attrs.SuggestedFeeRecipient,
+		StateRoot:     head.StateRoot,
+		ReceiptsRoot:  head.ReceiptsRoot,
+		PrevRandao:    attrs.PrevRandao,
+		BlockNumber:   head.BlockNumber + 1,
+		GasLimit:      head.GasLimit,
+		Timestamp:     attrs.Timestamp,
+		ExtraData:     []byte{},
+		BaseFeePerGas: head.BaseFeePerGas,
+		Transactions:  [][]byte{},
+	}
+	blockHash, err := ComputeBlockHash(payload)
+	if err != nil {
+		return nil, err
+	}
+	payload.BlockHash = blockHash
+	api.nextPayloadID++
+	payloadID := engine.PayloadID{}
+	binary.BigEndian.PutUint64(payloadID[:], api.nextPayloadID)
+	api.building[payloadID] = payload
+	resp.PayloadID = &payloadID
+	return resp, nil
+}
+
+// GetPayloadV1 implements engine_getPayloadV1.
+func (api *engineAPI) GetPayloadV1(payloadID engine.PayloadID) (*engine.ExecutionPayload, error) {
+	api.lock.RLock()
+	defer api.lock.RUnlock()
+	payload, ok := api.building[payloadID]
+	if !ok {
+		return nil, &engineError{code: unknownPayloadCode, msg: "unknown payload"}
+	}
+	return payload, nil
+}
+
+// authHandler rejects the requests which are not authenticated with a token signed with the JWT
+// secret, and issued around the current time.
+func authHandler(jwtSecret []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := validateToken(jwtSecret, r.Header.Get("Authorization")); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func validateToken(jwtSecret []byte, header string) error {
+	if !strings.HasPrefix(header, "Bearer ") {
+		return errors.New("missing bearer token")
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(strings.TrimPrefix(header, "Bearer "), claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return jwtSecret, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "invalid token")
+	}
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return errors.New("missing issuance time")
+	}
+	drift := timeutils.Now().Sub(time.Unix(int64(iat), 0))
+	if drift > maxIssuanceDrift || drift < -maxIssuanceDrift {
+		return errors.Errorf("token issued %s from the current time", drift)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package engine
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+)
+
+// Status of a payload, as returned by the execution engine when it is asked to process a new payload
+// or to update its fork choice.
+const (
+	// StatusValid means the payload and all its ancestors were fully validated.
+	StatusValid = "VALID"
+	// StatusInvalid means the payload, or one of its ancestors, is invalid.
+	StatusInvalid = "INVALID"
+	// StatusSyncing means the execution engine is syncing and could not validate the payload yet.
+	StatusSyncing = "SYNCING"
+	// StatusAccepted means the payload was accepted, but is not on the canonical chain of the
+	// execution engine, so it could not be fully validated yet.
+	StatusAccepted = "ACCEPTED"
+	// StatusInvalidBlockHash means the block hash of the payload does not match its contents.
+	StatusInvalidBlockHash = "INVALID_BLOCK_HASH"
+)
+
+// ExecutionPayload is an execution block, as exchanged with the execution engine.
+type ExecutionPayload struct {
+	ParentHash    common.Hash
+	FeeRecipient  common.Address
+	StateRoot     common.Hash
+	ReceiptsRoot  common.Hash
+	LogsBloom     gethTypes.Bloom
+	PrevRandao    common.Hash
+	BlockNumber   uint64
+	GasLimit      uint64
+	GasUsed       uint64
+	Timestamp     uint64
+	ExtraData     []byte
+	BaseFeePerGas *big.Int
+	BlockHash     common.Hash
+	Transactions  [][]byte
+}
+
+type executionPayloadJSON struct {
+	ParentHash    *common.Hash     `json:"parentHash"`
+	FeeRecipient  *common.Address  `json:"feeRecipient"`
+	StateRoot     *common.Hash     `json:"stateRoot"`
+	ReceiptsRoot  *common.Hash     `json:"receiptsRoot"`
+	LogsBloom     *gethTypes.Bloom `json:"logsBloom"`
+	PrevRandao    *common.Hash     `json:"prevRandao"`
+	BlockNumber   *hexutil.Uint64  `json:"blockNumber"`
+	GasLimit      *hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       *hexutil.Uint64  `json:"gasUsed"`
+	Timestamp     *hexutil.Uint64  `json:"timestamp"`
+	ExtraData     *hexutil.Bytes   `json:"extraData"`
+	BaseFeePerGas *hexutil.Big     `json:"baseFeePerGas"`
+	BlockHash     *common.Hash     `json:"blockHash"`
+	Transactions  []hexutil.Bytes  `json:"transactions"`
+}
+
+// PayloadFromProto converts an execution payload of a beacon block to the payload sent to the
+// execution engine. The base fee per gas of the beacon block is a little-endian uint256.
+func PayloadFromProto(p *enginev1.ExecutionPayload) *ExecutionPayload {
+	baseFee := make([]byte, len(p.BaseFeePerGas))
+	for i, b := range p.BaseFeePerGas {
+		baseFee[len(baseFee)-1-i] = b
+	}
+	return &ExecutionPayload{
+		ParentHash:    common.BytesToHash(p.ParentHash),
+		FeeRecipient:  common.BytesToAddress(p.FeeRecipient),
+		StateRoot:     common.BytesToHash(p.StateRoot),
+		ReceiptsRoot:  common.BytesToHash(p.ReceiptsRoot),
+		LogsBloom:     gethTypes.BytesToBloom(p.LogsBloom),
+		PrevRandao:    common.BytesToHash(p.PrevRandao),
+		BlockNumber:   p.BlockNumber,
+		GasLimit:      p.GasLimit,
+		GasUsed:       p.GasUsed,
+		Timestamp:     p.Timestamp,
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: new(big.Int).SetBytes(baseFee),
+		BlockHash:     common.BytesToHash(p.BlockHash),
+		Transactions:  p.Transactions,
+	}
+}
+
+// MarshalJSON encodes the payload with the hex encoding of the engine API.
+func (p *ExecutionPayload) MarshalJSON() ([]byte, error) {
+	blockNumber := hexutil.Uint64(p.BlockNumber)
+	gasLimit := hexutil.Uint64(p.GasLimit)
+	gasUsed := hexutil.Uint64(p.GasUsed)
+	timestamp := hexutil.Uint64(p.Timestamp)
+	extraData := hexutil.Bytes(p.ExtraData)
+	baseFeePerGas := new(big.Int)
+	if p.BaseFeePerGas != nil {
+		baseFeePerGas = p.BaseFeePerGas
+	}
+	transactions := make([]hexutil.Bytes, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		transactions[i] = tx
+	}
+	return json.Marshal(&executionPayloadJSON{
+		ParentHash:    &p.ParentHash,
+		FeeRecipient:  &p.FeeRecipient,
+		StateRoot:     &p.StateRoot,
+		ReceiptsRoot:  &p.ReceiptsRoot,
+		LogsBloom:     &p.LogsBloom,
+		PrevRandao:    &p.PrevRandao,
+		BlockNumber:   &blockNumber,
+		GasLimit:      &gasLimit,
+		GasUsed:       &gasUsed,
+		Timestamp:     &timestamp,
+		ExtraData:     &extraData,
+		BaseFeePerGas: (*hexutil.Big)(baseFeePerGas),
+		BlockHash:     &p.BlockHash,
+		Transactions:  transactions,
+	})
+}
+
+// UnmarshalJSON decodes a payload with the hex encoding of the engine API. All the fields of the
+// payload are required.
+func (p *ExecutionPayload) UnmarshalJSON(enc []byte) error {
+	dec := &executionPayloadJSON{}
+	if err := json.Unmarshal(enc, dec); err != nil {
+		return err
+	}
+	switch {
+	case dec.ParentHash == nil:
+		return errors.New("missing required field 'parentHash' for ExecutionPayload")
+	case dec.FeeRecipient == nil:
+		return errors.New("missing required field 'feeRecipient' for ExecutionPayload")
+	case dec.StateRoot == nil:
+		return errors.New("missing required field 'stateRoot' for ExecutionPayload")
+	case dec.ReceiptsRoot == nil:
+		return errors.New("missing required field 'receiptsRoot' for ExecutionPayload")
+	case dec.LogsBloom == nil:
+		return errors.New("missing required field 'logsBloom' for ExecutionPayload")
+	case dec.PrevRandao == nil:
+		return errors.New("missing required field 'prevRandao' for ExecutionPayload")
+	case dec.BlockNumber == nil:
+		return errors.New("missing required field 'blockNumber' for ExecutionPayload")
+	case dec.GasLimit == nil:
+		return errors.New("missing required field 'gasLimit' for ExecutionPayload")
+	case dec.GasUsed == nil:
+		return errors.New("missing required field 'gasUsed' for ExecutionPayload")
+	case dec.Timestamp == nil:
+		return errors.New("missing required field 'timestamp' for ExecutionPayload")
+	case dec.ExtraData == nil:
+		return errors.New("missing required field 'extraData' for ExecutionPayload")
+	case dec.BaseFeePerGas == nil:
+		return errors.New("missing required field 'baseFeePerGas' for ExecutionPayload")
+	case dec.BlockHash == nil:
+		return errors.New("missing required field 'blockHash' for ExecutionPayload")
+	case dec.Transactions == nil:
+		return errors.New("missing required field 'transactions' for ExecutionPayload")
+	}
+	transactions := make([][]byte, len(dec.Transactions))
+	for i, tx := range dec.Transactions {
+		transactions[i] = tx
+	}
+	*p = ExecutionPayload{
+		ParentHash:    *dec.ParentHash,
+		FeeRecipient:  *dec.FeeRecipient,
+		StateRoot:     *dec.StateRoot,
+		ReceiptsRoot:  *dec.ReceiptsRoot,
+		LogsBloom:     *dec.LogsBloom,
+		PrevRandao:    *dec.PrevRandao,
+		BlockNumber:   uint64(*dec.BlockNumber),
+		GasLimit:      uint64(*dec.GasLimit),
+		GasUsed:       uint64(*dec.GasUsed),
+		Timestamp:     uint64(*dec.Timestamp),
+		ExtraData:     *dec.ExtraData,
+		BaseFeePerGas: (*big.Int)(dec.BaseFeePerGas),
+		BlockHash:     *dec.BlockHash,
+		Transactions:  transactions,
+	}
+	return nil
+}
+
+// PayloadAttributes are the attributes of a payload the execution engine is asked to build on top
+// of the head of its fork choice.
+type PayloadAttributes struct {
+	Timestamp             uint64
+	PrevRandao            common.Hash
+	SuggestedFeeRecipient common.Address
+}
+
+type payloadAttributesJSON struct {
+	Timestamp             *hexutil.Uint64 `json:"timestamp"`
+	PrevRandao            *common.Hash    `json:"prevRandao"`
+	SuggestedFeeRecipient *common.Address `json:"suggestedFeeRecipient"`
+}
+
+// MarshalJSON encodes the payload attributes with the hex encoding of the engine API.
+func (a *PayloadAttributes) MarshalJSON() ([]byte, error) {
+	timestamp := hexutil.Uint64(a.Timestamp)
+	return json.Marshal(&payloadAttributesJSON{
+		Timestamp:             &timestamp,
+		PrevRandao:            &a.PrevRandao,
+		SuggestedFeeRecipient: &a.SuggestedFeeRecipient,
+	})
+}
+
+// UnmarshalJSON decodes payload attributes with the hex encoding of the engine API. All the fields
+// of the attributes are required.
+func (a *PayloadAttributes) UnmarshalJSON(enc []byte) error {
+	dec := &payloadAttributesJSON{}
+	if err := json.Unmarshal(enc, dec); err != nil {
+		return err
+	}
+	switch {
+	case dec.Timestamp == nil:
+		return errors.New("missing required field 'timestamp' for PayloadAttributes")
+	case dec.PrevRandao == nil:
+		return errors.New("missing required field 'prevRandao' for PayloadAttributes")
+	case dec.SuggestedFeeRecipient == nil:
+		return errors.New("missing required field 'suggestedFeeRecipient' for PayloadAttributes")
+	}
+	*a = PayloadAttributes{
+		Timestamp:             uint64(*dec.Timestamp),
+		PrevRandao:            *dec.PrevRandao,
+		SuggestedFeeRecipient: *dec.SuggestedFeeRecipient,
+	}
+	return nil
+}
+
+// ForkchoiceState is the fork choice of the consensus layer, as sent to the execution engine.
+type ForkchoiceState struct {
+	HeadBlockHash      common.Hash `json:"headBlockHash"`
+	SafeBlockHash      common.Hash `json:"safeBlockHash"`
+	FinalizedBlockHash common.Hash `json:"finalizedBlockHash"`
+}
+
+// PayloadStatus is the result of the processing of a payload by the execution engine.
+type PayloadStatus struct {
+	Status          string       `json:"status"`
+	LatestValidHash *common.Hash `json:"latestValidHash"`
+	ValidationError *string      `json:"validationError"`
+}
+
+// PayloadID identifies a payload the execution engine is building.
+type PayloadID [8]byte
+
+// MarshalText encodes the payload ID as a hex string.
+func (id PayloadID) MarshalText() ([]byte, error) {
+	return hexutil.Bytes(id[:]).MarshalText()
+}
+
+// UnmarshalText decodes a payload ID from a hex string.
+func (id *PayloadID) UnmarshalText(input []byte) error {
+	return hexutil.UnmarshalFixedText("PayloadID", input, id[:])
+}
+
+// ForkchoiceUpdatedResponse is the response of the execution engine to a fork choice update. The
+// payload ID is only set if the execution engine started building a payload.
+type ForkchoiceUpdatedResponse struct {
+	PayloadStatus PayloadStatus `json:"payloadStatus"`
+	PayloadID     *PayloadID    `json:"payloadId"`
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestExecutionPayload_JSONRoundTrip(t *testing.T) {
+	payload := &ExecutionPayload{
+		ParentHash:    common.BytesToHash([]byte("parent")),
+		FeeRecipient:  common.BytesToAddress([]byte("fee recipient")),
+		StateRoot:     common.BytesToHash([]byte("state")),
+		ReceiptsRoot:  common.BytesToHash([]byte("receipts")),
+		PrevRandao:    common.BytesToHash([]byte("randao")),
+		BlockNumber:   10,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1638000000,
+		ExtraData:     []byte("extra"),
+		BaseFeePerGas: big.NewInt(7),
+		BlockHash:     common.BytesToHash([]byte("block")),
+		Transactions:  [][]byte{{1, 2}, {3}},
+	}
+	payload.LogsBloom[0] = 1
+	enc, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	// Quantities are hex encoded.
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(enc, &fields))
+	assert.Equal(t, "0xa", fields["blockNumber"])
+	assert.Equal(t, "0x7", fields["baseFeePerGas"])
+	assert.Equal(t, "0x6578747261", fields["extraData"])
+
+	dec := &ExecutionPayload{}
+	require.NoError(t, json.Unmarshal(enc, dec))
+	assert.DeepEqual(t, payload, dec)
+}
+
+func TestExecutionPayload_UnmarshalJSON_MissingField(t *testing.T) {
+	enc, err := json.Marshal(&ExecutionPayload{})
+	require.NoError(t, err)
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(enc, &fields))
+	delete(fields, "blockHash")
+	enc, err = json.Marshal(fields)
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, "missing required field 'blockHash'", json.Unmarshal(enc, &ExecutionPayload{}))
+}
+
+func TestPayloadAttributes_JSONRoundTrip(t *testing.T) {
+	attrs := &PayloadAttributes{
+		Timestamp:             16,
+		PrevRandao:            common.BytesToHash([]byte("randao")),
+		SuggestedFeeRecipient: common.BytesToAddress([]byte("fee recipient")),
+	}
+	enc, err := json.Marshal(attrs)
+	require.NoError(t, err)
+	dec := &PayloadAttributes{}
+	require.NoError(t, json.Unmarshal(enc, dec))
+	assert.DeepEqual(t, attrs, dec)
+
+	assert.ErrorContains(t, "missing required field 'timestamp'", json.Unmarshal([]byte("{}"), dec))
+}
+
+func TestForkchoiceUpdatedResponse_JSON(t *testing.T) {
+	enc := []byte(`{"payloadStatus":{"status":"VALID","latestValidHash":null,"validationError":null},"payloadId":"0x0000000000000001"}`)
+	resp := &ForkchoiceUpdatedResponse{}
+	require.NoError(t, json.Unmarshal(enc, resp))
+	assert.Equal(t, StatusValid, resp.PayloadStatus.Status)
+	require.NotNil(t, resp.PayloadID)
+	assert.Equal(t, PayloadID{0, 0, 0, 0, 0, 0, 0, 1}, *resp.PayloadID)
+
+	got, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, string(enc), string(got))
+}
+
+func TestPayloadFromProto(t *testing.T) {
+	baseFee := make([]byte, 32)
+	baseFee[0], baseFee[1] = 0x07, 0x01
+	p := &enginev1.ExecutionPayload{
+		ParentHash:    bytesutil.PadTo([]byte("parent"), 32),
+		FeeRecipient:  bytesutil.PadTo([]byte("fee recipient"), 20),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		ReceiptsRoot:  bytesutil.PadTo([]byte("receipts"), 32),
+		LogsBloom:     make([]byte, 256),
+		PrevRandao:    bytesutil.PadTo([]byte("randao"), 32),
+		BlockNumber:   10,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1638000000,
+		ExtraData:     []byte("extra"),
+		BaseFeePerGas: baseFee,
+		BlockHash:     bytesutil.PadTo([]byte("block"), 32),
+		Transactions:  [][]byte{{1, 2}, {3}},
+	}
+	payload := PayloadFromProto(p)
+	assert.Equal(t, common.BytesToHash(p.ParentHash), payload.ParentHash)
+	assert.Equal(t, common.BytesToAddress(p.FeeRecipient), payload.FeeRecipient)
+	assert.Equal(t, common.BytesToHash(p.BlockHash), payload.BlockHash)
+	assert.Equal(t, uint64(10), payload.BlockNumber)
+	// The base fee is converted from little-endian.
+	assert.Equal(t, int64(0x0107), payload.BaseFeePerGas.Int64())
+	assert.DeepEqual(t, p.Transactions, payload.Transactions)
+}
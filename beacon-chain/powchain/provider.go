@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/httputils"
 	"github.com/prysmaticlabs/prysm/shared/httputils/authorizationmethod"
 )
@@ -47,3 +48,43 @@ func HttpEndpoint(eth1Provider string) httputils.Endpoint {
 	}
 	return endpoint
 }
+
+// EndpointHeader extracts the url of an endpoint, and the name and value of a header to send to
+// it, from a string in the format "<url>,<name>: <value>".
+func EndpointHeader(header string) (url, name, value string, err error) {
+	parts := strings.SplitN(header, ",", 2)
+	if len(parts) != 2 {
+		return "", "", "", errors.New("endpoint header must be in the format <url>,<name>: <value>")
+	}
+	nameValue := strings.SplitN(parts[1], ":", 2)
+	if len(nameValue) != 2 || strings.TrimSpace(nameValue[0]) == "" {
+		return "", "", "", errors.New("endpoint header must be in the format <url>,<name>: <value>")
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(nameValue[0]), strings.TrimSpace(nameValue[1]), nil
+}
+
+// withEndpointHeaders adds the given headers, in the format of EndpointHeader, to the endpoints
+// with their url.
+func withEndpointHeaders(endpoints []httputils.Endpoint, headers []string) error {
+	for _, h := range headers {
+		url, name, value, err := EndpointHeader(h)
+		if err != nil {
+			return err
+		}
+		found := false
+		for i := range endpoints {
+			if endpoints[i].Url != url {
+				continue
+			}
+			if endpoints[i].Headers == nil {
+				endpoints[i].Headers = make(map[string]string)
+			}
+			endpoints[i].Headers[name] = value
+			found = true
+		}
+		if !found {
+			return errors.Errorf("header %s is set for an endpoint which is not configured", name)
+		}
+	}
+	return nil
+}
@@ -3,8 +3,10 @@ package powchain
 import (
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/httputils"
 	"github.com/prysmaticlabs/prysm/shared/httputils/authorizationmethod"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -72,3 +74,26 @@ func TestHttpEndpoint(t *testing.T) {
 		assert.LogsContain(t, hook, "Skipping authorization")
 	})
 }
+
+func TestEndpointHeader(t *testing.T) {
+	url, name, value, err := EndpointHeader("http://test:8545, X-Api-Key: a:b ")
+	require.NoError(t, err)
+	assert.Equal(t, "http://test:8545", url)
+	assert.Equal(t, "X-Api-Key", name)
+	assert.Equal(t, "a:b", value)
+
+	for _, h := range []string{"http://test", "http://test,X-Api-Key", "http://test,: key"} {
+		_, _, _, err = EndpointHeader(h)
+		assert.ErrorContains(t, "endpoint header must be in the format", err)
+	}
+}
+
+func TestWithEndpointHeaders(t *testing.T) {
+	endpoints := []httputils.Endpoint{{Url: "http://a"}, {Url: "http://b"}}
+	require.NoError(t, withEndpointHeaders(endpoints, []string{"http://b,X-Api-Key: key", "http://b,X-Other: other"}))
+	assert.Equal(t, 0, len(endpoints[0].Headers))
+	assert.DeepEqual(t, map[string]string{"X-Api-Key": "key", "X-Other": "other"}, endpoints[1].Headers)
+
+	err := withEndpointHeaders(endpoints, []string{"http://c,X-Api-Key: key"})
+	assert.ErrorContains(t, "endpoint which is not configured", err)
+}
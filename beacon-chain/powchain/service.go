@@ -5,6 +5,7 @@ package powchain
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -28,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	core "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -74,6 +76,8 @@ var (
 	errNotSynced = errors.New("eth1 node is still syncing")
 	// error when eth1 node is too far behind.
 	errFarBehind = errors.Errorf("eth1 head is more than %s behind from current wall clock time", eth1Threshold.String())
+	// error when a deposit snapshot does not match the deposit contract.
+	errDepositSnapshotMismatch = errors.New("deposit snapshot does not match the deposit contract")
 )
 
 // ChainStartFetcher retrieves information pertaining to the chain start event
@@ -148,6 +152,13 @@ type Service struct {
 	runError                error
 	preGenesisState         state.BeaconState
 	bsUpdater               BeaconNodeStatsUpdater
+	engineClient            *engine.Client
+	depositSnapshot         *ethpb.DepositSnapshot
+	pendingDepositSnapshot  *ethpb.DepositSnapshot
+	endpointStatuses        map[string]*endpointStatus
+	endpointStatusLock      sync.RWMutex
+	lastCrossCheckedHeight  uint64
+	endpointChecks          chan *endpointCheck
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
@@ -160,6 +171,18 @@ type Web3ServiceConfig struct {
 	StateGen               *stategen.State
 	Eth1HeaderReqLimit     uint64
 	BeaconNodeStatsUpdater BeaconNodeStatsUpdater
+	// ExecutionEngineEndpoint is the endpoint of the engine API of the execution client, which is
+	// only called if it is set.
+	ExecutionEngineEndpoint string
+	// ExecutionJWTSecret authenticates the requests to the engine API.
+	ExecutionJWTSecret []byte
+	// DepositSnapshot is a snapshot of finalized deposits to start from, instead of processing
+	// every deposit log since the deployment of the deposit contract. It is only used if there
+	// are no deposits in the database yet, once it is verified against the deposit contract.
+	DepositSnapshot *ethpb.DepositSnapshot
+	// EndpointHeaders are additional headers to send to the http endpoints, in the format
+	// "<url>,<name>: <value>".
+	EndpointHeaders []string
 }
 
 // NewService sets up a new instance with an ethclient when
@@ -186,6 +209,10 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	for i, e := range stringEndpoints {
 		endpoints[i] = HttpEndpoint(e)
 	}
+	if err := withEndpointHeaders(endpoints, config.EndpointHeaders); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not set endpoint headers")
+	}
 
 	// Select first http endpoint in the provided list.
 	var currEndpoint httputils.Endpoint
@@ -213,6 +240,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		lastReceivedMerkleIndex: -1,
 		preGenesisState:         genState,
 		headTicker:              time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
+		endpointChecks:          make(chan *endpointCheck, 1),
 		// use the nop updater by default, rely on upstream set up to pass in an appropriate impl
 		bsUpdater: config.BeaconNodeStatsUpdater,
 	}
@@ -221,6 +249,16 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		s.bsUpdater = &NopBeaconNodeStatsUpdater{}
 	}
 
+	if config.ExecutionEngineEndpoint != "" {
+		s.engineClient, err = engine.New(ctx, &engine.Config{
+			Endpoint:  config.ExecutionEngineEndpoint,
+			JWTSecret: config.ExecutionJWTSecret,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create execution engine client")
+		}
+	}
+
 	if err := s.ensureValidPowchainData(ctx); err != nil {
 		return nil, errors.Wrap(err, "unable to validate powchain data")
 	}
@@ -233,6 +271,18 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		return nil, err
 	}
 
+	if config.DepositSnapshot != nil {
+		if s.depositTrie.NumOfItems() != 0 {
+			log.Info("Ignoring deposit snapshot, as deposits have already been processed")
+		} else if err := checkDepositSnapshot(s.chainStartData, config.DepositSnapshot); err != nil {
+			return nil, errors.Wrap(err, "could not start from deposit snapshot")
+		} else {
+			// The snapshot is only applied once it is verified against the deposit contract, when
+			// connected to the eth1 node.
+			s.pendingDepositSnapshot = config.DepositSnapshot
+		}
+	}
+
 	return s, nil
 }
 
@@ -273,9 +323,22 @@ func (s *Service) Stop() error {
 		defer s.cancel()
 	}
 	s.closeClients()
+	s.closeEndpointClients()
+	if s.engineClient != nil {
+		s.engineClient.Close()
+	}
 	return nil
 }
 
+// ExecutionEngineCaller returns the client of the engine API of the execution client, or nil if
+// no execution engine endpoint is configured.
+func (s *Service) ExecutionEngineCaller() engine.Caller {
+	if s.engineClient == nil {
+		return nil
+	}
+	return s.engineClient
+}
+
 // ChainStartDeposits returns a slice of validator deposit data processed
 // by the deposit contract and cached in the powchain service.
 func (s *Service) ChainStartDeposits() []*ethpb.Deposit {
@@ -411,17 +474,10 @@ func (s *Service) connectToPowChain() error {
 }
 
 func (s *Service) dialETH1Nodes(endpoint httputils.Endpoint) (*ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(endpoint.Url)
+	httpRPCClient, err := newRPCClient(endpoint)
 	if err != nil {
 		return nil, nil, err
 	}
-	if endpoint.Auth.Method != authorizationmethod.None {
-		header, err := endpoint.Auth.ToHeaderValue()
-		if err != nil {
-			return nil, nil, err
-		}
-		httpRPCClient.SetHeader("Authorization", header)
-	}
 	httpClient := ethclient.NewClient(httpRPCClient)
 	// Add a method to clean-up and close clients in the event
 	// of any connection failure.
@@ -461,6 +517,26 @@ func (s *Service) dialETH1Nodes(endpoint httputils.Endpoint) (*ethclient.Client,
 	return httpClient, httpRPCClient, nil
 }
 
+// newRPCClient dials the endpoint, with its authorization and additional headers.
+func newRPCClient(endpoint httputils.Endpoint) (*gethRPC.Client, error) {
+	client, err := gethRPC.Dial(endpoint.Url)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Auth.Method != authorizationmethod.None {
+		header, err := endpoint.Auth.ToHeaderValue()
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		client.SetHeader("Authorization", header)
+	}
+	for name, value := range endpoint.Headers {
+		client.SetHeader(name, value)
+	}
+	return client, nil
+}
+
 func (s *Service) initializeConnection(
 	httpClient *ethclient.Client,
 	rpcClient *gethRPC.Client,
@@ -574,6 +650,9 @@ func (s *Service) isEth1NodeSynced() (bool, error) {
 // Reconnect to eth1 node in case of any failure.
 func (s *Service) retryETH1Node(err error) {
 	s.runError = err
+	if err != nil {
+		s.recordEndpointError()
+	}
 	s.updateConnectedETH1(false)
 	// Back off for a while before
 	// resuming dialing the eth1 node.
@@ -618,16 +697,122 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 		currIndex = fState.Eth1DepositIndex()
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add the deposits which are not yet included in the state as pending. The containers
+	// do not start at index 0 if the node was started from a deposit snapshot.
+	for _, c := range ctrs {
+		if uint64(c.Index) >= currIndex {
 			s.cfg.DepositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
 	return nil
 }
 
+// checkDepositSnapshot checks that a deposit snapshot can be started from.
+func checkDepositSnapshot(chainStartData *protodb.ChainStartData, snapshot *ethpb.DepositSnapshot) error {
+	if !chainStartData.Chainstarted {
+		return errors.New("a deposit snapshot can only be used once the genesis state is known")
+	}
+	if snapshot.DepositCount == 0 {
+		return errors.New("deposit snapshot has no deposits")
+	}
+	return nil
+}
+
+// verifyDepositSnapshot checks the deposit root and count of a snapshot against the deposit
+// contract at the block of the last deposit in the snapshot. The contract is read at the end of
+// that block, so the later deposits of the block are added to the snapshot from their logs before
+// the comparison.
+func (s *Service) verifyDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	blockNumber := new(big.Int).SetUint64(snapshot.ExecutionBlockHeight)
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: blockNumber}
+	rawCount, err := s.depositContractCaller.GetDepositCount(opts)
+	if err != nil {
+		return errors.Wrap(err, "could not get deposit count")
+	}
+	count := binary.LittleEndian.Uint64(rawCount)
+	root, err := s.depositContractCaller.GetDepositRoot(opts)
+	if err != nil {
+		return errors.Wrap(err, "could not get deposit root")
+	}
+	if count < snapshot.DepositCount {
+		return errors.Wrapf(errDepositSnapshotMismatch, "deposit contract has %d deposits at block %d, but the snapshot has %d", count, snapshot.ExecutionBlockHeight, snapshot.DepositCount)
+	}
+	depositTrie, err := trieutil.CreateTrieFromSnapshot(snapshot.Finalized, snapshot.DepositCount, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	if count > snapshot.DepositCount {
+		logs, err := s.httpLogger.FilterLogs(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{s.cfg.DepositContract},
+			FromBlock: blockNumber,
+			ToBlock:   blockNumber,
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not get deposit logs")
+		}
+		for _, depositLog := range logs {
+			pubkey, withdrawalCredentials, amount, signature, merkleTreeIndex, err := contracts.UnpackDepositLogData(depositLog.Data)
+			if err != nil {
+				return errors.Wrap(err, "could not unpack log")
+			}
+			index := binary.LittleEndian.Uint64(merkleTreeIndex)
+			if index < snapshot.DepositCount {
+				continue
+			}
+			if index != uint64(depositTrie.NumOfItems()) {
+				return errors.Errorf("received incorrect merkle index: wanted %d but got %d", depositTrie.NumOfItems(), index)
+			}
+			depositData := &ethpb.Deposit_Data{
+				Amount:                bytesutil.FromBytes8(amount),
+				PublicKey:             pubkey,
+				Signature:             signature,
+				WithdrawalCredentials: withdrawalCredentials,
+			}
+			depositHash, err := depositData.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "unable to determine hashed value of deposit")
+			}
+			depositTrie.Insert(depositHash[:], int(index))
+		}
+		if uint64(depositTrie.NumOfItems()) != count {
+			return errors.Errorf("received %d deposits up to block %d, but the deposit contract has %d", depositTrie.NumOfItems(), snapshot.ExecutionBlockHeight, count)
+		}
+	}
+	if depositTrie.HashTreeRoot() != root {
+		return errors.Wrapf(errDepositSnapshotMismatch, "deposit contract has root %#x at block %d, but the snapshot has root %#x", root, snapshot.ExecutionBlockHeight, depositTrie.HashTreeRoot())
+	}
+	return nil
+}
+
+// applyDepositSnapshot starts the deposit trie and cache from a snapshot of finalized deposits,
+// and resumes processing deposit logs from the block of the last deposit in the snapshot.
+func (s *Service) applyDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	if err := checkDepositSnapshot(s.chainStartData, snapshot); err != nil {
+		return err
+	}
+	depositTrie, err := trieutil.CreateTrieFromSnapshot(snapshot.Finalized, snapshot.DepositCount, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	if err := s.cfg.DepositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	s.depositTrie = depositTrie
+	s.depositSnapshot = snapshot
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1
+	// Logs are requested from the last requested block onwards, so later deposits in the block of
+	// the last deposit of the snapshot are not missed.
+	if s.latestEth1Data.LastRequestedBlock < snapshot.ExecutionBlockHeight {
+		s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionBlockHeight
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"depositRoot":  hexutil.Encode(snapshot.DepositRoot),
+		"blockHeight":  snapshot.ExecutionBlockHeight,
+	}).Info("Starting from deposit snapshot")
+	return s.savePowchainData(ctx)
+}
+
 // processBlockHeader adds a newly observed eth1 block to the block cache and
 // updates the latest blockHeight, blockHash, and blockTime properties of the service.
 func (s *Service) processBlockHeader(header *gethTypes.Header) {
@@ -756,6 +941,21 @@ func (s *Service) initPOWService() {
 			s.latestEth1Data.BlockHash = header.Hash().Bytes()
 			s.latestEth1Data.BlockTime = header.Time
 
+			if s.pendingDepositSnapshot != nil {
+				if err := s.verifyDepositSnapshot(ctx, s.pendingDepositSnapshot); err != nil {
+					if errors.Is(err, errDepositSnapshotMismatch) {
+						log.WithError(err).Fatal("Could not start from deposit snapshot")
+					}
+					log.Errorf("Unable to verify deposit snapshot: %v", err)
+					s.retryETH1Node(err)
+					continue
+				}
+				if err := s.applyDepositSnapshot(ctx, s.pendingDepositSnapshot); err != nil {
+					log.WithError(err).Fatal("Could not start from deposit snapshot")
+				}
+				s.pendingDepositSnapshot = nil
+			}
+
 			if err := s.processPastLogs(ctx); err != nil {
 				log.Errorf("Unable to process past logs %v", err)
 				s.retryETH1Node(err)
@@ -792,6 +992,10 @@ func (s *Service) run(done <-chan struct{}) {
 
 	s.initPOWService()
 
+	if len(s.httpEndpoints) > 1 {
+		go s.checkEndpointsRoutine(done)
+	}
+
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
 
@@ -817,7 +1021,7 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
-			s.checkDefaultEndpoint()
+			s.requestEndpointCheck(s.ctx)
 		case <-chainstartTicker.C:
 			if s.chainStartData.Chainstarted {
 				chainstartTicker.Stop()
@@ -904,42 +1108,12 @@ func (s *Service) determineEarliestVotingBlock(ctx context.Context, followBlock
 	return hdr.Number.Uint64(), nil
 }
 
-// This performs a health check on our primary endpoint, and if it
-// is ready to serve we connect to it again. This method is only
-// relevant if we are on our backup endpoint.
-func (s *Service) checkDefaultEndpoint() {
-	primaryEndpoint := s.httpEndpoints[0]
-	// Return early if we are running on our primary
-	// endpoint.
-	if s.currHttpEndpoint.Equals(primaryEndpoint) {
-		return
-	}
-
-	httpClient, rpcClient, err := s.dialETH1Nodes(primaryEndpoint)
-	if err != nil {
-		log.Debugf("Primary endpoint not ready: %v", err)
-		return
-	}
-	log.Info("Primary endpoint ready again, switching back to it")
-	// Close the clients and let our main connection routine
-	// properly connect with it.
-	httpClient.Close()
-	rpcClient.Close()
-	// Close current active clients.
-	s.closeClients()
-
-	// Switch back to primary endpoint and try connecting
-	// to it again.
-	s.updateCurrHttpEndpoint(primaryEndpoint)
-	s.retryETH1Node(nil)
-}
-
 // This is an inefficient way to search for the next endpoint, but given N is expected to be
-// small ( < 25), it is fine to search this way.
+// small ( < 25), it is fine to search this way. The best scoring endpoint is chosen, preferring
+// the endpoints right after the current one.
 func (s *Service) fallbackToNextEndpoint() {
 	currEndpoint := s.currHttpEndpoint
 	currIndex := 0
-	totalEndpoints := len(s.httpEndpoints)
 
 	for i, endpoint := range s.httpEndpoints {
 		if endpoint.Equals(currEndpoint) {
@@ -947,10 +1121,7 @@ func (s *Service) fallbackToNextEndpoint() {
 			break
 		}
 	}
-	nextIndex := currIndex + 1
-	if nextIndex >= totalEndpoints {
-		nextIndex = 0
-	}
+	nextIndex := s.nextEndpointIndex(currIndex)
 	s.updateCurrHttpEndpoint(s.httpEndpoints[nextIndex])
 	if nextIndex != currIndex {
 		log.Infof("Falling back to alternative endpoint: %s", logutil.MaskCredentialsLogging(s.currHttpEndpoint.Url))
@@ -977,6 +1148,12 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if eth1DataInDB.DepositSnapshot != nil {
+		if err := s.cfg.DepositCache.InsertDepositSnapshot(ctx, eth1DataInDB.DepositSnapshot); err != nil {
+			return errors.Wrap(err, "could not insert deposit snapshot")
+		}
+		s.depositSnapshot = eth1DataInDB.DepositSnapshot
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
@@ -984,8 +1161,8 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 }
 
 // validates that all deposit containers are valid and have their relevant indices
-// in order.
-func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bool {
+// in order, starting from startIndex.
+func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer, startIndex int64) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -995,7 +1172,6 @@ func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bo
 	sort.Slice(ctrs, func(i, j int) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
-	startIndex := int64(0)
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -1021,7 +1197,7 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !s.validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !s.validateDepositContainers(eth1Data.DepositContainers, firstDepositIndex(eth1Data)) {
 		pbState, err := v1.ProtobufBeaconState(s.preGenesisState.InnerStateUnsafe())
 		if err != nil {
 			return err
@@ -1045,6 +1221,15 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	return nil
 }
 
+// firstDepositIndex returns the index of the first deposit container saved with the eth1 data,
+// which is the deposit count of the deposit snapshot the node was started from, if any.
+func firstDepositIndex(eth1Data *protodb.ETH1ChainData) int64 {
+	if eth1Data.DepositSnapshot == nil {
+		return 0
+	}
+	return int64(eth1Data.DepositSnapshot.DepositCount)
+}
+
 func dedupEndpoints(endpoints []string) []string {
 	selectionMap := make(map[string]bool)
 	newEndpoints := make([]string, 0, len(endpoints))
@@ -17,10 +17,13 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine"
+	mockExecution "github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine/testing"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/clientstats"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/httputils"
@@ -463,6 +466,138 @@ func TestInitDepositCache_OK(t *testing.T) {
 	require.Equal(t, 3, len(s.cfg.DepositCache.PendingContainers(context.Background(), nil)))
 }
 
+func TestApplyDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	// Build a snapshot from a cache holding the first 5 deposits, with deposit i in block 20+i.
+	full, err := depositcache.New()
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                32,
+				Signature:             make([]byte, 96),
+			},
+		}
+		require.NoError(t, full.InsertDeposit(ctx, deposit, uint64(20+i), int64(i), [32]byte{}))
+	}
+	full.InsertFinalizedDeposits(ctx, 4)
+	snapshot, err := full.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+
+	beaconDB := dbutil.SetupDB(t)
+	newService := func() *Service {
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		dc, err := depositcache.New()
+		require.NoError(t, err)
+		return &Service{
+			chainStartData:          &protodb.ChainStartData{Chainstarted: true},
+			latestEth1Data:          &protodb.LatestETH1Data{},
+			preGenesisState:         st,
+			lastReceivedMerkleIndex: -1,
+			cfg:                     &Web3ServiceConfig{BeaconDB: beaconDB, DepositCache: dc},
+		}
+	}
+
+	s := newService()
+	s.chainStartData.Chainstarted = false
+	require.ErrorContains(t, "genesis state is known", s.applyDepositSnapshot(ctx, snapshot))
+
+	s = newService()
+	require.NoError(t, s.applyDepositSnapshot(ctx, snapshot))
+	root := full.FinalizedDeposits(ctx).Deposits.HashTreeRoot()
+	assert.Equal(t, root, s.depositTrie.HashTreeRoot())
+	assert.Equal(t, int64(4), s.lastReceivedMerkleIndex)
+	assert.Equal(t, uint64(24), s.latestEth1Data.LastRequestedBlock)
+	assert.Equal(t, root, s.cfg.DepositCache.FinalizedDeposits(ctx).Deposits.HashTreeRoot())
+
+	// The snapshot is restored from the saved eth1 data after a restart.
+	eth1Data, err := s.cfg.BeaconDB.PowchainData(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, eth1Data.DepositSnapshot)
+	assert.Equal(t, true, s.validateDepositContainers(eth1Data.DepositContainers, firstDepositIndex(eth1Data)))
+	restarted := newService()
+	require.NoError(t, restarted.initializeEth1Data(ctx, eth1Data))
+	assert.Equal(t, root, restarted.depositTrie.HashTreeRoot())
+	assert.Equal(t, int64(4), restarted.lastReceivedMerkleIndex)
+	assert.Equal(t, root, restarted.cfg.DepositCache.FinalizedDeposits(ctx).Deposits.HashTreeRoot())
+	count, depositRoot := restarted.cfg.DepositCache.DepositsNumberAndRootAtHeight(ctx, big.NewInt(30))
+	assert.Equal(t, uint64(5), count)
+	assert.Equal(t, root, depositRoot)
+}
+
+func TestVerifyDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	testAcc, err := contracts.Setup()
+	require.NoError(t, err, "Unable to set up simulated backend")
+	depositContractCaller, err := contracts.NewDepositContractCaller(testAcc.ContractAddr, testAcc.Backend)
+	require.NoError(t, err)
+	s := &Service{
+		cfg:                   &Web3ServiceConfig{DepositContract: testAcc.ContractAddr},
+		httpLogger:            testAcc.Backend,
+		depositContractCaller: depositContractCaller,
+	}
+
+	// Deposits 0 and 1 are in a first block, and deposits 2 to 4 in the latest block.
+	cache, err := depositcache.New()
+	require.NoError(t, err)
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	for i := 0; i < 6; i++ {
+		data := &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+			Signature:             make([]byte, 96),
+		}
+		root, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, cache.InsertDeposit(ctx, &ethpb.Deposit{Data: data}, uint64(i), int64(i), [32]byte{}))
+		if i == 5 {
+			// The last deposit is only known to the cache.
+			break
+		}
+		_, err = testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, root)
+		require.NoError(t, err, "Could not deposit to deposit contract")
+		if i == 1 {
+			testAcc.Backend.Commit()
+		}
+	}
+	testAcc.Backend.Commit()
+	head, err := testAcc.Backend.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+
+	snapshotOf := func(count int64) *ethpb.DepositSnapshot {
+		finalized, err := depositcache.New()
+		require.NoError(t, err)
+		for _, d := range cache.AllDepositContainers(ctx)[:count] {
+			require.NoError(t, finalized.InsertDeposit(ctx, d.Deposit, d.Eth1BlockHeight, d.Index, [32]byte{}))
+		}
+		finalized.InsertFinalizedDeposits(ctx, count-1)
+		snapshot, err := finalized.DepositSnapshot(ctx)
+		require.NoError(t, err)
+		snapshot.ExecutionBlockHeight = head.Number.Uint64()
+		return snapshot
+	}
+
+	// The contract also holds the deposit after the snapshot in the block of its last deposit.
+	require.NoError(t, s.verifyDepositSnapshot(ctx, snapshotOf(4)))
+	require.NoError(t, s.verifyDepositSnapshot(ctx, snapshotOf(5)))
+
+	err = s.verifyDepositSnapshot(ctx, snapshotOf(6))
+	assert.ErrorContains(t, "deposit contract has 5 deposits", err)
+	assert.Equal(t, true, errors.Is(err, errDepositSnapshotMismatch))
+
+	other := snapshotOf(4)
+	other.Finalized[0] = bytesutil.PadTo([]byte("other"), 32)
+	err = s.verifyDepositSnapshot(ctx, other)
+	assert.ErrorContains(t, "but the snapshot has root", err)
+	assert.Equal(t, true, errors.Is(err, errDepositSnapshotMismatch))
+}
+
 func TestNewService_EarliestVotingBlock(t *testing.T) {
 	testAcc, err := contracts.Setup()
 	require.NoError(t, err, "Unable to set up simulated backend")
@@ -536,6 +671,39 @@ func TestNewService_Eth1HeaderRequLimit(t *testing.T) {
 	assert.Equal(t, uint64(150), s2.cfg.Eth1HeaderReqLimit, "unable to set eth1HeaderRequestLimit")
 }
 
+func TestNewService_ExecutionEngine(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	require.NoError(t, err, "Unable to set up simulated backend")
+	beaconDB := dbutil.SetupDB(t)
+
+	s1, err := NewService(context.Background(), &Web3ServiceConfig{
+		HttpEndpoints:   []string{endpoint},
+		DepositContract: testAcc.ContractAddr,
+		BeaconDB:        beaconDB,
+	})
+	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
+	assert.Equal(t, nil, s1.ExecutionEngineCaller(), "execution engine caller set without endpoint")
+
+	secret := bytesutil.PadTo([]byte("secret"), engine.JWTSecretLength)
+	mockEngine, err := mockExecution.NewMockEngine(secret)
+	require.NoError(t, err)
+	defer mockEngine.Close()
+	s2, err := NewService(context.Background(), &Web3ServiceConfig{
+		HttpEndpoints:           []string{endpoint},
+		DepositContract:         testAcc.ContractAddr,
+		BeaconDB:                beaconDB,
+		ExecutionEngineEndpoint: mockEngine.Endpoint(),
+		ExecutionJWTSecret:      secret,
+	})
+	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
+	caller := s2.ExecutionEngineCaller()
+	require.NotNil(t, caller)
+	resp, err := caller.ForkchoiceUpdated(context.Background(), &engine.ForkchoiceState{HeadBlockHash: mockEngine.GenesisHash()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, engine.StatusValid, resp.PayloadStatus.Status)
+	require.NoError(t, s2.Stop())
+}
+
 type mockBSUpdater struct {
 	lastBS clientstats.BeaconNodeStats
 }
@@ -752,7 +920,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, s1.validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, s1.validateDepositContainers(test.ctrsFunc(), 0))
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -24,6 +25,11 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// attestationProvenanceCacheSize is the number of attestation data roots for which the
+// provenance of the first received attestation is kept, about two epochs worth of
+// committees on mainnet.
+const attestationProvenanceCacheSize = 4096
+
 // sortableAttestations implements the Sort interface to sort attestations
 // by slot as the canonical sorting attribute.
 type sortableAttestations []*ethpb.Attestation
@@ -237,11 +243,41 @@ func (bs *Server) StreamAttestations(
 // sends them over a gRPC stream.
 func (bs *Server) StreamIndexedAttestations(
 	_ *emptypb.Empty, stream ethpb.BeaconChain_StreamIndexedAttestationsServer,
+) error {
+	return bs.streamIndexedAttestations(stream.Context(), nil /* provenances */, func(att *ethpb.IndexedAttestation, _ *ethpb.GossipProvenance) error {
+		return stream.Send(att)
+	})
+}
+
+// StreamIndexedAttestationsWithProvenance streams indexed attestations like StreamIndexedAttestations,
+// along with the gossip topic and peer the first received attestation of each aggregate came from.
+func (bs *Server) StreamIndexedAttestationsWithProvenance(
+	_ *emptypb.Empty, stream ethpb.BeaconChain_StreamIndexedAttestationsWithProvenanceServer,
+) error {
+	provenances, err := lru.New(attestationProvenanceCacheSize)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not create provenance cache: %v", err)
+	}
+	return bs.streamIndexedAttestations(stream.Context(), provenances, func(att *ethpb.IndexedAttestation, provenance *ethpb.GossipProvenance) error {
+		return stream.Send(&ethpb.IndexedAttestationWithProvenance{
+			Attestation: att,
+			Provenance:  provenance,
+		})
+	})
+}
+
+// Sends received attestations in indexed form at the end of every slot. If a provenance
+// cache is given, the gossip provenance of the first received attestation of every
+// attestation data root is recorded in it and sent along with the aggregate of that data.
+func (bs *Server) streamIndexedAttestations(
+	ctx context.Context,
+	provenances *lru.Cache,
+	send func(att *ethpb.IndexedAttestation, provenance *ethpb.GossipProvenance) error,
 ) error {
 	attestationsChannel := make(chan *feed.Event, 1)
 	attSub := bs.AttestationNotifier.OperationFeed().Subscribe(attestationsChannel)
 	defer attSub.Unsubscribe()
-	go bs.collectReceivedAttestations(stream.Context())
+	go bs.collectReceivedAttestations(ctx)
 	for {
 		select {
 		case event, ok := <-attestationsChannel:
@@ -261,6 +297,7 @@ func (bs *Server) StreamIndexedAttestations(
 					log.Debug("Indexed attestations stream got a nil attestation")
 					continue
 				}
+				recordAttestationProvenance(provenances, data.Attestation, data.Provenance)
 				bs.ReceivedAttestationsBuffer <- data.Attestation
 			} else if event.Type == operation.AggregatedAttReceived {
 				data, ok := event.Data.(*operation.AggregatedAttReceivedData)
@@ -274,6 +311,7 @@ func (bs *Server) StreamIndexedAttestations(
 					log.Debug("Indexed attestations stream got nil attestation or nil attestation aggregate")
 					continue
 				}
+				recordAttestationProvenance(provenances, data.Attestation.Aggregate, data.Provenance)
 				bs.ReceivedAttestationsBuffer <- data.Attestation.Aggregate
 			}
 		case aggAtts, ok := <-bs.CollectedAttestationsBuffer:
@@ -290,7 +328,7 @@ func (bs *Server) StreamIndexedAttestations(
 			// form.
 			targetRoot := aggAtts[0].Data.Target.Root
 			targetEpoch := aggAtts[0].Data.Target.Epoch
-			committeesBySlot, _, err := bs.retrieveCommitteesForRoot(stream.Context(), targetRoot)
+			committeesBySlot, _, err := bs.retrieveCommitteesForRoot(ctx, targetRoot)
 			if err != nil {
 				return status.Errorf(
 					codes.Internal,
@@ -320,22 +358,53 @@ func (bs *Server) StreamIndexedAttestations(
 					continue
 				}
 				committee := committeesForSlot.Committees[att.Data.CommitteeIndex]
-				idxAtt, err := attestationutil.ConvertToIndexed(stream.Context(), att, committee.ValidatorIndices)
+				idxAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee.ValidatorIndices)
 				if err != nil {
 					continue
 				}
-				if err := stream.Send(idxAtt); err != nil {
+				if err := send(idxAtt, attestationProvenance(provenances, att)); err != nil {
 					return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
 				}
 			}
 		case <-bs.Ctx.Done():
 			return status.Error(codes.Canceled, "Context canceled")
-		case <-stream.Context().Done():
+		case <-ctx.Done():
 			return status.Error(codes.Canceled, "Context canceled")
 		}
 	}
 }
 
+// Records where an attestation was received from under its data root, unless an
+// attestation with the same data was received before.
+func recordAttestationProvenance(provenances *lru.Cache, att *ethpb.Attestation, provenance *ethpb.GossipProvenance) {
+	if provenances == nil || provenance == nil {
+		return
+	}
+	root, err := att.Data.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Error("Could not hash tree root attestation data")
+		return
+	}
+	provenances.ContainsOrAdd(root, provenance)
+}
+
+// Returns where the first attestation with the same data as the given one was received
+// from, or nil if it is not known.
+func attestationProvenance(provenances *lru.Cache, att *ethpb.Attestation) *ethpb.GossipProvenance {
+	if provenances == nil {
+		return nil
+	}
+	root, err := att.Data.HashTreeRoot()
+	if err != nil {
+		return nil
+	}
+	provenance, ok := provenances.Get(root)
+	if !ok {
+		return nil
+	}
+	return provenance.(*ethpb.GossipProvenance)
+}
+
 // already being done by the attestation pool in the operations service.
 func (bs *Server) collectReceivedAttestations(ctx context.Context) {
 	attsByRoot := make(map[[32]byte][]*ethpb.Attestation)
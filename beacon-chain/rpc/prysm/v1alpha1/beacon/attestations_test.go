@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	lru "github.com/hashicorp/golang-lru"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
@@ -1052,3 +1053,22 @@ func TestServer_StreamAttestations_OnSlotTick(t *testing.T) {
 	}
 	<-exitRoutine
 }
+
+func TestServer_AttestationProvenance_FirstReceivedWins(t *testing.T) {
+	provenances, err := lru.New(attestationProvenanceCacheSize)
+	require.NoError(t, err)
+	att := testutil.HydrateAttestation(&ethpb.Attestation{})
+	sameData := testutil.HydrateAttestation(&ethpb.Attestation{Signature: bytesutil.PadTo([]byte{1}, 96)})
+	otherData := testutil.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}})
+	first := &ethpb.GossipProvenance{Topic: "/eth2/00000000/beacon_attestation_1/ssz_snappy", PeerId: "peer1"}
+	second := &ethpb.GossipProvenance{Topic: "/eth2/00000000/beacon_aggregate_and_proof/ssz_snappy", PeerId: "peer2"}
+
+	recordAttestationProvenance(provenances, att, first)
+	recordAttestationProvenance(provenances, sameData, second)
+	assert.DeepEqual(t, first, attestationProvenance(provenances, sameData))
+	assert.Equal(t, (*ethpb.GossipProvenance)(nil), attestationProvenance(provenances, otherData))
+
+	// Without a cache, as for streams which do not send provenance, nothing is recorded.
+	recordAttestationProvenance(nil, otherData, second)
+	assert.Equal(t, (*ethpb.GossipProvenance)(nil), attestationProvenance(nil, otherData))
+}
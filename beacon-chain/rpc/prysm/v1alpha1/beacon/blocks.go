@@ -258,6 +258,31 @@ func (bs *Server) GetChainHead(ctx context.Context, _ *emptypb.Empty) (*ethpb.Ch
 
 // StreamBlocks to clients every single time a block is received by the beacon node.
 func (bs *Server) StreamBlocks(req *ethpb.StreamBlocksRequest, stream ethpb.BeaconChain_StreamBlocksServer) error {
+	return bs.streamBlocks(stream.Context(), req, func(blk *ethpb.SignedBeaconBlock, _ *ethpb.GossipProvenance) error {
+		return stream.Send(blk)
+	})
+}
+
+// StreamBlocksWithProvenance streams blocks like StreamBlocks, along with the gossip topic and
+// peer the beacon node received them from. Verified blocks are streamed without provenance.
+func (bs *Server) StreamBlocksWithProvenance(
+	req *ethpb.StreamBlocksRequest, stream ethpb.BeaconChain_StreamBlocksWithProvenanceServer,
+) error {
+	return bs.streamBlocks(stream.Context(), req, func(blk *ethpb.SignedBeaconBlock, provenance *ethpb.GossipProvenance) error {
+		return stream.Send(&ethpb.SignedBeaconBlockWithProvenance{
+			Block:      blk,
+			Provenance: provenance,
+		})
+	})
+}
+
+// Sends blocks as they are received by the beacon node, or once they are processed
+// if only verified blocks are requested.
+func (bs *Server) streamBlocks(
+	ctx context.Context,
+	req *ethpb.StreamBlocksRequest,
+	send func(blk *ethpb.SignedBeaconBlock, provenance *ethpb.GossipProvenance) error,
+) error {
 	blocksChannel := make(chan *feed.Event, 1)
 	var blockSub event.Subscription
 	if req.VerifiedOnly {
@@ -281,7 +306,7 @@ func (bs *Server) StreamBlocks(req *ethpb.StreamBlocksRequest, stream ethpb.Beac
 						log.Error(err)
 						continue
 					}
-					if err := stream.Send(phBlk); err != nil {
+					if err := send(phBlk, nil /* provenance */); err != nil {
 						return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
 					}
 				}
@@ -311,7 +336,7 @@ func (bs *Server) StreamBlocks(req *ethpb.StreamBlocksRequest, stream ethpb.Beac
 						log.Error(err)
 						continue
 					}
-					if err := stream.Send(phBlk); err != nil {
+					if err := send(phBlk, data.Provenance); err != nil {
 						return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
 					}
 				}
@@ -320,7 +345,7 @@ func (bs *Server) StreamBlocks(req *ethpb.StreamBlocksRequest, stream ethpb.Beac
 			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
 		case <-bs.Ctx.Done():
 			return status.Error(codes.Canceled, "Context canceled")
-		case <-stream.Context().Done():
+		case <-ctx.Done():
 			return status.Error(codes.Canceled, "Context canceled")
 		}
 	}
@@ -471,3 +496,19 @@ func (bs *Server) GetWeakSubjectivityCheckpoint(ctx context.Context, _ *emptypb.
 		Epoch:     wsEpoch,
 	}, nil
 }
+
+// GetDepositSnapshot retrieves the snapshot of the finalized part of the deposit tree, which a new
+// node can start from instead of processing every deposit log.
+func (bs *Server) GetDepositSnapshot(ctx context.Context, _ *emptypb.Empty) (*ethpb.DepositSnapshot, error) {
+	if bs.DepositFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Deposit cache is not available")
+	}
+	snapshot, err := bs.DepositFetcher.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get deposit snapshot: %v", err)
+	}
+	if snapshot == nil {
+		return nil, status.Error(codes.NotFound, "No finalized deposits")
+	}
+	return snapshot, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	types "github.com/prysmaticlabs/eth2-types"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
@@ -637,6 +638,44 @@ func TestServer_StreamBlocks_OnHeadUpdated(t *testing.T) {
 	<-exitRoutine
 }
 
+func TestServer_StreamBlocksWithProvenance_OnHeadUpdated(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privs := testutil.DeterministicGenesisState(t, 32)
+	b, err := testutil.GenerateFullBlock(beaconState, privs, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	chainService := &chainMock.ChainService{State: beaconState}
+	server := &Server{
+		Ctx:           ctx,
+		BlockNotifier: chainService.BlockNotifier(),
+		HeadFetcher:   chainService,
+	}
+	provenance := &ethpb.GossipProvenance{Topic: "/eth2/00000000/beacon_block/ssz_snappy", PeerId: "peer1"}
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChain_StreamBlocksWithProvenanceServer(ctrl)
+	mockStream.EXPECT().Send(&ethpb.SignedBeaconBlockWithProvenance{Block: b, Provenance: provenance}).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamBlocksWithProvenance(&ethpb.StreamBlocksRequest{}, mockStream), "Could not call RPC method")
+	}(t)
+
+	// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+	for sent := 0; sent == 0; {
+		sent = server.BlockNotifier.BlockFeed().Send(&feed.Event{
+			Type: blockfeed.ReceivedBlock,
+			Data: &blockfeed.ReceivedBlockData{
+				SignedBlock: wrapper.WrappedPhase0SignedBeaconBlock(b),
+				Provenance:  provenance,
+			},
+		})
+	}
+	<-exitRoutine
+}
+
 func TestServer_StreamBlocksVerified_OnHeadUpdated(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	ctx := context.Background()
@@ -747,3 +786,33 @@ func TestServer_GetWeakSubjectivityCheckpoint(t *testing.T) {
 	require.NoError(t, err)
 	require.DeepEqual(t, sRoot[:], c.StateRoot)
 }
+
+func TestServer_GetDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dc, err := depositcache.New()
+	require.NoError(t, err)
+	bs := &Server{DepositFetcher: dc}
+
+	_, err = bs.GetDepositSnapshot(ctx, &emptypb.Empty{})
+	assert.ErrorContains(t, "No finalized deposits", err)
+
+	for i := 0; i < 4; i++ {
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                32,
+				Signature:             make([]byte, 96),
+			},
+		}
+		require.NoError(t, dc.InsertDeposit(ctx, deposit, uint64(100+i), int64(i), [32]byte{}))
+	}
+	dc.InsertFinalizedDeposits(ctx, 2)
+
+	snapshot, err := bs.GetDepositSnapshot(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), snapshot.DepositCount)
+	assert.Equal(t, uint64(102), snapshot.ExecutionBlockHeight)
+	root := dc.FinalizedDeposits(ctx).Deposits.HashTreeRoot()
+	assert.DeepEqual(t, root[:], snapshot.DepositRoot)
+}
@@ -0,0 +1,101 @@
+package debug
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGossipScores returns the gossipsub score of every connected peer, broken down by the
+// scoring components of each topic, along with the thresholds the scores are checked against.
+func (ds *Server) GetGossipScores(_ context.Context, _ *empty.Empty) (*pbrpc.DebugGossipScoresResponse, error) {
+	if ds.GossipScoringProvider == nil {
+		return nil, status.Error(codes.Unavailable, "Gossip scoring parameters are not available")
+	}
+	thresholds := ds.GossipScoringProvider.PeerScoreThresholds()
+	if thresholds == nil {
+		return nil, status.Error(codes.Unavailable, "Gossip scoring thresholds are not available")
+	}
+	topicParams := ds.GossipScoringProvider.TopicScoreParamsByTopic()
+
+	peers := ds.PeersFetcher.Peers()
+	var scores []*pbrpc.DebugPeerGossipScore
+	for _, pid := range peers.Connected() {
+		// A peer which disconnected in the meantime has no score data, and is reported with a zero score.
+		gScore, bPenalty, topicSnapshots, err := peers.Scorers().GossipScorer().GossipData(pid)
+		if err != nil {
+			gScore, bPenalty, topicSnapshots = 0, 0, nil
+		}
+		topics := make([]*pbrpc.DebugTopicScore, 0, len(topicSnapshots))
+		for topic, snap := range topicSnapshots {
+			topics = append(topics, topicScoreBreakdown(topic, topicParams[topic], snap))
+		}
+		sort.Slice(topics, func(i, j int) bool {
+			return topics[i].Topic < topics[j].Topic
+		})
+		scores = append(scores, &pbrpc.DebugPeerGossipScore{
+			PeerId:           pid.String(),
+			Score:            float32(gScore),
+			BehaviourPenalty: float32(bPenalty),
+			Graylisted:       gScore < thresholds.GraylistThreshold,
+			Topics:           topics,
+		})
+	}
+	return &pbrpc.DebugGossipScoresResponse{
+		Thresholds: &pbrpc.GossipScoreThresholds{
+			GossipThreshold:             float32(thresholds.GossipThreshold),
+			PublishThreshold:            float32(thresholds.PublishThreshold),
+			GraylistThreshold:           float32(thresholds.GraylistThreshold),
+			AcceptPxThreshold:           float32(thresholds.AcceptPXThreshold),
+			OpportunisticGraftThreshold: float32(thresholds.OpportunisticGraftThreshold),
+		},
+		Peers: scores,
+	}, nil
+}
+
+// topicScoreBreakdown computes the score components of a topic in the same manner as gossipsub,
+// using the counters of the last score snapshot. The mesh failure penalty is not part of the
+// snapshot, so the components may not add up exactly to the score computed by gossipsub.
+func topicScoreBreakdown(topic string, p *pubsub.TopicScoreParams, snap *pbrpc.TopicScoreSnapshot) *pbrpc.DebugTopicScore {
+	breakdown := &pbrpc.DebugTopicScore{Topic: topic}
+	if p == nil || snap == nil {
+		return breakdown
+	}
+	timeInMesh := time.Duration(snap.TimeInMesh) * time.Millisecond
+
+	var p1 float64
+	if p.TimeInMeshQuantum > 0 {
+		p1 = float64(timeInMesh / p.TimeInMeshQuantum)
+		if p1 > p.TimeInMeshCap {
+			p1 = p.TimeInMeshCap
+		}
+	}
+	p1 *= p.TimeInMeshWeight
+
+	p2 := float64(snap.FirstMessageDeliveries) * p.FirstMessageDeliveriesWeight
+
+	// Mesh message deliveries are only scored once the peer has been in the mesh for the activation period.
+	var p3 float64
+	meshDeliveries := float64(snap.MeshMessageDeliveries)
+	if timeInMesh >= p.MeshMessageDeliveriesActivation && meshDeliveries < p.MeshMessageDeliveriesThreshold {
+		deficit := p.MeshMessageDeliveriesThreshold - meshDeliveries
+		p3 = deficit * deficit * p.MeshMessageDeliveriesWeight
+	}
+
+	invalid := float64(snap.InvalidMessageDeliveries)
+	p4 := invalid * invalid * p.InvalidMessageDeliveriesWeight
+
+	breakdown.TopicWeight = float32(p.TopicWeight)
+	breakdown.TimeInMesh = float32(p1)
+	breakdown.FirstMessageDeliveries = float32(p2)
+	breakdown.MeshDeliveryDeficit = float32(p3)
+	breakdown.InvalidMessages = float32(p4)
+	breakdown.Total = float32((p1 + p2 + p3 + p4) * p.TopicWeight)
+	return breakdown
+}
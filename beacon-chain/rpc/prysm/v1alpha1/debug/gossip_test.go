@@ -0,0 +1,110 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockGossipScoringProvider struct {
+	topics     map[string]*pubsub.TopicScoreParams
+	thresholds *pubsub.PeerScoreThresholds
+}
+
+func (m *mockGossipScoringProvider) TopicScoreParamsByTopic() map[string]*pubsub.TopicScoreParams {
+	return m.topics
+}
+
+func (m *mockGossipScoringProvider) PeerScoreThresholds() *pubsub.PeerScoreThresholds {
+	return m.thresholds
+}
+
+func TestDebugServer_GetGossipScores(t *testing.T) {
+	const topic = "/eth2/b5303f2a/beacon_block/ssz_snappy"
+	peersProvider := &mockP2p.MockPeersProvider{}
+	connected := peersProvider.Peers().Connected()
+	require.Equal(t, 2, len(connected))
+	scorer := peersProvider.Peers().Scorers().GossipScorer()
+	scorer.SetGossipData(connected[0], 10, 0, map[string]*pbrpc.TopicScoreSnapshot{
+		topic: {TimeInMesh: uint64((2 * time.Minute).Milliseconds()), FirstMessageDeliveries: 4, MeshMessageDeliveries: 1},
+	})
+	scorer.SetGossipData(connected[1], -20000, 3, map[string]*pbrpc.TopicScoreSnapshot{
+		topic: {TimeInMesh: uint64((2 * time.Minute).Milliseconds()), MeshMessageDeliveries: 2, InvalidMessageDeliveries: 2},
+	})
+
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		GossipScoringProvider: &mockGossipScoringProvider{
+			topics: map[string]*pubsub.TopicScoreParams{
+				topic: {
+					TopicWeight:                     0.5,
+					TimeInMeshWeight:                1,
+					TimeInMeshQuantum:               time.Minute,
+					TimeInMeshCap:                   10,
+					FirstMessageDeliveriesWeight:    2,
+					MeshMessageDeliveriesWeight:     -1,
+					MeshMessageDeliveriesThreshold:  4,
+					MeshMessageDeliveriesActivation: time.Minute,
+					InvalidMessageDeliveriesWeight:  -10,
+				},
+			},
+			thresholds: &pubsub.PeerScoreThresholds{GraylistThreshold: -16000},
+		},
+	}
+	res, err := ds.GetGossipScores(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, float32(-16000), res.Thresholds.GraylistThreshold)
+	require.Equal(t, 2, len(res.Peers))
+
+	good, bad := res.Peers[0], res.Peers[1]
+	if good.PeerId != connected[0].String() {
+		good, bad = bad, good
+	}
+	assert.Equal(t, false, good.Graylisted)
+	require.Equal(t, 1, len(good.Topics))
+	assert.Equal(t, float32(2), good.Topics[0].TimeInMesh)
+	assert.Equal(t, float32(8), good.Topics[0].FirstMessageDeliveries)
+	assert.Equal(t, float32(-9), good.Topics[0].MeshDeliveryDeficit)
+	assert.Equal(t, float32(0.5), good.Topics[0].Total)
+
+	assert.Equal(t, true, bad.Graylisted)
+	require.Equal(t, 1, len(bad.Topics))
+	assert.Equal(t, float32(-40), bad.Topics[0].InvalidMessages)
+	assert.Equal(t, float32(-4), bad.Topics[0].MeshDeliveryDeficit)
+	assert.Equal(t, float32(-21), bad.Topics[0].Total)
+}
+
+func TestDebugServer_GetGossipScores_UnscoredTopic(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	pid := peersProvider.Peers().Connected()[0]
+	peersProvider.Peers().Scorers().GossipScorer().SetGossipData(pid, 0, 0, map[string]*pbrpc.TopicScoreSnapshot{
+		"unscored": {FirstMessageDeliveries: 4},
+	})
+	ds := &Server{
+		PeersFetcher:          peersProvider,
+		GossipScoringProvider: &mockGossipScoringProvider{thresholds: &pubsub.PeerScoreThresholds{}},
+	}
+	res, err := ds.GetGossipScores(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	for _, p := range res.Peers {
+		if p.PeerId != pid.String() {
+			continue
+		}
+		require.Equal(t, 1, len(p.Topics))
+		assert.Equal(t, float32(0), p.Topics[0].TopicWeight)
+		assert.Equal(t, float32(0), p.Topics[0].Total)
+	}
+}
+
+func TestDebugServer_GetGossipScores_Unavailable(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.GetGossipScores(context.Background(), &empty.Empty{})
+	assert.ErrorContains(t, "Gossip scoring parameters are not available", err)
+}
@@ -0,0 +1,72 @@
+package debug
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPendingQueues returns the blocks and attestations held in the pending queues of the
+// sync service, along with the peers they were received from.
+func (ds *Server) GetPendingQueues(_ context.Context, _ *empty.Empty) (*pbrpc.DebugPendingQueuesResponse, error) {
+	if ds.PendingQueueFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Pending queues are not available")
+	}
+	counts := make(map[peer.ID]*pbrpc.DebugPendingPeerCount)
+	countFor := func(pid peer.ID) *pbrpc.DebugPendingPeerCount {
+		c, ok := counts[pid]
+		if !ok {
+			c = &pbrpc.DebugPendingPeerCount{PeerId: pid.String()}
+			counts[pid] = c
+		}
+		return c
+	}
+
+	pendingBlocks := ds.PendingQueueFetcher.PendingBlocks()
+	blocks := make([]*pbrpc.DebugPendingBlock, len(pendingBlocks))
+	for i, b := range pendingBlocks {
+		root, parentRoot := b.Root, b.ParentRoot
+		blocks[i] = &pbrpc.DebugPendingBlock{
+			Slot:       b.Slot,
+			BlockRoot:  root[:],
+			ParentRoot: parentRoot[:],
+			PeerId:     b.Peer.String(),
+		}
+		if b.Peer != "" {
+			countFor(b.Peer).Blocks++
+		}
+	}
+
+	pendingAtts := ds.PendingQueueFetcher.PendingAttestations()
+	atts := make([]*pbrpc.DebugPendingAttestation, len(pendingAtts))
+	for i, a := range pendingAtts {
+		root := a.BlockRoot
+		atts[i] = &pbrpc.DebugPendingAttestation{
+			Slot:            a.Slot,
+			BlockRoot:       root[:],
+			AggregatorIndex: a.AggregatorIndex,
+			PeerId:          a.Peer.String(),
+		}
+		if a.Peer != "" {
+			countFor(a.Peer).Attestations++
+		}
+	}
+
+	peerCounts := make([]*pbrpc.DebugPendingPeerCount, 0, len(counts))
+	for _, c := range counts {
+		peerCounts = append(peerCounts, c)
+	}
+	sort.Slice(peerCounts, func(i, j int) bool {
+		return peerCounts[i].PeerId < peerCounts[j].PeerId
+	})
+	return &pbrpc.DebugPendingQueuesResponse{
+		Blocks:       blocks,
+		Attestations: atts,
+		PeerCounts:   peerCounts,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockPendingQueueFetcher struct {
+	blocks []*sync.PendingBlock
+	atts   []*sync.PendingAttestation
+}
+
+func (m *mockPendingQueueFetcher) PendingBlocks() []*sync.PendingBlock {
+	return m.blocks
+}
+
+func (m *mockPendingQueueFetcher) PendingAttestations() []*sync.PendingAttestation {
+	return m.atts
+}
+
+func TestDebugServer_GetPendingQueues(t *testing.T) {
+	p1, p2 := peer.ID("a"), peer.ID("b")
+	ds := &Server{
+		PendingQueueFetcher: &mockPendingQueueFetcher{
+			blocks: []*sync.PendingBlock{
+				{Slot: 1, Root: [32]byte{'a'}, ParentRoot: [32]byte{'b'}, Peer: p1},
+				{Slot: 2, Root: [32]byte{'c'}, ParentRoot: [32]byte{'a'}, Peer: p2},
+			},
+			atts: []*sync.PendingAttestation{
+				{Slot: 1, BlockRoot: [32]byte{'d'}, AggregatorIndex: 3, Peer: p1},
+				{Slot: 1, BlockRoot: [32]byte{'d'}, AggregatorIndex: 4, Peer: p1},
+				{Slot: 1, BlockRoot: [32]byte{'d'}, AggregatorIndex: 5},
+			},
+		},
+	}
+
+	res, err := ds.GetPendingQueues(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Blocks))
+	require.Equal(t, 3, len(res.Attestations))
+	root := [32]byte{'c'}
+	assert.DeepEqual(t, root[:], res.Blocks[1].BlockRoot)
+	assert.Equal(t, p2.String(), res.Blocks[1].PeerId)
+	assert.Equal(t, uint64(4), uint64(res.Attestations[1].AggregatorIndex))
+
+	// The attestation without a known peer is not counted.
+	require.Equal(t, 2, len(res.PeerCounts))
+	assert.Equal(t, p1.String(), res.PeerCounts[0].PeerId)
+	assert.Equal(t, uint64(1), res.PeerCounts[0].Blocks)
+	assert.Equal(t, uint64(2), res.PeerCounts[0].Attestations)
+	assert.Equal(t, p2.String(), res.PeerCounts[1].PeerId)
+	assert.Equal(t, uint64(1), res.PeerCounts[1].Blocks)
+	assert.Equal(t, uint64(0), res.PeerCounts[1].Attestations)
+}
+
+func TestDebugServer_GetPendingQueues_Unavailable(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.GetPendingQueues(context.Background(), &empty.Empty{})
+	assert.ErrorContains(t, "Pending queues are not available", err)
+}
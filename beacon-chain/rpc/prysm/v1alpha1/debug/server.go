@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -24,12 +25,15 @@ import (
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
 type Server struct {
-	BeaconDB           db.NoHeadAccessDatabase
-	GenesisTimeFetcher blockchain.TimeFetcher
-	StateGen           *stategen.State
-	HeadFetcher        blockchain.HeadFetcher
-	PeerManager        p2p.PeerManager
-	PeersFetcher       p2p.PeersProvider
+	BeaconDB              db.NoHeadAccessDatabase
+	GenesisTimeFetcher    blockchain.TimeFetcher
+	StateGen              *stategen.State
+	HeadFetcher           blockchain.HeadFetcher
+	PeerManager           p2p.PeerManager
+	PeersFetcher          p2p.PeersProvider
+	PendingQueueFetcher   sync.PendingQueueFetcher
+	GossipScoringProvider p2p.GossipScoringProvider
+	SlasherDB             db.SlasherDatabase
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
@@ -0,0 +1,51 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSlasherStorage returns the disk space used by the slasher database, per bucket and
+// per validator chunk of the min and max span chunks, along with the chunk parameters.
+func (ds *Server) GetSlasherStorage(ctx context.Context, _ *empty.Empty) (*pbrpc.DebugSlasherStorageResponse, error) {
+	if ds.SlasherDB == nil {
+		return nil, status.Error(codes.Unavailable, "Slasher is not enabled")
+	}
+	report, err := slasher.Storage(ctx, ds.SlasherDB)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get slasher storage: %v", err)
+	}
+	buckets := make([]*pbrpc.SlasherBucketStorage, len(report.Database.Buckets))
+	for i, bkt := range report.Database.Buckets {
+		buckets[i] = &pbrpc.SlasherBucketStorage{
+			Name:           bkt.Name,
+			NumKeys:        bkt.NumKeys,
+			AllocatedBytes: bkt.AllocatedBytes,
+			UsedBytes:      bkt.UsedBytes,
+		}
+	}
+	validatorChunks := make([]*pbrpc.SlasherValidatorChunkStorage, len(report.ValidatorChunks))
+	for i, chunk := range report.ValidatorChunks {
+		validatorChunks[i] = &pbrpc.SlasherValidatorChunkStorage{
+			ValidatorChunkIndex: chunk.ValidatorChunkIndex,
+			MinSpanChunks:       chunk.MinSpanChunks,
+			MaxSpanChunks:       chunk.MaxSpanChunks,
+			MinSpanBytes:        chunk.MinSpanBytes,
+			MaxSpanBytes:        chunk.MaxSpanBytes,
+		}
+	}
+	return &pbrpc.DebugSlasherStorageResponse{
+		ChunkSize:          report.ChunkParameters.ChunkSize,
+		ValidatorChunkSize: report.ChunkParameters.ValidatorChunkSize,
+		HistoryLength:      report.ChunkParameters.HistoryLength,
+		FileBytes:          report.Database.FileBytes,
+		FreeBytes:          report.Database.FreeBytes,
+		Buckets:            buckets,
+		ValidatorChunks:    validatorChunks,
+	}, nil
+}
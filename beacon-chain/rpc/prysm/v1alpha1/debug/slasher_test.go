@@ -0,0 +1,45 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDebugServer_GetSlasherStorage(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetSlasherStorage(ctx, &empty.Empty{})
+	require.ErrorContains(t, "Slasher is not enabled", err)
+
+	slasherDB := dbTest.SetupSlasherDB(t)
+	require.NoError(t, slasherDB.SaveChunkParameters(ctx, &slashertypes.ChunkParameters{
+		ChunkSize:          2,
+		ValidatorChunkSize: 2,
+		HistoryLength:      8,
+	}))
+	// With 4 chunks per validator chunk, flat slice ID 5 belongs to validator chunk index 1.
+	require.NoError(t, slasherDB.SaveSlasherChunks(
+		ctx, slashertypes.MaxSpan, [][]byte{ssz.MarshalUint64(make([]byte, 0), 5)}, [][]uint16{{1, 2, 3, 4}},
+	))
+	ds.SlasherDB = slasherDB
+	res, err := ds.GetSlasherStorage(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.ChunkSize)
+	assert.Equal(t, uint64(2), res.ValidatorChunkSize)
+	assert.Equal(t, types.Epoch(8), res.HistoryLength)
+	assert.Equal(t, true, res.FileBytes > 0)
+	assert.NotEqual(t, 0, len(res.Buckets))
+	require.Equal(t, 1, len(res.ValidatorChunks))
+	assert.Equal(t, uint64(1), res.ValidatorChunks[0].ValidatorChunkIndex)
+	assert.Equal(t, uint64(0), res.ValidatorChunks[0].MinSpanChunks)
+	assert.Equal(t, uint64(1), res.ValidatorChunks[0].MaxSpanChunks)
+	assert.Equal(t, true, res.ValidatorChunks[0].MaxSpanBytes > 0)
+}
@@ -427,6 +427,7 @@ func (vs *Server) ComputeStateRoot(ctx context.Context, block block.SignedBeacon
 		ctx,
 		beaconState,
 		block,
+		nil,
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not calculate state root at slot %d", beaconState.Slot())
@@ -100,9 +100,11 @@ type Config struct {
 	SlashingsPool           slashings.PoolManager
 	SyncCommitteeObjectPool synccommittee.Pool
 	SyncService             chainSync.Checker
+	PendingQueueFetcher     chainSync.PendingQueueFetcher
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	GossipScoringProvider   p2p.GossipScoringProvider
 	MetadataProvider        p2p.MetadataProvider
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
@@ -111,6 +113,7 @@ type Config struct {
 	OperationNotifier       opfeed.Notifier
 	StateGen                *stategen.State
 	MaxMsgSize              int
+	SlasherDB               db.SlasherDatabase
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -298,12 +301,15 @@ func (s *Service) Start() {
 		log.Info("Enabled debug gRPC endpoints")
 
 		debugServer := &debugv1alpha1.Server{
-			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			BeaconDB:           s.cfg.BeaconDB,
-			StateGen:           s.cfg.StateGen,
-			HeadFetcher:        s.cfg.HeadFetcher,
-			PeerManager:        s.cfg.PeerManager,
-			PeersFetcher:       s.cfg.PeersFetcher,
+			GenesisTimeFetcher:    s.cfg.GenesisTimeFetcher,
+			BeaconDB:              s.cfg.BeaconDB,
+			StateGen:              s.cfg.StateGen,
+			HeadFetcher:           s.cfg.HeadFetcher,
+			PeerManager:           s.cfg.PeerManager,
+			PeersFetcher:          s.cfg.PeersFetcher,
+			PendingQueueFetcher:   s.cfg.PendingQueueFetcher,
+			GossipScoringProvider: s.cfg.GossipScoringProvider,
+			SlasherDB:             s.cfg.SlasherDB,
 		}
 		debugServerV1 := &debug.Server{
 			BeaconDB:    s.cfg.BeaconDB,
@@ -0,0 +1,203 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// The number of epochs of stored blocks replayed as a single batch during a backfill.
+const backfillBatchEpochs = types.Epoch(8)
+
+// Determines the epoch slasher should start replaying stored blocks from when it starts
+// at the current epoch. An explicitly requested start epoch takes precedence, otherwise
+// detection resumes after the last epoch checkpointed in the slasher database. Epochs
+// which fell out of the history we keep are skipped, as nothing can be detected on them.
+// Returns false if there is nothing to backfill.
+func (s *Service) backfillStartEpoch(ctx context.Context, currentEpoch types.Epoch) (types.Epoch, bool, error) {
+	var startEpoch types.Epoch
+	if s.serviceCfg.BackfillFromEpoch != nil {
+		startEpoch = *s.serviceCfg.BackfillFromEpoch
+	} else {
+		lastProcessed, exists, err := s.serviceCfg.Database.LastProcessedEpoch(ctx)
+		if err != nil {
+			return 0, false, errors.Wrap(err, "could not get last processed epoch")
+		}
+		if !exists {
+			return 0, false, nil
+		}
+		startEpoch = lastProcessed + 1
+	}
+	if currentEpoch >= s.params.historyLength && startEpoch <= currentEpoch-s.params.historyLength {
+		startEpoch = currentEpoch - s.params.historyLength + 1
+	}
+	if startEpoch >= currentEpoch {
+		return 0, false, nil
+	}
+	return startEpoch, true, nil
+}
+
+// Backfill replays the attestations and proposals of all blocks stored in the beacon
+// node database from the start epoch up to and including the end epoch, detecting
+// slashable offenses on them in batches of several epochs. Found slashings are submitted
+// to the slashings pool, and the last processed epoch is checkpointed in the slasher
+// database after each batch, so an interrupted backfill resumes where it left off.
+func (s *Service) Backfill(ctx context.Context, startEpoch, endEpoch types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.Backfill")
+	defer span.End()
+	if startEpoch > endEpoch {
+		return fmt.Errorf("start epoch %d cannot be after end epoch %d", startEpoch, endEpoch)
+	}
+	log.WithFields(logrus.Fields{
+		"startEpoch": startEpoch,
+		"endEpoch":   endEpoch,
+	}).Info("Backfilling slasher with historical blocks")
+	for batchStart := startEpoch; batchStart <= endEpoch; batchStart += backfillBatchEpochs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		batchEnd := batchStart + backfillBatchEpochs - 1
+		if batchEnd > endEpoch {
+			batchEnd = endEpoch
+		}
+		atts, blocks, err := s.historicalData(ctx, batchStart, batchEnd)
+		if err != nil {
+			return errors.Wrapf(err, "could not retrieve blocks for epochs %d to %d", batchStart, batchEnd)
+		}
+		validAtts, _, numDropped := s.filterAttestations(atts, batchEnd)
+		droppedAttestationsTotal.Add(float64(numDropped))
+		attSlashings, err := s.checkSlashableAttestations(ctx, batchEnd, validAtts)
+		if err != nil {
+			return errors.Wrapf(err, "could not check slashable attestations for epochs %d to %d", batchStart, batchEnd)
+		}
+		processedAttestationsTotal.Add(float64(len(validAtts)))
+		propSlashings, err := s.detectProposerSlashings(ctx, blocks)
+		if err != nil {
+			return errors.Wrapf(err, "could not detect proposer slashings for epochs %d to %d", batchStart, batchEnd)
+		}
+		processedBlocksTotal.Add(float64(len(blocks)))
+		if err := s.processAttesterSlashings(ctx, attSlashings, nil /* historical data has no gossip provenance */); err != nil {
+			return err
+		}
+		if err := s.processProposerSlashings(ctx, propSlashings, nil /* historical data has no gossip provenance */); err != nil {
+			return err
+		}
+		if err := s.serviceCfg.Database.SaveLastProcessedEpoch(ctx, batchEnd); err != nil {
+			return errors.Wrap(err, "could not save last processed epoch")
+		}
+		log.WithFields(logrus.Fields{
+			"startEpoch":      batchStart,
+			"endEpoch":        batchEnd,
+			"numBlocks":       len(blocks),
+			"numAttestations": len(validAtts),
+		}).Debug("Backfilled slasher epochs")
+	}
+	return nil
+}
+
+// Queues the attestations and proposals of the blocks already stored for the current epoch,
+// which were received before slasher started and are not sent through its feeds. They are
+// processed with the next batch of live data.
+func (s *Service) queueStoredBlocks(ctx context.Context, currentEpoch types.Epoch) error {
+	atts, blocks, err := s.historicalData(ctx, currentEpoch, currentEpoch)
+	if err != nil {
+		return err
+	}
+	s.attsQueue.extend(atts)
+	s.blksQueue.extend(blocks)
+	return nil
+}
+
+// Retrieves all blocks stored in the beacon node database within an epoch range, returning
+// their signed headers and their attestations converted to indexed form.
+func (s *Service) historicalData(
+	ctx context.Context, startEpoch, endEpoch types.Epoch,
+) ([]*slashertypes.IndexedAttestationWrapper, []*slashertypes.SignedBlockHeaderWrapper, error) {
+	blks, _, err := s.serviceCfg.BeaconDatabase.Blocks(
+		ctx, filters.NewFilter().SetStartEpoch(startEpoch).SetEndEpoch(endEpoch),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	headers := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(blks))
+	atts := make([]*ethpb.Attestation, 0)
+	for _, blk := range blks {
+		if blk == nil || blk.IsNil() || blk.Block().IsNil() {
+			continue
+		}
+		header, err := blockutil.SignedBeaconBlockHeaderFromBlockInterface(blk)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get header of block at slot %d", blk.Block().Slot())
+		}
+		signingRoot, err := header.Header.HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+		headers = append(headers, &slashertypes.SignedBlockHeaderWrapper{
+			SignedBeaconBlockHeader: header,
+			SigningRoot:             signingRoot,
+		})
+		atts = append(atts, blk.Block().Body().Attestations()...)
+	}
+	indexedAtts, err := s.indexedAttestations(ctx, atts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return indexedAtts, headers, nil
+}
+
+// Converts attestations to indexed form using the committees of the state at their target
+// root. Attestations whose target state is not available are skipped.
+func (s *Service) indexedAttestations(
+	ctx context.Context, atts []*ethpb.Attestation,
+) ([]*slashertypes.IndexedAttestationWrapper, error) {
+	targetStates := make(map[[32]byte]state.BeaconState)
+	indexedAtts := make([]*slashertypes.IndexedAttestationWrapper, 0, len(atts))
+	for _, att := range atts {
+		if att == nil || att.Data == nil || att.Data.Target == nil {
+			continue
+		}
+		targetRoot := bytesutil.ToBytes32(att.Data.Target.Root)
+		targetState, ok := targetStates[targetRoot]
+		if !ok {
+			st, err := s.serviceCfg.StateGen.StateByRoot(ctx, targetRoot)
+			if err != nil {
+				log.WithError(err).Debugf("Could not get state for attestation target root %#x", targetRoot)
+			}
+			targetStates[targetRoot] = st
+			targetState = st
+		}
+		if targetState == nil || targetState.IsNil() {
+			continue
+		}
+		committee, err := helpers.BeaconCommitteeFromState(targetState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get attestation committee")
+		}
+		indexedAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert to indexed attestation")
+		}
+		signingRoot, err := indexedAtt.Data.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		indexedAtts = append(indexedAtts, &slashertypes.IndexedAttestationWrapper{
+			IndexedAttestation: indexedAtt,
+			SigningRoot:        signingRoot,
+		})
+	}
+	return indexedAtts, nil
+}
@@ -0,0 +1,129 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_Backfill(t *testing.T) {
+	ctx := context.Background()
+	validators := make([]*ethpb.Validator, 64)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+		}
+	}
+	beaconState, err := testutil.NewBeaconState(func(st *statepb.BeaconState) error {
+		st.Validators = validators
+		return nil
+	})
+	require.NoError(t, err)
+	targetRoot := [32]byte{'t'}
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForRoot(beaconState, targetRoot)
+
+	// Two attestations by the same committee for target epoch 1 voting for different
+	// block roots, and two blocks by the same proposer for the same slot.
+	aggregationBits := bitfield.NewBitlist(2)
+	aggregationBits.SetBitAt(0, true)
+	newAttestation := func(blockRoot byte) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			AggregationBits: aggregationBits,
+			Data: &ethpb.AttestationData{
+				Slot:            params.BeaconConfig().SlotsPerEpoch,
+				BeaconBlockRoot: bytesutil.PadTo([]byte{blockRoot}, 32),
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: targetRoot[:]},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	beaconDB := dbtest.SetupDB(t)
+	for i, att := range []*ethpb.Attestation{newAttestation(1), newAttestation(2)} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = params.BeaconConfig().SlotsPerEpoch + 1
+		blk.Block.ProposerIndex = 3
+		blk.Block.Body.Graffiti = bytesutil.PadTo([]byte{byte(i)}, 32)
+		blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+		blk.Signature = bytesutil.PadTo([]byte{1}, 96)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	}
+
+	pool := &slashings.PoolMock{}
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			BeaconDatabase:       beaconDB,
+			StateGen:             stateGen,
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			SlashingPoolInserter: pool,
+		},
+	}
+	require.NoError(t, s.Backfill(ctx, 0, 2))
+
+	assert.Equal(t, 1, len(pool.PendingAttSlashings))
+	assert.Equal(t, 1, len(pool.PendingPropSlashings))
+	lastProcessed, exists, err := slasherDB.LastProcessedEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(2), lastProcessed)
+
+	require.ErrorContains(t, "cannot be after end epoch", s.Backfill(ctx, 3, 2))
+}
+
+func TestService_backfillStartEpoch(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		params:     &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 8},
+		serviceCfg: &ServiceConfig{Database: slasherDB},
+	}
+
+	// Nothing to backfill without a checkpoint or a requested start epoch.
+	_, ok, err := s.backfillStartEpoch(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	require.NoError(t, slasherDB.SaveLastProcessedEpoch(ctx, 3))
+	startEpoch, ok, err := s.backfillStartEpoch(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(4), startEpoch)
+
+	// Already caught up.
+	_, ok, err = s.backfillStartEpoch(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	// The start is capped to the history we keep.
+	startEpoch, ok, err = s.backfillStartEpoch(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(13), startEpoch)
+
+	// A requested start epoch overrides the checkpoint.
+	requested := types.Epoch(1)
+	s.serviceCfg.BackfillFromEpoch = &requested
+	startEpoch, ok, err = s.backfillStartEpoch(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(1), startEpoch)
+}
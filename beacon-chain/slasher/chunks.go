@@ -0,0 +1,468 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// A struct encapsulating input arguments to
+// functions used for attester slashing detection and
+// loading, saving, and updating min/max span chunks.
+type chunkUpdateArgs struct {
+	kind                slashertypes.ChunkKind
+	validatorChunkIndex uint64
+	currentEpoch        types.Epoch
+}
+
+// Chunker defines a struct which represents a slice containing a chunk for K different validator's
+// min or max spans used for surround vote detection in slasher. The interface defines methods used
+// to check if an attestation is slashable for a validator index based on the contents of
+// the chunk as well as the ability to update the data in the chunk with incoming information.
+type Chunker interface {
+	NeutralElement() uint16
+	Chunk() []uint16
+	CheckSlashable(
+		ctx context.Context,
+		slasherDB db.SlasherDatabase,
+		validatorIdx types.ValidatorIndex,
+		attestation *slashertypes.IndexedAttestationWrapper,
+	) (*ethpb.AttesterSlashing, error)
+	Update(
+		chunkIndex uint64,
+		currentEpoch types.Epoch,
+		validatorIndex types.ValidatorIndex,
+		startEpoch,
+		newTargetEpoch types.Epoch,
+	) (keepGoing bool, err error)
+	StartEpoch(sourceEpoch, currentEpoch types.Epoch) (epoch types.Epoch, exists bool)
+	NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch
+}
+
+// MinSpanChunksSlice represents a slice containing a chunk for K different validator's min spans.
+//
+// For a given epoch, e, and attestations a validator index has produced, atts,
+// min_spans[e] is defined as min((att.target.epoch - e) for att in attestations)
+// where att.source.epoch > e. That is, it is the minimum distance between the
+// specified epoch and all attestation target epochs a validator has created
+// where att.source.epoch > e.
+//
+// Under ideal network conditions, where every target epoch immediately follows its source,
+// min spans for a validator will look as follows:
+//
+//  min_spans = [2, 2, 2, ..., 2]
+//
+// Next, we can chunk this list of min spans into chunks of length C. For C = 2, for example:
+//
+//                       chunk0  chunk1       chunkN
+//                        {  }   {   }         {  }
+//  chunked_min_spans = [[2, 2], [2, 2], ..., [2, 2]]
+//
+// Finally, we can store each chunk index for K validators into a single flat slice. For K = 3:
+//
+//                                   val0    val1    val2
+//                                   {  }    {  }    {  }
+//  chunk_0_for_validators_0_to_3 = [2, 2,   2, 2,   2, 2]
+//
+//                                   val0    val1    val2
+//                                   {  }    {  }    {  }
+//  chunk_1_for_validators_0_to_3 = [2, 2,   2, 2,   2, 2]
+//
+//                                   ...
+//
+//                                   val0    val1    val2
+//                                   {  }    {  }    {  }
+//  chunk_N_for_validators_0_to_3 = [2, 2,   2, 2,   2, 2]
+//
+// MinSpanChunksSlice represents the data structure above for a single chunk index.
+type MinSpanChunksSlice struct {
+	params *Parameters
+	data   []uint16
+}
+
+// MaxSpanChunksSlice represents the same data structure as MinSpanChunksSlice however
+// keeps track of validator max spans for slashing detection instead.
+type MaxSpanChunksSlice struct {
+	params *Parameters
+	data   []uint16
+}
+
+// EmptyMinSpanChunksSlice initializes a min span chunk of length C*K for
+// C = chunkSize and K = validatorChunkSize filled with neutral elements.
+// For min spans, the neutral element is `undefined`, represented by MaxUint16.
+func EmptyMinSpanChunksSlice(params *Parameters) *MinSpanChunksSlice {
+	m := &MinSpanChunksSlice{
+		params: params,
+	}
+	m.data = emptyChunk(params, m.NeutralElement())
+	return m
+}
+
+// EmptyMaxSpanChunksSlice initializes a max span chunk of length C*K for
+// C = chunkSize and K = validatorChunkSize filled with neutral elements.
+// For max spans, the neutral element is 0.
+func EmptyMaxSpanChunksSlice(params *Parameters) *MaxSpanChunksSlice {
+	m := &MaxSpanChunksSlice{
+		params: params,
+	}
+	m.data = emptyChunk(params, m.NeutralElement())
+	return m
+}
+
+// MinChunkSpansSliceFrom initializes a min span chunks slice from a slice of uint16 values.
+// Returns an error if the slice is not of length C*K for C = chunkSize and K = validatorChunkSize.
+func MinChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MinSpanChunksSlice, error) {
+	if err := validateChunkLength(params, chunk); err != nil {
+		return nil, err
+	}
+	return &MinSpanChunksSlice{
+		params: params,
+		data:   chunk,
+	}, nil
+}
+
+// MaxChunkSpansSliceFrom initializes a max span chunks slice from a slice of uint16 values.
+// Returns an error if the slice is not of length C*K for C = chunkSize and K = validatorChunkSize.
+func MaxChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MaxSpanChunksSlice, error) {
+	if err := validateChunkLength(params, chunk); err != nil {
+		return nil, err
+	}
+	return &MaxSpanChunksSlice{
+		params: params,
+		data:   chunk,
+	}, nil
+}
+
+// NeutralElement for a min span chunks slice is undefined, in this case
+// using MaxUint16 as a sane value given it is impossible we reach it.
+func (*MinSpanChunksSlice) NeutralElement() uint16 {
+	return math.MaxUint16
+}
+
+// NeutralElement for a max span chunks slice is 0.
+func (*MaxSpanChunksSlice) NeutralElement() uint16 {
+	return 0
+}
+
+// Chunk returns the underlying slice of uint16's for the min chunks slice.
+func (m *MinSpanChunksSlice) Chunk() []uint16 {
+	return m.data
+}
+
+// Chunk returns the underlying slice of uint16's for the max chunks slice.
+func (m *MaxSpanChunksSlice) Chunk() []uint16 {
+	return m.data
+}
+
+// CheckSlashable takes in a validator index and an incoming attestation
+// and checks if the validator is slashable depending on the data
+// within the min span chunks slice. Recall that for an incoming attestation, B, and an
+// existing attestation, A:
+//
+//  B surrounds A if and only if B.target > min_spans[B.source]
+//
+// That is, this condition is sufficient to check if an incoming attestation
+// is surrounding a previous one. We also check if we indeed have an existing
+// attestation record in the database if the condition holds true in order
+// to be confident of a slashable offense.
+func (m *MinSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx types.ValidatorIndex,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := attestation.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := attestation.IndexedAttestation.Data.Target.Epoch
+	minTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIdx, sourceEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "could not get min target for validator %d at epoch %d", validatorIdx, sourceEpoch,
+		)
+	}
+	if targetEpoch <= minTarget {
+		return nil, nil
+	}
+	existingAttRecord, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, minTarget)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get existing attestation record at target %d", minTarget)
+	}
+	if existingAttRecord == nil || sourceEpoch >= existingAttRecord.IndexedAttestation.Data.Source.Epoch {
+		return nil, nil
+	}
+	surroundingVotesTotal.Inc()
+	return &ethpb.AttesterSlashing{
+		Attestation_1: attestation.IndexedAttestation,
+		Attestation_2: existingAttRecord.IndexedAttestation,
+	}, nil
+}
+
+// CheckSlashable takes in a validator index and an incoming attestation
+// and checks if the validator is slashable depending on the data
+// within the max span chunks slice. Recall that for an incoming attestation, B, and an
+// existing attestation, A:
+//
+//  B is surrounded by A if and only if B.target < max_spans[B.source]
+//
+// That is, this condition is sufficient to check if an incoming attestation
+// is surrounded by a previous one. We also check if we indeed have an existing
+// attestation record in the database if the condition holds true in order
+// to be confident of a slashable offense.
+func (m *MaxSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx types.ValidatorIndex,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := attestation.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := attestation.IndexedAttestation.Data.Target.Epoch
+	maxTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIdx, sourceEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "could not get max target for validator %d at epoch %d", validatorIdx, sourceEpoch,
+		)
+	}
+	if targetEpoch >= maxTarget {
+		return nil, nil
+	}
+	existingAttRecord, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, maxTarget)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get existing attestation record at target %d", maxTarget)
+	}
+	if existingAttRecord == nil || existingAttRecord.IndexedAttestation.Data.Source.Epoch >= sourceEpoch {
+		return nil, nil
+	}
+	surroundedVotesTotal.Inc()
+	return &ethpb.AttesterSlashing{
+		Attestation_1: existingAttRecord.IndexedAttestation,
+		Attestation_2: attestation.IndexedAttestation,
+	}, nil
+}
+
+// Update a min span chunk for a validator index starting at the current epoch, e_c, then updating
+// down to e_c - H where H is the historyLength we keep for each span. This historyLength
+// corresponds to the weak subjectivity period of Ethereum consensus.
+//
+// We update the min span for a validator index as follows, for each epoch e going down
+// from the start epoch, we set min_spans[e] = attestation.target - e as long as it is
+// smaller than the existing min span at e. Once it is not, all earlier min spans are
+// smaller too and we can stop.
+//
+// We return a keepGoing boolean when the update crossed into the previous chunk, which
+// the caller then continues the update in.
+func (m *MinSpanChunksSlice) Update(
+	chunkIndex uint64,
+	currentEpoch types.Epoch,
+	validatorIndex types.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch types.Epoch,
+) (keepGoing bool, err error) {
+	minEpoch := types.Epoch(0)
+	if currentEpoch > m.params.historyLength-1 {
+		minEpoch = currentEpoch - (m.params.historyLength - 1)
+	}
+	epochInChunk := startEpoch
+	for {
+		chunkTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIndex, epochInChunk)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get chunk data at epoch %d", epochInChunk)
+		}
+		// We can stop because spans are guaranteed to be minimums and
+		// if we did not meet the minimum condition, there is nothing to update.
+		if newTargetEpoch >= chunkTarget {
+			return false, nil
+		}
+		if err := setChunkDataAtEpoch(m.params, m.data, validatorIndex, epochInChunk, newTargetEpoch); err != nil {
+			return false, errors.Wrapf(err, "could not set chunk data at epoch %d", epochInChunk)
+		}
+		if epochInChunk <= minEpoch {
+			return false, nil
+		}
+		epochInChunk--
+		if m.params.chunkIndex(epochInChunk) != chunkIndex {
+			return true, nil
+		}
+	}
+}
+
+// Update a max span chunk for a validator index starting at a given start epoch, e_c, then updating
+// up to the current epoch according to the definition of max spans. For each epoch e going up
+// from the start epoch, we set max_spans[e] = attestation.target - e as long as it is larger
+// than the existing max span at e. Once it is not, all later max spans are larger too and we
+// can stop.
+//
+// We return a keepGoing boolean when the update crossed into the next chunk, which
+// the caller then continues the update in.
+func (m *MaxSpanChunksSlice) Update(
+	chunkIndex uint64,
+	currentEpoch types.Epoch,
+	validatorIndex types.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch types.Epoch,
+) (keepGoing bool, err error) {
+	epochInChunk := startEpoch
+	for {
+		chunkTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIndex, epochInChunk)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get chunk data at epoch %d", epochInChunk)
+		}
+		// We can stop because spans are guaranteed to be maximums and
+		// if we did not meet the maximum condition, there is nothing to update.
+		if newTargetEpoch <= chunkTarget {
+			return false, nil
+		}
+		if err := setChunkDataAtEpoch(m.params, m.data, validatorIndex, epochInChunk, newTargetEpoch); err != nil {
+			return false, errors.Wrapf(err, "could not set chunk data at epoch %d", epochInChunk)
+		}
+		if epochInChunk >= currentEpoch {
+			return false, nil
+		}
+		epochInChunk++
+		if m.params.chunkIndex(epochInChunk) != chunkIndex {
+			return true, nil
+		}
+	}
+}
+
+// StartEpoch given a source epoch and current epoch, determines the start epoch of
+// a min span chunk for use in chunk updates. Min spans are updated from the epoch before
+// the source epoch down, as long as it is within the history we keep. Otherwise, we return
+// to the caller a boolean signifying the input argument is invalid for the chunk.
+func (m *MinSpanChunksSlice) StartEpoch(
+	sourceEpoch, currentEpoch types.Epoch,
+) (epoch types.Epoch, exists bool) {
+	// Given min span chunks are used for detecting surrounding votes, we have no need
+	// for a start epoch of the chunk if the source epoch is 0 in the input arguments.
+	// To further clarify, min span chunks are used for detecting attestations surrounding
+	// attestations we have for a validator. To avoid the source epoch < 0, we check
+	// for this condition.
+	if sourceEpoch == 0 {
+		return
+	}
+	var difference types.Epoch
+	if currentEpoch > m.params.historyLength {
+		difference = currentEpoch - m.params.historyLength
+	}
+	if sourceEpoch <= difference {
+		return
+	}
+	epoch = sourceEpoch.Sub(1)
+	exists = true
+	return
+}
+
+// StartEpoch given a source epoch and current epoch, determines the start epoch of
+// a max span chunk for use in chunk updates. The source epoch cannot be >= the current epoch.
+func (*MaxSpanChunksSlice) StartEpoch(
+	sourceEpoch, currentEpoch types.Epoch,
+) (epoch types.Epoch, exists bool) {
+	if sourceEpoch >= currentEpoch {
+		return
+	}
+	epoch = sourceEpoch.Add(1)
+	exists = true
+	return
+}
+
+// NextChunkStartEpoch given an epoch, determines the start epoch of the next chunk to update
+// for min spans, going down: the last epoch of the chunk before the one containing the epoch.
+//
+// For example, with C = 3 and H = 9:
+//
+//  [-, -, -, -, -, -, -, -, -]
+//            |-> start epoch 3, next chunk start epoch 2
+//
+func (m *MinSpanChunksSlice) NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch {
+	return startEpoch.Sub(uint64(m.params.chunkOffset(startEpoch))).Sub(1)
+}
+
+// NextChunkStartEpoch given an epoch, determines the start epoch of the next chunk to update
+// for max spans, going up: the first epoch of the chunk after the one containing the epoch.
+//
+// For example, with C = 3 and H = 9:
+//
+//  [-, -, -, -, -, -, -, -, -]
+//         |-> start epoch 2, next chunk start epoch 3
+//
+func (m *MaxSpanChunksSlice) NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch {
+	return startEpoch.Sub(uint64(m.params.chunkOffset(startEpoch))).Add(m.params.chunkSize)
+}
+
+// Given a validator index and epoch, retrieves the target epoch at its specific
+// index for the validator index and epoch in a min/max span chunk.
+func chunkDataAtEpoch(
+	params *Parameters, chunk []uint16, validatorIdx types.ValidatorIndex, epoch types.Epoch,
+) (types.Epoch, error) {
+	if err := validateChunkLength(params, chunk); err != nil {
+		return 0, err
+	}
+	distance := chunk[params.cellIndex(validatorIdx, epoch)]
+	return epoch.Add(uint64(distance)), nil
+}
+
+// Updates the value at a cell for a validator index and epoch value in a min or max span
+// chunk, storing the distance of the target epoch from the epoch.
+func setChunkDataAtEpoch(
+	params *Parameters,
+	chunk []uint16,
+	validatorIdx types.ValidatorIndex,
+	epochInChunk,
+	targetEpoch types.Epoch,
+) error {
+	distance, err := epochDistance(targetEpoch, epochInChunk)
+	if err != nil {
+		return err
+	}
+	return setChunkRawDistance(params, chunk, validatorIdx, epochInChunk, distance)
+}
+
+// Updates the value at a cell for a validator index and epoch value in a min or max span
+// chunk with a raw distance value.
+func setChunkRawDistance(
+	params *Parameters,
+	chunk []uint16,
+	validatorIdx types.ValidatorIndex,
+	epochInChunk types.Epoch,
+	distance uint16,
+) error {
+	if err := validateChunkLength(params, chunk); err != nil {
+		return err
+	}
+	chunk[params.cellIndex(validatorIdx, epochInChunk)] = distance
+	return nil
+}
+
+// Computes a distance between two epochs. Given the result stored in min/max spans
+// is maximum WEAK_SUBJECTIVITY_PERIOD, we are guaranteed the
+// distance can be represented as a uint16 safely.
+func epochDistance(epoch, baseEpoch types.Epoch) (uint16, error) {
+	if baseEpoch > epoch {
+		return 0, fmt.Errorf("base epoch %d cannot be greater than epoch %d", baseEpoch, epoch)
+	}
+	distance := uint64(epoch - baseEpoch)
+	if distance > math.MaxUint16 {
+		return 0, fmt.Errorf("distance %d between epochs %d and %d does not fit a span", distance, baseEpoch, epoch)
+	}
+	return uint16(distance), nil
+}
+
+func emptyChunk(params *Parameters, neutralElement uint16) []uint16 {
+	data := make([]uint16, params.chunkSize*params.validatorChunkSize)
+	for i := 0; i < len(data); i++ {
+		data[i] = neutralElement
+	}
+	return data
+}
+
+func validateChunkLength(params *Parameters, chunk []uint16) error {
+	requiredLen := params.chunkSize * params.validatorChunkSize
+	if uint64(len(chunk)) != requiredLen {
+		return fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), requiredLen)
+	}
+	return nil
+}
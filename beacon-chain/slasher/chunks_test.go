@@ -0,0 +1,120 @@
+package slasher
+
+import (
+	"math"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEmptySpanChunksSlices(t *testing.T) {
+	params := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}
+	minSpans := EmptyMinSpanChunksSlice(params)
+	assert.DeepEqual(t, []uint16{math.MaxUint16, math.MaxUint16, math.MaxUint16, math.MaxUint16}, minSpans.Chunk())
+	maxSpans := EmptyMaxSpanChunksSlice(params)
+	assert.DeepEqual(t, []uint16{0, 0, 0, 0}, maxSpans.Chunk())
+}
+
+func TestChunkSpansSliceFrom_WrongLength(t *testing.T) {
+	params := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}
+	_, err := MinChunkSpansSliceFrom(params, []uint16{1, 2, 3})
+	assert.ErrorContains(t, "chunk has wrong length, 3, expected 4", err)
+	_, err = MaxChunkSpansSliceFrom(params, []uint16{1})
+	assert.ErrorContains(t, "chunk has wrong length, 1, expected 4", err)
+	_, err = MinChunkSpansSliceFrom(params, []uint16{1, 2, 3, 4})
+	require.NoError(t, err)
+}
+
+func TestMinSpanChunksSlice_StartEpoch(t *testing.T) {
+	params := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}
+	tests := []struct {
+		name         string
+		source       types.Epoch
+		current      types.Epoch
+		wantEpoch    types.Epoch
+		wantExisting bool
+	}{
+		{name: "source is genesis", source: 0, current: 3},
+		{name: "source out of history", source: 2, current: 6},
+		{name: "source within history", source: 3, current: 6, wantEpoch: 2, wantExisting: true},
+		{name: "before history length", source: 1, current: 2, wantEpoch: 0, wantExisting: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			epoch, exists := EmptyMinSpanChunksSlice(params).StartEpoch(tt.source, tt.current)
+			assert.Equal(t, tt.wantExisting, exists)
+			assert.Equal(t, tt.wantEpoch, epoch)
+		})
+	}
+}
+
+func TestMaxSpanChunksSlice_StartEpoch(t *testing.T) {
+	params := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}
+	epoch, exists := EmptyMaxSpanChunksSlice(params).StartEpoch(3, 3)
+	assert.Equal(t, false, exists)
+	assert.Equal(t, types.Epoch(0), epoch)
+	epoch, exists = EmptyMaxSpanChunksSlice(params).StartEpoch(1, 3)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(2), epoch)
+}
+
+func TestSpanChunksSlice_NextChunkStartEpoch(t *testing.T) {
+	params := &Parameters{chunkSize: 3, validatorChunkSize: 2, historyLength: 9}
+	minSpans := EmptyMinSpanChunksSlice(params)
+	assert.Equal(t, types.Epoch(2), minSpans.NextChunkStartEpoch(3))
+	assert.Equal(t, types.Epoch(2), minSpans.NextChunkStartEpoch(5))
+	maxSpans := EmptyMaxSpanChunksSlice(params)
+	assert.Equal(t, types.Epoch(3), maxSpans.NextChunkStartEpoch(2))
+	assert.Equal(t, types.Epoch(6), maxSpans.NextChunkStartEpoch(4))
+}
+
+func TestMinSpanChunksSlice_Update(t *testing.T) {
+	params := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}
+	minSpans := EmptyMinSpanChunksSlice(params)
+	// An attestation with source 2 and target 3 for validator 1 at current epoch 3
+	// sets the min spans of epochs 1 and 0, within the first chunk.
+	keepGoing, err := minSpans.Update(0 /* chunk index */, 3, 1, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, false, keepGoing)
+	assert.DeepEqual(t, []uint16{math.MaxUint16, math.MaxUint16, 3, 2}, minSpans.Chunk())
+
+	// A larger target does not change the existing minimums.
+	keepGoing, err = minSpans.Update(0, 3, 1, 1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, false, keepGoing)
+	assert.DeepEqual(t, []uint16{math.MaxUint16, math.MaxUint16, 3, 2}, minSpans.Chunk())
+
+	// Updating from the start of a later chunk continues in the previous one.
+	keepGoing, err = EmptyMinSpanChunksSlice(params).Update(1, 3, 0, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, true, keepGoing)
+}
+
+func TestMaxSpanChunksSlice_Update(t *testing.T) {
+	params := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}
+	maxSpans := EmptyMaxSpanChunksSlice(params)
+	// An attestation with source 0 and target 2 for validator 0 at current epoch 3
+	// sets the max span of epoch 1 and continues in the next chunk.
+	keepGoing, err := maxSpans.Update(0 /* chunk index */, 3, 0, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, keepGoing)
+	assert.DeepEqual(t, []uint16{0, 1, 0, 0}, maxSpans.Chunk())
+
+	// A smaller target does not change the existing maximums.
+	keepGoing, err = maxSpans.Update(0, 3, 0, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, keepGoing)
+	assert.DeepEqual(t, []uint16{0, 1, 0, 0}, maxSpans.Chunk())
+}
+
+func TestEpochDistance(t *testing.T) {
+	distance, err := epochDistance(5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(3), distance)
+	_, err = epochDistance(2, 5)
+	assert.ErrorContains(t, "cannot be greater than epoch", err)
+	_, err = epochDistance(math.MaxUint16+1, 0)
+	assert.ErrorContains(t, "does not fit a span", err)
+}
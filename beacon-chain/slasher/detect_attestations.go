@@ -0,0 +1,257 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Takes in a list of indexed attestation wrappers and returns any
+// found attester slashings to the caller. Double votes are checked first, against
+// both the batch itself and the attestation records on disk, before the batch is
+// persisted. Then, for every validator chunk index the batch touches, min and max
+// span chunks are loaded, checked for surround votes and updated.
+func (s *Service) checkSlashableAttestations(
+	ctx context.Context, currentEpoch types.Epoch, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.checkSlashableAttestations")
+	defer span.End()
+	slashings, err := s.checkDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check slashable double votes")
+	}
+	if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(ctx, atts); err != nil {
+		return nil, errors.Wrap(err, "could not save attestation records to db")
+	}
+	groupedAtts := s.groupByValidatorChunkIndex(atts)
+	for validatorChunkIdx, attsInChunk := range groupedAtts {
+		surroundSlashings, err := s.detectSurroundVotes(ctx, validatorChunkIdx, currentEpoch, attsInChunk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect surround votes in validator chunk %d", validatorChunkIdx)
+		}
+		slashings = append(slashings, surroundSlashings...)
+	}
+	return dedupeAttesterSlashings(slashings), nil
+}
+
+// Checks a batch of attestations for double votes, that is, two attestations by
+// the same validator for the same target epoch with different signing roots.
+func (s *Service) checkDoubleVotes(
+	ctx context.Context, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	seen := make(map[string]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range atts {
+		for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+			key := fmt.Sprintf("%d:%d", att.IndexedAttestation.Data.Target.Epoch, valIdx)
+			existing, ok := seen[key]
+			if !ok {
+				seen[key] = att
+				continue
+			}
+			if existing.SigningRoot != att.SigningRoot {
+				doubleVotesTotal.Inc()
+				slashings = append(slashings, &ethpb.AttesterSlashing{
+					Attestation_1: existing.IndexedAttestation,
+					Attestation_2: att.IndexedAttestation,
+				})
+			}
+		}
+	}
+	doubleVotes, err := s.serviceCfg.Database.CheckAttesterDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve double votes from disk")
+	}
+	for _, doubleVote := range doubleVotes {
+		doubleVotesTotal.Inc()
+		slashings = append(slashings, &ethpb.AttesterSlashing{
+			Attestation_1: doubleVote.PrevAttestationWrapper.IndexedAttestation,
+			Attestation_2: doubleVote.AttestationWrapper.IndexedAttestation,
+		})
+	}
+	return slashings, nil
+}
+
+// Detects surround votes for the attestations of validators within a single validator
+// chunk index, updating the min and max span chunks of those validators as it goes and
+// writing the updated chunks back to disk.
+func (s *Service) detectSurroundVotes(
+	ctx context.Context,
+	validatorChunkIdx uint64,
+	currentEpoch types.Epoch,
+	atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		args := &chunkUpdateArgs{
+			kind:                kind,
+			validatorChunkIndex: validatorChunkIdx,
+			currentEpoch:        currentEpoch,
+		}
+		chunks := make(map[uint64]Chunker)
+		if err := s.epochUpdateForValidators(ctx, args, chunks, atts); err != nil {
+			return nil, errors.Wrap(err, "could not reset stale chunk cells")
+		}
+		for _, att := range atts {
+			for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+				validatorIdx := types.ValidatorIndex(valIdx)
+				if s.params.validatorChunkIndex(validatorIdx) != validatorChunkIdx {
+					continue
+				}
+				slashing, err := s.applyAttestationForValidator(ctx, args, chunks, validatorIdx, att)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not apply attestation for validator %d", validatorIdx)
+				}
+				if slashing != nil {
+					slashings = append(slashings, slashing)
+				}
+			}
+		}
+		if err := s.saveUpdatedChunks(ctx, args, chunks); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.serviceCfg.Database.SaveLastEpochWrittenForValidators(
+		ctx, validatorsInAttestations(s.params, validatorChunkIdx, atts), currentEpoch,
+	); err != nil {
+		return nil, errors.Wrap(err, "could not save last epoch written for validators")
+	}
+	return slashings, nil
+}
+
+// Span chunks are indexed by epoch modulo the history length, so cells are reused as the
+// chain progresses. Before a validator's spans are updated, every cell for the epochs
+// since the validator was last written up to the current epoch is reset to the neutral
+// element of the chunk kind, discarding data that fell out of the history.
+func (s *Service) epochUpdateForValidators(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	chunks map[uint64]Chunker,
+	atts []*slashertypes.IndexedAttestationWrapper,
+) error {
+	validatorIndices := validatorsInAttestations(s.params, args.validatorChunkIndex, atts)
+	attestedEpochs, err := s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, validatorIndices)
+	if err != nil {
+		return errors.Wrap(err, "could not get last epoch written for validators")
+	}
+	for _, attested := range attestedEpochs {
+		if attested.Epoch >= args.currentEpoch {
+			continue
+		}
+		epoch := attested.Epoch + 1
+		if args.currentEpoch >= s.params.historyLength && epoch <= args.currentEpoch-s.params.historyLength {
+			epoch = args.currentEpoch - s.params.historyLength + 1
+		}
+		for ; epoch <= args.currentEpoch; epoch++ {
+			chunk, err := s.getChunk(ctx, args, chunks, s.params.chunkIndex(epoch))
+			if err != nil {
+				return err
+			}
+			if err := setChunkRawDistance(
+				s.params, chunk.Chunk(), attested.ValidatorIndex, epoch, chunk.NeutralElement(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Checks an attestation of a validator against the span chunk holding its source epoch,
+// then updates the validator's spans with the attestation chunk by chunk until the
+// update no longer changes any value.
+func (s *Service) applyAttestationForValidator(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	chunks map[uint64]Chunker,
+	validatorIdx types.ValidatorIndex,
+	att *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := att.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := att.IndexedAttestation.Data.Target.Epoch
+	chunk, err := s.getChunk(ctx, args, chunks, s.params.chunkIndex(sourceEpoch))
+	if err != nil {
+		return nil, err
+	}
+	slashing, err := chunk.CheckSlashable(ctx, s.serviceCfg.Database, validatorIdx, att)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check if attestation is slashable")
+	}
+	startEpoch, exists := chunk.StartEpoch(sourceEpoch, args.currentEpoch)
+	if !exists {
+		return slashing, nil
+	}
+	for {
+		chunkIdx := s.params.chunkIndex(startEpoch)
+		chunk, err = s.getChunk(ctx, args, chunks, chunkIdx)
+		if err != nil {
+			return nil, err
+		}
+		keepGoing, err := chunk.Update(chunkIdx, args.currentEpoch, validatorIdx, startEpoch, targetEpoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not update chunk at chunk index %d", chunkIdx)
+		}
+		if !keepGoing {
+			return slashing, nil
+		}
+		startEpoch = chunk.NextChunkStartEpoch(startEpoch)
+	}
+}
+
+// Retrieves a span chunk by chunk index from the given map, loading it from disk the first
+// time it is requested. Chunks that do not exist on disk yet start out empty.
+func (s *Service) getChunk(
+	ctx context.Context, args *chunkUpdateArgs, chunks map[uint64]Chunker, chunkIdx uint64,
+) (Chunker, error) {
+	if chunk, ok := chunks[chunkIdx]; ok {
+		return chunk, nil
+	}
+	key := s.params.flatSliceID(args.validatorChunkIndex, chunkIdx)
+	rawChunks, exists, err := s.serviceCfg.Database.LoadSlasherChunks(ctx, args.kind, [][]byte{key})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load chunk at index %d", chunkIdx)
+	}
+	var chunk Chunker
+	switch args.kind {
+	case slashertypes.MinSpan:
+		if len(exists) == 1 && exists[0] {
+			chunk, err = MinChunkSpansSliceFrom(s.params, rawChunks[0])
+		} else {
+			chunk = EmptyMinSpanChunksSlice(s.params)
+		}
+	case slashertypes.MaxSpan:
+		if len(exists) == 1 && exists[0] {
+			chunk, err = MaxChunkSpansSliceFrom(s.params, rawChunks[0])
+		} else {
+			chunk = EmptyMaxSpanChunksSlice(s.params)
+		}
+	default:
+		return nil, fmt.Errorf("chunk kind %d not supported", args.kind)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode chunk at index %d", chunkIdx)
+	}
+	chunks[chunkIdx] = chunk
+	return chunk, nil
+}
+
+// Writes every chunk loaded or created during an update back to disk.
+func (s *Service) saveUpdatedChunks(
+	ctx context.Context, args *chunkUpdateArgs, chunks map[uint64]Chunker,
+) error {
+	chunkKeys := make([][]byte, 0, len(chunks))
+	chunkData := make([][]uint16, 0, len(chunks))
+	for chunkIdx, chunk := range chunks {
+		chunkKeys = append(chunkKeys, s.params.flatSliceID(args.validatorChunkIndex, chunkIdx))
+		chunkData = append(chunkData, chunk.Chunk())
+	}
+	if err := s.serviceCfg.Database.SaveSlasherChunks(ctx, args.kind, chunkKeys, chunkData); err != nil {
+		return errors.Wrap(err, "could not save chunks to db")
+	}
+	return nil
+}
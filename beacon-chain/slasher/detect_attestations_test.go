@@ -0,0 +1,198 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_checkSlashableAttestations(t *testing.T) {
+	tests := []struct {
+		name    string
+		batches [][]*slashertypes.IndexedAttestationWrapper
+		want    []*ethpb.AttesterSlashing
+	}{
+		{
+			name: "no slashings for consecutive votes",
+			batches: [][]*slashertypes.IndexedAttestationWrapper{
+				{createAttestationWrapper(0, 1, []uint64{0, 1}, []byte{1})},
+				{createAttestationWrapper(1, 2, []uint64{0, 1}, []byte{2})},
+				{createAttestationWrapper(2, 3, []uint64{0, 1}, []byte{3})},
+			},
+		},
+		{
+			name: "double vote within a batch",
+			batches: [][]*slashertypes.IndexedAttestationWrapper{
+				{
+					createAttestationWrapper(0, 1, []uint64{0}, []byte{1}),
+					createAttestationWrapper(0, 1, []uint64{0, 1}, []byte{2}),
+				},
+			},
+			want: []*ethpb.AttesterSlashing{{
+				Attestation_1: createAttestationWrapper(0, 1, []uint64{0}, []byte{1}).IndexedAttestation,
+				Attestation_2: createAttestationWrapper(0, 1, []uint64{0, 1}, []byte{2}).IndexedAttestation,
+			}},
+		},
+		{
+			name: "double vote across batches",
+			batches: [][]*slashertypes.IndexedAttestationWrapper{
+				{createAttestationWrapper(0, 1, []uint64{3}, []byte{1})},
+				{createAttestationWrapper(0, 1, []uint64{3}, []byte{2})},
+			},
+			want: []*ethpb.AttesterSlashing{{
+				Attestation_1: createAttestationWrapper(0, 1, []uint64{3}, []byte{1}).IndexedAttestation,
+				Attestation_2: createAttestationWrapper(0, 1, []uint64{3}, []byte{2}).IndexedAttestation,
+			}},
+		},
+		{
+			name: "surrounding vote",
+			batches: [][]*slashertypes.IndexedAttestationWrapper{
+				{createAttestationWrapper(1, 2, []uint64{1}, []byte{1})},
+				{createAttestationWrapper(0, 3, []uint64{1}, []byte{2})},
+			},
+			want: []*ethpb.AttesterSlashing{{
+				Attestation_1: createAttestationWrapper(0, 3, []uint64{1}, []byte{2}).IndexedAttestation,
+				Attestation_2: createAttestationWrapper(1, 2, []uint64{1}, []byte{1}).IndexedAttestation,
+			}},
+		},
+		{
+			name: "surrounded vote within a batch",
+			batches: [][]*slashertypes.IndexedAttestationWrapper{
+				{
+					createAttestationWrapper(0, 3, []uint64{2}, []byte{1}),
+					createAttestationWrapper(1, 2, []uint64{2}, []byte{2}),
+				},
+			},
+			want: []*ethpb.AttesterSlashing{{
+				Attestation_1: createAttestationWrapper(0, 3, []uint64{2}, []byte{1}).IndexedAttestation,
+				Attestation_2: createAttestationWrapper(1, 2, []uint64{2}, []byte{2}).IndexedAttestation,
+			}},
+		},
+		{
+			name: "surrounding vote spanning several chunks",
+			batches: [][]*slashertypes.IndexedAttestationWrapper{
+				{createAttestationWrapper(4, 5, []uint64{5}, []byte{1})},
+				{createAttestationWrapper(1, 6, []uint64{5}, []byte{2})},
+			},
+			want: []*ethpb.AttesterSlashing{{
+				Attestation_1: createAttestationWrapper(1, 6, []uint64{5}, []byte{2}).IndexedAttestation,
+				Attestation_2: createAttestationWrapper(4, 5, []uint64{5}, []byte{1}).IndexedAttestation,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				params: &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 8},
+				serviceCfg: &ServiceConfig{
+					Database: dbtest.SetupSlasherDB(t),
+				},
+			}
+			var got []*ethpb.AttesterSlashing
+			for _, batch := range tt.batches {
+				slashings, err := s.checkSlashableAttestations(context.Background(), 6, batch)
+				require.NoError(t, err)
+				got = append(got, slashings...)
+			}
+			require.Equal(t, len(tt.want), len(got))
+			for i := range tt.want {
+				assert.DeepEqual(t, tt.want[i], got[i])
+			}
+		})
+	}
+}
+
+func TestService_checkSlashableAttestations_ResetsStaleSpans(t *testing.T) {
+	s := &Service{
+		params: &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 8},
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+	ctx := context.Background()
+	slashings, err := s.checkSlashableAttestations(ctx, 7, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(0, 7, []uint64{0}, []byte{1}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(slashings))
+
+	// The max spans written for epochs 1 to 6 fall out of the history by epoch 12, and
+	// epochs 9 to 14 reuse their cells. Left in place, they would hide the second
+	// attestation being surrounded by the first one.
+	first := createAttestationWrapper(8, 12, []uint64{0}, []byte{2})
+	second := createAttestationWrapper(10, 11, []uint64{0}, []byte{3})
+	slashings, err = s.checkSlashableAttestations(ctx, 12, []*slashertypes.IndexedAttestationWrapper{first, second})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.DeepEqual(t, &ethpb.AttesterSlashing{
+		Attestation_1: first.IndexedAttestation,
+		Attestation_2: second.IndexedAttestation,
+	}, slashings[0])
+}
+
+func TestService_processQueuedAttestations(t *testing.T) {
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	pool := &slashings.PoolMock{}
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:             dbtest.SetupSlasherDB(t),
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			SlashingPoolInserter: pool,
+		},
+		attsQueue: newAttestationsQueue(),
+	}
+	s.attsQueue.extend([]*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(1, 2, []uint64{0}, []byte{1}),
+		createAttestationWrapper(0, 3, []uint64{0}, []byte{2}),
+		// Deferred, as its target is in the future.
+		createAttestationWrapper(3, 5, []uint64{0}, []byte{3}),
+		// Dropped, as its source is not before its target.
+		createAttestationWrapper(2, 2, []uint64{0}, []byte{4}),
+	})
+	require.NoError(t, s.processQueuedAttestations(context.Background(), 4))
+
+	require.Equal(t, 1, len(pool.PendingAttSlashings))
+	assert.DeepEqual(t, &ethpb.AttesterSlashing{
+		Attestation_1: createAttestationWrapper(0, 3, []uint64{0}, []byte{2}).IndexedAttestation,
+		Attestation_2: createAttestationWrapper(1, 2, []uint64{0}, []byte{1}).IndexedAttestation,
+	}, pool.PendingAttSlashings[0])
+	require.Equal(t, 1, s.attsQueue.size())
+	assert.Equal(t, types.Epoch(5), s.attsQueue.dequeue()[0].IndexedAttestation.Data.Target.Epoch)
+}
+
+func createAttestationWrapper(
+	source, target types.Epoch, indices []uint64, signingRoot []byte,
+) *slashertypes.IndexedAttestationWrapper {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: bytesutil.PadTo(signingRoot, 32),
+		Source: &ethpb.Checkpoint{
+			Epoch: source,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+		Target: &ethpb.Checkpoint{
+			Epoch: target,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+	}
+	return &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
@@ -0,0 +1,48 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Detects double block proposals for a batch of signed block headers, both within the
+// batch itself and against proposals previously saved to disk. The batch is saved to
+// disk once checked.
+func (s *Service) detectProposerSlashings(
+	ctx context.Context, proposedBlocks []*slashertypes.SignedBlockHeaderWrapper,
+) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.detectProposerSlashings")
+	defer span.End()
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	seen := make(map[string]*slashertypes.SignedBlockHeaderWrapper)
+	for _, blk := range proposedBlocks {
+		header := blk.SignedBeaconBlockHeader.Header
+		key := fmt.Sprintf("%d:%d", header.Slot, header.ProposerIndex)
+		existing, ok := seen[key]
+		if !ok {
+			seen[key] = blk
+			continue
+		}
+		if existing.SigningRoot != blk.SigningRoot {
+			slashings = append(slashings, &ethpb.ProposerSlashing{
+				Header_1: existing.SignedBeaconBlockHeader,
+				Header_2: blk.SignedBeaconBlockHeader,
+			})
+		}
+	}
+	onDisk, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, proposedBlocks)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double proposals on disk")
+	}
+	slashings = append(slashings, onDisk...)
+	if err := s.serviceCfg.Database.SaveBlockProposals(ctx, proposedBlocks); err != nil {
+		return nil, errors.Wrap(err, "could not save safe proposals")
+	}
+	doubleProposalsTotal.Add(float64(len(slashings)))
+	return slashings, nil
+}
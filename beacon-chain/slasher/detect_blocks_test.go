@@ -0,0 +1,95 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_detectProposerSlashings(t *testing.T) {
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+	ctx := context.Background()
+	slashings, err := s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(1, 1, []byte{1}),
+		createProposalWrapper(2, 1, []byte{1}),
+		// Same proposal received twice.
+		createProposalWrapper(2, 1, []byte{1}),
+		// Double proposal within the batch.
+		createProposalWrapper(3, 2, []byte{1}),
+		createProposalWrapper(3, 2, []byte{2}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.DeepEqual(t, &ethpb.ProposerSlashing{
+		Header_1: createProposalWrapper(3, 2, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(3, 2, []byte{2}).SignedBeaconBlockHeader,
+	}, slashings[0])
+
+	// Double proposal against a proposal of the previous batch.
+	slashings, err = s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(1, 1, []byte{2}),
+		createProposalWrapper(4, 1, []byte{2}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.DeepEqual(t, &ethpb.ProposerSlashing{
+		Header_1: createProposalWrapper(1, 1, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(1, 1, []byte{2}).SignedBeaconBlockHeader,
+	}, slashings[0])
+}
+
+func TestService_processQueuedBlocks_LogsGossipProvenance(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+	ctx := context.Background()
+	prev := createProposalWrapper(1, 1, []byte{1})
+	prev.Provenance = &ethpb.GossipProvenance{Topic: "/eth2/00000000/beacon_block/ssz_snappy", PeerId: "peer1"}
+	blk := createProposalWrapper(1, 1, []byte{2})
+	blk.Provenance = &ethpb.GossipProvenance{Topic: "/eth2/00000000/beacon_block/ssz_snappy", PeerId: "peer2"}
+	batch := []*slashertypes.SignedBlockHeaderWrapper{prev, blk}
+	slashings, err := s.detectProposerSlashings(ctx, batch)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+
+	logProposerSlashing(slashings[0], blockHeaderProvenances(batch))
+	require.LogsContain(t, hook, "prevGossipPeer=peer1")
+	require.LogsContain(t, hook, "gossipPeer=peer2")
+	require.LogsContain(t, hook, "gossipTopic=/eth2/00000000/beacon_block/ssz_snappy")
+}
+
+func createProposalWrapper(
+	slot types.Slot, proposerIndex types.ValidatorIndex, signingRoot []byte,
+) *slashertypes.SignedBlockHeaderWrapper {
+	return &slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposerIndex,
+				ParentRoot:    params.BeaconConfig().ZeroHash[:],
+				StateRoot:     bytesutil.PadTo(signingRoot, 32),
+				BodyRoot:      params.BeaconConfig().ZeroHash[:],
+			},
+			Signature: params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
@@ -0,0 +1,211 @@
+package slasher
+
+import (
+	"bytes"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/sirupsen/logrus"
+)
+
+// Group a list of attestations into batches by validator chunk index.
+// This way, each batch only touches the min and max span chunks of a single
+// validator chunk index, allowing us to effectively use a single 2D chunk
+// for slashing detection through this logical grouping.
+func (s *Service) groupByValidatorChunkIndex(
+	attestations []*slashertypes.IndexedAttestationWrapper,
+) map[uint64][]*slashertypes.IndexedAttestationWrapper {
+	groupedAttestations := make(map[uint64][]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range attestations {
+		validatorChunkIndices := make(map[uint64]bool)
+		for _, validatorIdx := range att.IndexedAttestation.AttestingIndices {
+			validatorChunkIndex := s.params.validatorChunkIndex(types.ValidatorIndex(validatorIdx))
+			validatorChunkIndices[validatorChunkIndex] = true
+		}
+		for validatorChunkIndex := range validatorChunkIndices {
+			groupedAttestations[validatorChunkIndex] = append(
+				groupedAttestations[validatorChunkIndex],
+				att,
+			)
+		}
+	}
+	return groupedAttestations
+}
+
+// Splits a batch of attestations into the ones which can be checked in the current epoch,
+// the ones with a target epoch in the future which are deferred to a later batch, and
+// drops the ones which are malformed or too old to fall within the history we keep.
+func (s *Service) filterAttestations(
+	atts []*slashertypes.IndexedAttestationWrapper, currentEpoch types.Epoch,
+) (valid, validInFuture []*slashertypes.IndexedAttestationWrapper, numDropped int) {
+	valid = make([]*slashertypes.IndexedAttestationWrapper, 0, len(atts))
+	validInFuture = make([]*slashertypes.IndexedAttestationWrapper, 0)
+	for _, attWrapper := range atts {
+		if attWrapper == nil || !validateAttestationIntegrity(attWrapper.IndexedAttestation) {
+			numDropped++
+			continue
+		}
+		// If an attestation's source is epoch is older than the max history length
+		// we keep track of for slashing detection, we drop it.
+		if attWrapper.IndexedAttestation.Data.Source.Epoch+s.params.historyLength <= currentEpoch {
+			numDropped++
+			continue
+		}
+		// If an attestations's target epoch is in the future, we defer processing for later.
+		if attWrapper.IndexedAttestation.Data.Target.Epoch > currentEpoch {
+			validInFuture = append(validInFuture, attWrapper)
+		} else {
+			valid = append(valid, attWrapper)
+		}
+	}
+	return
+}
+
+// Validates the attestation data integrity, ensuring we have no nil values for
+// source and target epochs, and that the source epoch of the attestation must
+// be less than the target epoch, which is a precondition for performing slashing
+// detection (except for the genesis epoch).
+func validateAttestationIntegrity(att *ethpb.IndexedAttestation) bool {
+	// If an attestation is malformed, we drop it.
+	if att == nil ||
+		att.Data == nil ||
+		att.Data.Source == nil ||
+		att.Data.Target == nil {
+		return false
+	}
+
+	sourceEpoch := att.Data.Source.Epoch
+	targetEpoch := att.Data.Target.Epoch
+
+	// The genesis epoch is a special case, since all attestations formed in it
+	// will have source and target 0, and they should be considered valid.
+	if sourceEpoch == 0 && targetEpoch == 0 {
+		return true
+	}
+	return sourceEpoch < targetEpoch
+}
+
+// Validates the signed beacon block header integrity, ensuring we have no nil values.
+func validateBlockHeaderIntegrity(header *ethpb.SignedBeaconBlockHeader) bool {
+	// If a signed block header is malformed, we drop it.
+	if header == nil ||
+		header.Header == nil ||
+		len(header.Signature) != 96 ||
+		bytes.Equal(header.Signature, make([]byte, 96)) {
+		return false
+	}
+	return true
+}
+
+// Returns the validator indices attesting in a batch of attestations which belong
+// to a validator chunk index, without duplicates.
+func validatorsInAttestations(
+	params *Parameters, validatorChunkIdx uint64, atts []*slashertypes.IndexedAttestationWrapper,
+) []types.ValidatorIndex {
+	seen := make(map[types.ValidatorIndex]bool)
+	indices := make([]types.ValidatorIndex, 0)
+	for _, att := range atts {
+		for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+			validatorIdx := types.ValidatorIndex(valIdx)
+			if seen[validatorIdx] || params.validatorChunkIndex(validatorIdx) != validatorChunkIdx {
+				continue
+			}
+			seen[validatorIdx] = true
+			indices = append(indices, validatorIdx)
+		}
+	}
+	return indices
+}
+
+// Removes duplicate attester slashings, which arise when several validators take part
+// in the same pair of slashable attestations.
+func dedupeAttesterSlashings(slashings []*ethpb.AttesterSlashing) []*ethpb.AttesterSlashing {
+	seen := make(map[[32]byte]bool)
+	deduped := make([]*ethpb.AttesterSlashing, 0, len(slashings))
+	for _, slashing := range slashings {
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			deduped = append(deduped, slashing)
+			continue
+		}
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		deduped = append(deduped, slashing)
+	}
+	return deduped
+}
+
+// Maps the indexed attestations of a batch to the gossip topic and peer they were
+// received from, so detected slashings can be logged with where they came from.
+func attestationProvenances(
+	atts []*slashertypes.IndexedAttestationWrapper,
+) map[*ethpb.IndexedAttestation]*ethpb.GossipProvenance {
+	provenances := make(map[*ethpb.IndexedAttestation]*ethpb.GossipProvenance, len(atts))
+	for _, att := range atts {
+		if att.Provenance != nil {
+			provenances[att.IndexedAttestation] = att.Provenance
+		}
+	}
+	return provenances
+}
+
+// Maps the signed block headers of a batch to the gossip topic and peer their blocks
+// were received from, so detected slashings can be logged with where they came from.
+func blockHeaderProvenances(
+	blocks []*slashertypes.SignedBlockHeaderWrapper,
+) map[*ethpb.SignedBeaconBlockHeader]*ethpb.GossipProvenance {
+	provenances := make(map[*ethpb.SignedBeaconBlockHeader]*ethpb.GossipProvenance, len(blocks))
+	for _, blk := range blocks {
+		if blk.Provenance != nil {
+			provenances[blk.SignedBeaconBlockHeader] = blk.Provenance
+		}
+	}
+	return provenances
+}
+
+// Adds the gossip topic and peer of a slashable message to the fields of a slashing log,
+// unless the message was not received over gossip or was loaded from disk.
+func addGossipProvenance(fields logrus.Fields, topicKey, peerKey string, provenance *ethpb.GossipProvenance) {
+	if provenance == nil {
+		return
+	}
+	fields[topicKey] = provenance.Topic
+	fields[peerKey] = provenance.PeerId
+}
+
+func logAttesterSlashing(
+	slashing *ethpb.AttesterSlashing, provenances map[*ethpb.IndexedAttestation]*ethpb.GossipProvenance,
+) {
+	att1 := slashing.Attestation_1
+	att2 := slashing.Attestation_2
+	fields := logrus.Fields{
+		"validatorIndices": sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices),
+		"prevSourceEpoch":  att1.Data.Source.Epoch,
+		"prevTargetEpoch":  att1.Data.Target.Epoch,
+		"sourceEpoch":      att2.Data.Source.Epoch,
+		"targetEpoch":      att2.Data.Target.Epoch,
+	}
+	addGossipProvenance(fields, "prevGossipTopic", "prevGossipPeer", provenances[att1])
+	addGossipProvenance(fields, "gossipTopic", "gossipPeer", provenances[att2])
+	log.WithFields(fields).Info("Attester slashing detected")
+}
+
+func logProposerSlashing(
+	slashing *ethpb.ProposerSlashing, provenances map[*ethpb.SignedBeaconBlockHeader]*ethpb.GossipProvenance,
+) {
+	fields := logrus.Fields{
+		"validatorIndex": slashing.Header_1.Header.ProposerIndex,
+		"slot":           slashing.Header_1.Header.Slot,
+		"prevBodyRoot":   fmt.Sprintf("%#x", bytesutil.Trunc(slashing.Header_1.Header.BodyRoot)),
+		"bodyRoot":       fmt.Sprintf("%#x", bytesutil.Trunc(slashing.Header_2.Header.BodyRoot)),
+	}
+	addGossipProvenance(fields, "prevGossipTopic", "prevGossipPeer", provenances[slashing.Header_1])
+	addGossipProvenance(fields, "gossipTopic", "gossipPeer", provenances[slashing.Header_2])
+	log.WithFields(fields).Info("Proposer slashing detected")
+}
@@ -0,0 +1,57 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	processedAttestationsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_attestations_processed_total",
+			Help: "The number of attestations checked for slashable offenses.",
+		},
+	)
+	droppedAttestationsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_attestations_dropped_total",
+			Help: "The number of malformed or too old attestations dropped without being checked.",
+		},
+	)
+	deferredAttestationsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_attestations_deferred_total",
+			Help: "The number of attestations with a future target epoch deferred to a later batch.",
+		},
+	)
+	processedBlocksTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_blocks_processed_total",
+			Help: "The number of block headers checked for slashable offenses.",
+		},
+	)
+	doubleVotesTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_double_votes_total",
+			Help: "The number of attester double votes detected.",
+		},
+	)
+	surroundingVotesTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_surrounding_votes_total",
+			Help: "The number of attestations detected surrounding an earlier attestation.",
+		},
+	)
+	surroundedVotesTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_surrounded_votes_total",
+			Help: "The number of attestations detected surrounded by an earlier attestation.",
+		},
+	)
+	doubleProposalsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_double_proposals_total",
+			Help: "The number of double block proposals detected.",
+		},
+	)
+)
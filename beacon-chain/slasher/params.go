@@ -0,0 +1,187 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+)
+
+// Parameters for slashing detection.
+//
+// To properly access the element at epoch `e` for a validator index `i`, we leverage helper
+// functions from these parameter values as nice abstractions. The following parameters are
+// required for the helper functions defined in this file.
+//
+// (C) chunkSize defines how many elements are in a chunk for a validator
+// min or max span slice.
+// (K) validatorChunkSize defines how many validators' chunks we store in a single
+// flat byte slice on disk.
+// (H) historyLength defines how many epochs we keep of min or max spans.
+type Parameters struct {
+	chunkSize          uint64
+	validatorChunkSize uint64
+	historyLength      types.Epoch
+}
+
+// DefaultParams defines default values for slasher's important parameters, defined
+// based on optimization analysis for best and worst case scenarios for
+// slasher's performance.
+func DefaultParams() *Parameters {
+	return &Parameters{
+		chunkSize:          16,
+		validatorChunkSize: 256,
+		historyLength:      4096,
+	}
+}
+
+// NewParams creates slasher parameters from a chunk size, validator chunk size and
+// history length. The history length must be a multiple of the chunk size, so the spans
+// of a validator are split into whole chunks, and below the undefined min span distance.
+func NewParams(chunkSize, validatorChunkSize uint64, historyLength types.Epoch) (*Parameters, error) {
+	if chunkSize == 0 || validatorChunkSize == 0 || historyLength == 0 {
+		return nil, errors.New("chunk size, validator chunk size and history length must be positive")
+	}
+	if uint64(historyLength)%chunkSize != 0 {
+		return nil, fmt.Errorf("history length %d is not a multiple of chunk size %d", historyLength, chunkSize)
+	}
+	if historyLength >= math.MaxUint16 {
+		return nil, fmt.Errorf("history length %d must be below %d", historyLength, math.MaxUint16)
+	}
+	return &Parameters{
+		chunkSize:          chunkSize,
+		validatorChunkSize: validatorChunkSize,
+		historyLength:      historyLength,
+	}, nil
+}
+
+// LoadParams retrieves the parameters the span chunks of a slasher database are laid
+// out with. Databases which predate saving the parameters were written with the
+// default parameters.
+func LoadParams(ctx context.Context, database db.SlasherDatabase) (*Parameters, error) {
+	chunkParams, exists, err := database.ChunkParameters(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chunk parameters")
+	}
+	if !exists {
+		return DefaultParams(), nil
+	}
+	return NewParams(chunkParams.ChunkSize, chunkParams.ValidatorChunkSize, chunkParams.HistoryLength)
+}
+
+// ChunkParameters returns the chunk layout defined by the parameters, as saved to
+// the slasher database.
+func (p *Parameters) ChunkParameters() *slashertypes.ChunkParameters {
+	return &slashertypes.ChunkParameters{
+		ChunkSize:          p.chunkSize,
+		ValidatorChunkSize: p.validatorChunkSize,
+		HistoryLength:      p.historyLength,
+	}
+}
+
+// The number of chunks the span of a validator is split into, which is the number of
+// flat slice IDs between consecutive validator chunk indices.
+func (p *Parameters) chunksPerValidatorChunk() uint64 {
+	return uint64(p.historyLength.Div(p.chunkSize))
+}
+
+// Validator min and max spans are split into chunks of length C = chunkSize.
+// That is, if we are keeping N epochs worth of attesting history, finding what
+// chunk a certain epoch, e, falls into can be computed as (e % N) / C. For example,
+// if we are keeping 6 epochs worth of data, and we have chunks of size 2, then epoch
+// 4 will fall into chunk index (4 % 6) / 2 = 2.
+//
+//  span    = [-, -, -, -, -, -]
+//  chunked = [[-, -], [-, -], [-, -]]
+//                              |-> epoch 4, chunk idx 2
+//
+func (p *Parameters) chunkIndex(epoch types.Epoch) uint64 {
+	return uint64(epoch.Mod(uint64(p.historyLength)).Div(p.chunkSize))
+}
+
+// When storing data on disk, we take K validators' chunks. To figure out
+// which validator chunk index a validator index is for, we simply divide
+// the validator index, i, by K.
+func (p *Parameters) validatorChunkIndex(validatorIndex types.ValidatorIndex) uint64 {
+	return uint64(validatorIndex.Div(p.validatorChunkSize))
+}
+
+// Given a validator index, and epoch, we compute the exact index
+// into our flat slice on disk which stores K validators' chunks, each
+// chunk of size C. For example, if C = 3 and K = 3, the data we store
+// on disk is a flat slice as follows:
+//
+//    val0     val1     val2
+//     |        |        |
+//   {   }    {   }    {   }
+//  [-, -, -, -, -, -, -, -, -]
+//
+// Then, figuring out the exact cell index for epoch 1 for validator index 1 can be
+// computed as (validatorIndex % K)*C + (epoch % C), which gives us:
+//
+//  (1 % 3)*3 + (1 % 3) =
+//  (1*3) + 1 =
+//  4
+//
+//    val0     val1     val2
+//     |        |        |
+//   {   }    {   }    {   }
+//  [-, -, -, -, -, -, -, -, -]
+//               |-> epoch 1 for val1
+//
+func (p *Parameters) cellIndex(validatorIndex types.ValidatorIndex, epoch types.Epoch) uint64 {
+	validatorChunkOffset := p.validatorOffset(validatorIndex)
+	chunkOffset := p.chunkOffset(epoch)
+	return validatorChunkOffset*p.chunkSize + chunkOffset
+}
+
+// Computes the start index of a chunk given an epoch.
+func (p *Parameters) chunkOffset(epoch types.Epoch) uint64 {
+	return uint64(epoch.Mod(p.chunkSize))
+}
+
+// Computes the start index of a validator chunk given a validator index.
+func (p *Parameters) validatorOffset(validatorIndex types.ValidatorIndex) uint64 {
+	return uint64(validatorIndex.Mod(p.validatorChunkSize))
+}
+
+// Construct a key for our database schema given a validator chunk index and chunk index.
+// This calculation gives us a uint encoded as bytes that uniquely represents
+// a 2D chunk given a validator index and epoch value.
+// First, we compute the validator chunk index for the validator index,
+// Then, we compute the chunk index for the epoch.
+// If chunkSize C = 3 and validatorChunkSize K = 3, and historyLength H = 12,
+// if we are looking for epoch 6 and validator 6, then
+//
+//  validatorChunkIndex = 6 / 3 = 2
+//  chunkIndex = (6 % historyLength) / 3 = (6 % 12) / 3 = 2
+//
+// Then we compute how many chunks there are per max span, known as the "width"
+//
+//  width = H / C = 12 / 3 = 4
+//
+// So every span has 4 chunks. Then, we have a disk key calculated by
+//
+//  validatorChunkIndex * width + chunkIndex = 2*4 + 2 = 10
+//
+func (p *Parameters) flatSliceID(validatorChunkIndex, chunkIndex uint64) []byte {
+	width := p.historyLength.Div(p.chunkSize)
+	return ssz.MarshalUint64(make([]byte, 0), uint64(width.Mul(validatorChunkIndex).Add(chunkIndex)))
+}
+
+// Given a validator chunk index, we determine all of the validator
+// indices that will belong in that chunk.
+func (p *Parameters) validatorIndicesInChunk(validatorChunkIdx uint64) []types.ValidatorIndex {
+	validatorIndices := make([]types.ValidatorIndex, 0)
+	low := validatorChunkIdx * p.validatorChunkSize
+	high := (validatorChunkIdx + 1) * p.validatorChunkSize
+	for i := low; i < high; i++ {
+		validatorIndices = append(validatorIndices, types.ValidatorIndex(i))
+	}
+	return validatorIndices
+}
@@ -0,0 +1,86 @@
+package slasher
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestParams_chunkIndex(t *testing.T) {
+	p := &Parameters{chunkSize: 3, historyLength: 12}
+	tests := []struct {
+		epoch types.Epoch
+		want  uint64
+	}{
+		{epoch: 0, want: 0},
+		{epoch: 2, want: 0},
+		{epoch: 3, want: 1},
+		{epoch: 11, want: 3},
+		// Epochs wrap around the history length.
+		{epoch: 12, want: 0},
+		{epoch: 16, want: 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, p.chunkIndex(tt.epoch))
+	}
+}
+
+func TestParams_cellIndex(t *testing.T) {
+	p := &Parameters{chunkSize: 3, validatorChunkSize: 3}
+	assert.Equal(t, uint64(0), p.cellIndex(0, 0))
+	assert.Equal(t, uint64(4), p.cellIndex(1, 1))
+	assert.Equal(t, uint64(8), p.cellIndex(2, 2))
+	// Validator 3 is the first validator of the next validator chunk.
+	assert.Equal(t, uint64(1), p.cellIndex(3, 4))
+}
+
+func TestParams_validatorChunkIndex(t *testing.T) {
+	p := &Parameters{validatorChunkSize: 3}
+	assert.Equal(t, uint64(0), p.validatorChunkIndex(2))
+	assert.Equal(t, uint64(1), p.validatorChunkIndex(3))
+	assert.Equal(t, uint64(3), p.validatorChunkIndex(11))
+}
+
+func TestParams_flatSliceID(t *testing.T) {
+	p := &Parameters{chunkSize: 3, validatorChunkSize: 3, historyLength: 12}
+	assert.DeepEqual(t, ssz.MarshalUint64(make([]byte, 0), 10), p.flatSliceID(2, 2))
+	assert.DeepEqual(t, ssz.MarshalUint64(make([]byte, 0), 0), p.flatSliceID(0, 0))
+}
+
+func TestParams_validatorIndicesInChunk(t *testing.T) {
+	p := &Parameters{validatorChunkSize: 3}
+	assert.DeepEqual(t, []types.ValidatorIndex{3, 4, 5}, p.validatorIndicesInChunk(1))
+}
+
+func TestNewParams(t *testing.T) {
+	p, err := NewParams(3, 4, 12)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &Parameters{chunkSize: 3, validatorChunkSize: 4, historyLength: 12}, p)
+
+	_, err = NewParams(0, 4, 12)
+	assert.ErrorContains(t, "must be positive", err)
+	_, err = NewParams(5, 4, 12)
+	assert.ErrorContains(t, "not a multiple of chunk size", err)
+	_, err = NewParams(1, 4, math.MaxUint16)
+	assert.ErrorContains(t, "must be below", err)
+}
+
+func TestLoadParams(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.SetupSlasherDB(t)
+	p, err := LoadParams(ctx, database)
+	require.NoError(t, err)
+	assert.DeepEqual(t, DefaultParams(), p)
+
+	want := &Parameters{chunkSize: 3, validatorChunkSize: 4, historyLength: 12}
+	require.NoError(t, database.SaveChunkParameters(ctx, want.ChunkParameters()))
+	p, err = LoadParams(ctx, database)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, p)
+}
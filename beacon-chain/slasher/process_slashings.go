@@ -0,0 +1,58 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// Inserts attester slashings found by slasher into the beacon node's slashings pool,
+// which verifies them against the head state before accepting them for inclusion
+// in a block. Slashings are logged with the gossip provenance of their attestations,
+// if known.
+func (s *Service) processAttesterSlashings(
+	ctx context.Context,
+	slashings []*ethpb.AttesterSlashing,
+	provenances map[*ethpb.IndexedAttestation]*ethpb.GossipProvenance,
+) error {
+	if len(slashings) == 0 {
+		return nil
+	}
+	beaconState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	for _, slashing := range slashings {
+		logAttesterSlashing(slashing, provenances)
+		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, beaconState, slashing); err != nil {
+			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+		}
+	}
+	return nil
+}
+
+// Inserts proposer slashings found by slasher into the beacon node's slashings pool,
+// which verifies them against the head state before accepting them for inclusion
+// in a block. Slashings are logged with the gossip provenance of their block headers,
+// if known.
+func (s *Service) processProposerSlashings(
+	ctx context.Context,
+	slashings []*ethpb.ProposerSlashing,
+	provenances map[*ethpb.SignedBeaconBlockHeader]*ethpb.GossipProvenance,
+) error {
+	if len(slashings) == 0 {
+		return nil
+	}
+	beaconState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	for _, slashing := range slashings {
+		logProposerSlashing(slashing, provenances)
+		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
+			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Attestation and proposal records are pruned this many epochs
+	// at a time, each in its own database transaction.
+	pruningEpochIncrements = types.Epoch(10)
+)
+
+// Receive indexed attestations from some source event feed,
+// validating their integrity before appending them to an attestation queue
+// for batch processing in a separate routine. The feed carries wrapped
+// attestations along with where they were received from, their signing
+// root is computed here.
+func (s *Service) receiveAttestations(ctx context.Context) {
+	indexedAttsChan := make(chan *slashertypes.IndexedAttestationWrapper, 1)
+	subscription := s.serviceCfg.IndexedAttestationsFeed.Subscribe(indexedAttsChan)
+	defer subscription.Unsubscribe()
+	for {
+		select {
+		case wrapped := <-indexedAttsChan:
+			if wrapped == nil || !validateAttestationIntegrity(wrapped.IndexedAttestation) {
+				droppedAttestationsTotal.Inc()
+				continue
+			}
+			signingRoot, err := wrapped.IndexedAttestation.Data.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of attestation")
+				continue
+			}
+			s.attsQueue.push(&slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: wrapped.IndexedAttestation,
+				SigningRoot:        signingRoot,
+				Provenance:         wrapped.Provenance,
+			})
+		case err := <-subscription.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Receive beacon blocks from some source event feed,
+// validating their integrity before appending them to a block queue
+// for batch processing in a separate routine. The feed carries wrapped
+// block headers along with where their blocks were received from, their
+// signing root is computed here.
+func (s *Service) receiveBlocks(ctx context.Context) {
+	beaconBlockHeadersChan := make(chan *slashertypes.SignedBlockHeaderWrapper, 1)
+	subscription := s.serviceCfg.BeaconBlockHeadersFeed.Subscribe(beaconBlockHeadersChan)
+	defer subscription.Unsubscribe()
+	for {
+		select {
+		case wrapped := <-beaconBlockHeadersChan:
+			if wrapped == nil || !validateBlockHeaderIntegrity(wrapped.SignedBeaconBlockHeader) {
+				continue
+			}
+			signingRoot, err := wrapped.SignedBeaconBlockHeader.Header.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of signed block header")
+				continue
+			}
+			s.blksQueue.push(&slashertypes.SignedBlockHeaderWrapper{
+				SignedBeaconBlockHeader: wrapped.SignedBeaconBlockHeader,
+				SigningRoot:             signingRoot,
+				Provenance:              wrapped.Provenance,
+			})
+		case err := <-subscription.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Process queued attestations and blocks at the start of every epoch. The queued
+// attestations are filtered and checked for slashable offenses as one batch, deferring
+// the ones with a future target epoch to the next batch, while the queued blocks are
+// checked for double proposals. Found slashings are submitted to the slashings pool.
+// Once both batches of an epoch are processed, the previous epoch is checkpointed as the
+// last processed one, so detection can resume from it after a restart.
+func (s *Service) processQueued(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			if !helpers.IsEpochStart(currentSlot) {
+				continue
+			}
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+			attsErr := s.processQueuedAttestations(ctx, currentEpoch)
+			if attsErr != nil {
+				log.WithError(attsErr).Error("Could not process queued attestations")
+			}
+			blksErr := s.processQueuedBlocks(ctx, currentEpoch)
+			if blksErr != nil {
+				log.WithError(blksErr).Error("Could not process queued blocks")
+			}
+			if attsErr == nil && blksErr == nil {
+				s.checkpoint(ctx, currentEpoch)
+			}
+			s.pruneSlasherData(ctx, currentEpoch)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) processQueuedAttestations(ctx context.Context, currentEpoch types.Epoch) error {
+	atts := s.attsQueue.dequeue()
+	validAtts, validInFuture, numDropped := s.filterAttestations(atts, currentEpoch)
+	deferredAttestationsTotal.Add(float64(len(validInFuture)))
+	droppedAttestationsTotal.Add(float64(numDropped))
+	s.attsQueue.extend(validInFuture)
+
+	log.WithFields(logrus.Fields{
+		"currentEpoch":    currentEpoch,
+		"numValidAtts":    len(validAtts),
+		"numDeferredAtts": len(validInFuture),
+		"numDroppedAtts":  numDropped,
+	}).Debug("Processing queued attestations for slashing detection")
+
+	slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+	if err != nil {
+		return errors.Wrap(err, "could not check slashable attestations")
+	}
+	processedAttestationsTotal.Add(float64(len(validAtts)))
+	if err := s.processAttesterSlashings(ctx, slashings, attestationProvenances(validAtts)); err != nil {
+		return errors.Wrap(err, "could not process attester slashings")
+	}
+	return nil
+}
+
+func (s *Service) processQueuedBlocks(ctx context.Context, currentEpoch types.Epoch) error {
+	blocks := s.blksQueue.dequeue()
+	log.WithFields(logrus.Fields{
+		"currentEpoch": currentEpoch,
+		"numBlocks":    len(blocks),
+	}).Debug("Processing queued blocks for slashing detection")
+
+	slashings, err := s.detectProposerSlashings(ctx, blocks)
+	if err != nil {
+		return errors.Wrap(err, "could not detect proposer slashings")
+	}
+	processedBlocksTotal.Add(float64(len(blocks)))
+	if err := s.processProposerSlashings(ctx, slashings, blockHeaderProvenances(blocks)); err != nil {
+		return errors.Wrap(err, "could not process proposer slashings")
+	}
+	return nil
+}
+
+// Saves the epoch preceding the current one as the last processed epoch, as all of its
+// attestations and blocks were part of the batch processed at the start of the current one.
+func (s *Service) checkpoint(ctx context.Context, currentEpoch types.Epoch) {
+	if !s.checkpointing || currentEpoch == 0 {
+		return
+	}
+	if err := s.serviceCfg.Database.SaveLastProcessedEpoch(ctx, currentEpoch-1); err != nil {
+		log.WithError(err).Error("Could not save last processed epoch")
+	}
+}
+
+// Prunes attestation and proposal records which fell out of the history we keep.
+func (s *Service) pruneSlasherData(ctx context.Context, currentEpoch types.Epoch) {
+	if err := s.serviceCfg.Database.PruneAttestations(
+		ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength,
+	); err != nil {
+		log.WithError(err).Error("Could not prune attestations")
+	}
+	if err := s.serviceCfg.Database.PruneProposals(
+		ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength,
+	); err != nil {
+		log.WithError(err).Error("Could not prune proposals")
+	}
+}
@@ -0,0 +1,150 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// The number of validator chunks of the new layout rechunked between progress logs.
+const rechunkLogInterval = 1000
+
+// Rechunk migrates the min and max span chunks of a slasher database to new chunk
+// parameters, writing them to another database along with the new parameters. Span
+// distances do not depend on the chunk layout, so for every validator the cells of the
+// epochs kept by both the old and the new history length, up to the last epoch written
+// for the validator, are copied as is. All other cells are left neutral, and chunks
+// without any data are not written.
+func Rechunk(ctx context.Context, src, dst db.SlasherDatabase, newParams *Parameters) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.Rechunk")
+	defer span.End()
+	oldParams, err := LoadParams(ctx, src)
+	if err != nil {
+		return err
+	}
+	attestedEpochs, err := src.LastEpochWrittenForAllValidators(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get last epoch written for validators")
+	}
+	log.WithFields(logrus.Fields{
+		"oldChunkSize":          oldParams.chunkSize,
+		"oldValidatorChunkSize": oldParams.validatorChunkSize,
+		"oldHistoryLength":      oldParams.historyLength,
+		"newChunkSize":          newParams.chunkSize,
+		"newValidatorChunkSize": newParams.validatorChunkSize,
+		"newHistoryLength":      newParams.historyLength,
+		"numValidators":         len(attestedEpochs),
+	}).Info("Rechunking slasher spans")
+	numRechunked := 0
+	for start := 0; start < len(attestedEpochs); {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		validatorChunkIdx := newParams.validatorChunkIndex(attestedEpochs[start].ValidatorIndex)
+		end := start
+		for end < len(attestedEpochs) &&
+			newParams.validatorChunkIndex(attestedEpochs[end].ValidatorIndex) == validatorChunkIdx {
+			end++
+		}
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			if err := rechunkValidatorChunk(
+				ctx, src, dst, oldParams, newParams, kind, validatorChunkIdx, attestedEpochs[start:end],
+			); err != nil {
+				return errors.Wrapf(err, "could not rechunk validator chunk %d", validatorChunkIdx)
+			}
+		}
+		numRechunked++
+		if numRechunked%rechunkLogInterval == 0 {
+			log.WithField("numValidatorChunks", numRechunked).Info("Rechunking slasher spans")
+		}
+		start = end
+	}
+	if err := dst.SaveChunkParameters(ctx, newParams.ChunkParameters()); err != nil {
+		return errors.Wrap(err, "could not save chunk parameters")
+	}
+	log.WithField("numValidatorChunks", numRechunked).Info("Rechunked slasher spans")
+	return nil
+}
+
+// Builds the span chunks of one kind for a validator chunk index of the new layout from
+// the spans of its validators in the old layout, and saves them.
+func rechunkValidatorChunk(
+	ctx context.Context,
+	src, dst db.SlasherDatabase,
+	oldParams, newParams *Parameters,
+	kind slashertypes.ChunkKind,
+	validatorChunkIdx uint64,
+	attestedEpochs []*slashertypes.AttestedEpochForValidator,
+) error {
+	var neutral uint16
+	switch kind {
+	case slashertypes.MinSpan:
+		neutral = (&MinSpanChunksSlice{}).NeutralElement()
+	case slashertypes.MaxSpan:
+		neutral = (&MaxSpanChunksSlice{}).NeutralElement()
+	default:
+		return fmt.Errorf("chunk kind %d not supported", kind)
+	}
+	historyLength := oldParams.historyLength
+	if newParams.historyLength < historyLength {
+		historyLength = newParams.historyLength
+	}
+	oldChunks := make(map[string][]uint16)
+	newChunks := make(map[uint64][]uint16)
+	for _, attested := range attestedEpochs {
+		validatorIdx := attested.ValidatorIndex
+		var epoch types.Epoch
+		if attested.Epoch >= historyLength {
+			epoch = attested.Epoch - historyLength + 1
+		}
+		for ; epoch <= attested.Epoch; epoch++ {
+			oldKey := oldParams.flatSliceID(oldParams.validatorChunkIndex(validatorIdx), oldParams.chunkIndex(epoch))
+			oldChunk, ok := oldChunks[string(oldKey)]
+			if !ok {
+				chunks, exists, err := src.LoadSlasherChunks(ctx, kind, [][]byte{oldKey})
+				if err != nil {
+					return errors.Wrap(err, "could not load chunk")
+				}
+				if len(exists) == 1 && exists[0] {
+					oldChunk = chunks[0]
+					if err := validateChunkLength(oldParams, oldChunk); err != nil {
+						return err
+					}
+				}
+				oldChunks[string(oldKey)] = oldChunk
+			}
+			if oldChunk == nil {
+				continue
+			}
+			distance := oldChunk[oldParams.cellIndex(validatorIdx, epoch)]
+			if distance == neutral {
+				continue
+			}
+			chunkIdx := newParams.chunkIndex(epoch)
+			newChunk, ok := newChunks[chunkIdx]
+			if !ok {
+				newChunk = emptyChunk(newParams, neutral)
+				newChunks[chunkIdx] = newChunk
+			}
+			if err := setChunkRawDistance(newParams, newChunk, validatorIdx, epoch, distance); err != nil {
+				return err
+			}
+		}
+	}
+	if len(newChunks) == 0 {
+		return nil
+	}
+	chunkKeys := make([][]byte, 0, len(newChunks))
+	chunks := make([][]uint16, 0, len(newChunks))
+	for chunkIdx, chunk := range newChunks {
+		chunkKeys = append(chunkKeys, newParams.flatSliceID(validatorChunkIdx, chunkIdx))
+		chunks = append(chunks, chunk)
+	}
+	return dst.SaveSlasherChunks(ctx, kind, chunkKeys, chunks)
+}
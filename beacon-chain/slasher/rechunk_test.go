@@ -0,0 +1,93 @@
+package slasher
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRechunk(t *testing.T) {
+	tests := []struct {
+		name      string
+		newParams *Parameters
+	}{
+		{
+			name:      "larger chunks and history",
+			newParams: &Parameters{chunkSize: 3, validatorChunkSize: 3, historyLength: 12},
+		},
+		{
+			name:      "smaller chunks and history",
+			newParams: &Parameters{chunkSize: 2, validatorChunkSize: 1, historyLength: 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			oldParams := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 8}
+			src := dbtest.SetupSlasherDB(t)
+			require.NoError(t, src.SaveChunkParameters(ctx, oldParams.ChunkParameters()))
+			s := &Service{params: oldParams, serviceCfg: &ServiceConfig{Database: src}}
+			slashings, err := s.checkSlashableAttestations(ctx, 6, []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(3, 4, []uint64{0, 1, 5}, []byte{1}),
+				createAttestationWrapper(1, 6, []uint64{2}, []byte{2}),
+			})
+			require.NoError(t, err)
+			require.Equal(t, 0, len(slashings))
+
+			dst := dbtest.SetupSlasherDB(t)
+			srcStore, ok := src.(*slasherkv.Store)
+			require.Equal(t, true, ok)
+			dstStore, ok := dst.(*slasherkv.Store)
+			require.Equal(t, true, ok)
+			require.NoError(t, srcStore.CopyWithoutChunks(ctx, dstStore))
+			require.NoError(t, Rechunk(ctx, src, dst, tt.newParams))
+
+			p, err := LoadParams(ctx, dst)
+			require.NoError(t, err)
+			require.DeepEqual(t, tt.newParams, p)
+
+			// The spans written before rechunking still catch surrounding and surrounded votes.
+			s = &Service{params: p, serviceCfg: &ServiceConfig{Database: dst}}
+			surrounding := createAttestationWrapper(2, 5, []uint64{5}, []byte{3})
+			surrounded := createAttestationWrapper(4, 5, []uint64{2}, []byte{4})
+			slashings, err = s.checkSlashableAttestations(ctx, 6, []*slashertypes.IndexedAttestationWrapper{
+				surrounding, surrounded,
+			})
+			require.NoError(t, err)
+			require.Equal(t, 2, len(slashings))
+			// Surround votes are detected per validator chunk, which are not checked in order.
+			sort.Slice(slashings, func(i, j int) bool {
+				return slashings[i].Attestation_1.Data.Source.Epoch > slashings[j].Attestation_1.Data.Source.Epoch
+			})
+			assert.DeepEqual(t, &ethpb.AttesterSlashing{
+				Attestation_1: surrounding.IndexedAttestation,
+				Attestation_2: createAttestationWrapper(3, 4, []uint64{0, 1, 5}, []byte{1}).IndexedAttestation,
+			}, slashings[0])
+			assert.DeepEqual(t, &ethpb.AttesterSlashing{
+				Attestation_1: createAttestationWrapper(1, 6, []uint64{2}, []byte{2}).IndexedAttestation,
+				Attestation_2: surrounded.IndexedAttestation,
+			}, slashings[1])
+		})
+	}
+}
+
+func TestRechunk_EmptyDatabase(t *testing.T) {
+	ctx := context.Background()
+	src := dbtest.SetupSlasherDB(t)
+	dst := dbtest.SetupSlasherDB(t)
+	newParams, err := NewParams(4, 8, types.Epoch(64))
+	require.NoError(t, err)
+	require.NoError(t, Rechunk(ctx, src, dst, newParams))
+	report, err := Storage(ctx, dst)
+	require.NoError(t, err)
+	assert.DeepEqual(t, newParams.ChunkParameters(), report.ChunkParameters)
+	assert.Equal(t, 0, len(report.ValidatorChunks))
+}
@@ -0,0 +1,158 @@
+// Package slasher implements slashing detection for eth2, able to catch slashable attestations
+// and proposals that it receives via two event feeds, respectively. Any found slashings
+// are then submitted to the beacon node's slashing operations pool.
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// ServiceConfig for the slasher service in the beacon node.
+// This struct allows us to specify required dependencies and
+// parameters for slasher to function as needed.
+type ServiceConfig struct {
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+	Database                db.SlasherDatabase
+	StateNotifier           statefeed.Notifier
+	HeadStateFetcher        blockchain.HeadFetcher
+	SlashingPoolInserter    slashings.PoolInserter
+	BeaconDatabase          db.ReadOnlyDatabase
+	StateGen                stategen.StateManager
+	// BackfillFromEpoch, if set, is the epoch from which stored blocks are replayed
+	// on startup, instead of the epoch following the last checkpointed one.
+	BackfillFromEpoch *types.Epoch
+}
+
+// Service defining a slasher implementation as part of
+// the beacon node, able to detect eth2 slashable offenses.
+type Service struct {
+	params       *Parameters
+	serviceCfg   *ServiceConfig
+	attsQueue    *attestationsQueue
+	blksQueue    *blocksQueue
+	ctx          context.Context
+	cancel       context.CancelFunc
+	stateChannel chan *feed.Event
+	stateSub     event.Subscription
+	// Whether the last processed epoch is checkpointed after each batch. It is
+	// disabled when a backfill fails, as the epochs it missed were never processed.
+	checkpointing bool
+}
+
+// NewService instantiates a new slasher from configuration values. The service
+// subscribes to the state feed right away so it cannot miss the chain initialized
+// event, which may be sent before the service is started.
+func NewService(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
+	p, err := LoadParams(ctx, srvCfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	if err := srvCfg.Database.SaveChunkParameters(ctx, p.ChunkParameters()); err != nil {
+		return nil, errors.Wrap(err, "could not save chunk parameters")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := srvCfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	return &Service{
+		params:        p,
+		serviceCfg:    srvCfg,
+		attsQueue:     newAttestationsQueue(),
+		blksQueue:     newBlocksQueue(),
+		ctx:           ctx,
+		cancel:        cancel,
+		stateChannel:  stateChannel,
+		stateSub:      stateSub,
+		checkpointing: true,
+	}, nil
+}
+
+// Start listening for received indexed attestations and blocks
+// and perform slashing detection on them.
+func (s *Service) Start() {
+	go s.receiveAttestations(s.ctx)
+	go s.receiveBlocks(s.ctx)
+	go s.run()
+}
+
+func (s *Service) run() {
+	defer s.stateSub.Unsubscribe()
+	genesisTime, ok := s.waitForChainInitialization()
+	if !ok {
+		return
+	}
+	slotTicker := slotutil.NewSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer slotTicker.Done()
+	currentEpoch := helpers.SlotToEpoch(slotutil.SlotsSinceGenesis(genesisTime))
+	s.catchUp(s.ctx, currentEpoch)
+	log.WithField("genesisTime", genesisTime).Info("Starting slasher detection")
+	s.processQueued(s.ctx, slotTicker.C())
+}
+
+// Replays the blocks stored while slasher was not running, up to the epoch before the
+// current one, and queues the blocks of the current epoch for the first live batch.
+func (s *Service) catchUp(ctx context.Context, currentEpoch types.Epoch) {
+	startEpoch, ok, err := s.backfillStartEpoch(ctx, currentEpoch)
+	if err != nil {
+		log.WithError(err).Error("Could not determine slasher backfill start epoch")
+		s.checkpointing = false
+		return
+	}
+	if ok {
+		if err := s.Backfill(ctx, startEpoch, currentEpoch-1); err != nil {
+			log.WithError(err).Warn("Could not backfill slasher, disabling detection checkpoints")
+			s.checkpointing = false
+		}
+	}
+	if err := s.queueStoredBlocks(ctx, currentEpoch); err != nil {
+		log.WithError(err).Error("Could not queue stored blocks of the current epoch")
+	}
+}
+
+// Blocks until the state feed notifies the chain is initialized, returning its genesis time.
+func (s *Service) waitForChainInitialization() (time.Time, bool) {
+	for {
+		select {
+		case event := <-s.stateChannel:
+			if event.Type != statefeed.Initialized {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.InitializedData)
+			if !ok {
+				log.Error("Event feed data is not type *statefeed.InitializedData")
+				return time.Time{}, false
+			}
+			return data.StartTime, true
+		case err := <-s.stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return time.Time{}, false
+		case <-s.ctx.Done():
+			return time.Time{}, false
+		}
+	}
+}
+
+// Stop the slasher service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the slasher service.
+func (s *Service) Status() error {
+	return nil
+}
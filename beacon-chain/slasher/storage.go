@@ -0,0 +1,42 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"go.opencensus.io/trace"
+)
+
+// StorageReport describes the disk space used by a slasher database, per bucket and per
+// validator chunk index of the span chunks, along with the chunk parameters the span
+// chunks are laid out with.
+type StorageReport struct {
+	ChunkParameters *slashertypes.ChunkParameters
+	Database        *slashertypes.DatabaseStorage
+	ValidatorChunks []*slashertypes.ValidatorChunkStorage
+}
+
+// Storage reports the disk space used by a slasher database.
+func Storage(ctx context.Context, database db.SlasherDatabase) (*StorageReport, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.Storage")
+	defer span.End()
+	p, err := LoadParams(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	dbStorage, err := database.Storage(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get database storage")
+	}
+	validatorChunks, err := database.ValidatorChunkStorage(ctx, p.chunksPerValidatorChunk())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator chunk storage")
+	}
+	return &StorageReport{
+		ChunkParameters: p.ChunkParameters(),
+		Database:        dbStorage,
+		ValidatorChunks: validatorChunks,
+	}, nil
+}
@@ -15,10 +15,12 @@ const (
 )
 
 // IndexedAttestationWrapper contains an indexed attestation with its
-// signing root to reduce duplicated computation.
+// signing root to reduce duplicated computation, and the gossip topic and
+// peer it was received from, if it was received over gossip.
 type IndexedAttestationWrapper struct {
 	IndexedAttestation *ethpb.IndexedAttestation
 	SigningRoot        [32]byte
+	Provenance         *ethpb.GossipProvenance
 }
 
 // AttesterDoubleVote represents a double vote instance
@@ -39,10 +41,12 @@ type DoubleBlockProposal struct {
 }
 
 // SignedBlockHeaderWrapper contains an signed beacon block header with its
-// signing root to reduce duplicated computation.
+// signing root to reduce duplicated computation, and the gossip topic and
+// peer its block was received from.
 type SignedBlockHeaderWrapper struct {
 	SignedBeaconBlockHeader *ethpb.SignedBeaconBlockHeader
 	SigningRoot             [32]byte
+	Provenance              *ethpb.GossipProvenance
 }
 
 // AttestedEpochForValidator encapsulates a previously attested epoch
@@ -51,3 +55,39 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex types.ValidatorIndex
 	Epoch          types.Epoch
 }
+
+// ChunkParameters defines the layout of the min and max span chunks slasher
+// stores on disk: how many epochs of a validator's span are in a chunk, how many
+// validators' chunks are stored together, and how many epochs of spans are kept.
+type ChunkParameters struct {
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      types.Epoch
+}
+
+// DatabaseStorage describes the disk space used by the slasher database. Space freed
+// by deletions is kept in the database file for reuse, which is reported as free bytes.
+type DatabaseStorage struct {
+	FileBytes uint64
+	FreeBytes uint64
+	Buckets   []*BucketStorage
+}
+
+// BucketStorage describes the number of keys in a slasher database bucket and the
+// bytes of the pages allocated to and used by the bucket.
+type BucketStorage struct {
+	Name           string
+	NumKeys        uint64
+	AllocatedBytes uint64
+	UsedBytes      uint64
+}
+
+// ValidatorChunkStorage describes the number of min and max span chunks stored for a
+// validator chunk index, along with the bytes of their keys and encoded values.
+type ValidatorChunkStorage struct {
+	ValidatorChunkIndex uint64
+	MinSpanChunks       uint64
+	MaxSpanChunks       uint64
+	MinSpanBytes        uint64
+	MaxSpanBytes        uint64
+}
@@ -0,0 +1,10 @@
+package state
+
+import enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+
+// BeaconStateBellatrix has read and write access to beacon state methods.
+type BeaconStateBellatrix interface {
+	BeaconStateAltair
+	LatestExecutionPayloadHeader() (*enginev1.ExecutionPayloadHeader, error)
+	SetLatestExecutionPayloadHeader(val *enginev1.ExecutionPayloadHeader) error
+}
@@ -8,6 +8,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
@@ -215,4 +216,6 @@ type FutureForkStub interface {
 	SetCurrentParticipationBits(val []byte) error
 	NextSyncCommittee() (*statepb.SyncCommittee, error)
 	SetNextSyncCommittee(val *statepb.SyncCommittee) error
+	LatestExecutionPayloadHeader() (*enginev1.ExecutionPayloadHeader, error)
+	SetLatestExecutionPayloadHeader(val *enginev1.ExecutionPayloadHeader) error
 }
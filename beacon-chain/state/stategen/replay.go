@@ -7,10 +7,12 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/bellatrix"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -115,6 +117,15 @@ func (s *State) LoadBlocks(ctx context.Context, startSlot, endSlot types.Slot, e
 	return filteredBlocks, nil
 }
 
+// storedPayloadVerifier accepts the execution payloads of stored blocks. They were verified with the
+// execution engine when the blocks were imported, and are not sent to it again when replayed.
+type storedPayloadVerifier struct{}
+
+// VerifyPayload accepts the payload.
+func (storedPayloadVerifier) VerifyPayload(_ context.Context, _ *enginev1.ExecutionPayload) (bool, error) {
+	return true, nil
+}
+
 // executeStateTransitionStateGen applies state transition on input historical state and block for state gen usages.
 // There's no signature verification involved given state gen only works with stored block and state in DB.
 // If the objects are already in stored in DB, one can omit redundant signature checks and ssz hashing calculations.
@@ -144,12 +155,12 @@ func executeStateTransitionStateGen(
 
 	// Execute per block transition.
 	// Given this is for state gen, a node only cares about the post state without proposer
-	// and randao signature verifications.
-	state, err = transition.ProcessBlockForStateRoot(ctx, state, signed)
+	// and randao signature verifications, nor execution payload verifications.
+	state, err = transition.ProcessBlockForStateRoot(ctx, state, signed, storedPayloadVerifier{})
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process block")
 	}
-	if signed.Version() == version.Altair {
+	if signed.Version() == version.Altair || signed.Version() == version.Bellatrix {
 		sa, err := signed.Block().Body().SyncAggregate()
 		if err != nil {
 			return nil, err
@@ -195,7 +206,7 @@ func processSlotsStateGen(ctx context.Context, state state.BeaconState, slot typ
 				if err != nil {
 					return nil, errors.Wrap(err, "could not process epoch with optimizations")
 				}
-			case version.Altair:
+			case version.Altair, version.Bellatrix:
 				state, err = altair.ProcessEpoch(ctx, state)
 				if err != nil {
 					return nil, errors.Wrap(err, "could not process epoch with optimization")
@@ -213,6 +224,12 @@ func processSlotsStateGen(ctx context.Context, state state.BeaconState, slot typ
 				return nil, err
 			}
 		}
+		if helpers.IsEpochStart(state.Slot()) && helpers.SlotToEpoch(state.Slot()) == params.BeaconConfig().BellatrixForkEpoch {
+			state, err = bellatrix.UpgradeToBellatrix(state)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return state, nil
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"github.com/pkg/errors"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
 )
 
@@ -29,3 +30,8 @@ func (b *BeaconState) CurrentSyncCommittee() (*statepb.SyncCommittee, error) {
 func (b *BeaconState) NextSyncCommittee() (*statepb.SyncCommittee, error) {
 	return nil, errors.New("NextSyncCommittee is not supported for phase 0 beacon state")
 }
+
+// LatestExecutionPayloadHeader is not supported for phase 0 beacon state.
+func (b *BeaconState) LatestExecutionPayloadHeader() (*enginev1.ExecutionPayloadHeader, error) {
+	return nil, errors.New("LatestExecutionPayloadHeader is not supported for phase 0 beacon state")
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"github.com/pkg/errors"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
 )
 
@@ -44,3 +45,8 @@ func (b *BeaconState) SetCurrentParticipationBits(val []byte) error {
 func (b *BeaconState) SetInactivityScores(val []uint64) error {
 	return errors.New("SetInactivityScores is not supported for phase 0 beacon state")
 }
+
+// SetLatestExecutionPayloadHeader is not supported for phase 0 beacon state.
+func (b *BeaconState) SetLatestExecutionPayloadHeader(val *enginev1.ExecutionPayloadHeader) error {
+	return errors.New("SetLatestExecutionPayloadHeader is not supported for phase 0 beacon state")
+}
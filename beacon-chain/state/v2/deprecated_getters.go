@@ -2,6 +2,7 @@ package v2
 
 import (
 	"github.com/pkg/errors"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
 )
 
@@ -14,3 +15,8 @@ func (b *BeaconState) PreviousEpochAttestations() ([]*statepb.PendingAttestation
 func (b *BeaconState) CurrentEpochAttestations() ([]*statepb.PendingAttestation, error) {
 	return nil, errors.New("CurrentEpochAttestations is not supported for hard fork 1 beacon state")
 }
+
+// LatestExecutionPayloadHeader is not supported for HF1 beacon state.
+func (b *BeaconState) LatestExecutionPayloadHeader() (*enginev1.ExecutionPayloadHeader, error) {
+	return nil, errors.New("LatestExecutionPayloadHeader is not supported for hard fork 1 beacon state")
+}
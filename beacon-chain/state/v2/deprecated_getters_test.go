@@ -17,3 +17,9 @@ func TestBeaconState_PreviousEpochAttestations(t *testing.T) {
 	_, err := s.PreviousEpochAttestations()
 	require.ErrorContains(t, "PreviousEpochAttestations is not supported for hard fork 1 beacon state", err)
 }
+
+func TestBeaconState_LatestExecutionPayloadHeader(t *testing.T) {
+	s := &BeaconState{}
+	_, err := s.LatestExecutionPayloadHeader()
+	require.ErrorContains(t, "LatestExecutionPayloadHeader is not supported for hard fork 1 beacon state", err)
+}
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"github.com/pkg/errors"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
 )
@@ -35,3 +36,8 @@ func (b *BeaconState) RotateAttestations() error {
 func (b *BeaconState) ToProto() (*v1.BeaconState, error) {
 	return nil, errors.New("ToProto is not yet supported for hard fork 1 beacon state")
 }
+
+// SetLatestExecutionPayloadHeader is not supported for HF1 beacon state.
+func (b *BeaconState) SetLatestExecutionPayloadHeader(val *enginev1.ExecutionPayloadHeader) error {
+	return errors.New("SetLatestExecutionPayloadHeader is not supported for hard fork 1 beacon state")
+}
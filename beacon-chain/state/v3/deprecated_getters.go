@@ -0,0 +1,16 @@
+package v3
+
+import (
+	"github.com/pkg/errors"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+)
+
+// PreviousEpochAttestations is not supported for the Bellatrix beacon state.
+func (b *BeaconState) PreviousEpochAttestations() ([]*statepb.PendingAttestation, error) {
+	return nil, errors.New("PreviousEpochAttestations is not supported for Bellatrix beacon state")
+}
+
+// CurrentEpochAttestations is not supported for the Bellatrix beacon state.
+func (b *BeaconState) CurrentEpochAttestations() ([]*statepb.PendingAttestation, error) {
+	return nil, errors.New("CurrentEpochAttestations is not supported for Bellatrix beacon state")
+}
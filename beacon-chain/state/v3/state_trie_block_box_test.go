@@ -0,0 +1,94 @@
+package v3_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	stateBellatrix "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ state.BeaconStateBellatrix = (*stateBellatrix.BeaconState)(nil)
+
+func emptyPayloadHeader() *enginev1.ExecutionPayloadHeader {
+	return &enginev1.ExecutionPayloadHeader{
+		ParentHash:       make([]byte, 32),
+		FeeRecipient:     make([]byte, 20),
+		StateRoot:        make([]byte, 32),
+		ReceiptsRoot:     make([]byte, 32),
+		LogsBloom:        make([]byte, 256),
+		PrevRandao:       make([]byte, 32),
+		BaseFeePerGas:    make([]byte, 32),
+		BlockHash:        make([]byte, 32),
+		TransactionsRoot: make([]byte, 32),
+	}
+}
+
+func TestBeaconStateBellatrix_ProtoBeaconStateCompatibility(t *testing.T) {
+	ctx := context.Background()
+	s, err := testutil.NewBeaconStateBellatrix()
+	require.NoError(t, err)
+	genesis, err := stateBellatrix.ProtobufBeaconState(s.CloneInnerState())
+	require.NoError(t, err)
+	genesis.Balances = []uint64{32, 31}
+	customState, err := stateBellatrix.InitializeFromProto(genesis)
+	require.NoError(t, err)
+	cloned, ok := proto.Clone(genesis).(*statepb.BeaconStateBellatrix)
+	assert.Equal(t, true, ok, "Object is not of type *statepb.BeaconStateBellatrix")
+	custom := customState.CloneInnerState()
+	assert.DeepSSZEqual(t, cloned, custom)
+
+	r1, err := customState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	beaconState, err := stateBellatrix.InitializeFromProto(genesis)
+	require.NoError(t, err)
+	r2, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, r1, r2, "Mismatched roots")
+
+	root := r1
+
+	// We then write the execution payload header and compare hash tree roots again.
+	header := emptyPayloadHeader()
+	header.BlockHash = bytesutil.PadTo([]byte("block hash"), 32)
+	header.BlockNumber = 1
+	require.NoError(t, customState.SetLatestExecutionPayloadHeader(header))
+	r1, err = customState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis.LatestExecutionPayloadHeader = header
+	beaconState, err = stateBellatrix.InitializeFromProto(genesis)
+	require.NoError(t, err)
+	r2, err = beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, r1, r2, "Mismatched roots")
+	assert.NotEqual(t, r1, root, "Expected the root to change with the header")
+
+	got, err := customState.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, header, got)
+}
+
+func TestBeaconStateBellatrix_Copy(t *testing.T) {
+	st, err := testutil.NewBeaconStateBellatrix()
+	require.NoError(t, err)
+	cp, ok := st.Copy().(*stateBellatrix.BeaconState)
+	require.Equal(t, true, ok)
+
+	header := emptyPayloadHeader()
+	header.BlockNumber = 10
+	require.NoError(t, cp.SetLatestExecutionPayloadHeader(header))
+
+	got, err := st.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), got.BlockNumber)
+	got, err = cp.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), got.BlockNumber)
+}
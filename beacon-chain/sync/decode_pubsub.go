@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	ssz "github.com/ferranbt/fastssz"
+	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -70,3 +71,11 @@ func (s *Service) replaceForkDigest(topic string) (string, error) {
 	subStrings[2] = "%x"
 	return strings.Join(subStrings, "/"), nil
 }
+
+// Returns the gossip topic a pubsub message was received on and the peer it was received from.
+func gossipProvenance(pid peer.ID, msg *pubsub.Message) *eth.GossipProvenance {
+	return &eth.GossipProvenance{
+		Topic:  msg.GetTopic(),
+		PeerId: pid.String(),
+	}
+}
@@ -48,6 +48,20 @@ var (
 		},
 	)
 
+	pendingItemsDroppedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pending_queue_items_dropped_total",
+			Help: "Count of items not added to a pending queue because the queue or peer bound was reached.",
+		},
+		[]string{"queue"},
+	)
+	unresolvedPendingItemsCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "pending_queue_unresolved_peer_penalties_total",
+			Help: "Count of times a peer was penalized for pending items that never resolved.",
+		},
+	)
+
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_arrival_latency_milliseconds",
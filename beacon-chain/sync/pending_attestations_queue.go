@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"sync"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -70,7 +71,8 @@ func (s *Service) processPendingAtts(ctx context.Context) error {
 					// Save the pending aggregated attestation to the pool if it passes the aggregated
 					// validation steps.
 					aggValid := s.validateAggregatedAtt(ctx, signedAtt) == pubsub.ValidationAccept
-					if s.validateBlockInAttestation(ctx, signedAtt) && aggValid {
+					pid, _ := s.pendingAttPeers.peer(pendingAttKey(bRoot, signedAtt))
+					if s.validateBlockInAttestation(ctx, signedAtt, pid) && aggValid {
 						if err := s.cfg.AttPool.SaveAggregatedAttestation(att.Aggregate); err != nil {
 							log.WithError(err).Debug("Could not save aggregate attestation")
 							continue
@@ -127,6 +129,9 @@ func (s *Service) processPendingAtts(ctx context.Context) error {
 
 			// Delete the missing block root key from pending attestation queue so a node will not request for the block again.
 			s.pendingAttsLock.Lock()
+			for _, signedAtt := range s.blkRootToPendingAtts[bRoot] {
+				s.pendingAttPeers.remove(pendingAttKey(bRoot, signedAtt))
+			}
 			delete(s.blkRootToPendingAtts, bRoot)
 			s.pendingAttsLock.Unlock()
 		} else {
@@ -145,14 +150,18 @@ func (s *Service) processPendingAtts(ctx context.Context) error {
 
 // This defines how pending attestations is saved in the map. The key is the
 // root of the missing block. The value is the list of pending attestations
-// that voted for that block root.
-func (s *Service) savePendingAtt(att *ethpb.SignedAggregateAttestationAndProof) {
+// that voted for that block root. The attestation is attributed to the peer
+// it was received from, and dropped if the queue or peer bound is reached.
+func (s *Service) savePendingAtt(att *ethpb.SignedAggregateAttestationAndProof, pid peer.ID) {
 	root := bytesutil.ToBytes32(att.Message.Aggregate.Data.BeaconBlockRoot)
 
 	s.pendingAttsLock.Lock()
 	defer s.pendingAttsLock.Unlock()
 	_, ok := s.blkRootToPendingAtts[root]
 	if !ok {
+		if !s.trackPendingAtt(root, att, pid) {
+			return
+		}
 		s.blkRootToPendingAtts[root] = []*ethpb.SignedAggregateAttestationAndProof{att}
 		return
 	}
@@ -164,9 +173,26 @@ func (s *Service) savePendingAtt(att *ethpb.SignedAggregateAttestationAndProof)
 		}
 	}
 
+	if !s.trackPendingAtt(root, att, pid) {
+		return
+	}
 	s.blkRootToPendingAtts[root] = append(s.blkRootToPendingAtts[root], att)
 }
 
+// This attributes a pending attestation to the peer it was received from. It returns false
+// if the attestation exceeds the bounds of the pending attestations queue.
+func (s *Service) trackPendingAtt(root [32]byte, att *ethpb.SignedAggregateAttestationAndProof, pid peer.ID) bool {
+	if s.pendingAttPeers.add(pendingAttKey(root, att), pid, maxPendingAtts, maxPendingAttsPerPeer) {
+		return true
+	}
+	pendingItemsDroppedCounter.WithLabelValues("attestations").Inc()
+	log.WithFields(logrus.Fields{
+		"blockRoot": hex.EncodeToString(bytesutil.Trunc(root[:])),
+		"peer":      pid,
+	}).Debug("Pending attestations queue is full, dropping attestation")
+	return false
+}
+
 // This validates the pending attestations in the queue are still valid.
 // If not valid, a node will remove it in the queue in place. The validity
 // check specifies the pending attestation could not fall one epoch behind
@@ -178,9 +204,14 @@ func (s *Service) validatePendingAtts(ctx context.Context, slot types.Slot) {
 	s.pendingAttsLock.Lock()
 	defer s.pendingAttsLock.Unlock()
 
+	var unresolved []peer.ID
 	for bRoot, atts := range s.blkRootToPendingAtts {
 		for i := len(atts) - 1; i >= 0; i-- {
 			if slot >= atts[i].Message.Aggregate.Data.Slot+params.BeaconConfig().SlotsPerEpoch {
+				// The block of a stale attestation never arrived, penalize the peer that sent it.
+				if pid, ok := s.pendingAttPeers.remove(pendingAttKey(bRoot, atts[i])); ok {
+					unresolved = append(unresolved, pid)
+				}
 				// Remove the pending attestation from the list in place.
 				atts = append(atts[:i], atts[i+1:]...)
 			}
@@ -193,4 +224,5 @@ func (s *Service) validatePendingAtts(ctx context.Context, slot types.Slot) {
 			delete(s.blkRootToPendingAtts, bRoot)
 		}
 	}
+	s.penalizeUnresolvedPeers(unresolved)
 }
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -48,6 +49,9 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "processPendingBlocks")
 	defer span.End()
 
+	// Penalize the peers whose pending blocks expired from the queue without being resolved.
+	s.penalizeUnresolvedPeers(s.releaseDroppedPendingBlocks())
+
 	pids := s.cfg.P2P.Peers().Connected()
 	if err := s.validatePendingSlots(); err != nil {
 		return errors.Wrap(err, "could not validate pending slots")
@@ -295,12 +299,14 @@ func (s *Service) clearPendingSlots() {
 	defer s.pendingQueueLock.Unlock()
 	s.slotToPendingBlocks.Flush()
 	s.seenPendingBlocks = make(map[[32]byte]bool)
+	s.pendingBlockPeers.reset()
 }
 
 // Delete block from the list from the pending queue using the slot as key.
 // Note: this helper is not thread safe.
 func (s *Service) deleteBlockFromPendingQueue(slot types.Slot, b block.SignedBeaconBlock, r [32]byte) error {
 	mutexasserts.AssertRWMutexLocked(&s.pendingQueueLock)
+	s.pendingBlockPeers.remove(pendingBlockKey(r))
 
 	blks := s.pendingBlocksInCache(slot)
 	if len(blks) == 0 {
@@ -328,16 +334,30 @@ func (s *Service) deleteBlockFromPendingQueue(slot types.Slot, b block.SignedBea
 	return nil
 }
 
-// Insert block to the list in the pending queue using the slot as key.
+// Insert block to the list in the pending queue using the slot as key. The block is attributed
+// to the peer it was received from, and dropped if the queue or peer bound is reached.
 // Note: this helper is not thread safe.
-func (s *Service) insertBlockToPendingQueue(slot types.Slot, b block.SignedBeaconBlock, r [32]byte) error {
+func (s *Service) insertBlockToPendingQueue(slot types.Slot, b block.SignedBeaconBlock, r [32]byte, pid peer.ID) error {
 	mutexasserts.AssertRWMutexLocked(&s.pendingQueueLock)
 
 	if s.seenPendingBlocks[r] {
 		return nil
 	}
+	if len(s.pendingBlocksInCache(slot)) >= maxBlocksPerSlot {
+		return nil
+	}
+	if !s.pendingBlockPeers.add(pendingBlockKey(r), pid, maxPendingBlocks, maxPendingBlocksPerPeer) {
+		pendingItemsDroppedCounter.WithLabelValues("blocks").Inc()
+		log.WithFields(logrus.Fields{
+			"slot":      slot,
+			"blockRoot": hex.EncodeToString(bytesutil.Trunc(r[:])),
+			"peer":      pid,
+		}).Debug("Pending blocks queue is full, dropping block")
+		return nil
+	}
 
 	if err := s.addPendingBlockToCache(b); err != nil {
+		s.pendingBlockPeers.remove(pendingBlockKey(r))
 		return err
 	}
 
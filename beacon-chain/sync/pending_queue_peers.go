@@ -0,0 +1,244 @@
+package sync
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+const (
+	// maxPendingBlocks is the maximum number of blocks held in the pending blocks queue.
+	maxPendingBlocks = 1024
+	// maxPendingBlocksPerPeer is the maximum number of pending blocks attributed to a single peer.
+	maxPendingBlocksPerPeer = 64
+	// maxPendingAtts is the maximum number of attestations held in the pending attestations queue.
+	maxPendingAtts = 8192
+	// maxPendingAttsPerPeer is the maximum number of pending attestations attributed to a single peer.
+	maxPendingAttsPerPeer = 512
+)
+
+// PendingBlock describes a block held in the pending blocks queue.
+type PendingBlock struct {
+	Slot       types.Slot
+	Root       [32]byte
+	ParentRoot [32]byte
+	Peer       peer.ID
+}
+
+// PendingAttestation describes an attestation held in the pending attestations queue.
+type PendingAttestation struct {
+	Slot            types.Slot
+	BlockRoot       [32]byte
+	AggregatorIndex types.ValidatorIndex
+	Peer            peer.ID
+}
+
+// PendingQueueFetcher retrieves the contents of the pending block and attestation queues.
+type PendingQueueFetcher interface {
+	PendingBlocks() []*PendingBlock
+	PendingAttestations() []*PendingAttestation
+}
+
+// pendingPeerTracker attributes the items of a pending queue to the peers they were
+// received from. This allows the queue to be bounded per peer, and peers whose items
+// never resolve to be penalized.
+type pendingPeerTracker struct {
+	lock      sync.Mutex
+	items     map[string]*pendingItem
+	peerCount map[peer.ID]int
+}
+
+type pendingItem struct {
+	pid peer.ID
+}
+
+// add attributes the item with the given key to the peer. It returns false if adding
+// the item would exceed either the total or the per peer bound of the queue.
+func (t *pendingPeerTracker) add(key string, pid peer.ID, maxTotal, maxPerPeer int) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.items == nil {
+		t.items = make(map[string]*pendingItem)
+		t.peerCount = make(map[peer.ID]int)
+	}
+	if _, ok := t.items[key]; ok {
+		return true
+	}
+	if len(t.items) >= maxTotal || t.peerCount[pid] >= maxPerPeer {
+		return false
+	}
+	t.items[key] = &pendingItem{pid: pid}
+	t.peerCount[pid]++
+	return true
+}
+
+// remove drops the attribution of the item with the given key, and returns the peer it was
+// attributed to.
+func (t *pendingPeerTracker) remove(key string) (peer.ID, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	item, ok := t.items[key]
+	if !ok {
+		return "", false
+	}
+	t.removeItem(key, item)
+	return item.pid, true
+}
+
+// retain drops every item whose key is not in the given keys, and returns the peers those
+// items were attributed to.
+func (t *pendingPeerTracker) retain(keys map[string]bool) []peer.ID {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var pids []peer.ID
+	for key, item := range t.items {
+		if !keys[key] {
+			t.removeItem(key, item)
+			pids = append(pids, item.pid)
+		}
+	}
+	return pids
+}
+
+// Note: this helper is not thread safe.
+func (t *pendingPeerTracker) removeItem(key string, item *pendingItem) {
+	delete(t.items, key)
+	t.peerCount[item.pid]--
+	if t.peerCount[item.pid] <= 0 {
+		delete(t.peerCount, item.pid)
+	}
+}
+
+// reset drops the attribution of every item.
+func (t *pendingPeerTracker) reset() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.items = make(map[string]*pendingItem)
+	t.peerCount = make(map[peer.ID]int)
+}
+
+// peer returns the peer the item with the given key is attributed to.
+func (t *pendingPeerTracker) peer(key string) (peer.ID, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	item, ok := t.items[key]
+	if !ok {
+		return "", false
+	}
+	return item.pid, true
+}
+
+// count returns the number of items attributed to the peer.
+func (t *pendingPeerTracker) count(pid peer.ID) int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.peerCount[pid]
+}
+
+// size returns the number of attributed items.
+func (t *pendingPeerTracker) size() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return len(t.items)
+}
+
+// This returns the key used to attribute a pending block.
+func pendingBlockKey(root [32]byte) string {
+	return string(root[:])
+}
+
+// This returns the key used to attribute a pending attestation. The key includes the signatures
+// of the attestation, so that every distinct attestation is attributed to its peer.
+func pendingAttKey(root [32]byte, att *ethpb.SignedAggregateAttestationAndProof) string {
+	key := append(root[:], bytesutil.Bytes8(uint64(att.Message.AggregatorIndex))...)
+	key = append(key, att.Message.Aggregate.Signature...)
+	return string(append(key, att.Signature...))
+}
+
+// releaseDroppedPendingBlocks drops the attribution of the blocks which left the pending blocks
+// queue without being processed, as the queue cache expired them, and returns the peers those
+// blocks were attributed to. Blocks still in the queue remain attributed to their peers.
+func (s *Service) releaseDroppedPendingBlocks() []peer.ID {
+	s.pendingQueueLock.RLock()
+	defer s.pendingQueueLock.RUnlock()
+	queued := make(map[string]bool)
+	for k := range s.slotToPendingBlocks.Items() {
+		for _, b := range s.pendingBlocksInCache(cacheKeyToSlot(k)) {
+			root, err := b.Block().HashTreeRoot()
+			if err != nil {
+				continue
+			}
+			queued[pendingBlockKey(root)] = true
+		}
+	}
+	return s.pendingBlockPeers.retain(queued)
+}
+
+// penalizeUnresolvedPeers increments the bad responses of every peer that sent pending
+// items which never resolved. Each peer is penalized at most once per call.
+func (s *Service) penalizeUnresolvedPeers(pids []peer.ID) {
+	seen := make(map[peer.ID]bool, len(pids))
+	for _, pid := range pids {
+		if seen[pid] || pid == "" {
+			continue
+		}
+		seen[pid] = true
+		s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		unresolvedPendingItemsCounter.Inc()
+		log.WithField("peer", pid).Debug("Penalized peer for unresolved pending items")
+	}
+}
+
+// PendingBlocks returns the blocks held in the pending blocks queue, sorted by slot.
+func (s *Service) PendingBlocks() []*PendingBlock {
+	s.pendingQueueLock.RLock()
+	defer s.pendingQueueLock.RUnlock()
+
+	var pending []*PendingBlock
+	for k := range s.slotToPendingBlocks.Items() {
+		for _, b := range s.pendingBlocksInCache(cacheKeyToSlot(k)) {
+			root, err := b.Block().HashTreeRoot()
+			if err != nil {
+				continue
+			}
+			pid, _ := s.pendingBlockPeers.peer(pendingBlockKey(root))
+			pending = append(pending, &PendingBlock{
+				Slot:       b.Block().Slot(),
+				Root:       root,
+				ParentRoot: bytesutil.ToBytes32(b.Block().ParentRoot()),
+				Peer:       pid,
+			})
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Slot < pending[j].Slot
+	})
+	return pending
+}
+
+// PendingAttestations returns the attestations held in the pending attestations queue, sorted by slot.
+func (s *Service) PendingAttestations() []*PendingAttestation {
+	s.pendingAttsLock.RLock()
+	defer s.pendingAttsLock.RUnlock()
+
+	var pending []*PendingAttestation
+	for root, atts := range s.blkRootToPendingAtts {
+		for _, att := range atts {
+			pid, _ := s.pendingAttPeers.peer(pendingAttKey(root, att))
+			pending = append(pending, &PendingAttestation{
+				Slot:            att.Message.Aggregate.Data.Slot,
+				BlockRoot:       root,
+				AggregatorIndex: att.Message.AggregatorIndex,
+				Peer:            pid,
+			})
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Slot < pending[j].Slot
+	})
+	return pending
+}
@@ -0,0 +1,150 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	gcache "github.com/patrickmn/go-cache"
+	types "github.com/prysmaticlabs/eth2-types"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestPendingPeerTracker_Bounds(t *testing.T) {
+	tracker := pendingPeerTracker{}
+	p1, p2 := peer.ID("a"), peer.ID("b")
+
+	require.Equal(t, true, tracker.add("1", p1, 3, 2))
+	require.Equal(t, true, tracker.add("2", p1, 3, 2))
+	// Adding the same item again does not count against the bounds.
+	require.Equal(t, true, tracker.add("2", p1, 3, 2))
+	assert.Equal(t, false, tracker.add("3", p1, 3, 2), "Expected per peer bound to be reached")
+	require.Equal(t, true, tracker.add("3", p2, 3, 2))
+	assert.Equal(t, false, tracker.add("4", p2, 3, 2), "Expected total bound to be reached")
+	assert.Equal(t, 3, tracker.size())
+
+	pid, ok := tracker.remove("1")
+	require.Equal(t, true, ok)
+	assert.Equal(t, p1, pid)
+	assert.Equal(t, 1, tracker.count(p1))
+	require.Equal(t, true, tracker.add("4", p2, 3, 2))
+	_, ok = tracker.remove("1")
+	assert.Equal(t, false, ok)
+}
+
+func TestPendingPeerTracker_Retain(t *testing.T) {
+	tracker := pendingPeerTracker{}
+	p1, p2 := peer.ID("a"), peer.ID("b")
+	require.Equal(t, true, tracker.add("1", p1, 10, 10))
+	require.Equal(t, true, tracker.add("2", p2, 10, 10))
+
+	assert.Equal(t, 0, len(tracker.retain(map[string]bool{"1": true, "2": true})))
+	pids := tracker.retain(map[string]bool{"1": true})
+	assert.DeepEqual(t, []peer.ID{p2}, pids)
+	assert.Equal(t, 1, tracker.size())
+	assert.Equal(t, 1, tracker.count(p1))
+	assert.Equal(t, 0, tracker.count(p2))
+}
+
+func TestService_ReleaseDroppedPendingBlocks(t *testing.T) {
+	r := &Service{
+		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
+		seenPendingBlocks:   make(map[[32]byte]bool),
+	}
+	pid := peer.ID("a")
+	r.pendingQueueLock.Lock()
+	for i := 0; i < 2; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, r.insertBlockToPendingQueue(b.Block.Slot, wrapper.WrappedPhase0SignedBeaconBlock(b), root, pid))
+	}
+	r.pendingQueueLock.Unlock()
+
+	// Blocks still held in the queue remain attributed to their peer.
+	assert.Equal(t, 0, len(r.releaseDroppedPendingBlocks()))
+	assert.Equal(t, 2, r.pendingBlockPeers.count(pid))
+
+	// A block which left the queue without being processed is released.
+	r.slotToPendingBlocks.Delete(slotToCacheKey(0))
+	assert.DeepEqual(t, []peer.ID{pid}, r.releaseDroppedPendingBlocks())
+	assert.Equal(t, 1, r.pendingBlockPeers.count(pid))
+}
+
+func TestPendingAttKey_DistinctAttestations(t *testing.T) {
+	root := [32]byte{'A'}
+	newAtt := func(aggregatorIndex types.ValidatorIndex, sig byte) *ethpb.SignedAggregateAttestationAndProof {
+		return &ethpb.SignedAggregateAttestationAndProof{
+			Message: &ethpb.AggregateAttestationAndProof{
+				AggregatorIndex: aggregatorIndex,
+				Aggregate:       &ethpb.Attestation{Signature: bytesutil.PadTo([]byte{sig}, 96)},
+			},
+			Signature: bytesutil.PadTo([]byte{sig}, 96),
+		}
+	}
+	assert.Equal(t, pendingAttKey(root, newAtt(1, 'a')), pendingAttKey(root, newAtt(1, 'a')))
+	// Different attestations of the same aggregator are attributed separately.
+	assert.NotEqual(t, pendingAttKey(root, newAtt(1, 'a')), pendingAttKey(root, newAtt(1, 'b')))
+	assert.NotEqual(t, pendingAttKey(root, newAtt(1, 'a')), pendingAttKey(root, newAtt(2, 'a')))
+}
+
+func TestService_InsertBlockToPendingQueue_PeerBound(t *testing.T) {
+	r := &Service{
+		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
+		seenPendingBlocks:   make(map[[32]byte]bool),
+	}
+	pid := peer.ID("a")
+	r.pendingQueueLock.Lock()
+	for i := 0; i < maxPendingBlocksPerPeer+1; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, r.insertBlockToPendingQueue(b.Block.Slot, wrapper.WrappedPhase0SignedBeaconBlock(b), root, pid))
+	}
+	r.pendingQueueLock.Unlock()
+
+	assert.Equal(t, maxPendingBlocksPerPeer, len(r.seenPendingBlocks), "Expected pending blocks to be bounded per peer")
+	assert.Equal(t, maxPendingBlocksPerPeer, r.pendingBlockPeers.count(pid))
+	blks := r.PendingBlocks()
+	require.Equal(t, maxPendingBlocksPerPeer, len(blks))
+	assert.Equal(t, pid, blks[0].Peer)
+}
+
+func TestService_ValidatePendingAtts_PenalizesPeer(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Peers().Add(nil, p2.PeerID(), p2.BHost.Addrs()[0], 0)
+	s := &Service{
+		cfg:                  &Config{P2P: p1},
+		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+	}
+
+	r1 := [32]byte{'A'}
+	for i := types.ValidatorIndex(0); i < 2; i++ {
+		s.savePendingAtt(&ethpb.SignedAggregateAttestationAndProof{
+			Message: &ethpb.AggregateAttestationAndProof{
+				AggregatorIndex: i,
+				Aggregate: &ethpb.Attestation{
+					Data: &ethpb.AttestationData{Slot: 1, BeaconBlockRoot: r1[:]}}}}, p2.PeerID())
+	}
+	atts := s.PendingAttestations()
+	require.Equal(t, 2, len(atts))
+	assert.Equal(t, p2.PeerID(), atts[0].Peer)
+
+	s.validatePendingAtts(context.Background(), 1+params.BeaconConfig().SlotsPerEpoch)
+	assert.Equal(t, 0, len(s.blkRootToPendingAtts))
+	assert.Equal(t, 0, s.pendingAttPeers.size())
+	count, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "Expected peer to be penalized once")
+}
@@ -45,6 +45,13 @@ func WriteBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher
 			return err
 		}
 		obtainedCtx = digest[:]
+	case version.Bellatrix:
+		valRoot := chain.GenesisValidatorRoot()
+		digest, err := p2putils.ForkDigestFromEpoch(params.BeaconConfig().BellatrixForkEpoch, valRoot[:])
+		if err != nil {
+			return err
+		}
+		obtainedCtx = digest[:]
 	}
 
 	if err := writeContextToStream(obtainedCtx, stream, chain); err != nil {
@@ -0,0 +1,477 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// RecordedInbound marks an exchange initiated by a remote peer.
+	RecordedInbound = "inbound"
+	// RecordedOutbound marks an exchange initiated by the node.
+	RecordedOutbound = "outbound"
+
+	defaultRecorderMaxFileSize = 100 << 20
+	defaultRecorderMaxBackups  = 3
+)
+
+// RecordedExchange is a single req/resp exchange between the node and a peer, as written
+// by the RPC recorder.
+type RecordedExchange struct {
+	Direction string           `json:"direction"`
+	Topic     string           `json:"topic"`
+	Peer      string           `json:"peer"`
+	Start     time.Time        `json:"start"`
+	Duration  time.Duration    `json:"duration"`
+	Request   []byte           `json:"request,omitempty"`
+	Chunks    []*RecordedChunk `json:"chunks,omitempty"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// RecordedChunk is a single response chunk of a recorded exchange. The payload holds the
+// SSZ encoded response for a successful chunk, while the error message is set otherwise.
+type RecordedChunk struct {
+	Code         byte          `json:"code"`
+	Context      []byte        `json:"context,omitempty"`
+	Payload      []byte        `json:"payload,omitempty"`
+	ErrorMessage string        `json:"error_message,omitempty"`
+	Offset       time.Duration `json:"offset"`
+}
+
+// RPCRecorderConfig defines the file the RPC recorder writes to and how it is rotated.
+type RPCRecorderConfig struct {
+	Path        string
+	MaxFileSize int64
+	MaxBackups  int
+}
+
+// RPCRecorder writes every req/resp exchange going through the wrapped p2p service to a
+// rotating file, one JSON encoded exchange per line. This is meant to debug sync issues
+// which cannot be reproduced locally, and the recording can be replayed with the RPCReplayer.
+type RPCRecorder struct {
+	cfg  *RPCRecorderConfig
+	lock sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewRPCRecorder opens the recording file, appending to it if it already exists.
+func NewRPCRecorder(cfg *RPCRecorderConfig) (*RPCRecorder, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("no rpc recording file provided")
+	}
+	if cfg.MaxFileSize <= 0 {
+		cfg.MaxFileSize = defaultRecorderMaxFileSize
+	}
+	if cfg.MaxBackups <= 0 {
+		cfg.MaxBackups = defaultRecorderMaxBackups
+	}
+	r := &RPCRecorder{cfg: cfg}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Close closes the recording file.
+func (r *RPCRecorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// WrapP2P returns a p2p service recording the inbound streams of the registered
+// stream handlers, and the outbound streams opened by Send.
+func (r *RPCRecorder) WrapP2P(p p2p.P2P) p2p.P2P {
+	return &recordingP2P{P2P: p, recorder: r}
+}
+
+func (r *RPCRecorder) open() error {
+	f, err := os.OpenFile(r.cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not open rpc recording file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+// rotate moves the current recording file to the first backup, shifting the existing
+// backups and dropping the oldest one.
+func (r *RPCRecorder) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	for i := r.cfg.MaxBackups - 1; i > 0; i-- {
+		from := fmt.Sprintf("%s.%d", r.cfg.Path, i)
+		if !fileutil.FileExists(from) {
+			continue
+		}
+		if err := os.Rename(from, fmt.Sprintf("%s.%d", r.cfg.Path, i+1)); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(r.cfg.Path, r.cfg.Path+".1"); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+func (r *RPCRecorder) record(exchange *RecordedExchange) {
+	enc, err := json.Marshal(exchange)
+	if err != nil {
+		log.WithError(err).Debug("Could not encode recorded rpc exchange")
+		return
+	}
+	enc = append(enc, '\n')
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return
+	}
+	if r.size > 0 && r.size+int64(len(enc)) > r.cfg.MaxFileSize {
+		if err := r.rotate(); err != nil {
+			log.WithError(err).Error("Could not rotate rpc recording file")
+			return
+		}
+	}
+	n, err := r.file.Write(enc)
+	r.size += int64(n)
+	if err != nil {
+		log.WithError(err).Error("Could not write rpc recording")
+	}
+}
+
+// ReadRecordedExchanges reads all the exchanges of the provided recording file.
+func ReadRecordedExchanges(path string) ([]*RecordedExchange, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open rpc recording file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close rpc recording file")
+		}
+	}()
+	var exchanges []*RecordedExchange
+	rd := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		enc, err := rd.ReadBytes('\n')
+		if len(bytes.TrimSpace(enc)) > 0 {
+			exchange := &RecordedExchange{}
+			if err := json.Unmarshal(enc, exchange); err != nil {
+				return nil, errors.Wrapf(err, "could not decode exchange on line %d", line)
+			}
+			exchanges = append(exchanges, exchange)
+		}
+		if err == io.EOF {
+			return exchanges, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// recordingP2P wraps a p2p service to record its req/resp streams.
+type recordingP2P struct {
+	p2p.P2P
+	recorder *RPCRecorder
+}
+
+// SetStreamHandler records every inbound stream once the handler is done with it.
+func (p *recordingP2P) SetStreamHandler(topic string, handler network.StreamHandler) {
+	p.P2P.SetStreamHandler(topic, func(stream network.Stream) {
+		rs := newRecordedStream(stream, RecordedInbound, nil, p.Encoding(), p.recorder.record)
+		defer rs.finish("")
+		handler(rs)
+	})
+}
+
+// Send records the outbound stream once it is closed or reset by the caller.
+func (p *recordingP2P) Send(ctx context.Context, msg interface{}, topic string, pid peer.ID) (network.Stream, error) {
+	start := time.Now()
+	stream, err := p.P2P.Send(ctx, msg, topic, pid)
+	var request []byte
+	if !isMetadataTopic(topic) {
+		request = marshalRequest(msg)
+	}
+	if err != nil {
+		p.recorder.record(&RecordedExchange{
+			Direction: RecordedOutbound,
+			Topic:     topic + p.Encoding().ProtocolSuffix(),
+			Peer:      pid.String(),
+			Start:     start,
+			Duration:  time.Since(start),
+			Request:   request,
+			Error:     err.Error(),
+		})
+		return nil, err
+	}
+	rs := newRecordedStream(stream, RecordedOutbound, request, p.Encoding(), p.recorder.record)
+	rs.start = start
+	return rs, nil
+}
+
+func marshalRequest(msg interface{}) []byte {
+	m, ok := msg.(ssz.Marshaler)
+	if !ok {
+		return nil
+	}
+	enc, err := m.MarshalSSZ()
+	if err != nil {
+		return nil
+	}
+	return enc
+}
+
+// streamOp marks the end offset of the data transferred by a single read or write.
+type streamOp struct {
+	end int
+	at  time.Time
+}
+
+// recordedStream captures the data read from and written to a stream, which is decoded into
+// an exchange when the stream is done with.
+type recordedStream struct {
+	network.Stream
+	direction string
+	request   []byte
+	encoding  encoder.NetworkEncoding
+	onFinish  func(*RecordedExchange)
+
+	lock     sync.Mutex
+	start    time.Time
+	read     []byte
+	written  []byte
+	readOps  []streamOp
+	writeOps []streamOp
+	err      error
+	done     bool
+}
+
+func newRecordedStream(
+	stream network.Stream, direction string, request []byte, encoding encoder.NetworkEncoding, onFinish func(*RecordedExchange),
+) *recordedStream {
+	return &recordedStream{
+		Stream:    stream,
+		direction: direction,
+		request:   request,
+		encoding:  encoding,
+		onFinish:  onFinish,
+		start:     time.Now(),
+	}
+}
+
+// Read reads from the underlying stream and captures the data read.
+func (s *recordedStream) Read(b []byte) (int, error) {
+	n, err := s.Stream.Read(b)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if n > 0 {
+		s.read = append(s.read, b[:n]...)
+		s.readOps = append(s.readOps, streamOp{end: len(s.read), at: time.Now()})
+	}
+	if err != nil && err != io.EOF && s.err == nil {
+		s.err = err
+	}
+	return n, err
+}
+
+// Write writes to the underlying stream and captures the data written.
+func (s *recordedStream) Write(b []byte) (int, error) {
+	n, err := s.Stream.Write(b)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if n > 0 {
+		s.written = append(s.written, b[:n]...)
+		s.writeOps = append(s.writeOps, streamOp{end: len(s.written), at: time.Now()})
+	}
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+	return n, err
+}
+
+// Close closes the underlying stream and completes the recording.
+func (s *recordedStream) Close() error {
+	err := s.Stream.Close()
+	s.finish("")
+	return err
+}
+
+// Reset resets the underlying stream and completes the recording.
+func (s *recordedStream) Reset() error {
+	err := s.Stream.Reset()
+	s.finish("stream reset")
+	return err
+}
+
+// finish decodes the captured data into an exchange, only the first time it is called.
+func (s *recordedStream) finish(reason string) *RecordedExchange {
+	s.lock.Lock()
+	if s.done {
+		s.lock.Unlock()
+		return nil
+	}
+	s.done = true
+	exchange := &RecordedExchange{
+		Direction: s.direction,
+		Topic:     string(s.Stream.Protocol()),
+		Start:     s.start,
+		Duration:  time.Since(s.start),
+	}
+	if conn := s.Stream.Conn(); conn != nil {
+		exchange.Peer = conn.RemotePeer().String()
+	}
+	respData, respOps := s.written, s.writeOps
+	if s.direction == RecordedOutbound {
+		exchange.Request = s.request
+		respData, respOps = s.read, s.readOps
+	} else if len(s.read) > 0 && !isMetadataTopic(exchange.Topic) {
+		req := &rawSSZ{}
+		if err := s.encoding.DecodeWithMaxLength(bytes.NewReader(s.read), req); err == nil {
+			exchange.Request = *req
+		}
+	}
+	chunks, offsets, err := decodeResponseChunks(exchange.Topic, respData, s.encoding)
+	for i, c := range chunks {
+		c.Offset = opTime(respOps, offsets[i]).Sub(s.start)
+	}
+	exchange.Chunks = chunks
+	switch {
+	case s.err != nil:
+		exchange.Error = s.err.Error()
+	case err != nil:
+		exchange.Error = err.Error()
+	case reason != "":
+		exchange.Error = reason
+	}
+	s.lock.Unlock()
+
+	if s.onFinish != nil {
+		s.onFinish(exchange)
+	}
+	return exchange
+}
+
+// opTime returns the time at which the data at the given offset was transferred.
+func opTime(ops []streamOp, offset int) time.Time {
+	for _, op := range ops {
+		if op.end > offset {
+			return op.at
+		}
+	}
+	if len(ops) > 0 {
+		return ops[len(ops)-1].at
+	}
+	return time.Time{}
+}
+
+// decodeResponseChunks decodes the response chunks of the topic from the raw stream data,
+// along with the position of each chunk in the data.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func decodeResponseChunks(topic string, data []byte, encoding encoder.NetworkEncoding) ([]*RecordedChunk, []int, error) {
+	rd := bytes.NewReader(data)
+	withContext := hasContextBytes(topic)
+	var chunks []*RecordedChunk
+	var offsets []int
+	for rd.Len() > 0 {
+		offset := len(data) - rd.Len()
+		code, err := rd.ReadByte()
+		if err != nil {
+			return chunks, offsets, err
+		}
+		chunk := &RecordedChunk{Code: code}
+		if code != responseCodeSuccess {
+			msg := &types.ErrorMessage{}
+			if err := encoding.DecodeWithMaxLength(rd, msg); err != nil {
+				return chunks, offsets, errors.Wrap(err, "could not decode error response")
+			}
+			chunk.ErrorMessage = string(*msg)
+			chunks = append(chunks, chunk)
+			offsets = append(offsets, offset)
+			continue
+		}
+		if withContext {
+			chunk.Context = make([]byte, digestLength)
+			if _, err := io.ReadFull(rd, chunk.Context); err != nil {
+				return chunks, offsets, errors.Wrap(err, "could not read context bytes")
+			}
+		}
+		payload := &rawSSZ{}
+		if err := encoding.DecodeWithMaxLength(rd, payload); err != nil {
+			return chunks, offsets, errors.Wrap(err, "could not decode response chunk")
+		}
+		chunk.Payload = *payload
+		chunks = append(chunks, chunk)
+		offsets = append(offsets, offset)
+	}
+	return chunks, offsets, nil
+}
+
+// hasContextBytes returns whether the response chunks of the topic are prefixed with context bytes.
+func hasContextBytes(topic string) bool {
+	_, message, version, err := p2p.TopicDeconstructor(topic)
+	if err != nil || version != p2p.SchemaVersionV2 {
+		return false
+	}
+	return message == p2p.BeaconBlocksByRangeMessageName || message == p2p.BeaconBlocksByRootsMessageName
+}
+
+// isMetadataTopic returns whether the topic is a metadata request, which carries no payload.
+func isMetadataTopic(topic string) bool {
+	_, message, _, err := p2p.TopicDeconstructor(topic)
+	return err == nil && message == p2p.MetadataMessageName
+}
+
+// rawSSZ holds an SSZ encoded object without decoding it.
+type rawSSZ []byte
+
+// MarshalSSZ returns the encoded object.
+func (r *rawSSZ) MarshalSSZ() ([]byte, error) {
+	return *r, nil
+}
+
+// MarshalSSZTo appends the encoded object to the provided byte slice.
+func (r *rawSSZ) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, *r...), nil
+}
+
+// SizeSSZ returns the size of the encoded object.
+func (r *rawSSZ) SizeSSZ() int {
+	return len(*r)
+}
+
+// UnmarshalSSZ copies the encoded object.
+func (r *rawSSZ) UnmarshalSSZ(buf []byte) error {
+	*r = append([]byte{}, buf...)
+	return nil
+}
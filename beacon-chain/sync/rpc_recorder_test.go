@@ -0,0 +1,181 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func sszUint64(t *testing.T, v uint64) []byte {
+	seq := types.SSZUint64(v)
+	enc, err := seq.MarshalSSZ()
+	require.NoError(t, err)
+	return enc
+}
+
+// pingResponder returns a stream handler answering ping requests with the provided sequence number.
+func pingResponder(t *testing.T, p *p2ptest.TestP2P, seq uint64) network.StreamHandler {
+	return func(stream network.Stream) {
+		req := new(types.SSZUint64)
+		require.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+		_, err := stream.Write([]byte{responseCodeSuccess})
+		require.NoError(t, err)
+		resp := types.SSZUint64(seq)
+		_, err = p.Encoding().EncodeWithMaxLength(stream, &resp)
+		require.NoError(t, err)
+		closeStream(stream, log)
+	}
+}
+
+func sendPing(t *testing.T, sender p2p.P2P, receiver *p2ptest.TestP2P, seq uint64) (uint8, string) {
+	req := types.SSZUint64(seq)
+	stream, err := sender.Send(context.Background(), &req, p2p.RPCPingTopicV1, receiver.PeerID())
+	require.NoError(t, err)
+	code, errMsg, err := ReadStatusCode(stream, sender.Encoding())
+	require.NoError(t, err)
+	if code == responseCodeSuccess {
+		resp := new(types.SSZUint64)
+		require.NoError(t, sender.Encoding().DecodeWithMaxLength(stream, resp))
+	}
+	closeStream(stream, log)
+	return code, errMsg
+}
+
+func waitForExchanges(t *testing.T, path string, count int) []*RecordedExchange {
+	for i := 0; i < 100; i++ {
+		exchanges, err := ReadRecordedExchanges(path)
+		require.NoError(t, err)
+		if len(exchanges) >= count {
+			return exchanges
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Did not record %d exchanges", count)
+	return nil
+}
+
+func TestRPCRecorder_RecordsExchanges(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	path := filepath.Join(t.TempDir(), "rpc.log")
+	recorder, err := NewRPCRecorder(&RPCRecorderConfig{Path: path})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, recorder.Close())
+	}()
+	wrapped := recorder.WrapP2P(p1)
+	topic := p2p.RPCPingTopicV1 + p1.Encoding().ProtocolSuffix()
+
+	// Inbound request answered by the node.
+	wrapped.SetStreamHandler(topic, pingResponder(t, p1, 5))
+	code, _ := sendPing(t, p2, p1, 3)
+	require.Equal(t, responseCodeSuccess, code)
+	exchanges := waitForExchanges(t, path, 1)
+	inbound := exchanges[0]
+	assert.Equal(t, RecordedInbound, inbound.Direction)
+	assert.Equal(t, topic, inbound.Topic)
+	assert.Equal(t, p2.PeerID().String(), inbound.Peer)
+	assert.DeepEqual(t, sszUint64(t, 3), inbound.Request)
+	require.Equal(t, 1, len(inbound.Chunks))
+	assert.Equal(t, responseCodeSuccess, inbound.Chunks[0].Code)
+	assert.DeepEqual(t, sszUint64(t, 5), inbound.Chunks[0].Payload)
+	assert.Equal(t, "", inbound.Error)
+
+	// Outbound request answered with an error.
+	p2.SetStreamHandler(topic, func(stream network.Stream) {
+		writeErrorResponseToStream(responseCodeInvalidRequest, "invalid sequence number", stream, p2)
+	})
+	code, errMsg := sendPing(t, wrapped, p2, 4)
+	require.Equal(t, responseCodeInvalidRequest, code)
+	require.Equal(t, "invalid sequence number", errMsg)
+	exchanges = waitForExchanges(t, path, 2)
+	outbound := exchanges[1]
+	assert.Equal(t, RecordedOutbound, outbound.Direction)
+	assert.Equal(t, p2.PeerID().String(), outbound.Peer)
+	assert.DeepEqual(t, sszUint64(t, 4), outbound.Request)
+	require.Equal(t, 1, len(outbound.Chunks))
+	assert.Equal(t, responseCodeInvalidRequest, outbound.Chunks[0].Code)
+	assert.Equal(t, "invalid sequence number", outbound.Chunks[0].ErrorMessage)
+}
+
+func TestRPCRecorder_Rotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rpc.log")
+	recorder, err := NewRPCRecorder(&RPCRecorderConfig{Path: path, MaxFileSize: 300, MaxBackups: 2})
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		recorder.record(&RecordedExchange{
+			Direction: RecordedInbound,
+			Topic:     p2p.RPCPingTopicV1,
+			Peer:      fmt.Sprintf("peer-%d", i),
+			Request:   sszUint64(t, uint64(i)),
+		})
+	}
+	require.NoError(t, recorder.Close())
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(name)
+		require.NoError(t, err)
+		assert.Equal(t, true, info.Size() <= 300, "Recording file %s exceeds the max size", name)
+	}
+	_, err = os.Stat(path + ".3")
+	assert.Equal(t, true, os.IsNotExist(err), "Expected the oldest backups to be dropped")
+
+	exchanges, err := ReadRecordedExchanges(path)
+	require.NoError(t, err)
+	require.Equal(t, true, len(exchanges) > 0)
+	assert.Equal(t, "peer-9", exchanges[len(exchanges)-1].Peer)
+}
+
+func TestRPCReplayer_ReplaysSession(t *testing.T) {
+	node := p2ptest.NewTestP2P(t)
+	replayHost := p2ptest.NewTestP2P(t)
+	node.Connect(replayHost)
+	topic := p2p.RPCPingTopicV1 + node.Encoding().ProtocolSuffix()
+	recorded := []*RecordedExchange{
+		{
+			Direction: RecordedInbound,
+			Topic:     topic,
+			Request:   sszUint64(t, 3),
+			Chunks:    []*RecordedChunk{{Code: responseCodeSuccess, Payload: sszUint64(t, 5)}},
+		},
+		{
+			Direction: RecordedOutbound,
+			Topic:     topic,
+			Request:   sszUint64(t, 1),
+			Chunks:    []*RecordedChunk{{Code: responseCodeSuccess, Payload: sszUint64(t, 7)}},
+		},
+	}
+	replayer := NewRPCReplayer(replayHost.BHost, replayHost.Encoding(), recorded, 0)
+	replayer.Serve()
+
+	// The node now answers the recorded request differently.
+	node.SetStreamHandler(topic, pingResponder(t, node, 9))
+	results, err := replayer.Replay(context.Background(), node.PeerID())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.NotNil(t, results[0].Replayed)
+	require.Equal(t, 1, len(results[0].Replayed.Chunks))
+	assert.DeepEqual(t, sszUint64(t, 9), results[0].Replayed.Chunks[0].Payload)
+	assert.Equal(t, true, results[0].Diverged())
+
+	// Requests of the node are answered with the recorded responses.
+	req := types.SSZUint64(1)
+	stream, err := node.Send(context.Background(), &req, p2p.RPCPingTopicV1, replayHost.PeerID())
+	require.NoError(t, err)
+	code, _, err := ReadStatusCode(stream, node.Encoding())
+	require.NoError(t, err)
+	require.Equal(t, responseCodeSuccess, code)
+	resp := new(types.SSZUint64)
+	require.NoError(t, node.Encoding().DecodeWithMaxLength(stream, resp))
+	assert.Equal(t, types.SSZUint64(7), *resp)
+}
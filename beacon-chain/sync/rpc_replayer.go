@@ -0,0 +1,224 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+)
+
+// ReplayResult pairs a recorded inbound exchange with the exchange obtained by replaying
+// its request against the node.
+type ReplayResult struct {
+	Recorded *RecordedExchange
+	Replayed *RecordedExchange
+}
+
+// Diverged returns whether the node responded differently than during the recording.
+func (r *ReplayResult) Diverged() bool {
+	if len(r.Recorded.Chunks) != len(r.Replayed.Chunks) {
+		return true
+	}
+	for i, c := range r.Recorded.Chunks {
+		replayed := r.Replayed.Chunks[i]
+		if c.Code != replayed.Code || !bytes.Equal(c.Payload, replayed.Payload) {
+			return true
+		}
+	}
+	return false
+}
+
+// RPCReplayer replays a session recorded by the RPCRecorder from a single host, which stands
+// in for all the recorded peers. The requests the node received are sent again to the node, except for
+// goodbye messages, while the requests of the node are answered with the responses recorded
+// for them.
+type RPCReplayer struct {
+	host      host.Host
+	encoding  encoder.NetworkEncoding
+	speed     float64
+	inbound   []*RecordedExchange
+	lock      sync.Mutex
+	responses map[string][]*RecordedExchange
+}
+
+// NewRPCReplayer creates a replayer of the provided exchanges. The speed scales the delays
+// between requests and response chunks, where a speed of 0 replays without any delay.
+func NewRPCReplayer(h host.Host, encoding encoder.NetworkEncoding, exchanges []*RecordedExchange, speed float64) *RPCReplayer {
+	r := &RPCReplayer{
+		host:      h,
+		encoding:  encoding,
+		speed:     speed,
+		responses: make(map[string][]*RecordedExchange),
+	}
+	for _, e := range exchanges {
+		switch e.Direction {
+		case RecordedInbound:
+			// Goodbye messages would end the replayed session.
+			if _, message, _, err := p2p.TopicDeconstructor(e.Topic); err == nil && message == p2p.GoodbyeMessageName {
+				continue
+			}
+			r.inbound = append(r.inbound, e)
+		case RecordedOutbound:
+			if e.Error == "" || len(e.Chunks) > 0 {
+				r.responses[e.Topic] = append(r.responses[e.Topic], e)
+			}
+		}
+	}
+	sort.SliceStable(r.inbound, func(i, j int) bool {
+		return r.inbound[i].Start.Before(r.inbound[j].Start)
+	})
+	return r
+}
+
+// Serve registers a stream handler on the host for every topic the node sent requests on
+// during the recording.
+func (r *RPCReplayer) Serve() {
+	for topic := range r.responses {
+		r.host.SetStreamHandler(protocol.ID(topic), r.serveStream)
+	}
+}
+
+// serveStream answers a request of the node with the recorded response of the same request. If
+// the request was not recorded, the oldest recorded response of the topic is used instead.
+func (r *RPCReplayer) serveStream(stream network.Stream) {
+	defer func() {
+		_err := stream.Reset()
+		_ = _err
+	}()
+	topic := string(stream.Protocol())
+	var request []byte
+	if !isMetadataTopic(topic) {
+		req := &rawSSZ{}
+		if err := r.encoding.DecodeWithMaxLength(stream, req); err != nil {
+			log.WithError(err).WithField("topic", topic).Debug("Could not decode replayed request")
+			return
+		}
+		request = *req
+	}
+	exchange := r.nextResponse(topic, request)
+	if exchange == nil {
+		return
+	}
+	var elapsed time.Duration
+	for _, chunk := range exchange.Chunks {
+		r.wait(chunk.Offset - elapsed)
+		elapsed = chunk.Offset
+		if err := r.writeChunk(stream, chunk); err != nil {
+			log.WithError(err).WithField("topic", topic).Debug("Could not write replayed response")
+			return
+		}
+	}
+	closeStream(stream, log)
+}
+
+// nextResponse picks the recorded exchange answering the request. Matched exchanges are
+// consumed, except for the last one of the topic which keeps answering later requests.
+func (r *RPCReplayer) nextResponse(topic string, request []byte) *RecordedExchange {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	recorded := r.responses[topic]
+	if len(recorded) == 0 {
+		return nil
+	}
+	idx := 0
+	for i, e := range recorded {
+		if bytes.Equal(e.Request, request) {
+			idx = i
+			break
+		}
+	}
+	exchange := recorded[idx]
+	if len(recorded) > 1 {
+		r.responses[topic] = append(recorded[:idx:idx], recorded[idx+1:]...)
+	}
+	return exchange
+}
+
+func (r *RPCReplayer) writeChunk(stream network.Stream, chunk *RecordedChunk) error {
+	if _, err := stream.Write([]byte{chunk.Code}); err != nil {
+		return err
+	}
+	if chunk.Code != responseCodeSuccess {
+		errMsg := types.ErrorMessage(chunk.ErrorMessage)
+		_, err := r.encoding.EncodeWithMaxLength(stream, &errMsg)
+		return err
+	}
+	if len(chunk.Context) > 0 {
+		if _, err := stream.Write(chunk.Context); err != nil {
+			return err
+		}
+	}
+	payload := rawSSZ(chunk.Payload)
+	_, err := r.encoding.EncodeWithMaxLength(stream, &payload)
+	return err
+}
+
+// Replay sends the requests the node received during the recording to the node, paced as
+// they were recorded, and returns the responses of the node.
+func (r *RPCReplayer) Replay(ctx context.Context, pid peer.ID) ([]*ReplayResult, error) {
+	results := make([]*ReplayResult, 0, len(r.inbound))
+	for i, exchange := range r.inbound {
+		if i > 0 {
+			r.wait(exchange.Start.Sub(r.inbound[i-1].Start))
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		replayed, err := r.replayExchange(ctx, pid, exchange)
+		if err != nil {
+			return results, errors.Wrapf(err, "could not replay request on topic %s", exchange.Topic)
+		}
+		results = append(results, &ReplayResult{Recorded: exchange, Replayed: replayed})
+	}
+	return results, nil
+}
+
+func (r *RPCReplayer) replayExchange(ctx context.Context, pid peer.ID, exchange *RecordedExchange) (*RecordedExchange, error) {
+	stream, err := r.host.NewStream(ctx, pid, protocol.ID(exchange.Topic))
+	if err != nil {
+		return nil, err
+	}
+	var replayed *RecordedExchange
+	rs := newRecordedStream(stream, RecordedOutbound, exchange.Request, r.encoding, func(e *RecordedExchange) {
+		replayed = e
+	})
+	if !isMetadataTopic(exchange.Topic) {
+		req := rawSSZ(exchange.Request)
+		if _, err := r.encoding.EncodeWithMaxLength(rs, &req); err != nil {
+			_err := rs.Reset()
+			_ = _err
+			return replayed, err
+		}
+	}
+	if err := rs.CloseWrite(); err != nil {
+		_err := rs.Reset()
+		_ = _err
+		return replayed, err
+	}
+	SetStreamReadDeadline(rs, respTimeout)
+	buf := make([]byte, 4096)
+	for {
+		if _, err := rs.Read(buf); err != nil {
+			break
+		}
+	}
+	closeStream(rs, log)
+	return replayed, nil
+}
+
+func (r *RPCReplayer) wait(d time.Duration) {
+	if r.speed <= 0 || d <= 0 {
+		return
+	}
+	time.Sleep(time.Duration(float64(d) / r.speed))
+}
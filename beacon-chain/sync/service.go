@@ -31,6 +31,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
@@ -72,6 +73,9 @@ type Config struct {
 	BlockNotifier     blockfeed.Notifier
 	OperationNotifier operation.Notifier
 	StateGen          *stategen.State
+	// Feeds of the in-process slasher, which are only set when slasher is enabled.
+	SlasherAttestationsFeed *event.Feed
+	SlasherBlockHeadersFeed *event.Feed
 }
 
 // This defines the interface for interacting with block chain service
@@ -94,7 +98,9 @@ type Service struct {
 	cancel                    context.CancelFunc
 	slotToPendingBlocks       *gcache.Cache
 	seenPendingBlocks         map[[32]byte]bool
+	pendingBlockPeers         pendingPeerTracker
 	blkRootToPendingAtts      map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof
+	pendingAttPeers           pendingPeerTracker
 	subHandler                *subTopicHandler
 	pendingAttsLock           sync.RWMutex
 	pendingQueueLock          sync.RWMutex
@@ -14,8 +14,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
@@ -58,6 +60,8 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if err := helpers.ValidateNilAttestation(att); err != nil {
 		return pubsub.ValidationReject
 	}
+	provenance := gossipProvenance(pid, msg)
+	s.sendToSlasher(att, provenance)
 
 	// Broadcast the unaggregated attestation on a feed to notify other services in the beacon node
 	// of a received unaggregated attestation.
@@ -65,6 +69,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		Type: operation.UnaggregatedAttReceived,
 		Data: &operation.UnAggregatedAttReceivedData{
 			Attestation: att,
+			Provenance:  provenance,
 		},
 	})
 
@@ -95,7 +100,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	blockRoot := bytesutil.ToBytes32(att.Data.BeaconBlockRoot)
 	if !s.hasBlockAndState(ctx, blockRoot) {
 		// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
-		s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}})
+		s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}}, pid)
 		return pubsub.ValidationIgnore
 	}
 
@@ -132,6 +137,40 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	return pubsub.ValidationAccept
 }
 
+// Feeds the indexed form of an attestation to the in-process slasher, if enabled, along with
+// the gossip topic and peer it was received from. Attestations are fed before the seen checks
+// of gossip validation, as slashable attestations are by definition conflicting with ones seen
+// before. The committee lookup is done in the background to keep it off the critical validation path.
+func (s *Service) sendToSlasher(att *eth.Attestation, provenance *eth.GossipProvenance) {
+	if s.cfg.SlasherAttestationsFeed == nil {
+		return
+	}
+	go func() {
+		// Using a separate context, as the validation context may be cancelled before the
+		// attestation pre state is retrieved.
+		ctx := context.Background()
+		preState, err := s.cfg.Chain.AttestationPreState(ctx, att)
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve attestation pre state for slasher")
+			return
+		}
+		committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			log.WithError(err).Debug("Could not get attestation committee for slasher")
+			return
+		}
+		indexedAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee)
+		if err != nil {
+			log.WithError(err).Debug("Could not convert attestation to indexed form for slasher")
+			return
+		}
+		s.cfg.SlasherAttestationsFeed.Send(&slashertypes.IndexedAttestationWrapper{
+			IndexedAttestation: indexedAtt,
+			Provenance:         provenance,
+		})
+	}()
+}
+
 // This validates beacon unaggregated attestation has correct topic string.
 func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attestation, bs state.ReadOnlyBeaconState, t string) pubsub.ValidationResult {
 	ctx, span := trace.StartSpan(ctx, "sync.validateUnaggregatedAttTopic")
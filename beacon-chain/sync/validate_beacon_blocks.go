@@ -14,7 +14,9 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
@@ -65,13 +67,31 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 
 	// Broadcast the block on a feed to notify other services in the beacon node
 	// of a received block (even if it does not process correctly through a state transition).
+	provenance := gossipProvenance(pid, msg)
 	s.cfg.BlockNotifier.BlockFeed().Send(&feed.Event{
 		Type: blockfeed.ReceivedBlock,
 		Data: &blockfeed.ReceivedBlockData{
 			SignedBlock: blk,
+			Provenance:  provenance,
 		},
 	})
 
+	if s.cfg.SlasherBlockHeadersFeed != nil {
+		// Feed the block header to slasher in the background, so its processing does not
+		// delay block validation.
+		go func() {
+			blockHeader, err := blockutil.SignedBeaconBlockHeaderFromBlockInterface(blk)
+			if err != nil {
+				log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Warn("Could not extract block header")
+				return
+			}
+			s.cfg.SlasherBlockHeadersFeed.Send(&slashertypes.SignedBlockHeaderWrapper{
+				SignedBeaconBlockHeader: blockHeader,
+				Provenance:              provenance,
+			})
+		}()
+	}
+
 	// Verify the block is the first block received for the proposer for the slot.
 	if s.hasSeenBlockIndexSlot(blk.Block().Slot(), blk.Block().ProposerIndex()) {
 		return pubsub.ValidationIgnore
@@ -130,7 +150,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	// Otherwise queue it for processing in the right slot.
 	if isBlockQueueable(genesisTime, blk.Block().Slot(), receivedTime) {
 		s.pendingQueueLock.Lock()
-		if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot); err != nil {
+		if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot, pid); err != nil {
 			s.pendingQueueLock.Unlock()
 			log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Debug("Ignored block")
 			return pubsub.ValidationIgnore
@@ -143,7 +163,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	// Handle block when the parent is unknown.
 	if !s.cfg.DB.HasBlock(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot())) {
 		s.pendingQueueLock.Lock()
-		if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot); err != nil {
+		if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot, pid); err != nil {
 			s.pendingQueueLock.Unlock()
 			log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Debug("Ignored block")
 			return pubsub.ValidationIgnore
@@ -743,7 +743,7 @@ func fillUpBlocksAndState(ctx context.Context, t *testing.T, beaconDB db.Databas
 		require.NoError(t, err)
 		wsb, err := wrapper.WrappedAltairSignedBeaconBlock(blk)
 		require.NoError(t, err)
-		_, testState, err = core.ExecuteStateTransitionNoVerifyAnySig(ctx, testState, wsb)
+		_, testState, err = core.ExecuteStateTransitionNoVerifyAnySig(ctx, testState, wsb, nil)
 		assert.NoError(t, err)
 		assert.NoError(t, beaconDB.SaveBlock(ctx, wsb))
 		assert.NoError(t, beaconDB.SaveStateSummary(ctx, &statepb.StateSummary{Slot: i, Root: r[:]}))
@@ -31,5 +31,37 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "slasher-storage",
+			Description: `reports the disk space used by the slasher database, per bucket and per validator chunk`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := slasherStorage(cliCtx); err != nil {
+					log.Fatalf("Could not report slasher database storage: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "slasher-rechunk",
+			Description: `migrates the min and max span chunks of the slasher database to a new chunk size, ` +
+				`validator chunk size and history length. The beacon node must not be running`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				SlasherChunkSizeFlag,
+				SlasherValidatorChunkSizeFlag,
+				SlasherHistoryLengthFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := slasherRechunk(cliCtx); err != nil {
+					log.Fatalf("Could not rechunk slasher database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// The directory, within the slasher database directory, the rechunked database is
+// written to before it replaces the original one.
+const rechunkDirName = "rechunk"
+
+var (
+	// SlasherChunkSizeFlag defines the number of epochs of a validator's spans stored in a chunk.
+	SlasherChunkSizeFlag = &cli.Uint64Flag{
+		Name:  "slasher-chunk-size",
+		Usage: "Number of epochs of a validator's min or max spans stored in a chunk. Defaults to the current value",
+	}
+	// SlasherValidatorChunkSizeFlag defines the number of validators whose chunks are stored together.
+	SlasherValidatorChunkSizeFlag = &cli.Uint64Flag{
+		Name:  "slasher-validator-chunk-size",
+		Usage: "Number of validators whose span chunks are stored together on disk. Defaults to the current value",
+	}
+	// SlasherHistoryLengthFlag defines the number of epochs of spans slasher keeps.
+	SlasherHistoryLengthFlag = &cli.Uint64Flag{
+		Name:  "slasher-history-length",
+		Usage: "Number of epochs of min and max spans kept for slashing detection. Defaults to the current value",
+	}
+)
+
+// The directory of the slasher database within a beacon node data directory.
+func slasherDBDir(cliCtx *cli.Context) (string, error) {
+	dir := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName, slasherkv.SlasherDbDirName)
+	if !fileutil.FileExists(path.Join(dir, slasherkv.DatabaseFileName)) {
+		return "", errors.Errorf("no slasher database found in %s", dir)
+	}
+	return dir, nil
+}
+
+// Reports the disk space used by the slasher database, per bucket and per validator
+// chunk index of the span chunks.
+func slasherStorage(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dir, err := slasherDBDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	d, err := slasherkv.NewKVStore(ctx, dir, &slasherkv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+	report, err := slasher.Storage(ctx, d)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"chunkSize":          report.ChunkParameters.ChunkSize,
+		"validatorChunkSize": report.ChunkParameters.ValidatorChunkSize,
+		"historyLength":      report.ChunkParameters.HistoryLength,
+		"fileSize":           humanize.Bytes(report.Database.FileBytes),
+		"freeSize":           humanize.Bytes(report.Database.FreeBytes),
+	}).Info("Slasher database")
+	for _, bkt := range report.Database.Buckets {
+		log.WithFields(logrus.Fields{
+			"numKeys":       bkt.NumKeys,
+			"allocatedSize": humanize.Bytes(bkt.AllocatedBytes),
+			"usedSize":      humanize.Bytes(bkt.UsedBytes),
+		}).Infof("Bucket %s", bkt.Name)
+	}
+	for _, chunk := range report.ValidatorChunks {
+		log.WithFields(logrus.Fields{
+			"minSpanChunks": chunk.MinSpanChunks,
+			"minSpanSize":   humanize.Bytes(chunk.MinSpanBytes),
+			"maxSpanChunks": chunk.MaxSpanChunks,
+			"maxSpanSize":   humanize.Bytes(chunk.MaxSpanBytes),
+		}).Infof("Validator chunk %d", chunk.ValidatorChunkIndex)
+	}
+	return nil
+}
+
+// Migrates the span chunks of the slasher database to new chunk parameters. The data
+// is written into a new database file which then replaces the original one, so the
+// original is left untouched if the migration is interrupted, and the space it had
+// allocated but no longer used is reclaimed.
+func slasherRechunk(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dir, err := slasherDBDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	src, err := slasherkv.NewKVStore(ctx, dir, &slasherkv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+	oldParams, err := slasher.LoadParams(ctx, src)
+	if err != nil {
+		return err
+	}
+	chunkParams := oldParams.ChunkParameters()
+	if cliCtx.IsSet(SlasherChunkSizeFlag.Name) {
+		chunkParams.ChunkSize = cliCtx.Uint64(SlasherChunkSizeFlag.Name)
+	}
+	if cliCtx.IsSet(SlasherValidatorChunkSizeFlag.Name) {
+		chunkParams.ValidatorChunkSize = cliCtx.Uint64(SlasherValidatorChunkSizeFlag.Name)
+	}
+	if cliCtx.IsSet(SlasherHistoryLengthFlag.Name) {
+		chunkParams.HistoryLength = types.Epoch(cliCtx.Uint64(SlasherHistoryLengthFlag.Name))
+	}
+	newParams, err := slasher.NewParams(chunkParams.ChunkSize, chunkParams.ValidatorChunkSize, chunkParams.HistoryLength)
+	if err != nil {
+		return errors.Wrap(err, "invalid slasher chunk parameters")
+	}
+
+	// Leftovers of an interrupted migration are discarded.
+	rechunkDir := path.Join(dir, rechunkDirName)
+	if err := os.RemoveAll(rechunkDir); err != nil {
+		return err
+	}
+	dst, err := slasherkv.NewKVStore(ctx, rechunkDir, &slasherkv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not create rechunked slasher database")
+	}
+	if err := src.CopyWithoutChunks(ctx, dst); err != nil {
+		return errors.Wrap(err, "could not copy slasher database")
+	}
+	if err := slasher.Rechunk(ctx, src, dst, newParams); err != nil {
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return errors.Wrap(err, "could not close rechunked slasher database")
+	}
+	if err := os.Rename(
+		path.Join(rechunkDir, slasherkv.DatabaseFileName), path.Join(dir, slasherkv.DatabaseFileName),
+	); err != nil {
+		return errors.Wrap(err, "could not replace slasher database")
+	}
+	if err := os.RemoveAll(rechunkDir); err != nil {
+		return err
+	}
+	log.Info("Rechunked slasher database successfully")
+	return nil
+}
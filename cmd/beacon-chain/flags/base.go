@@ -19,6 +19,29 @@ var (
 		Name:  "fallback-web3provider",
 		Usage: "A mainchain web3 provider string http endpoint. This is our fallback web3 provider, this flag may be used multiple times.",
 	}
+	// Web3ProviderHeaderFlag provides additional headers to send to a web3 provider.
+	Web3ProviderHeaderFlag = &cli.StringSliceFlag{
+		Name:  "web3provider-header",
+		Usage: "An additional header to send to a web3 provider, such as an api key, in the format --web3provider-header=\"<provider url>,<header name>: <value>\". This flag may be used multiple times, for the same or different providers.",
+	}
+	// ExecutionEngineEndpoint provides an HTTP access endpoint to the engine API of an execution client.
+	ExecutionEngineEndpoint = &cli.StringFlag{
+		Name:  "execution-endpoint",
+		Usage: "An http endpoint to the engine API of an execution client, which the beacon node drives for the merge",
+		Value: "",
+	}
+	// ExecutionJWTSecretFlag provides the path to the secret authenticating the requests to the engine API.
+	ExecutionJWTSecretFlag = &cli.StringFlag{
+		Name:  "jwt-secret",
+		Usage: "Path to a file containing the hex encoded 32 bytes secret shared with the execution client, which authenticates the requests to its engine API",
+		Value: "",
+	}
+	// DepositSnapshotFlag provides the path to a snapshot of finalized deposits to start from.
+	DepositSnapshotFlag = &cli.StringFlag{
+		Name:  "deposit-snapshot",
+		Usage: "Path to a JSON file containing a snapshot of the finalized deposit tree, as served by /prysm/v1alpha1/beacon/deposit_snapshot, which a node without deposits in its database starts from instead of processing every deposit log",
+		Value: "",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
 		Name:  "deposit-contract",
@@ -120,6 +143,24 @@ var (
 		Name:  "disable-discv5",
 		Usage: "Does not run the discoveryV5 dht.",
 	}
+	// GossipScoringConfig specifies a YAML file overriding the gossipsub scoring parameters.
+	GossipScoringConfig = &cli.StringFlag{
+		Name: "p2p-gossip-scoring-config",
+		Usage: "The YAML file containing overrides of the gossipsub peer scoring thresholds and topic parameters. " +
+			"Topic parameters are reapplied when the file changes.",
+	}
+	// RPCRecorderFile enables recording every req/resp exchange to the provided file.
+	RPCRecorderFile = &cli.StringFlag{
+		Name: "rpc-recorder-file",
+		Usage: "Records every inbound and outbound req/resp exchange to the provided file, which can be " +
+			"replayed against a local node to debug sync issues.",
+	}
+	// RPCRecorderMaxSize specifies the size at which the req/resp recording file is rotated.
+	RPCRecorderMaxSize = &cli.IntFlag{
+		Name:  "rpc-recorder-max-size-mb",
+		Usage: "The size in megabytes at which the req/resp recording file is rotated. The three most recent files are kept.",
+		Value: 100,
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -153,6 +194,17 @@ var (
 		Name:  "historical-slasher-node",
 		Usage: "Enables required flags for serving historical data to a slasher client. Results in additional storage usage",
 	}
+	// SlasherFlag enables the in-process slasher service of the beacon node.
+	SlasherFlag = &cli.BoolFlag{
+		Name:  "slasher",
+		Usage: "Enables a slasher in the beacon node for detecting slashable offenses. Found slashings are submitted to the slashings pool of the node.",
+	}
+	// SlasherBackfillFromEpoch sets the epoch from which slasher replays stored blocks on startup.
+	SlasherBackfillFromEpoch = &cli.Uint64Flag{
+		Name: "slasher-backfill-from-epoch",
+		Usage: "Replays the attestations and proposals of blocks stored in the database from this epoch onwards " +
+			"through slasher on startup. By default, slasher resumes after the last epoch it processed.",
+	}
 	// ChainID defines a flag to set the chain id. If none is set, it derives this value from NetworkConfig
 	ChainID = &cli.Uint64Flag{
 		Name:  "chain-id",
@@ -182,4 +234,10 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// MonitorIndices defines the validator indices whose performance is tracked by the beacon node.
+	MonitorIndices = &cli.IntSliceFlag{
+		Name: "monitor-indices",
+		Usage: "List of validator indices whose attestations, proposals, sync committee participation " +
+			"and balance changes are tracked in logs and metrics, e.g. --monitor-indices=1,2,3",
+	}
 )
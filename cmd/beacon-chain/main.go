@@ -32,6 +32,10 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.HTTPWeb3ProviderFlag,
 	flags.FallbackWeb3ProviderFlag,
+	flags.Web3ProviderHeaderFlag,
+	flags.ExecutionEngineEndpoint,
+	flags.ExecutionJWTSecretFlag,
+	flags.DepositSnapshotFlag,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -47,6 +51,9 @@ var appFlags = []cli.Flag{
 	flags.HeadSync,
 	flags.DisableSync,
 	flags.DisableDiscv5,
+	flags.GossipScoringConfig,
+	flags.RPCRecorderFile,
+	flags.RPCRecorderMaxSize,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
 	flags.InteropMockEth1DataVotesFlag,
@@ -57,11 +64,14 @@ var appFlags = []cli.Flag{
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
+	flags.SlasherFlag,
+	flags.SlasherBackfillFromEpoch,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.MonitorIndices,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -73,6 +83,8 @@ var appFlags = []cli.Flag{
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
+	cmd.P2PQUIC,
+	cmd.P2PQUICPort,
 	cmd.P2PIP,
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
@@ -52,6 +52,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PUDPPort,
 			cmd.P2PTCPPort,
+			cmd.P2PQUIC,
+			cmd.P2PQUICPort,
 			cmd.DataDirFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
@@ -106,21 +108,31 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GPRCGatewayCorsDomain,
 			flags.HTTPWeb3ProviderFlag,
 			flags.FallbackWeb3ProviderFlag,
+			flags.Web3ProviderHeaderFlag,
+			flags.ExecutionEngineEndpoint,
+			flags.ExecutionJWTSecretFlag,
+			flags.DepositSnapshotFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
 			flags.DisableDiscv5,
+			flags.GossipScoringConfig,
+			flags.RPCRecorderFile,
+			flags.RPCRecorderMaxSize,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
+			flags.SlasherFlag,
+			flags.SlasherBackfillFromEpoch,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.MonitorIndices,
 		},
 	},
 	{
@@ -178,5 +178,73 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "presign-voluntary-exits",
+			Description: "Signs voluntary exits of selected accounts and writes them to a file without broadcasting them",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.AccountPasswordFileFlag,
+				flags.VoluntaryExitPublicKeysFlag,
+				flags.ExitEpochFlag,
+				flags.PreSignedExitsFileFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				flags.ExitAllFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := accounts.PreSignExitsCli(cliCtx, os.Stdin); err != nil {
+					log.Fatalf("Could not pre-sign voluntary exits: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "submit-voluntary-exits",
+			Description: "Submits pre-signed voluntary exits from a file to a beacon node",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.PreSignedExitsFileFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := accounts.SubmitExitsCli(cliCtx); err != nil {
+					log.Fatalf("Could not submit voluntary exits: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
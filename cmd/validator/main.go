@@ -47,6 +47,7 @@ func startNode(ctx *cli.Context) error {
 
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
+	flags.BroadcastToAllBeaconNodesFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
@@ -68,10 +69,12 @@ var appFlags = []cli.Flag{
 	cmd.DisableMonitoringFlag,
 	flags.SlasherRPCProviderFlag,
 	flags.SlasherCertFlag,
+	flags.DistributedSlashingProtectionURLFlag,
 	flags.WalletPasswordFileFlag,
 	flags.WalletDirFlag,
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
+	flags.ProposerConfigFileFlag,
 	flags.EnableDutyCountDown,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
@@ -21,6 +21,9 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionPublicKeysFlag,
+				flags.SlashingProtectionMinimalFlag,
+				flags.DistributedSlashingProtectionURLFlag,
 			}),
 			Before: func(cliCtx *cli.Context) error {
 				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
@@ -39,6 +42,10 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionJSONFileFlag,
+				flags.SlashingProtectionPublicKeysFlag,
+				flags.SlashingProtectionMinimalFlag,
+				flags.SlashingProtectionDryRunFlag,
+				flags.DistributedSlashingProtectionURLFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
 				featureconfig.ToledoTestnet,
@@ -85,6 +85,7 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "validator",
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
+			flags.BroadcastToAllBeaconNodesFlag,
 			flags.BeaconRPCGatewayProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
@@ -101,10 +102,12 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GrpcHeadersFlag,
 			flags.SlasherRPCProviderFlag,
 			flags.SlasherCertFlag,
+			flags.DistributedSlashingProtectionURLFlag,
 			flags.DisableAccountMetricsFlag,
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
+			flags.ProposerConfigFileFlag,
 			flags.EnableDutyCountDown,
 		},
 	},
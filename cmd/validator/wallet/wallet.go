@@ -21,7 +21,8 @@ var Commands = &cli.Command{
 		{
 			Name: "create",
 			Usage: "creates a new wallet with a desired type of keymanager: " +
-				"either on-disk (imported), derived, or using remote credentials",
+				"either on-disk (imported), derived, using remote credentials (remote or web3signer), " +
+				"or split across several signers (threshold)",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.KeymanagerKindFlag,
@@ -30,6 +31,10 @@ var Commands = &cli.Command{
 				flags.RemoteSignerCertPathFlag,
 				flags.RemoteSignerKeyPathFlag,
 				flags.RemoteSignerCACertPathFlag,
+				flags.Web3SignerURLFlag,
+				flags.Web3SignerPublicKeysFlag,
+				flags.Web3SignerGenesisValidatorsRootFlag,
+				flags.ThresholdKeymanagerConfigFlag,
 				flags.WalletPasswordFileFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
@@ -64,6 +69,10 @@ var Commands = &cli.Command{
 				flags.RemoteSignerCertPathFlag,
 				flags.RemoteSignerKeyPathFlag,
 				flags.RemoteSignerCACertPathFlag,
+				flags.Web3SignerURLFlag,
+				flags.Web3SignerPublicKeysFlag,
+				flags.Web3SignerGenesisValidatorsRootFlag,
+				flags.ThresholdKeymanagerConfigFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
 				featureconfig.ToledoTestnet,
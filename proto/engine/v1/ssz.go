@@ -0,0 +1,378 @@
+package enginev1
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// The SSZ methods of the execution payload types are written by hand: the pinned version of
+// sszgen can neither generate lists of byte lists, as the transactions of a payload, nor merkleize
+// byte lists, as the extra data of a payload, with their length mixed in.
+
+const (
+	// maxExtraDataBytes is MAX_EXTRA_DATA_BYTES, the maximum length of the extra data of a payload.
+	maxExtraDataBytes = 32
+	// maxTransactionsPerPayload is MAX_TRANSACTIONS_PER_PAYLOAD.
+	maxTransactionsPerPayload = 1048576
+	// maxBytesPerTransaction is MAX_BYTES_PER_TRANSACTION.
+	maxBytesPerTransaction = 1073741824
+
+	// executionPayloadFixedSize is the size of the fixed part of an encoded execution payload.
+	executionPayloadFixedSize = 508
+	// executionPayloadHeaderFixedSize is the size of the fixed part of an encoded execution
+	// payload header.
+	executionPayloadHeaderFixedSize = 536
+)
+
+// MarshalSSZ ssz marshals the ExecutionPayload object
+func (e *ExecutionPayload) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayload object to a target array
+func (e *ExecutionPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := executionPayloadFixedSize
+
+	if dst, err = marshalPayloadPrefix(dst, e.ParentHash, e.FeeRecipient, e.StateRoot, e.ReceiptsRoot, e.LogsBloom, e.PrevRandao); err != nil {
+		return
+	}
+	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+	dst = ssz.MarshalUint64(dst, e.GasLimit)
+	dst = ssz.MarshalUint64(dst, e.GasUsed)
+	dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+	// Offset 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.ExtraData)
+
+	if dst, err = marshalFixedBytes(dst, e.BaseFeePerGas, 32); err != nil {
+		return
+	}
+	if dst, err = marshalFixedBytes(dst, e.BlockHash, 32); err != nil {
+		return
+	}
+
+	// Offset 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field 'ExtraData'
+	if len(e.ExtraData) > maxExtraDataBytes {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+
+	// Field 'Transactions'
+	if len(e.Transactions) > maxTransactionsPerPayload {
+		err = ssz.ErrListTooBig
+		return
+	}
+	txOffset := 4 * len(e.Transactions)
+	for _, tx := range e.Transactions {
+		dst = ssz.WriteOffset(dst, txOffset)
+		txOffset += len(tx)
+	}
+	for _, tx := range e.Transactions {
+		if len(tx) > maxBytesPerTransaction {
+			err = ssz.ErrBytesLength
+			return
+		}
+		dst = append(dst, tx...)
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionPayload object
+func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
+	size := uint64(len(buf))
+	if size < executionPayloadFixedSize {
+		return ssz.ErrSize
+	}
+
+	e.ParentHash = append(e.ParentHash[:0], buf[0:32]...)
+	e.FeeRecipient = append(e.FeeRecipient[:0], buf[32:52]...)
+	e.StateRoot = append(e.StateRoot[:0], buf[52:84]...)
+	e.ReceiptsRoot = append(e.ReceiptsRoot[:0], buf[84:116]...)
+	e.LogsBloom = append(e.LogsBloom[:0], buf[116:372]...)
+	e.PrevRandao = append(e.PrevRandao[:0], buf[372:404]...)
+	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
+	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+
+	o10 := ssz.ReadOffset(buf[436:440])
+	if o10 != executionPayloadFixedSize {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	e.BaseFeePerGas = append(e.BaseFeePerGas[:0], buf[440:472]...)
+	e.BlockHash = append(e.BlockHash[:0], buf[472:504]...)
+
+	o13 := ssz.ReadOffset(buf[504:508])
+	if o13 < o10 || o13 > size {
+		return ssz.ErrOffset
+	}
+
+	// Field 'ExtraData'
+	extraData := buf[o10:o13]
+	if len(extraData) > maxExtraDataBytes {
+		return ssz.ErrBytesLength
+	}
+	e.ExtraData = append(make([]byte, 0, len(extraData)), extraData...)
+
+	// Field 'Transactions'
+	txs := buf[o13:]
+	num, err := ssz.DecodeDynamicLength(txs, maxTransactionsPerPayload)
+	if err != nil {
+		return err
+	}
+	e.Transactions = make([][]byte, num)
+	return ssz.UnmarshalDynamic(txs, num, func(indx int, buf []byte) error {
+		if len(buf) > maxBytesPerTransaction {
+			return ssz.ErrBytesLength
+		}
+		e.Transactions[indx] = append(make([]byte, 0, len(buf)), buf...)
+		return nil
+	})
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayload object
+func (e *ExecutionPayload) SizeSSZ() (size int) {
+	size = executionPayloadFixedSize
+	size += len(e.ExtraData)
+	for _, tx := range e.Transactions {
+		size += 4 + len(tx)
+	}
+	return
+}
+
+// HashTreeRoot ssz hashes the ExecutionPayload object
+func (e *ExecutionPayload) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionPayload object with a hasher
+func (e *ExecutionPayload) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	if err = putPayloadPrefix(hh, e.ParentHash, e.FeeRecipient, e.StateRoot, e.ReceiptsRoot, e.LogsBloom, e.PrevRandao); err != nil {
+		return
+	}
+	hh.PutUint64(e.BlockNumber)
+	hh.PutUint64(e.GasLimit)
+	hh.PutUint64(e.GasUsed)
+	hh.PutUint64(e.Timestamp)
+	if err = putByteList(hh, e.ExtraData, maxExtraDataBytes); err != nil {
+		return
+	}
+	if err = putFixedBytes(hh, e.BaseFeePerGas, 32); err != nil {
+		return
+	}
+	if err = putFixedBytes(hh, e.BlockHash, 32); err != nil {
+		return
+	}
+
+	// Field 'Transactions'
+	if err = putTransactions(hh, e.Transactions); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayloadHeader object to a target array
+func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	if dst, err = marshalPayloadPrefix(dst, e.ParentHash, e.FeeRecipient, e.StateRoot, e.ReceiptsRoot, e.LogsBloom, e.PrevRandao); err != nil {
+		return
+	}
+	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+	dst = ssz.MarshalUint64(dst, e.GasLimit)
+	dst = ssz.MarshalUint64(dst, e.GasUsed)
+	dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+	// Offset 'ExtraData'
+	dst = ssz.WriteOffset(dst, executionPayloadHeaderFixedSize)
+
+	if dst, err = marshalFixedBytes(dst, e.BaseFeePerGas, 32); err != nil {
+		return
+	}
+	if dst, err = marshalFixedBytes(dst, e.BlockHash, 32); err != nil {
+		return
+	}
+	if dst, err = marshalFixedBytes(dst, e.TransactionsRoot, 32); err != nil {
+		return
+	}
+
+	// Field 'ExtraData'
+	if len(e.ExtraData) > maxExtraDataBytes {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
+	size := uint64(len(buf))
+	if size < executionPayloadHeaderFixedSize {
+		return ssz.ErrSize
+	}
+
+	e.ParentHash = append(e.ParentHash[:0], buf[0:32]...)
+	e.FeeRecipient = append(e.FeeRecipient[:0], buf[32:52]...)
+	e.StateRoot = append(e.StateRoot[:0], buf[52:84]...)
+	e.ReceiptsRoot = append(e.ReceiptsRoot[:0], buf[84:116]...)
+	e.LogsBloom = append(e.LogsBloom[:0], buf[116:372]...)
+	e.PrevRandao = append(e.PrevRandao[:0], buf[372:404]...)
+	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
+	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+
+	if o10 := ssz.ReadOffset(buf[436:440]); o10 != executionPayloadHeaderFixedSize {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	e.BaseFeePerGas = append(e.BaseFeePerGas[:0], buf[440:472]...)
+	e.BlockHash = append(e.BlockHash[:0], buf[472:504]...)
+	e.TransactionsRoot = append(e.TransactionsRoot[:0], buf[504:536]...)
+
+	// Field 'ExtraData'
+	extraData := buf[executionPayloadHeaderFixedSize:]
+	if len(extraData) > maxExtraDataBytes {
+		return ssz.ErrBytesLength
+	}
+	e.ExtraData = append(make([]byte, 0, len(extraData)), extraData...)
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) SizeSSZ() (size int) {
+	return executionPayloadHeaderFixedSize + len(e.ExtraData)
+}
+
+// HashTreeRoot ssz hashes the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionPayloadHeader object with a hasher
+func (e *ExecutionPayloadHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	if err = putPayloadPrefix(hh, e.ParentHash, e.FeeRecipient, e.StateRoot, e.ReceiptsRoot, e.LogsBloom, e.PrevRandao); err != nil {
+		return
+	}
+	hh.PutUint64(e.BlockNumber)
+	hh.PutUint64(e.GasLimit)
+	hh.PutUint64(e.GasUsed)
+	hh.PutUint64(e.Timestamp)
+	if err = putByteList(hh, e.ExtraData, maxExtraDataBytes); err != nil {
+		return
+	}
+	if err = putFixedBytes(hh, e.BaseFeePerGas, 32); err != nil {
+		return
+	}
+	if err = putFixedBytes(hh, e.BlockHash, 32); err != nil {
+		return
+	}
+	if err = putFixedBytes(hh, e.TransactionsRoot, 32); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// TransactionsRoot returns the hash tree root of the transactions of an execution payload, which
+// is the transactions root of the header of the payload.
+func TransactionsRoot(txs [][]byte) ([32]byte, error) {
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+	if err := putTransactions(hh, txs); err != nil {
+		return [32]byte{}, err
+	}
+	return hh.HashRoot()
+}
+
+// marshalPayloadPrefix marshals the fixed size fields shared by payloads and payload headers, up
+// to the randao mix.
+func marshalPayloadPrefix(dst, parentHash, feeRecipient, stateRoot, receiptsRoot, logsBloom, prevRandao []byte) ([]byte, error) {
+	var err error
+	fields := []struct {
+		b    []byte
+		size int
+	}{{parentHash, 32}, {feeRecipient, 20}, {stateRoot, 32}, {receiptsRoot, 32}, {logsBloom, 256}, {prevRandao, 32}}
+	for _, f := range fields {
+		if dst, err = marshalFixedBytes(dst, f.b, f.size); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+func marshalFixedBytes(dst, b []byte, size int) ([]byte, error) {
+	if len(b) != size {
+		return nil, ssz.ErrBytesLength
+	}
+	return append(dst, b...), nil
+}
+
+// putPayloadPrefix hashes the fixed size fields shared by payloads and payload headers, up to the
+// randao mix.
+func putPayloadPrefix(hh *ssz.Hasher, parentHash, feeRecipient, stateRoot, receiptsRoot, logsBloom, prevRandao []byte) error {
+	fields := []struct {
+		b    []byte
+		size int
+	}{{parentHash, 32}, {feeRecipient, 20}, {stateRoot, 32}, {receiptsRoot, 32}, {logsBloom, 256}, {prevRandao, 32}}
+	for _, f := range fields {
+		if err := putFixedBytes(hh, f.b, f.size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func putFixedBytes(hh *ssz.Hasher, b []byte, size int) error {
+	if len(b) != size {
+		return ssz.ErrBytesLength
+	}
+	hh.PutBytes(b)
+	return nil
+}
+
+// putByteList hashes a byte list of the given maximum length, mixing in its length.
+func putByteList(hh *ssz.Hasher, b []byte, maxLength uint64) error {
+	if uint64(len(b)) > maxLength {
+		return ssz.ErrBytesLength
+	}
+	indx := hh.Index()
+	hh.Append(b)
+	hh.FillUpTo32()
+	hh.MerkleizeWithMixin(indx, uint64(len(b)), (maxLength+31)/32)
+	return nil
+}
+
+// putTransactions merkleizes a list of transactions, as List[Transaction, MAX_TRANSACTIONS_PER_PAYLOAD].
+func putTransactions(hh *ssz.Hasher, txs [][]byte) error {
+	if len(txs) > maxTransactionsPerPayload {
+		return ssz.ErrListTooBig
+	}
+	indx := hh.Index()
+	for _, tx := range txs {
+		if err := putByteList(hh, tx, maxBytesPerTransaction); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(indx, uint64(len(txs)), maxTransactionsPerPayload)
+	return nil
+}
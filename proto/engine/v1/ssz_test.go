@@ -0,0 +1,177 @@
+package enginev1_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testPayload(transactions [][]byte) *enginev1.ExecutionPayload {
+	return &enginev1.ExecutionPayload{
+		ParentHash:    bytesutil.PadTo([]byte("parent"), 32),
+		FeeRecipient:  bytesutil.PadTo([]byte("fee recipient"), 20),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		ReceiptsRoot:  bytesutil.PadTo([]byte("receipts"), 32),
+		LogsBloom:     bytesutil.PadTo([]byte("bloom"), 256),
+		PrevRandao:    bytesutil.PadTo([]byte("randao"), 32),
+		BlockNumber:   1,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     12,
+		ExtraData:     []byte("extra"),
+		BaseFeePerGas: bytesutil.PadTo([]byte{7}, 32),
+		BlockHash:     bytesutil.PadTo([]byte("block"), 32),
+		Transactions:  transactions,
+	}
+}
+
+func TestExecutionPayload_SSZRoundTrip(t *testing.T) {
+	for _, txs := range [][][]byte{{}, {{}}, {[]byte("tx"), make([]byte, 100), []byte("other tx")}} {
+		p := testPayload(txs)
+		enc, err := p.MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, p.SizeSSZ(), len(enc))
+
+		decoded := &enginev1.ExecutionPayload{}
+		require.NoError(t, decoded.UnmarshalSSZ(enc))
+		assert.DeepSSZEqual(t, p, decoded)
+	}
+}
+
+func TestExecutionPayload_UnmarshalSSZ_Invalid(t *testing.T) {
+	enc, err := testPayload([][]byte{[]byte("tx")}).MarshalSSZ()
+	require.NoError(t, err)
+	assert.ErrorContains(t, ssz.ErrSize.Error(), (&enginev1.ExecutionPayload{}).UnmarshalSSZ(enc[:507]))
+
+	// The extra data is longer than MAX_EXTRA_DATA_BYTES.
+	long := testPayload(nil)
+	long.ExtraData = make([]byte, 33)
+	_, err = long.MarshalSSZ()
+	assert.ErrorContains(t, ssz.ErrBytesLength.Error(), err)
+}
+
+func TestExecutionPayloadHeader_SSZRoundTrip(t *testing.T) {
+	p := testPayload(nil)
+	h := &enginev1.ExecutionPayloadHeader{
+		ParentHash:       p.ParentHash,
+		FeeRecipient:     p.FeeRecipient,
+		StateRoot:        p.StateRoot,
+		ReceiptsRoot:     p.ReceiptsRoot,
+		LogsBloom:        p.LogsBloom,
+		PrevRandao:       p.PrevRandao,
+		BlockNumber:      p.BlockNumber,
+		GasLimit:         p.GasLimit,
+		GasUsed:          p.GasUsed,
+		Timestamp:        p.Timestamp,
+		ExtraData:        p.ExtraData,
+		BaseFeePerGas:    p.BaseFeePerGas,
+		BlockHash:        p.BlockHash,
+		TransactionsRoot: make([]byte, 32),
+	}
+	enc, err := h.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, h.SizeSSZ(), len(enc))
+	decoded := &enginev1.ExecutionPayloadHeader{}
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	assert.DeepSSZEqual(t, h, decoded)
+}
+
+func TestExecutionPayload_HashTreeRoot(t *testing.T) {
+	txs := [][]byte{[]byte("tx"), make([]byte, 100)}
+	p := testPayload(txs)
+	root, err := p.HashTreeRoot()
+	require.NoError(t, err)
+
+	txsRoot := listRoot(t, byteListRoots(t, txs, 1073741824), 1048576)
+	assert.Equal(t, expectedRoot(t, p, txsRoot), root)
+	gotTxsRoot, err := enginev1.TransactionsRoot(txs)
+	require.NoError(t, err)
+	assert.Equal(t, txsRoot, gotTxsRoot)
+
+	// The root of the header is the root of the payload, with the transactions replaced by their
+	// root.
+	h := &enginev1.ExecutionPayloadHeader{
+		ParentHash:       p.ParentHash,
+		FeeRecipient:     p.FeeRecipient,
+		StateRoot:        p.StateRoot,
+		ReceiptsRoot:     p.ReceiptsRoot,
+		LogsBloom:        p.LogsBloom,
+		PrevRandao:       p.PrevRandao,
+		BlockNumber:      p.BlockNumber,
+		GasLimit:         p.GasLimit,
+		GasUsed:          p.GasUsed,
+		Timestamp:        p.Timestamp,
+		ExtraData:        p.ExtraData,
+		BaseFeePerGas:    p.BaseFeePerGas,
+		BlockHash:        p.BlockHash,
+		TransactionsRoot: txsRoot[:],
+	}
+	headerRoot, err := h.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, headerRoot)
+}
+
+// expectedRoot computes the root of a payload from the roots of its fields.
+func expectedRoot(t *testing.T, p *enginev1.ExecutionPayload, txsRoot [32]byte) [32]byte {
+	vectorRoot := func(b []byte) [32]byte {
+		chunks, err := htrutils.Pack([][]byte{b})
+		require.NoError(t, err)
+		r, err := htrutils.BitwiseMerkleize(hashutil.CustomSHA256Hasher(), chunks, uint64(len(chunks)), uint64(len(chunks)))
+		require.NoError(t, err)
+		return r
+	}
+	fieldRoots := [][32]byte{
+		vectorRoot(p.ParentHash),
+		vectorRoot(p.FeeRecipient),
+		vectorRoot(p.StateRoot),
+		vectorRoot(p.ReceiptsRoot),
+		vectorRoot(p.LogsBloom),
+		vectorRoot(p.PrevRandao),
+		htrutils.Uint64Root(p.BlockNumber),
+		htrutils.Uint64Root(p.GasLimit),
+		htrutils.Uint64Root(p.GasUsed),
+		htrutils.Uint64Root(p.Timestamp),
+		byteListRoots(t, [][]byte{p.ExtraData}, 32)[0],
+		vectorRoot(p.BaseFeePerGas),
+		vectorRoot(p.BlockHash),
+		txsRoot,
+	}
+	r, err := htrutils.BitwiseMerkleizeArrays(hashutil.CustomSHA256Hasher(), fieldRoots, uint64(len(fieldRoots)), uint64(len(fieldRoots)))
+	require.NoError(t, err)
+	return r
+}
+
+func byteListRoots(t *testing.T, lists [][]byte, maxLength uint64) [][32]byte {
+	roots := make([][32]byte, len(lists))
+	for i, l := range lists {
+		var chunks [][]byte
+		if len(l) != 0 {
+			var err error
+			chunks, err = htrutils.Pack([][]byte{l})
+			require.NoError(t, err)
+		}
+		r, err := htrutils.BitwiseMerkleize(hashutil.CustomSHA256Hasher(), chunks, uint64(len(chunks)), (maxLength+31)/32)
+		require.NoError(t, err)
+		roots[i] = mixInLength(r, uint64(len(l)))
+	}
+	return roots
+}
+
+func listRoot(t *testing.T, roots [][32]byte, limit uint64) [32]byte {
+	r, err := htrutils.BitwiseMerkleizeArrays(hashutil.CustomSHA256Hasher(), roots, uint64(len(roots)), limit)
+	require.NoError(t, err)
+	return mixInLength(r, uint64(len(roots)))
+}
+
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	l := make([]byte, 32)
+	binary.LittleEndian.PutUint64(l, length)
+	return htrutils.MixInLength(root, l)
+}
@@ -3,6 +3,7 @@ package wrapper
 import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
@@ -85,6 +86,11 @@ func (w Phase0SignedBeaconBlock) PbAltairBlock() (*prysmv2.SignedBeaconBlockAlta
 	return nil, errors.New("unsupported altair block")
 }
 
+// PbBellatrixBlock is a stub.
+func (w Phase0SignedBeaconBlock) PbBellatrixBlock() (*prysmv2.SignedBeaconBlockBellatrix, error) {
+	return nil, errors.New("unsupported bellatrix block")
+}
+
 // Version of the underlying protobuf object.
 func (w Phase0SignedBeaconBlock) Version() int {
 	return version.Phase0
@@ -226,6 +232,11 @@ func (w Phase0BeaconBlockBody) SyncAggregate() (*prysmv2.SyncAggregate, error) {
 	return nil, errors.New("Sync aggregate is not supported in phase 0 block")
 }
 
+// ExecutionPayload returns the execution payload in the block.
+func (w Phase0BeaconBlockBody) ExecutionPayload() (*enginev1.ExecutionPayload, error) {
+	return nil, errors.New("Execution payload is not supported in phase 0 block")
+}
+
 // IsNil checks if the block body is nil.
 func (w Phase0BeaconBlockBody) IsNil() bool {
 	return w.b == nil
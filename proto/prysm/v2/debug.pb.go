@@ -913,6 +913,827 @@ func (x *TopicScoreSnapshot) GetInvalidMessageDeliveries() float32 {
 	return 0
 }
 
+type DebugPendingQueuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks       []*DebugPendingBlock       `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Attestations []*DebugPendingAttestation `protobuf:"bytes,2,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	PeerCounts   []*DebugPendingPeerCount   `protobuf:"bytes,3,rep,name=peer_counts,json=peerCounts,proto3" json:"peer_counts,omitempty"`
+}
+
+func (x *DebugPendingQueuesResponse) Reset() {
+	*x = DebugPendingQueuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugPendingQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugPendingQueuesResponse) ProtoMessage() {}
+
+func (x *DebugPendingQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugPendingQueuesResponse.ProtoReflect.Descriptor instead.
+func (*DebugPendingQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DebugPendingQueuesResponse) GetBlocks() []*DebugPendingBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *DebugPendingQueuesResponse) GetAttestations() []*DebugPendingAttestation {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+func (x *DebugPendingQueuesResponse) GetPeerCounts() []*DebugPendingPeerCount {
+	if x != nil {
+		return x.PeerCounts
+	}
+	return nil
+}
+
+type DebugPendingBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot       github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	BlockRoot  []byte                                   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ParentRoot []byte                                   `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	PeerId     string                                   `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+}
+
+func (x *DebugPendingBlock) Reset() {
+	*x = DebugPendingBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugPendingBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugPendingBlock) ProtoMessage() {}
+
+func (x *DebugPendingBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugPendingBlock.ProtoReflect.Descriptor instead.
+func (*DebugPendingBlock) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DebugPendingBlock) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *DebugPendingBlock) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *DebugPendingBlock) GetParentRoot() []byte {
+	if x != nil {
+		return x.ParentRoot
+	}
+	return nil
+}
+
+func (x *DebugPendingBlock) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+type DebugPendingAttestation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot            github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	BlockRoot       []byte                                             `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	AggregatorIndex github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,opt,name=aggregator_index,json=aggregatorIndex,proto3" json:"aggregator_index,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+	PeerId          string                                             `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+}
+
+func (x *DebugPendingAttestation) Reset() {
+	*x = DebugPendingAttestation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugPendingAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugPendingAttestation) ProtoMessage() {}
+
+func (x *DebugPendingAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugPendingAttestation.ProtoReflect.Descriptor instead.
+func (*DebugPendingAttestation) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DebugPendingAttestation) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *DebugPendingAttestation) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *DebugPendingAttestation) GetAggregatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.AggregatorIndex
+	}
+	return github_com_prysmaticlabs_eth2_types.ValidatorIndex(0)
+}
+
+func (x *DebugPendingAttestation) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+type DebugPendingPeerCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId       string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Blocks       uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Attestations uint64 `protobuf:"varint,3,opt,name=attestations,proto3" json:"attestations,omitempty"`
+}
+
+func (x *DebugPendingPeerCount) Reset() {
+	*x = DebugPendingPeerCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugPendingPeerCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugPendingPeerCount) ProtoMessage() {}
+
+func (x *DebugPendingPeerCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugPendingPeerCount.ProtoReflect.Descriptor instead.
+func (*DebugPendingPeerCount) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DebugPendingPeerCount) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *DebugPendingPeerCount) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *DebugPendingPeerCount) GetAttestations() uint64 {
+	if x != nil {
+		return x.Attestations
+	}
+	return 0
+}
+
+type DebugGossipScoresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thresholds *GossipScoreThresholds  `protobuf:"bytes,1,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	Peers      []*DebugPeerGossipScore `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *DebugGossipScoresResponse) Reset() {
+	*x = DebugGossipScoresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugGossipScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugGossipScoresResponse) ProtoMessage() {}
+
+func (x *DebugGossipScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugGossipScoresResponse.ProtoReflect.Descriptor instead.
+func (*DebugGossipScoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DebugGossipScoresResponse) GetThresholds() *GossipScoreThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *DebugGossipScoresResponse) GetPeers() []*DebugPeerGossipScore {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type GossipScoreThresholds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GossipThreshold             float32 `protobuf:"fixed32,1,opt,name=gossip_threshold,json=gossipThreshold,proto3" json:"gossip_threshold,omitempty"`
+	PublishThreshold            float32 `protobuf:"fixed32,2,opt,name=publish_threshold,json=publishThreshold,proto3" json:"publish_threshold,omitempty"`
+	GraylistThreshold           float32 `protobuf:"fixed32,3,opt,name=graylist_threshold,json=graylistThreshold,proto3" json:"graylist_threshold,omitempty"`
+	AcceptPxThreshold           float32 `protobuf:"fixed32,4,opt,name=accept_px_threshold,json=acceptPxThreshold,proto3" json:"accept_px_threshold,omitempty"`
+	OpportunisticGraftThreshold float32 `protobuf:"fixed32,5,opt,name=opportunistic_graft_threshold,json=opportunisticGraftThreshold,proto3" json:"opportunistic_graft_threshold,omitempty"`
+}
+
+func (x *GossipScoreThresholds) Reset() {
+	*x = GossipScoreThresholds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipScoreThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipScoreThresholds) ProtoMessage() {}
+
+func (x *GossipScoreThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipScoreThresholds.ProtoReflect.Descriptor instead.
+func (*GossipScoreThresholds) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GossipScoreThresholds) GetGossipThreshold() float32 {
+	if x != nil {
+		return x.GossipThreshold
+	}
+	return 0
+}
+
+func (x *GossipScoreThresholds) GetPublishThreshold() float32 {
+	if x != nil {
+		return x.PublishThreshold
+	}
+	return 0
+}
+
+func (x *GossipScoreThresholds) GetGraylistThreshold() float32 {
+	if x != nil {
+		return x.GraylistThreshold
+	}
+	return 0
+}
+
+func (x *GossipScoreThresholds) GetAcceptPxThreshold() float32 {
+	if x != nil {
+		return x.AcceptPxThreshold
+	}
+	return 0
+}
+
+func (x *GossipScoreThresholds) GetOpportunisticGraftThreshold() float32 {
+	if x != nil {
+		return x.OpportunisticGraftThreshold
+	}
+	return 0
+}
+
+type DebugPeerGossipScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId           string             `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Score            float32            `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	BehaviourPenalty float32            `protobuf:"fixed32,3,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
+	Graylisted       bool               `protobuf:"varint,4,opt,name=graylisted,proto3" json:"graylisted,omitempty"`
+	Topics           []*DebugTopicScore `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *DebugPeerGossipScore) Reset() {
+	*x = DebugPeerGossipScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugPeerGossipScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugPeerGossipScore) ProtoMessage() {}
+
+func (x *DebugPeerGossipScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugPeerGossipScore.ProtoReflect.Descriptor instead.
+func (*DebugPeerGossipScore) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DebugPeerGossipScore) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *DebugPeerGossipScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DebugPeerGossipScore) GetBehaviourPenalty() float32 {
+	if x != nil {
+		return x.BehaviourPenalty
+	}
+	return 0
+}
+
+func (x *DebugPeerGossipScore) GetGraylisted() bool {
+	if x != nil {
+		return x.Graylisted
+	}
+	return false
+}
+
+func (x *DebugPeerGossipScore) GetTopics() []*DebugTopicScore {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type DebugTopicScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic                  string  `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	TopicWeight            float32 `protobuf:"fixed32,2,opt,name=topic_weight,json=topicWeight,proto3" json:"topic_weight,omitempty"`
+	TimeInMesh             float32 `protobuf:"fixed32,3,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
+	FirstMessageDeliveries float32 `protobuf:"fixed32,4,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
+	MeshDeliveryDeficit    float32 `protobuf:"fixed32,5,opt,name=mesh_delivery_deficit,json=meshDeliveryDeficit,proto3" json:"mesh_delivery_deficit,omitempty"`
+	InvalidMessages        float32 `protobuf:"fixed32,6,opt,name=invalid_messages,json=invalidMessages,proto3" json:"invalid_messages,omitempty"`
+	Total                  float32 `protobuf:"fixed32,7,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *DebugTopicScore) Reset() {
+	*x = DebugTopicScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugTopicScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugTopicScore) ProtoMessage() {}
+
+func (x *DebugTopicScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugTopicScore.ProtoReflect.Descriptor instead.
+func (*DebugTopicScore) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DebugTopicScore) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DebugTopicScore) GetTopicWeight() float32 {
+	if x != nil {
+		return x.TopicWeight
+	}
+	return 0
+}
+
+func (x *DebugTopicScore) GetTimeInMesh() float32 {
+	if x != nil {
+		return x.TimeInMesh
+	}
+	return 0
+}
+
+func (x *DebugTopicScore) GetFirstMessageDeliveries() float32 {
+	if x != nil {
+		return x.FirstMessageDeliveries
+	}
+	return 0
+}
+
+func (x *DebugTopicScore) GetMeshDeliveryDeficit() float32 {
+	if x != nil {
+		return x.MeshDeliveryDeficit
+	}
+	return 0
+}
+
+func (x *DebugTopicScore) GetInvalidMessages() float32 {
+	if x != nil {
+		return x.InvalidMessages
+	}
+	return 0
+}
+
+func (x *DebugTopicScore) GetTotal() float32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DebugSlasherStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkSize          uint64                                    `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	ValidatorChunkSize uint64                                    `protobuf:"varint,2,opt,name=validator_chunk_size,json=validatorChunkSize,proto3" json:"validator_chunk_size,omitempty"`
+	HistoryLength      github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	FileBytes          uint64                                    `protobuf:"varint,4,opt,name=file_bytes,json=fileBytes,proto3" json:"file_bytes,omitempty"`
+	FreeBytes          uint64                                    `protobuf:"varint,5,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	Buckets            []*SlasherBucketStorage                   `protobuf:"bytes,6,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	ValidatorChunks    []*SlasherValidatorChunkStorage           `protobuf:"bytes,7,rep,name=validator_chunks,json=validatorChunks,proto3" json:"validator_chunks,omitempty"`
+}
+
+func (x *DebugSlasherStorageResponse) Reset() {
+	*x = DebugSlasherStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugSlasherStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugSlasherStorageResponse) ProtoMessage() {}
+
+func (x *DebugSlasherStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugSlasherStorageResponse.ProtoReflect.Descriptor instead.
+func (*DebugSlasherStorageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DebugSlasherStorageResponse) GetChunkSize() uint64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *DebugSlasherStorageResponse) GetValidatorChunkSize() uint64 {
+	if x != nil {
+		return x.ValidatorChunkSize
+	}
+	return 0
+}
+
+func (x *DebugSlasherStorageResponse) GetHistoryLength() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.HistoryLength
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *DebugSlasherStorageResponse) GetFileBytes() uint64 {
+	if x != nil {
+		return x.FileBytes
+	}
+	return 0
+}
+
+func (x *DebugSlasherStorageResponse) GetFreeBytes() uint64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+func (x *DebugSlasherStorageResponse) GetBuckets() []*SlasherBucketStorage {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *DebugSlasherStorageResponse) GetValidatorChunks() []*SlasherValidatorChunkStorage {
+	if x != nil {
+		return x.ValidatorChunks
+	}
+	return nil
+}
+
+type SlasherBucketStorage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NumKeys        uint64 `protobuf:"varint,2,opt,name=num_keys,json=numKeys,proto3" json:"num_keys,omitempty"`
+	AllocatedBytes uint64 `protobuf:"varint,3,opt,name=allocated_bytes,json=allocatedBytes,proto3" json:"allocated_bytes,omitempty"`
+	UsedBytes      uint64 `protobuf:"varint,4,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+}
+
+func (x *SlasherBucketStorage) Reset() {
+	*x = SlasherBucketStorage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlasherBucketStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlasherBucketStorage) ProtoMessage() {}
+
+func (x *SlasherBucketStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlasherBucketStorage.ProtoReflect.Descriptor instead.
+func (*SlasherBucketStorage) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SlasherBucketStorage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SlasherBucketStorage) GetNumKeys() uint64 {
+	if x != nil {
+		return x.NumKeys
+	}
+	return 0
+}
+
+func (x *SlasherBucketStorage) GetAllocatedBytes() uint64 {
+	if x != nil {
+		return x.AllocatedBytes
+	}
+	return 0
+}
+
+func (x *SlasherBucketStorage) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+type SlasherValidatorChunkStorage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorChunkIndex uint64 `protobuf:"varint,1,opt,name=validator_chunk_index,json=validatorChunkIndex,proto3" json:"validator_chunk_index,omitempty"`
+	MinSpanChunks       uint64 `protobuf:"varint,2,opt,name=min_span_chunks,json=minSpanChunks,proto3" json:"min_span_chunks,omitempty"`
+	MaxSpanChunks       uint64 `protobuf:"varint,3,opt,name=max_span_chunks,json=maxSpanChunks,proto3" json:"max_span_chunks,omitempty"`
+	MinSpanBytes        uint64 `protobuf:"varint,4,opt,name=min_span_bytes,json=minSpanBytes,proto3" json:"min_span_bytes,omitempty"`
+	MaxSpanBytes        uint64 `protobuf:"varint,5,opt,name=max_span_bytes,json=maxSpanBytes,proto3" json:"max_span_bytes,omitempty"`
+}
+
+func (x *SlasherValidatorChunkStorage) Reset() {
+	*x = SlasherValidatorChunkStorage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlasherValidatorChunkStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlasherValidatorChunkStorage) ProtoMessage() {}
+
+func (x *SlasherValidatorChunkStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlasherValidatorChunkStorage.ProtoReflect.Descriptor instead.
+func (*SlasherValidatorChunkStorage) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_debug_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SlasherValidatorChunkStorage) GetValidatorChunkIndex() uint64 {
+	if x != nil {
+		return x.ValidatorChunkIndex
+	}
+	return 0
+}
+
+func (x *SlasherValidatorChunkStorage) GetMinSpanChunks() uint64 {
+	if x != nil {
+		return x.MinSpanChunks
+	}
+	return 0
+}
+
+func (x *SlasherValidatorChunkStorage) GetMaxSpanChunks() uint64 {
+	if x != nil {
+		return x.MaxSpanChunks
+	}
+	return 0
+}
+
+func (x *SlasherValidatorChunkStorage) GetMinSpanBytes() uint64 {
+	if x != nil {
+		return x.MinSpanBytes
+	}
+	return 0
+}
+
+func (x *SlasherValidatorChunkStorage) GetMaxSpanBytes() uint64 {
+	if x != nil {
+		return x.MaxSpanBytes
+	}
+	return 0
+}
+
 type DebugPeerResponse_PeerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -930,7 +1751,7 @@ type DebugPeerResponse_PeerInfo struct {
 func (x *DebugPeerResponse_PeerInfo) Reset() {
 	*x = DebugPeerResponse_PeerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_prysm_v2_debug_proto_msgTypes[13]
+		mi := &file_proto_prysm_v2_debug_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -943,7 +1764,7 @@ func (x *DebugPeerResponse_PeerInfo) String() string {
 func (*DebugPeerResponse_PeerInfo) ProtoMessage() {}
 
 func (x *DebugPeerResponse_PeerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prysm_v2_debug_proto_msgTypes[13]
+	mi := &file_proto_prysm_v2_debug_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,7 +2034,165 @@ var file_proto_prysm_v2_debug_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
 	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x18, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
 	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x32, 0x81, 0x07, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x7c, 0x0a, 0x0e,
+	0x65, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x4e, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x49, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a,
+	0x70, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c,
+	0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x17,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x61, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x36, 0x82, 0xb5, 0x18, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x15, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x62, 0x75, 0x67, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x0a,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x15, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x67,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x67,
+	0x72, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x67, 0x72, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x5f, 0x70, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50,
+	0x78, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x42, 0x0a, 0x1d, 0x6f, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x67, 0x72, 0x61, 0x66,
+	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x1b, 0x6f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x47, 0x72, 0x61, 0x66, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xce,
+	0x01, 0x0a, 0x14, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x65, 0x72, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x75, 0x72, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x10, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22,
+	0x9b, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x73, 0x68, 0x12, 0x38,
+	0x0a, 0x18, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x16, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x65, 0x73, 0x68,
+	0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x63, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x6d, 0x65, 0x73, 0x68, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x44, 0x65, 0x66, 0x69, 0x63, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xa1, 0x03,
+	0x0a, 0x1b, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x54,
+	0x0a, 0x0e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x6e, 0x75, 0x6d, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0xee, 0x01, 0x0a, 0x1c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x70,
+	0x61, 0x6e, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x61, 0x6e,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x70,
+	0x61, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x6d, 0x69, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x61, 0x6e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x32, 0x98, 0x0a, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x7c, 0x0a, 0x0e,
 	0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25,
 	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e,
 	0x76, 0x32, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
@@ -1269,15 +2248,41 @@ var file_proto_prysm_v2_debug_proto_rawDesc = []byte{
 	0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
 	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x7f, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x42,
-	0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
-	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x32, 0x3b, 0x76, 0x32, 0xaa,
-	0x02, 0x11, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x50, 0x72, 0x79, 0x73, 0x6d,
-	0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x50,
-	0x72, 0x79, 0x73, 0x6d, 0x5c, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x84, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x47,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2f, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x12, 0x8a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2e,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e,
+	0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x7f, 0x0a,
+	0x15, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x42, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x2f, 0x76, 0x32, 0x3b, 0x76, 0x32, 0xaa, 0x02, 0x11, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x50, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x50, 0x72, 0x79, 0x73, 0x6d, 0x5c, 0x76, 0x32, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1293,7 +2298,7 @@ func file_proto_prysm_v2_debug_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_prysm_v2_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_prysm_v2_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_prysm_v2_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_proto_prysm_v2_debug_proto_goTypes = []interface{}{
 	(LoggingLevelRequest_Level)(0),       // 0: ethereum.prysm.v2.LoggingLevelRequest.Level
 	(*InclusionSlotRequest)(nil),         // 1: ethereum.prysm.v2.InclusionSlotRequest
@@ -1308,50 +2313,75 @@ var file_proto_prysm_v2_debug_proto_goTypes = []interface{}{
 	(*DebugPeerResponse)(nil),            // 10: ethereum.prysm.v2.DebugPeerResponse
 	(*ScoreInfo)(nil),                    // 11: ethereum.prysm.v2.ScoreInfo
 	(*TopicScoreSnapshot)(nil),           // 12: ethereum.prysm.v2.TopicScoreSnapshot
-	nil,                                  // 13: ethereum.prysm.v2.ProtoArrayForkChoiceResponse.IndicesEntry
-	(*DebugPeerResponse_PeerInfo)(nil),   // 14: ethereum.prysm.v2.DebugPeerResponse.PeerInfo
-	nil,                                  // 15: ethereum.prysm.v2.ScoreInfo.TopicScoresEntry
-	(v1alpha1.PeerDirection)(0),          // 16: ethereum.eth.v1alpha1.PeerDirection
-	(v1alpha1.ConnectionState)(0),        // 17: ethereum.eth.v1alpha1.ConnectionState
-	(*Status)(nil),                       // 18: ethereum.prysm.v2.Status
-	(*MetaDataV0)(nil),                   // 19: ethereum.prysm.v2.MetaDataV0
-	(*MetaDataV1)(nil),                   // 20: ethereum.prysm.v2.MetaDataV1
-	(*empty.Empty)(nil),                  // 21: google.protobuf.Empty
-	(*v1alpha1.PeerRequest)(nil),         // 22: ethereum.eth.v1alpha1.PeerRequest
+	(*DebugPendingQueuesResponse)(nil),   // 13: ethereum.prysm.v2.DebugPendingQueuesResponse
+	(*DebugPendingBlock)(nil),            // 14: ethereum.prysm.v2.DebugPendingBlock
+	(*DebugPendingAttestation)(nil),      // 15: ethereum.prysm.v2.DebugPendingAttestation
+	(*DebugPendingPeerCount)(nil),        // 16: ethereum.prysm.v2.DebugPendingPeerCount
+	(*DebugGossipScoresResponse)(nil),    // 17: ethereum.prysm.v2.DebugGossipScoresResponse
+	(*GossipScoreThresholds)(nil),        // 18: ethereum.prysm.v2.GossipScoreThresholds
+	(*DebugPeerGossipScore)(nil),         // 19: ethereum.prysm.v2.DebugPeerGossipScore
+	(*DebugTopicScore)(nil),              // 20: ethereum.prysm.v2.DebugTopicScore
+	(*DebugSlasherStorageResponse)(nil),  // 21: ethereum.prysm.v2.DebugSlasherStorageResponse
+	(*SlasherBucketStorage)(nil),         // 22: ethereum.prysm.v2.SlasherBucketStorage
+	(*SlasherValidatorChunkStorage)(nil), // 23: ethereum.prysm.v2.SlasherValidatorChunkStorage
+	nil,                                  // 24: ethereum.prysm.v2.ProtoArrayForkChoiceResponse.IndicesEntry
+	(*DebugPeerResponse_PeerInfo)(nil),   // 25: ethereum.prysm.v2.DebugPeerResponse.PeerInfo
+	nil,                                  // 26: ethereum.prysm.v2.ScoreInfo.TopicScoresEntry
+	(v1alpha1.PeerDirection)(0),          // 27: ethereum.eth.v1alpha1.PeerDirection
+	(v1alpha1.ConnectionState)(0),        // 28: ethereum.eth.v1alpha1.ConnectionState
+	(*Status)(nil),                       // 29: ethereum.prysm.v2.Status
+	(*MetaDataV0)(nil),                   // 30: ethereum.prysm.v2.MetaDataV0
+	(*MetaDataV1)(nil),                   // 31: ethereum.prysm.v2.MetaDataV1
+	(*empty.Empty)(nil),                  // 32: google.protobuf.Empty
+	(*v1alpha1.PeerRequest)(nil),         // 33: ethereum.eth.v1alpha1.PeerRequest
 }
 var file_proto_prysm_v2_debug_proto_depIdxs = []int32{
 	0,  // 0: ethereum.prysm.v2.LoggingLevelRequest.level:type_name -> ethereum.prysm.v2.LoggingLevelRequest.Level
 	8,  // 1: ethereum.prysm.v2.ProtoArrayForkChoiceResponse.proto_array_nodes:type_name -> ethereum.prysm.v2.ProtoArrayNode
-	13, // 2: ethereum.prysm.v2.ProtoArrayForkChoiceResponse.indices:type_name -> ethereum.prysm.v2.ProtoArrayForkChoiceResponse.IndicesEntry
+	24, // 2: ethereum.prysm.v2.ProtoArrayForkChoiceResponse.indices:type_name -> ethereum.prysm.v2.ProtoArrayForkChoiceResponse.IndicesEntry
 	10, // 3: ethereum.prysm.v2.DebugPeerResponses.responses:type_name -> ethereum.prysm.v2.DebugPeerResponse
-	16, // 4: ethereum.prysm.v2.DebugPeerResponse.direction:type_name -> ethereum.eth.v1alpha1.PeerDirection
-	17, // 5: ethereum.prysm.v2.DebugPeerResponse.connection_state:type_name -> ethereum.eth.v1alpha1.ConnectionState
-	14, // 6: ethereum.prysm.v2.DebugPeerResponse.peer_info:type_name -> ethereum.prysm.v2.DebugPeerResponse.PeerInfo
-	18, // 7: ethereum.prysm.v2.DebugPeerResponse.peer_status:type_name -> ethereum.prysm.v2.Status
+	27, // 4: ethereum.prysm.v2.DebugPeerResponse.direction:type_name -> ethereum.eth.v1alpha1.PeerDirection
+	28, // 5: ethereum.prysm.v2.DebugPeerResponse.connection_state:type_name -> ethereum.eth.v1alpha1.ConnectionState
+	25, // 6: ethereum.prysm.v2.DebugPeerResponse.peer_info:type_name -> ethereum.prysm.v2.DebugPeerResponse.PeerInfo
+	29, // 7: ethereum.prysm.v2.DebugPeerResponse.peer_status:type_name -> ethereum.prysm.v2.Status
 	11, // 8: ethereum.prysm.v2.DebugPeerResponse.score_info:type_name -> ethereum.prysm.v2.ScoreInfo
-	15, // 9: ethereum.prysm.v2.ScoreInfo.topic_scores:type_name -> ethereum.prysm.v2.ScoreInfo.TopicScoresEntry
-	19, // 10: ethereum.prysm.v2.DebugPeerResponse.PeerInfo.metadataV0:type_name -> ethereum.prysm.v2.MetaDataV0
-	20, // 11: ethereum.prysm.v2.DebugPeerResponse.PeerInfo.metadataV1:type_name -> ethereum.prysm.v2.MetaDataV1
-	12, // 12: ethereum.prysm.v2.ScoreInfo.TopicScoresEntry.value:type_name -> ethereum.prysm.v2.TopicScoreSnapshot
-	3,  // 13: ethereum.prysm.v2.Debug.GetBeaconState:input_type -> ethereum.prysm.v2.BeaconStateRequest
-	4,  // 14: ethereum.prysm.v2.Debug.GetBlock:input_type -> ethereum.prysm.v2.BlockRequestByRoot
-	6,  // 15: ethereum.prysm.v2.Debug.SetLoggingLevel:input_type -> ethereum.prysm.v2.LoggingLevelRequest
-	21, // 16: ethereum.prysm.v2.Debug.GetProtoArrayForkChoice:input_type -> google.protobuf.Empty
-	21, // 17: ethereum.prysm.v2.Debug.ListPeers:input_type -> google.protobuf.Empty
-	22, // 18: ethereum.prysm.v2.Debug.GetPeer:input_type -> ethereum.eth.v1alpha1.PeerRequest
-	1,  // 19: ethereum.prysm.v2.Debug.GetInclusionSlot:input_type -> ethereum.prysm.v2.InclusionSlotRequest
-	5,  // 20: ethereum.prysm.v2.Debug.GetBeaconState:output_type -> ethereum.prysm.v2.SSZResponse
-	5,  // 21: ethereum.prysm.v2.Debug.GetBlock:output_type -> ethereum.prysm.v2.SSZResponse
-	21, // 22: ethereum.prysm.v2.Debug.SetLoggingLevel:output_type -> google.protobuf.Empty
-	7,  // 23: ethereum.prysm.v2.Debug.GetProtoArrayForkChoice:output_type -> ethereum.prysm.v2.ProtoArrayForkChoiceResponse
-	9,  // 24: ethereum.prysm.v2.Debug.ListPeers:output_type -> ethereum.prysm.v2.DebugPeerResponses
-	10, // 25: ethereum.prysm.v2.Debug.GetPeer:output_type -> ethereum.prysm.v2.DebugPeerResponse
-	2,  // 26: ethereum.prysm.v2.Debug.GetInclusionSlot:output_type -> ethereum.prysm.v2.InclusionSlotResponse
-	20, // [20:27] is the sub-list for method output_type
-	13, // [13:20] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	26, // 9: ethereum.prysm.v2.ScoreInfo.topic_scores:type_name -> ethereum.prysm.v2.ScoreInfo.TopicScoresEntry
+	14, // 10: ethereum.prysm.v2.DebugPendingQueuesResponse.blocks:type_name -> ethereum.prysm.v2.DebugPendingBlock
+	15, // 11: ethereum.prysm.v2.DebugPendingQueuesResponse.attestations:type_name -> ethereum.prysm.v2.DebugPendingAttestation
+	16, // 12: ethereum.prysm.v2.DebugPendingQueuesResponse.peer_counts:type_name -> ethereum.prysm.v2.DebugPendingPeerCount
+	18, // 13: ethereum.prysm.v2.DebugGossipScoresResponse.thresholds:type_name -> ethereum.prysm.v2.GossipScoreThresholds
+	19, // 14: ethereum.prysm.v2.DebugGossipScoresResponse.peers:type_name -> ethereum.prysm.v2.DebugPeerGossipScore
+	20, // 15: ethereum.prysm.v2.DebugPeerGossipScore.topics:type_name -> ethereum.prysm.v2.DebugTopicScore
+	22, // 16: ethereum.prysm.v2.DebugSlasherStorageResponse.buckets:type_name -> ethereum.prysm.v2.SlasherBucketStorage
+	23, // 17: ethereum.prysm.v2.DebugSlasherStorageResponse.validator_chunks:type_name -> ethereum.prysm.v2.SlasherValidatorChunkStorage
+	30, // 18: ethereum.prysm.v2.DebugPeerResponse.PeerInfo.metadataV0:type_name -> ethereum.prysm.v2.MetaDataV0
+	31, // 19: ethereum.prysm.v2.DebugPeerResponse.PeerInfo.metadataV1:type_name -> ethereum.prysm.v2.MetaDataV1
+	12, // 20: ethereum.prysm.v2.ScoreInfo.TopicScoresEntry.value:type_name -> ethereum.prysm.v2.TopicScoreSnapshot
+	3,  // 21: ethereum.prysm.v2.Debug.GetBeaconState:input_type -> ethereum.prysm.v2.BeaconStateRequest
+	4,  // 22: ethereum.prysm.v2.Debug.GetBlock:input_type -> ethereum.prysm.v2.BlockRequestByRoot
+	6,  // 23: ethereum.prysm.v2.Debug.SetLoggingLevel:input_type -> ethereum.prysm.v2.LoggingLevelRequest
+	32, // 24: ethereum.prysm.v2.Debug.GetProtoArrayForkChoice:input_type -> google.protobuf.Empty
+	32, // 25: ethereum.prysm.v2.Debug.ListPeers:input_type -> google.protobuf.Empty
+	33, // 26: ethereum.prysm.v2.Debug.GetPeer:input_type -> ethereum.eth.v1alpha1.PeerRequest
+	1,  // 27: ethereum.prysm.v2.Debug.GetInclusionSlot:input_type -> ethereum.prysm.v2.InclusionSlotRequest
+	32, // 28: ethereum.prysm.v2.Debug.GetPendingQueues:input_type -> google.protobuf.Empty
+	32, // 29: ethereum.prysm.v2.Debug.GetGossipScores:input_type -> google.protobuf.Empty
+	32, // 30: ethereum.prysm.v2.Debug.GetSlasherStorage:input_type -> google.protobuf.Empty
+	5,  // 31: ethereum.prysm.v2.Debug.GetBeaconState:output_type -> ethereum.prysm.v2.SSZResponse
+	5,  // 32: ethereum.prysm.v2.Debug.GetBlock:output_type -> ethereum.prysm.v2.SSZResponse
+	32, // 33: ethereum.prysm.v2.Debug.SetLoggingLevel:output_type -> google.protobuf.Empty
+	7,  // 34: ethereum.prysm.v2.Debug.GetProtoArrayForkChoice:output_type -> ethereum.prysm.v2.ProtoArrayForkChoiceResponse
+	9,  // 35: ethereum.prysm.v2.Debug.ListPeers:output_type -> ethereum.prysm.v2.DebugPeerResponses
+	10, // 36: ethereum.prysm.v2.Debug.GetPeer:output_type -> ethereum.prysm.v2.DebugPeerResponse
+	2,  // 37: ethereum.prysm.v2.Debug.GetInclusionSlot:output_type -> ethereum.prysm.v2.InclusionSlotResponse
+	13, // 38: ethereum.prysm.v2.Debug.GetPendingQueues:output_type -> ethereum.prysm.v2.DebugPendingQueuesResponse
+	17, // 39: ethereum.prysm.v2.Debug.GetGossipScores:output_type -> ethereum.prysm.v2.DebugGossipScoresResponse
+	21, // 40: ethereum.prysm.v2.Debug.GetSlasherStorage:output_type -> ethereum.prysm.v2.DebugSlasherStorageResponse
+	31, // [31:41] is the sub-list for method output_type
+	21, // [21:31] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_proto_prysm_v2_debug_proto_init() }
@@ -1505,7 +2535,139 @@ func file_proto_prysm_v2_debug_proto_init() {
 				return nil
 			}
 		}
+		file_proto_prysm_v2_debug_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPendingQueuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_proto_prysm_v2_debug_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPendingBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPendingAttestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPendingPeerCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugGossipScoresResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipScoreThresholds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPeerGossipScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugTopicScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugSlasherStorageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlasherBucketStorage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlasherValidatorChunkStorage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_debug_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DebugPeerResponse_PeerInfo); i {
 			case 0:
 				return &v.state
@@ -1528,7 +2690,7 @@ func file_proto_prysm_v2_debug_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_prysm_v2_debug_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1562,6 +2724,9 @@ type DebugClient interface {
 	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
 	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
 	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	GetPendingQueues(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPendingQueuesResponse, error)
+	GetGossipScores(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugGossipScoresResponse, error)
+	GetSlasherStorage(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugSlasherStorageResponse, error)
 }
 
 type debugClient struct {
@@ -1635,6 +2800,33 @@ func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotReq
 	return out, nil
 }
 
+func (c *debugClient) GetPendingQueues(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPendingQueuesResponse, error) {
+	out := new(DebugPendingQueuesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.prysm.v2.Debug/GetPendingQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetGossipScores(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugGossipScoresResponse, error) {
+	out := new(DebugGossipScoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.prysm.v2.Debug/GetGossipScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetSlasherStorage(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugSlasherStorageResponse, error) {
+	out := new(DebugSlasherStorageResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.prysm.v2.Debug/GetSlasherStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
 	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
@@ -1644,6 +2836,9 @@ type DebugServer interface {
 	ListPeers(context.Context, *empty.Empty) (*DebugPeerResponses, error)
 	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
 	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	GetPendingQueues(context.Context, *empty.Empty) (*DebugPendingQueuesResponse, error)
+	GetGossipScores(context.Context, *empty.Empty) (*DebugGossipScoresResponse, error)
+	GetSlasherStorage(context.Context, *empty.Empty) (*DebugSlasherStorageResponse, error)
 }
 
 // UnimplementedDebugServer can be embedded to have forward compatible implementations.
@@ -1671,6 +2866,15 @@ func (*UnimplementedDebugServer) GetPeer(context.Context, *v1alpha1.PeerRequest)
 func (*UnimplementedDebugServer) GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
 }
+func (*UnimplementedDebugServer) GetPendingQueues(context.Context, *empty.Empty) (*DebugPendingQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingQueues not implemented")
+}
+func (*UnimplementedDebugServer) GetGossipScores(context.Context, *empty.Empty) (*DebugGossipScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGossipScores not implemented")
+}
+func (*UnimplementedDebugServer) GetSlasherStorage(context.Context, *empty.Empty) (*DebugSlasherStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlasherStorage not implemented")
+}
 
 func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
 	s.RegisterService(&_Debug_serviceDesc, srv)
@@ -1802,6 +3006,60 @@ func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_GetPendingQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetPendingQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.prysm.v2.Debug/GetPendingQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetPendingQueues(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetGossipScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetGossipScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.prysm.v2.Debug/GetGossipScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetGossipScores(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetSlasherStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetSlasherStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.prysm.v2.Debug/GetSlasherStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetSlasherStorage(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.prysm.v2.Debug",
 	HandlerType: (*DebugServer)(nil),
@@ -1834,6 +3092,18 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInclusionSlot",
 			Handler:    _Debug_GetInclusionSlot_Handler,
 		},
+		{
+			MethodName: "GetPendingQueues",
+			Handler:    _Debug_GetPendingQueues_Handler,
+		},
+		{
+			MethodName: "GetGossipScores",
+			Handler:    _Debug_GetGossipScores_Handler,
+		},
+		{
+			MethodName: "GetSlasherStorage",
+			Handler:    _Debug_GetSlasherStorage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/prysm/v2/debug.proto",
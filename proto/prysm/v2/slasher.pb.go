@@ -36,6 +36,61 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type MessageProvenance_Source int32
+
+const (
+	MessageProvenance_UNKNOWN            MessageProvenance_Source = 0
+	MessageProvenance_ATTESTATION_STREAM MessageProvenance_Source = 1
+	MessageProvenance_BLOCK_STREAM       MessageProvenance_Source = 2
+	MessageProvenance_BLOCK_INCLUSION    MessageProvenance_Source = 3
+	MessageProvenance_RPC                MessageProvenance_Source = 4
+)
+
+// Enum value maps for MessageProvenance_Source.
+var (
+	MessageProvenance_Source_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "ATTESTATION_STREAM",
+		2: "BLOCK_STREAM",
+		3: "BLOCK_INCLUSION",
+		4: "RPC",
+	}
+	MessageProvenance_Source_value = map[string]int32{
+		"UNKNOWN":            0,
+		"ATTESTATION_STREAM": 1,
+		"BLOCK_STREAM":       2,
+		"BLOCK_INCLUSION":    3,
+		"RPC":                4,
+	}
+)
+
+func (x MessageProvenance_Source) Enum() *MessageProvenance_Source {
+	p := new(MessageProvenance_Source)
+	*p = x
+	return p
+}
+
+func (x MessageProvenance_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageProvenance_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_prysm_v2_slasher_proto_enumTypes[0].Descriptor()
+}
+
+func (MessageProvenance_Source) Type() protoreflect.EnumType {
+	return &file_proto_prysm_v2_slasher_proto_enumTypes[0]
+}
+
+func (x MessageProvenance_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageProvenance_Source.Descriptor instead.
+func (MessageProvenance_Source) EnumDescriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{8, 0}
+}
+
 type AttesterSlashingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -395,6 +450,416 @@ func (x *Slashable) GetSlashable() bool {
 	return false
 }
 
+type SlashingEvidenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	EndEpoch         github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	ValidatorIndices []uint64                                  `protobuf:"varint,3,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+}
+
+func (x *SlashingEvidenceRequest) Reset() {
+	*x = SlashingEvidenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingEvidenceRequest) ProtoMessage() {}
+
+func (x *SlashingEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*SlashingEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SlashingEvidenceRequest) GetStartEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *SlashingEvidenceRequest) GetEndEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *SlashingEvidenceRequest) GetValidatorIndices() []uint64 {
+	if x != nil {
+		return x.ValidatorIndices
+	}
+	return nil
+}
+
+type MessageProvenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source       MessageProvenance_Source                 `protobuf:"varint,1,opt,name=source,proto3,enum=ethereum.prysm.v2.MessageProvenance_Source" json:"source,omitempty"`
+	Peer         string                                   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	BlockRoot    []byte                                   `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	BlockSlot    github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,4,opt,name=block_slot,json=blockSlot,proto3" json:"block_slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	FirstSeen    uint64                                   `protobuf:"varint,5,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	GossipTopic  string                                   `protobuf:"bytes,6,opt,name=gossip_topic,json=gossipTopic,proto3" json:"gossip_topic,omitempty"`
+	GossipPeerId string                                   `protobuf:"bytes,7,opt,name=gossip_peer_id,json=gossipPeerId,proto3" json:"gossip_peer_id,omitempty"`
+}
+
+func (x *MessageProvenance) Reset() {
+	*x = MessageProvenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageProvenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageProvenance) ProtoMessage() {}
+
+func (x *MessageProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageProvenance.ProtoReflect.Descriptor instead.
+func (*MessageProvenance) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MessageProvenance) GetSource() MessageProvenance_Source {
+	if x != nil {
+		return x.Source
+	}
+	return MessageProvenance_UNKNOWN
+}
+
+func (x *MessageProvenance) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *MessageProvenance) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *MessageProvenance) GetBlockSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.BlockSlot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *MessageProvenance) GetFirstSeen() uint64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *MessageProvenance) GetGossipTopic() string {
+	if x != nil {
+		return x.GossipTopic
+	}
+	return ""
+}
+
+func (x *MessageProvenance) GetGossipPeerId() string {
+	if x != nil {
+		return x.GossipPeerId
+	}
+	return ""
+}
+
+type AttesterSlashingEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashing                *v1alpha1.AttesterSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	Attestation_1Provenance *MessageProvenance         `protobuf:"bytes,2,opt,name=attestation_1_provenance,json=attestation1Provenance,proto3" json:"attestation_1_provenance,omitempty"`
+	Attestation_2Provenance *MessageProvenance         `protobuf:"bytes,3,opt,name=attestation_2_provenance,json=attestation2Provenance,proto3" json:"attestation_2_provenance,omitempty"`
+	DetectedAt              uint64                     `protobuf:"varint,4,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	Included                bool                       `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+}
+
+func (x *AttesterSlashingEvidence) Reset() {
+	*x = AttesterSlashingEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterSlashingEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterSlashingEvidence) ProtoMessage() {}
+
+func (x *AttesterSlashingEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttesterSlashingEvidence.ProtoReflect.Descriptor instead.
+func (*AttesterSlashingEvidence) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AttesterSlashingEvidence) GetSlashing() *v1alpha1.AttesterSlashing {
+	if x != nil {
+		return x.Slashing
+	}
+	return nil
+}
+
+func (x *AttesterSlashingEvidence) GetAttestation_1Provenance() *MessageProvenance {
+	if x != nil {
+		return x.Attestation_1Provenance
+	}
+	return nil
+}
+
+func (x *AttesterSlashingEvidence) GetAttestation_2Provenance() *MessageProvenance {
+	if x != nil {
+		return x.Attestation_2Provenance
+	}
+	return nil
+}
+
+func (x *AttesterSlashingEvidence) GetDetectedAt() uint64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
+func (x *AttesterSlashingEvidence) GetIncluded() bool {
+	if x != nil {
+		return x.Included
+	}
+	return false
+}
+
+type AttesterSlashingEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidence []*AttesterSlashingEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *AttesterSlashingEvidenceResponse) Reset() {
+	*x = AttesterSlashingEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterSlashingEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterSlashingEvidenceResponse) ProtoMessage() {}
+
+func (x *AttesterSlashingEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttesterSlashingEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*AttesterSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AttesterSlashingEvidenceResponse) GetEvidence() []*AttesterSlashingEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type ProposerSlashingEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashing           *v1alpha1.ProposerSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	Header_1Provenance *MessageProvenance         `protobuf:"bytes,2,opt,name=header_1_provenance,json=header1Provenance,proto3" json:"header_1_provenance,omitempty"`
+	Header_2Provenance *MessageProvenance         `protobuf:"bytes,3,opt,name=header_2_provenance,json=header2Provenance,proto3" json:"header_2_provenance,omitempty"`
+	DetectedAt         uint64                     `protobuf:"varint,4,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	Included           bool                       `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+}
+
+func (x *ProposerSlashingEvidence) Reset() {
+	*x = ProposerSlashingEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerSlashingEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerSlashingEvidence) ProtoMessage() {}
+
+func (x *ProposerSlashingEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerSlashingEvidence.ProtoReflect.Descriptor instead.
+func (*ProposerSlashingEvidence) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProposerSlashingEvidence) GetSlashing() *v1alpha1.ProposerSlashing {
+	if x != nil {
+		return x.Slashing
+	}
+	return nil
+}
+
+func (x *ProposerSlashingEvidence) GetHeader_1Provenance() *MessageProvenance {
+	if x != nil {
+		return x.Header_1Provenance
+	}
+	return nil
+}
+
+func (x *ProposerSlashingEvidence) GetHeader_2Provenance() *MessageProvenance {
+	if x != nil {
+		return x.Header_2Provenance
+	}
+	return nil
+}
+
+func (x *ProposerSlashingEvidence) GetDetectedAt() uint64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
+func (x *ProposerSlashingEvidence) GetIncluded() bool {
+	if x != nil {
+		return x.Included
+	}
+	return false
+}
+
+type ProposerSlashingEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidence []*ProposerSlashingEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *ProposerSlashingEvidenceResponse) Reset() {
+	*x = ProposerSlashingEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerSlashingEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerSlashingEvidenceResponse) ProtoMessage() {}
+
+func (x *ProposerSlashingEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerSlashingEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*ProposerSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProposerSlashingEvidenceResponse) GetEvidence() []*ProposerSlashingEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
 type AttestationHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -409,7 +874,7 @@ type AttestationHistory struct {
 func (x *AttestationHistory) Reset() {
 	*x = AttestationHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[7]
+		mi := &file_proto_prysm_v2_slasher_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +887,7 @@ func (x *AttestationHistory) String() string {
 func (*AttestationHistory) ProtoMessage() {}
 
 func (x *AttestationHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[7]
+	mi := &file_proto_prysm_v2_slasher_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +900,7 @@ func (x *AttestationHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestationHistory.ProtoReflect.Descriptor instead.
 func (*AttestationHistory) Descriptor() ([]byte, []int) {
-	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{7}
+	return file_proto_prysm_v2_slasher_proto_rawDescGZIP(), []int{13}
 }
 
 // Deprecated: Do not use.
@@ -525,80 +990,199 @@ var file_proto_prysm_v2_slasher_proto_rawDesc = []byte{
 	0x63, 0x68, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0x2d, 0x0a, 0x09, 0x53, 0x6c, 0x61,
 	0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61,
 	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x73,
-	0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x12, 0x41, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
-	0x67, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x74, 0x68, 0x65,
-	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0x18, 0x01, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
-	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0x41, 0x0a, 0x13, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xd9,
-	0x05, 0x0a, 0x07, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x12, 0xa9, 0x01, 0x0a, 0x16, 0x49,
-	0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
-	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73,
-	0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x61, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61,
-	0x62, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x49, 0x73, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x2e, 0x65, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x2b, 0x2e, 0x65, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e,
+	0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x17, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x4a, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0x9f, 0x03,
+	0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x65, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x5d, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x54, 0x54, 0x45, 0x53,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55,
+	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x50, 0x43, 0x10, 0x04, 0x22,
+	0xdc, 0x02, 0x0a, 0x18, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x08,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x12, 0x5e, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x31, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x5e, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x32, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0x6b,
+	0x0a, 0x20, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x18,
 	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
-	0x12, 0x26, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
-	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x73,
-	0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x6e, 0x0a, 0x1e, 0x49, 0x73, 0x53, 0x6c,
-	0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x74, 0x68,
+	0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68,
 	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
-	0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61,
-	0x62, 0x6c, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x67, 0x0a, 0x18, 0x49, 0x73, 0x53, 0x6c,
-	0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
-	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x1c,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a,
+	0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x31, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x11, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x32, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0x6b, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x12, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x67, 0x0a, 0x10, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2f, 0x18, 0x01, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x57,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xb9, 0x08, 0x0a, 0x07, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x72, 0x12, 0xa9, 0x01, 0x0a, 0x16, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31,
+	0x22, 0x2c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x01,
+	0x2a, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x72, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x6e, 0x0a, 0x1e, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x03,
+	0x88, 0x02, 0x01, 0x12, 0x67, 0x0a, 0x18, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0xa6, 0x01, 0x0a,
+	0x13, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72,
+	0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x12, 0xb0, 0x01, 0x0a, 0x18, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
 	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e,
-	0x76, 0x32, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x03, 0x88, 0x02,
-	0x01, 0x12, 0xa6, 0x01, 0x0a, 0x13, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65,
-	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x69,
-	0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x69, 0x67, 0x68,
-	0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a,
+	0x76, 0x32, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x72, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0xaa, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
 	0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c,
-	0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x81, 0x01, 0x0a, 0x15, 0x6f,
-	0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73,
-	0x6d, 0x2e, 0x76, 0x32, 0x42, 0x0c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70,
-	0x72, 0x79, 0x73, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
-	0x2f, 0x76, 0x32, 0x3b, 0x76, 0x32, 0xaa, 0x02, 0x11, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
-	0x6d, 0x2e, 0x50, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x45, 0x74, 0x68,
-	0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x50, 0x72, 0x79, 0x73, 0x6d, 0x5c, 0x76, 0x32, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x65, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x81, 0x01, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2e, 0x76, 0x32, 0x42,
+	0x0c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x32, 0x3b, 0x76,
+	0x32, 0xaa, 0x02, 0x11, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x50, 0x72, 0x79,
+	0x73, 0x6d, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x5c, 0x50, 0x72, 0x79, 0x73, 0x6d, 0x5c, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -613,43 +1197,64 @@ func file_proto_prysm_v2_slasher_proto_rawDescGZIP() []byte {
 	return file_proto_prysm_v2_slasher_proto_rawDescData
 }
 
-var file_proto_prysm_v2_slasher_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_prysm_v2_slasher_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_prysm_v2_slasher_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_proto_prysm_v2_slasher_proto_goTypes = []interface{}{
-	(*AttesterSlashingResponse)(nil),         // 0: ethereum.prysm.v2.AttesterSlashingResponse
-	(*ProposerSlashingResponse)(nil),         // 1: ethereum.prysm.v2.ProposerSlashingResponse
-	(*HighestAttestationRequest)(nil),        // 2: ethereum.prysm.v2.HighestAttestationRequest
-	(*HighestAttestationResponse)(nil),       // 3: ethereum.prysm.v2.HighestAttestationResponse
-	(*HighestAttestation)(nil),               // 4: ethereum.prysm.v2.HighestAttestation
-	(*ProposalHistory)(nil),                  // 5: ethereum.prysm.v2.ProposalHistory
-	(*Slashable)(nil),                        // 6: ethereum.prysm.v2.Slashable
-	(*AttestationHistory)(nil),               // 7: ethereum.prysm.v2.AttestationHistory
-	nil,                                      // 8: ethereum.prysm.v2.AttestationHistory.TargetToSourceEntry
-	(*v1alpha1.AttesterSlashing)(nil),        // 9: ethereum.eth.v1alpha1.AttesterSlashing
-	(*v1alpha1.ProposerSlashing)(nil),        // 10: ethereum.eth.v1alpha1.ProposerSlashing
-	(*v1alpha1.IndexedAttestation)(nil),      // 11: ethereum.eth.v1alpha1.IndexedAttestation
-	(*v1alpha1.SignedBeaconBlockHeader)(nil), // 12: ethereum.eth.v1alpha1.SignedBeaconBlockHeader
-	(*v1alpha1.BeaconBlockHeader)(nil),       // 13: ethereum.eth.v1alpha1.BeaconBlockHeader
+	(MessageProvenance_Source)(0),            // 0: ethereum.prysm.v2.MessageProvenance.Source
+	(*AttesterSlashingResponse)(nil),         // 1: ethereum.prysm.v2.AttesterSlashingResponse
+	(*ProposerSlashingResponse)(nil),         // 2: ethereum.prysm.v2.ProposerSlashingResponse
+	(*HighestAttestationRequest)(nil),        // 3: ethereum.prysm.v2.HighestAttestationRequest
+	(*HighestAttestationResponse)(nil),       // 4: ethereum.prysm.v2.HighestAttestationResponse
+	(*HighestAttestation)(nil),               // 5: ethereum.prysm.v2.HighestAttestation
+	(*ProposalHistory)(nil),                  // 6: ethereum.prysm.v2.ProposalHistory
+	(*Slashable)(nil),                        // 7: ethereum.prysm.v2.Slashable
+	(*SlashingEvidenceRequest)(nil),          // 8: ethereum.prysm.v2.SlashingEvidenceRequest
+	(*MessageProvenance)(nil),                // 9: ethereum.prysm.v2.MessageProvenance
+	(*AttesterSlashingEvidence)(nil),         // 10: ethereum.prysm.v2.AttesterSlashingEvidence
+	(*AttesterSlashingEvidenceResponse)(nil), // 11: ethereum.prysm.v2.AttesterSlashingEvidenceResponse
+	(*ProposerSlashingEvidence)(nil),         // 12: ethereum.prysm.v2.ProposerSlashingEvidence
+	(*ProposerSlashingEvidenceResponse)(nil), // 13: ethereum.prysm.v2.ProposerSlashingEvidenceResponse
+	(*AttestationHistory)(nil),               // 14: ethereum.prysm.v2.AttestationHistory
+	nil,                                      // 15: ethereum.prysm.v2.AttestationHistory.TargetToSourceEntry
+	(*v1alpha1.AttesterSlashing)(nil),        // 16: ethereum.eth.v1alpha1.AttesterSlashing
+	(*v1alpha1.ProposerSlashing)(nil),        // 17: ethereum.eth.v1alpha1.ProposerSlashing
+	(*v1alpha1.IndexedAttestation)(nil),      // 18: ethereum.eth.v1alpha1.IndexedAttestation
+	(*v1alpha1.SignedBeaconBlockHeader)(nil), // 19: ethereum.eth.v1alpha1.SignedBeaconBlockHeader
+	(*v1alpha1.BeaconBlockHeader)(nil),       // 20: ethereum.eth.v1alpha1.BeaconBlockHeader
 }
 var file_proto_prysm_v2_slasher_proto_depIdxs = []int32{
-	9,  // 0: ethereum.prysm.v2.AttesterSlashingResponse.attester_slashings:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
-	10, // 1: ethereum.prysm.v2.ProposerSlashingResponse.proposer_slashings:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
-	4,  // 2: ethereum.prysm.v2.HighestAttestationResponse.attestations:type_name -> ethereum.prysm.v2.HighestAttestation
-	8,  // 3: ethereum.prysm.v2.AttestationHistory.target_to_source:type_name -> ethereum.prysm.v2.AttestationHistory.TargetToSourceEntry
-	11, // 4: ethereum.prysm.v2.Slasher.IsSlashableAttestation:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
-	12, // 5: ethereum.prysm.v2.Slasher.IsSlashableBlock:input_type -> ethereum.eth.v1alpha1.SignedBeaconBlockHeader
-	11, // 6: ethereum.prysm.v2.Slasher.IsSlashableAttestationNoUpdate:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
-	13, // 7: ethereum.prysm.v2.Slasher.IsSlashableBlockNoUpdate:input_type -> ethereum.eth.v1alpha1.BeaconBlockHeader
-	2,  // 8: ethereum.prysm.v2.Slasher.HighestAttestations:input_type -> ethereum.prysm.v2.HighestAttestationRequest
-	0,  // 9: ethereum.prysm.v2.Slasher.IsSlashableAttestation:output_type -> ethereum.prysm.v2.AttesterSlashingResponse
-	1,  // 10: ethereum.prysm.v2.Slasher.IsSlashableBlock:output_type -> ethereum.prysm.v2.ProposerSlashingResponse
-	6,  // 11: ethereum.prysm.v2.Slasher.IsSlashableAttestationNoUpdate:output_type -> ethereum.prysm.v2.Slashable
-	6,  // 12: ethereum.prysm.v2.Slasher.IsSlashableBlockNoUpdate:output_type -> ethereum.prysm.v2.Slashable
-	3,  // 13: ethereum.prysm.v2.Slasher.HighestAttestations:output_type -> ethereum.prysm.v2.HighestAttestationResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	16, // 0: ethereum.prysm.v2.AttesterSlashingResponse.attester_slashings:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
+	17, // 1: ethereum.prysm.v2.ProposerSlashingResponse.proposer_slashings:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
+	5,  // 2: ethereum.prysm.v2.HighestAttestationResponse.attestations:type_name -> ethereum.prysm.v2.HighestAttestation
+	0,  // 3: ethereum.prysm.v2.MessageProvenance.source:type_name -> ethereum.prysm.v2.MessageProvenance.Source
+	16, // 4: ethereum.prysm.v2.AttesterSlashingEvidence.slashing:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
+	9,  // 5: ethereum.prysm.v2.AttesterSlashingEvidence.attestation_1_provenance:type_name -> ethereum.prysm.v2.MessageProvenance
+	9,  // 6: ethereum.prysm.v2.AttesterSlashingEvidence.attestation_2_provenance:type_name -> ethereum.prysm.v2.MessageProvenance
+	10, // 7: ethereum.prysm.v2.AttesterSlashingEvidenceResponse.evidence:type_name -> ethereum.prysm.v2.AttesterSlashingEvidence
+	17, // 8: ethereum.prysm.v2.ProposerSlashingEvidence.slashing:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
+	9,  // 9: ethereum.prysm.v2.ProposerSlashingEvidence.header_1_provenance:type_name -> ethereum.prysm.v2.MessageProvenance
+	9,  // 10: ethereum.prysm.v2.ProposerSlashingEvidence.header_2_provenance:type_name -> ethereum.prysm.v2.MessageProvenance
+	12, // 11: ethereum.prysm.v2.ProposerSlashingEvidenceResponse.evidence:type_name -> ethereum.prysm.v2.ProposerSlashingEvidence
+	15, // 12: ethereum.prysm.v2.AttestationHistory.target_to_source:type_name -> ethereum.prysm.v2.AttestationHistory.TargetToSourceEntry
+	18, // 13: ethereum.prysm.v2.Slasher.IsSlashableAttestation:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
+	19, // 14: ethereum.prysm.v2.Slasher.IsSlashableBlock:input_type -> ethereum.eth.v1alpha1.SignedBeaconBlockHeader
+	18, // 15: ethereum.prysm.v2.Slasher.IsSlashableAttestationNoUpdate:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
+	20, // 16: ethereum.prysm.v2.Slasher.IsSlashableBlockNoUpdate:input_type -> ethereum.eth.v1alpha1.BeaconBlockHeader
+	3,  // 17: ethereum.prysm.v2.Slasher.HighestAttestations:input_type -> ethereum.prysm.v2.HighestAttestationRequest
+	8,  // 18: ethereum.prysm.v2.Slasher.AttesterSlashingEvidence:input_type -> ethereum.prysm.v2.SlashingEvidenceRequest
+	8,  // 19: ethereum.prysm.v2.Slasher.ProposerSlashingEvidence:input_type -> ethereum.prysm.v2.SlashingEvidenceRequest
+	1,  // 20: ethereum.prysm.v2.Slasher.IsSlashableAttestation:output_type -> ethereum.prysm.v2.AttesterSlashingResponse
+	2,  // 21: ethereum.prysm.v2.Slasher.IsSlashableBlock:output_type -> ethereum.prysm.v2.ProposerSlashingResponse
+	7,  // 22: ethereum.prysm.v2.Slasher.IsSlashableAttestationNoUpdate:output_type -> ethereum.prysm.v2.Slashable
+	7,  // 23: ethereum.prysm.v2.Slasher.IsSlashableBlockNoUpdate:output_type -> ethereum.prysm.v2.Slashable
+	4,  // 24: ethereum.prysm.v2.Slasher.HighestAttestations:output_type -> ethereum.prysm.v2.HighestAttestationResponse
+	11, // 25: ethereum.prysm.v2.Slasher.AttesterSlashingEvidence:output_type -> ethereum.prysm.v2.AttesterSlashingEvidenceResponse
+	13, // 26: ethereum.prysm.v2.Slasher.ProposerSlashingEvidence:output_type -> ethereum.prysm.v2.ProposerSlashingEvidenceResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_prysm_v2_slasher_proto_init() }
@@ -743,6 +1348,78 @@ func file_proto_prysm_v2_slasher_proto_init() {
 			}
 		}
 		file_proto_prysm_v2_slasher_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingEvidenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_slasher_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageProvenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_slasher_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttesterSlashingEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_slasher_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttesterSlashingEvidenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_slasher_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerSlashingEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_slasher_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerSlashingEvidenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v2_slasher_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AttestationHistory); i {
 			case 0:
 				return &v.state
@@ -760,13 +1437,14 @@ func file_proto_prysm_v2_slasher_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_prysm_v2_slasher_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_prysm_v2_slasher_proto_goTypes,
 		DependencyIndexes: file_proto_prysm_v2_slasher_proto_depIdxs,
+		EnumInfos:         file_proto_prysm_v2_slasher_proto_enumTypes,
 		MessageInfos:      file_proto_prysm_v2_slasher_proto_msgTypes,
 	}.Build()
 	File_proto_prysm_v2_slasher_proto = out.File
@@ -794,6 +1472,8 @@ type SlasherClient interface {
 	// Deprecated: Do not use.
 	IsSlashableBlockNoUpdate(ctx context.Context, in *v1alpha1.BeaconBlockHeader, opts ...grpc.CallOption) (*Slashable, error)
 	HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error)
+	AttesterSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*AttesterSlashingEvidenceResponse, error)
+	ProposerSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*ProposerSlashingEvidenceResponse, error)
 }
 
 type slasherClient struct {
@@ -851,6 +1531,24 @@ func (c *slasherClient) HighestAttestations(ctx context.Context, in *HighestAtte
 	return out, nil
 }
 
+func (c *slasherClient) AttesterSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*AttesterSlashingEvidenceResponse, error) {
+	out := new(AttesterSlashingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.prysm.v2.Slasher/AttesterSlashingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) ProposerSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*ProposerSlashingEvidenceResponse, error) {
+	out := new(ProposerSlashingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.prysm.v2.Slasher/ProposerSlashingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SlasherServer is the server API for Slasher service.
 type SlasherServer interface {
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
@@ -860,6 +1558,8 @@ type SlasherServer interface {
 	// Deprecated: Do not use.
 	IsSlashableBlockNoUpdate(context.Context, *v1alpha1.BeaconBlockHeader) (*Slashable, error)
 	HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error)
+	AttesterSlashingEvidence(context.Context, *SlashingEvidenceRequest) (*AttesterSlashingEvidenceResponse, error)
+	ProposerSlashingEvidence(context.Context, *SlashingEvidenceRequest) (*ProposerSlashingEvidenceResponse, error)
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -881,6 +1581,12 @@ func (*UnimplementedSlasherServer) IsSlashableBlockNoUpdate(context.Context, *v1
 func (*UnimplementedSlasherServer) HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestations not implemented")
 }
+func (*UnimplementedSlasherServer) AttesterSlashingEvidence(context.Context, *SlashingEvidenceRequest) (*AttesterSlashingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttesterSlashingEvidence not implemented")
+}
+func (*UnimplementedSlasherServer) ProposerSlashingEvidence(context.Context, *SlashingEvidenceRequest) (*ProposerSlashingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposerSlashingEvidence not implemented")
+}
 
 func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
 	s.RegisterService(&_Slasher_serviceDesc, srv)
@@ -976,6 +1682,42 @@ func _Slasher_HighestAttestations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Slasher_AttesterSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).AttesterSlashingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.prysm.v2.Slasher/AttesterSlashingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).AttesterSlashingEvidence(ctx, req.(*SlashingEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_ProposerSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ProposerSlashingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.prysm.v2.Slasher/ProposerSlashingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ProposerSlashingEvidence(ctx, req.(*SlashingEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Slasher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.prysm.v2.Slasher",
 	HandlerType: (*SlasherServer)(nil),
@@ -1000,6 +1742,14 @@ var _Slasher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HighestAttestations",
 			Handler:    _Slasher_HighestAttestations_Handler,
 		},
+		{
+			MethodName: "AttesterSlashingEvidence",
+			Handler:    _Slasher_AttesterSlashingEvidence_Handler,
+		},
+		{
+			MethodName: "ProposerSlashingEvidence",
+			Handler:    _Slasher_ProposerSlashingEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/prysm/v2/slasher.proto",
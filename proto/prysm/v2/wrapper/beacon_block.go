@@ -3,6 +3,7 @@ package wrapper
 import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
@@ -22,6 +23,15 @@ var (
 	// ErrUnsupportedPhase0Block is returned when accessing a phase0 block from an altair wrapped
 	// block.
 	ErrUnsupportedPhase0Block = errors.New("unsupported phase0 block")
+	// ErrUnsupportedAltairBlock is returned when accessing an altair block from a bellatrix
+	// wrapped block.
+	ErrUnsupportedAltairBlock = errors.New("unsupported altair block")
+	// ErrUnsupportedBellatrixBlock is returned when accessing a bellatrix block from an altair
+	// wrapped block.
+	ErrUnsupportedBellatrixBlock = errors.New("unsupported bellatrix block")
+	// ErrUnsupportedExecutionPayload is returned when accessing the execution payload of a block
+	// body from before bellatrix.
+	ErrUnsupportedExecutionPayload = errors.New("execution payload is not supported before bellatrix")
 	// ErrNilObjectWrapped is returned in a constructor when the underlying object is nil.
 	ErrNilObjectWrapped = errors.New("attempted to wrap nil object")
 )
@@ -104,6 +114,11 @@ func (w altairSignedBeaconBlock) PbPhase0Block() (*ethpb.SignedBeaconBlock, erro
 	return nil, ErrUnsupportedPhase0Block
 }
 
+// PbBellatrixBlock is a stub.
+func (w altairSignedBeaconBlock) PbBellatrixBlock() (*prysmv2.SignedBeaconBlockBellatrix, error) {
+	return nil, ErrUnsupportedBellatrixBlock
+}
+
 // Version of the underlying protobuf object.
 func (w altairSignedBeaconBlock) Version() int {
 	return version.Altair
@@ -253,6 +268,11 @@ func (w altairBeaconBlockBody) SyncAggregate() (*prysmv2.SyncAggregate, error) {
 	return w.b.SyncAggregate, nil
 }
 
+// ExecutionPayload is a stub.
+func (w altairBeaconBlockBody) ExecutionPayload() (*enginev1.ExecutionPayload, error) {
+	return nil, ErrUnsupportedExecutionPayload
+}
+
 // IsNil checks if the block body is nil.
 func (w altairBeaconBlockBody) IsNil() bool {
 	return w.b == nil
@@ -0,0 +1,272 @@
+package wrapper
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
+	"github.com/prysmaticlabs/prysm/shared/copyutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/protobuf/proto"
+)
+
+// Interface conformance checks.
+var (
+	_ = block.SignedBeaconBlock(&bellatrixSignedBeaconBlock{})
+	_ = block.BeaconBlock(&bellatrixBeaconBlock{})
+	_ = block.BeaconBlockBody(&bellatrixBeaconBlockBody{})
+)
+
+// bellatrixSignedBeaconBlock is a convenience wrapper around a bellatrix beacon block
+// object. This wrapper allows us to conform to a common interface so that beacon
+// blocks for future forks can also be applied across prysm without issues.
+type bellatrixSignedBeaconBlock struct {
+	b *prysmv2.SignedBeaconBlockBellatrix
+}
+
+// WrappedBellatrixSignedBeaconBlock is constructor which wraps a protobuf bellatrix block
+// with the block wrapper.
+func WrappedBellatrixSignedBeaconBlock(b *prysmv2.SignedBeaconBlockBellatrix) (block.SignedBeaconBlock, error) {
+	w := bellatrixSignedBeaconBlock{b: b}
+	if w.IsNil() {
+		return nil, ErrNilObjectWrapped
+	}
+	return w, nil
+}
+
+// Signature returns the respective block signature.
+func (w bellatrixSignedBeaconBlock) Signature() []byte {
+	return w.b.Signature
+}
+
+// Block returns the underlying beacon block object.
+func (w bellatrixSignedBeaconBlock) Block() block.BeaconBlock {
+	return bellatrixBeaconBlock{b: w.b.Block}
+}
+
+// IsNil checks if the underlying beacon block is
+// nil.
+func (w bellatrixSignedBeaconBlock) IsNil() bool {
+	return w.b == nil || w.b.Block == nil
+}
+
+// Copy performs a deep copy of the signed beacon block
+// object.
+func (w bellatrixSignedBeaconBlock) Copy() block.SignedBeaconBlock {
+	return bellatrixSignedBeaconBlock{b: copyutil.CopySignedBeaconBlockBellatrix(w.b)}
+}
+
+// MarshalSSZ marshals the signed beacon block to its relevant ssz
+// form.
+func (w bellatrixSignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return w.b.MarshalSSZ()
+}
+
+// MarshalSSZTo marshals the signed beacon block to its relevant ssz
+// form to the provided byte buffer.
+func (w bellatrixSignedBeaconBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return w.b.MarshalSSZTo(dst)
+}
+
+// SizeSSZ returns the size of serialized signed block
+func (w bellatrixSignedBeaconBlock) SizeSSZ() int {
+	return w.b.SizeSSZ()
+}
+
+// UnmarshalSSZ unmarshalls the signed beacon block from its relevant ssz
+// form.
+func (w bellatrixSignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return w.b.UnmarshalSSZ(buf)
+}
+
+// Proto returns the block in its underlying protobuf
+// interface.
+func (w bellatrixSignedBeaconBlock) Proto() proto.Message {
+	return w.b
+}
+
+// PbBellatrixBlock returns the underlying protobuf object.
+func (w bellatrixSignedBeaconBlock) PbBellatrixBlock() (*prysmv2.SignedBeaconBlockBellatrix, error) {
+	return w.b, nil
+}
+
+// PbAltairBlock is a stub.
+func (w bellatrixSignedBeaconBlock) PbAltairBlock() (*prysmv2.SignedBeaconBlockAltair, error) {
+	return nil, ErrUnsupportedAltairBlock
+}
+
+// PbPhase0Block is a stub.
+func (w bellatrixSignedBeaconBlock) PbPhase0Block() (*ethpb.SignedBeaconBlock, error) {
+	return nil, ErrUnsupportedPhase0Block
+}
+
+// Version of the underlying protobuf object.
+func (w bellatrixSignedBeaconBlock) Version() int {
+	return version.Bellatrix
+}
+
+// bellatrixBeaconBlock is the wrapper for the actual block.
+type bellatrixBeaconBlock struct {
+	b *prysmv2.BeaconBlockBellatrix
+}
+
+// WrappedBellatrixBeaconBlock is constructor which wraps a protobuf bellatrix object
+// with the block wrapper.
+func WrappedBellatrixBeaconBlock(b *prysmv2.BeaconBlockBellatrix) (block.BeaconBlock, error) {
+	w := bellatrixBeaconBlock{b: b}
+	if w.IsNil() {
+		return nil, ErrNilObjectWrapped
+	}
+	return w, nil
+}
+
+// Slot returns the respective slot of the block.
+func (w bellatrixBeaconBlock) Slot() types.Slot {
+	return w.b.Slot
+}
+
+// ProposerIndex returns proposer index of the beacon block.
+func (w bellatrixBeaconBlock) ProposerIndex() types.ValidatorIndex {
+	return w.b.ProposerIndex
+}
+
+// ParentRoot returns the parent root of beacon block.
+func (w bellatrixBeaconBlock) ParentRoot() []byte {
+	return w.b.ParentRoot
+}
+
+// StateRoot returns the state root of the beacon block.
+func (w bellatrixBeaconBlock) StateRoot() []byte {
+	return w.b.StateRoot
+}
+
+// Body returns the underlying block body.
+func (w bellatrixBeaconBlock) Body() block.BeaconBlockBody {
+	return bellatrixBeaconBlockBody{b: w.b.Body}
+}
+
+// IsNil checks if the beacon block is nil.
+func (w bellatrixBeaconBlock) IsNil() bool {
+	return w.b == nil
+}
+
+// HashTreeRoot returns the ssz root of the block.
+func (w bellatrixBeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return w.b.HashTreeRoot()
+}
+
+// MarshalSSZ marshals the block into its respective
+// ssz form.
+func (w bellatrixBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return w.b.MarshalSSZ()
+}
+
+// MarshalSSZTo marshals the beacon block to its relevant ssz
+// form to the provided byte buffer.
+func (w bellatrixBeaconBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return w.b.MarshalSSZTo(dst)
+}
+
+// SizeSSZ returns the size of serialized block.
+func (w bellatrixBeaconBlock) SizeSSZ() int {
+	return w.b.SizeSSZ()
+}
+
+// UnmarshalSSZ unmarshalls the beacon block from its relevant ssz
+// form.
+func (w bellatrixBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return w.b.UnmarshalSSZ(buf)
+}
+
+// Proto returns the underlying block object in its
+// proto form.
+func (w bellatrixBeaconBlock) Proto() proto.Message {
+	return w.b
+}
+
+// Version of the underlying protobuf object.
+func (w bellatrixBeaconBlock) Version() int {
+	return version.Bellatrix
+}
+
+// bellatrixBeaconBlockBody is a wrapper of a beacon block body.
+type bellatrixBeaconBlockBody struct {
+	b *prysmv2.BeaconBlockBodyBellatrix
+}
+
+// WrappedBellatrixBeaconBlockBody is constructor which wraps a protobuf bellatrix object
+// with the block wrapper.
+func WrappedBellatrixBeaconBlockBody(b *prysmv2.BeaconBlockBodyBellatrix) (block.BeaconBlockBody, error) {
+	w := bellatrixBeaconBlockBody{b: b}
+	if w.IsNil() {
+		return nil, ErrNilObjectWrapped
+	}
+	return w, nil
+}
+
+// RandaoReveal returns the randao reveal from the block body.
+func (w bellatrixBeaconBlockBody) RandaoReveal() []byte {
+	return w.b.RandaoReveal
+}
+
+// Eth1Data returns the eth1 data in the block.
+func (w bellatrixBeaconBlockBody) Eth1Data() *ethpb.Eth1Data {
+	return w.b.Eth1Data
+}
+
+// Graffiti returns the graffiti in the block.
+func (w bellatrixBeaconBlockBody) Graffiti() []byte {
+	return w.b.Graffiti
+}
+
+// ProposerSlashings returns the proposer slashings in the block.
+func (w bellatrixBeaconBlockBody) ProposerSlashings() []*ethpb.ProposerSlashing {
+	return w.b.ProposerSlashings
+}
+
+// AttesterSlashings returns the attester slashings in the block.
+func (w bellatrixBeaconBlockBody) AttesterSlashings() []*ethpb.AttesterSlashing {
+	return w.b.AttesterSlashings
+}
+
+// Attestations returns the stored attestations in the block.
+func (w bellatrixBeaconBlockBody) Attestations() []*ethpb.Attestation {
+	return w.b.Attestations
+}
+
+// Deposits returns the stored deposits in the block.
+func (w bellatrixBeaconBlockBody) Deposits() []*ethpb.Deposit {
+	return w.b.Deposits
+}
+
+// VoluntaryExits returns the voluntary exits in the block.
+func (w bellatrixBeaconBlockBody) VoluntaryExits() []*ethpb.SignedVoluntaryExit {
+	return w.b.VoluntaryExits
+}
+
+// SyncAggregate returns the sync aggregate in the block.
+func (w bellatrixBeaconBlockBody) SyncAggregate() (*prysmv2.SyncAggregate, error) {
+	return w.b.SyncAggregate, nil
+}
+
+// ExecutionPayload returns the execution payload in the block.
+func (w bellatrixBeaconBlockBody) ExecutionPayload() (*enginev1.ExecutionPayload, error) {
+	return w.b.ExecutionPayload, nil
+}
+
+// IsNil checks if the block body is nil.
+func (w bellatrixBeaconBlockBody) IsNil() bool {
+	return w.b == nil
+}
+
+// HashTreeRoot returns the ssz root of the block body.
+func (w bellatrixBeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	return w.b.HashTreeRoot()
+}
+
+// Proto returns the underlying proto form of the block
+// body.
+func (w bellatrixBeaconBlockBody) Proto() proto.Message {
+	return w.b
+}
@@ -0,0 +1,108 @@
+package wrapper_test
+
+import (
+	"testing"
+
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+func TestBellatrixSignedBeaconBlock_IsNil(t *testing.T) {
+	_, err := wrapper.WrappedBellatrixSignedBeaconBlock(nil)
+	require.Equal(t, wrapper.ErrNilObjectWrapped, err)
+
+	wsb, err := wrapper.WrappedBellatrixSignedBeaconBlock(&prysmv2.SignedBeaconBlockBellatrix{Block: &prysmv2.BeaconBlockBellatrix{}})
+	require.NoError(t, err)
+
+	assert.Equal(t, false, wsb.IsNil())
+}
+
+func TestBellatrixSignedBeaconBlock_PbBlocks(t *testing.T) {
+	sb := &prysmv2.SignedBeaconBlockBellatrix{
+		Block:     &prysmv2.BeaconBlockBellatrix{Slot: 66},
+		Signature: []byte{0x11, 0x22},
+	}
+	wsb, err := wrapper.WrappedBellatrixSignedBeaconBlock(sb)
+	require.NoError(t, err)
+
+	got, err := wsb.PbBellatrixBlock()
+	assert.NoError(t, err)
+	assert.Equal(t, sb, got)
+
+	_, err = wsb.PbPhase0Block()
+	assert.Equal(t, wrapper.ErrUnsupportedPhase0Block, err)
+	_, err = wsb.PbAltairBlock()
+	assert.Equal(t, wrapper.ErrUnsupportedAltairBlock, err)
+}
+
+func TestAltairSignedBeaconBlock_PbBellatrixBlock(t *testing.T) {
+	wsb, err := wrapper.WrappedAltairSignedBeaconBlock(&prysmv2.SignedBeaconBlockAltair{Block: &prysmv2.BeaconBlockAltair{}})
+	require.NoError(t, err)
+
+	_, err = wsb.PbBellatrixBlock()
+	assert.Equal(t, wrapper.ErrUnsupportedBellatrixBlock, err)
+	_, err = wsb.Block().Body().ExecutionPayload()
+	assert.Equal(t, wrapper.ErrUnsupportedExecutionPayload, err)
+}
+
+func TestBellatrixSignedBeaconBlock_Copy(t *testing.T) {
+	wsb, err := wrapper.WrappedBellatrixSignedBeaconBlock(testutil.HydrateSignedBeaconBlockBellatrix(&prysmv2.SignedBeaconBlockBellatrix{}))
+	require.NoError(t, err)
+
+	cp := wsb.Copy()
+	pb, err := cp.PbBellatrixBlock()
+	require.NoError(t, err)
+	pb.Block.Body.ExecutionPayload.BlockNumber = 10
+
+	payload, err := wsb.Block().Body().ExecutionPayload()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), payload.BlockNumber)
+}
+
+func TestBellatrixSignedBeaconBlock_SSZ(t *testing.T) {
+	sb := testutil.HydrateSignedBeaconBlockBellatrix(&prysmv2.SignedBeaconBlockBellatrix{})
+	sb.Block.Body.ExecutionPayload.Transactions = [][]byte{{0x01, 0x02}}
+	wsb, err := wrapper.WrappedBellatrixSignedBeaconBlock(sb)
+	require.NoError(t, err)
+
+	b, err := wsb.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, wsb.SizeSSZ(), len(b))
+
+	decoded, err := wrapper.WrappedBellatrixSignedBeaconBlock(&prysmv2.SignedBeaconBlockBellatrix{Block: &prysmv2.BeaconBlockBellatrix{}})
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalSSZ(b))
+	assert.DeepSSZEqual(t, wsb.Proto(), decoded.Proto())
+}
+
+func TestBellatrixSignedBeaconBlock_Version(t *testing.T) {
+	wsb, err := wrapper.WrappedBellatrixSignedBeaconBlock(&prysmv2.SignedBeaconBlockBellatrix{Block: &prysmv2.BeaconBlockBellatrix{}})
+	require.NoError(t, err)
+
+	assert.Equal(t, version.Bellatrix, wsb.Version())
+	assert.Equal(t, version.Bellatrix, wsb.Block().Version())
+}
+
+func TestBellatrixBeaconBlock_HashTreeRoot(t *testing.T) {
+	wb, err := wrapper.WrappedBellatrixBeaconBlock(testutil.HydrateBeaconBlockBellatrix(&prysmv2.BeaconBlockBellatrix{}))
+	require.NoError(t, err)
+
+	rt, err := wb.HashTreeRoot()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rt)
+}
+
+func TestBellatrixBeaconBlockBody_ExecutionPayload(t *testing.T) {
+	payload := &enginev1.ExecutionPayload{BlockNumber: 5}
+	wbb, err := wrapper.WrappedBellatrixBeaconBlockBody(&prysmv2.BeaconBlockBodyBellatrix{ExecutionPayload: payload})
+	require.NoError(t, err)
+
+	got, err := wbb.ExecutionPayload()
+	require.NoError(t, err)
+	assert.Equal(t, payload, got)
+}
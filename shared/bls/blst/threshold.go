@@ -0,0 +1,49 @@
+// +build linux,amd64 linux,arm64 darwin,amd64 windows,amd64
+// +build !blst_disabled
+
+package blst
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+)
+
+// SignaturesLinearCombination computes S = s_1 * S_1 + s_2 * S_2 + ... + s_n * S_n from
+// signatures S_i and scalars s_i, which recovers a threshold signature from partial
+// signatures and their Lagrange coefficients. The signatures are combined even if BLS
+// verification is skipped, as the result is published as the signature of the shared key.
+func SignaturesLinearCombination(sigs []common.Signature, scalars []*big.Int) (common.Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to combine")
+	}
+	if len(sigs) != len(scalars) {
+		return nil, errors.Errorf("number of signatures %d does not match number of scalars %d", len(sigs), len(scalars))
+	}
+	combined := new(blstAggregateSignature)
+	for i, sig := range sigs {
+		s, ok := sig.(*Signature)
+		if !ok || s.s == nil {
+			return nil, errors.Errorf("invalid signature at index %d", i)
+		}
+		if scalars[i].Sign() < 0 {
+			return nil, errors.Errorf("negative scalar at index %d", i)
+		}
+		mulAdd(combined, s.s, scalars[i])
+	}
+	return &Signature{s: combined.ToAffine()}, nil
+}
+
+// mulAdd adds scalar * p to an aggregate signature by double-and-add. The scalars are
+// public Lagrange coefficients, so the multiplication needs not run in constant time.
+func mulAdd(agg *blstAggregateSignature, p *blstSignature, scalar *big.Int) {
+	base := new(blstAggregateSignature)
+	base.Add(p, false)
+	for i := 0; i < scalar.BitLen(); i++ {
+		if scalar.Bit(i) == 1 {
+			agg.Add(base.ToAffine(), false)
+		}
+		base.AddAggregate(base)
+	}
+}
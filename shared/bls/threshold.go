@@ -0,0 +1,121 @@
+package bls
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls/blst"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+)
+
+// curveOrder is the order r of the BLS12-381 groups, secret keys being scalars modulo r.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// SplitSecretKey splits a secret key into n shares using Shamir's secret sharing, so that
+// any threshold of the shares can produce signatures for the public key of the secret key
+// while fewer shares reveal nothing about it. The share at position i of the returned
+// slice has index i+1, the index to recover signatures with.
+func SplitSecretKey(secretKey SecretKey, threshold, n uint64) ([]SecretKey, error) {
+	secret := new(big.Int).SetBytes(secretKey.Marshal())
+	shares, err := shamirShares(rand.Reader, secret, threshold, n)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]SecretKey, len(shares))
+	for i, share := range shares {
+		keys[i], err = SecretKeyFromBytes(share.FillBytes(make([]byte, 32)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create secret key share %d", i+1)
+		}
+	}
+	return keys, nil
+}
+
+// RecoverSignature combines partial signatures, made by secret key shares with the given
+// indices, into the signature of the shared secret key using Lagrange interpolation. The
+// recovered signature is only valid given at least the threshold number of shares.
+func RecoverSignature(indices []uint64, partials []common.Signature) (common.Signature, error) {
+	if len(indices) != len(partials) {
+		return nil, errors.Errorf("number of indices %d does not match number of signatures %d", len(indices), len(partials))
+	}
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	return blst.SignaturesLinearCombination(partials, coefficients)
+}
+
+// shamirShares evaluates a random polynomial of degree threshold-1, whose constant term
+// is the secret, at 1, 2, ..., n.
+func shamirShares(r io.Reader, secret *big.Int, threshold, n uint64) ([]*big.Int, error) {
+	if threshold == 0 || threshold > n {
+		return nil, errors.Errorf("threshold %d must be between 1 and the number of shares %d", threshold, n)
+	}
+	if secret.Sign() <= 0 || secret.Cmp(curveOrder) >= 0 {
+		return nil, errors.New("secret is not a valid secret key")
+	}
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = secret
+	for i := uint64(1); i < threshold; i++ {
+		c, err := rand.Int(r, curveOrder)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate polynomial coefficient")
+		}
+		coefficients[i] = c
+	}
+	shares := make([]*big.Int, n)
+	for i := uint64(0); i < n; i++ {
+		x := new(big.Int).SetUint64(i + 1)
+		// Horner's method, from the highest degree coefficient down.
+		y := new(big.Int)
+		for j := len(coefficients) - 1; j >= 0; j-- {
+			y.Mul(y, x)
+			y.Add(y, coefficients[j])
+			y.Mod(y, curveOrder)
+		}
+		if y.Sign() == 0 {
+			return nil, errors.Errorf("share %d is zero", i+1)
+		}
+		shares[i] = y
+	}
+	return shares, nil
+}
+
+// lagrangeCoefficients returns the Lagrange basis polynomials at zero for the indices,
+// the coefficient of index i being the product of j / (j - i) over all other indices j.
+func lagrangeCoefficients(indices []uint64) ([]*big.Int, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no indices to interpolate")
+	}
+	seen := make(map[uint64]bool, len(indices))
+	for _, i := range indices {
+		if i == 0 {
+			return nil, errors.New("index 0 is not a valid share index")
+		}
+		if seen[i] {
+			return nil, errors.Errorf("duplicate share index %d", i)
+		}
+		seen[i] = true
+	}
+	coefficients := make([]*big.Int, len(indices))
+	for k, i := range indices {
+		xi := new(big.Int).SetUint64(i)
+		num := big.NewInt(1)
+		den := big.NewInt(1)
+		for _, j := range indices {
+			if j == i {
+				continue
+			}
+			xj := new(big.Int).SetUint64(j)
+			num.Mul(num, xj)
+			num.Mod(num, curveOrder)
+			den.Mul(den, new(big.Int).Sub(xj, xi))
+			den.Mod(den, curveOrder)
+		}
+		den.ModInverse(den, curveOrder)
+		coefficients[k] = num.Mul(num, den).Mod(num, curveOrder)
+	}
+	return coefficients, nil
+}
@@ -0,0 +1,88 @@
+package bls
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestShamirShares_Interpolation(t *testing.T) {
+	secret, err := rand.Int(rand.Reader, curveOrder)
+	require.NoError(t, err)
+	shares, err := shamirShares(rand.Reader, secret, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	interpolate := func(indices []uint64) *big.Int {
+		coefficients, err := lagrangeCoefficients(indices)
+		require.NoError(t, err)
+		sum := new(big.Int)
+		for k, i := range indices {
+			sum.Add(sum, new(big.Int).Mul(coefficients[k], shares[i-1]))
+		}
+		return sum.Mod(sum, curveOrder)
+	}
+	for _, indices := range [][]uint64{{1, 2, 3}, {5, 3, 1}, {2, 4, 5}, {1, 2, 3, 4, 5}} {
+		assert.Equal(t, 0, secret.Cmp(interpolate(indices)), "indices %v", indices)
+	}
+	// Fewer shares than the threshold interpolate a different value.
+	assert.NotEqual(t, 0, secret.Cmp(interpolate([]uint64{1, 2})))
+}
+
+func TestShamirShares_InvalidParameters(t *testing.T) {
+	_, err := shamirShares(rand.Reader, big.NewInt(1), 0, 3)
+	assert.ErrorContains(t, "threshold 0 must be between 1", err)
+	_, err = shamirShares(rand.Reader, big.NewInt(1), 4, 3)
+	assert.ErrorContains(t, "threshold 4 must be between 1", err)
+	_, err = shamirShares(rand.Reader, new(big.Int), 2, 3)
+	assert.ErrorContains(t, "not a valid secret key", err)
+	_, err = shamirShares(rand.Reader, curveOrder, 2, 3)
+	assert.ErrorContains(t, "not a valid secret key", err)
+}
+
+func TestLagrangeCoefficients_InvalidIndices(t *testing.T) {
+	_, err := lagrangeCoefficients(nil)
+	assert.ErrorContains(t, "no indices", err)
+	_, err = lagrangeCoefficients([]uint64{1, 0})
+	assert.ErrorContains(t, "index 0 is not a valid share index", err)
+	_, err = lagrangeCoefficients([]uint64{1, 2, 1})
+	assert.ErrorContains(t, "duplicate share index 1", err)
+}
+
+func TestSplitSecretKey_RecoverSignature(t *testing.T) {
+	secretKey, err := RandKey()
+	require.NoError(t, err)
+	shares, err := SplitSecretKey(secretKey, 2, 3)
+	require.NoError(t, err)
+	msg := []byte("threshold")
+
+	partials := []Signature{shares[2].Sign(msg), shares[0].Sign(msg)}
+	sig, err := RecoverSignature([]uint64{3, 1}, partials)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(msg).Marshal(), sig.Marshal())
+	assert.Equal(t, true, sig.Verify(secretKey.PublicKey(), msg))
+
+	sig, err = RecoverSignature([]uint64{3}, partials[:1])
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(secretKey.PublicKey(), msg))
+}
+
+func TestRecoverSignature_SkipBLSVerify(t *testing.T) {
+	secretKey, err := RandKey()
+	require.NoError(t, err)
+	shares, err := SplitSecretKey(secretKey, 2, 3)
+	require.NoError(t, err)
+	msg := []byte("threshold")
+	partials := []Signature{shares[0].Sign(msg), shares[1].Sign(msg)}
+
+	// Skipping verification still combines the partial signatures.
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{SkipBLSVerify: true})
+	sig, err := RecoverSignature([]uint64{1, 2}, partials)
+	resetCfg()
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(msg).Marshal(), sig.Marshal())
+}
@@ -119,6 +119,17 @@ var (
 		Usage: "The port used by libp2p.",
 		Value: 13000,
 	}
+	// P2PQUIC enables the QUIC transport in libp2p.
+	P2PQUIC = &cli.BoolFlag{
+		Name:  "p2p-quic",
+		Usage: "Enables listening and dialing over QUIC alongside TCP. QUIC is preferred when dialing peers which advertise it.",
+	}
+	// P2PQUICPort defines the udp port to be used by the QUIC transport of libp2p.
+	P2PQUICPort = &cli.IntFlag{
+		Name:  "p2p-quic-port",
+		Usage: "The udp port used by libp2p for QUIC connections.",
+		Value: 13000,
+	}
 	// P2PIP defines the local IP to be used by libp2p.
 	P2PIP = &cli.StringFlag{
 		Name:  "p2p-local-ip",
@@ -1,6 +1,7 @@
 package copyutil
 
 import (
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
@@ -155,6 +156,50 @@ func CopyBeaconBlockBodyAltair(body *prysmv2.BeaconBlockBodyAltair) *prysmv2.Bea
 	}
 }
 
+// CopySignedBeaconBlockBellatrix copies the provided SignedBeaconBlockBellatrix.
+func CopySignedBeaconBlockBellatrix(sigBlock *prysmv2.SignedBeaconBlockBellatrix) *prysmv2.SignedBeaconBlockBellatrix {
+	if sigBlock == nil {
+		return nil
+	}
+	return &prysmv2.SignedBeaconBlockBellatrix{
+		Block:     CopyBeaconBlockBellatrix(sigBlock.Block),
+		Signature: bytesutil.SafeCopyBytes(sigBlock.Signature),
+	}
+}
+
+// CopyBeaconBlockBellatrix copies the provided BeaconBlockBellatrix.
+func CopyBeaconBlockBellatrix(block *prysmv2.BeaconBlockBellatrix) *prysmv2.BeaconBlockBellatrix {
+	if block == nil {
+		return nil
+	}
+	return &prysmv2.BeaconBlockBellatrix{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    bytesutil.SafeCopyBytes(block.ParentRoot),
+		StateRoot:     bytesutil.SafeCopyBytes(block.StateRoot),
+		Body:          CopyBeaconBlockBodyBellatrix(block.Body),
+	}
+}
+
+// CopyBeaconBlockBodyBellatrix copies the provided BeaconBlockBodyBellatrix.
+func CopyBeaconBlockBodyBellatrix(body *prysmv2.BeaconBlockBodyBellatrix) *prysmv2.BeaconBlockBodyBellatrix {
+	if body == nil {
+		return nil
+	}
+	return &prysmv2.BeaconBlockBodyBellatrix{
+		RandaoReveal:      bytesutil.SafeCopyBytes(body.RandaoReveal),
+		Eth1Data:          CopyETH1Data(body.Eth1Data),
+		Graffiti:          bytesutil.SafeCopyBytes(body.Graffiti),
+		ProposerSlashings: CopyProposerSlashings(body.ProposerSlashings),
+		AttesterSlashings: CopyAttesterSlashings(body.AttesterSlashings),
+		Attestations:      CopyAttestations(body.Attestations),
+		Deposits:          CopyDeposits(body.Deposits),
+		VoluntaryExits:    CopySignedVoluntaryExits(body.VoluntaryExits),
+		SyncAggregate:     CopySyncAggregate(body.SyncAggregate),
+		ExecutionPayload:  CopyExecutionPayload(body.ExecutionPayload),
+	}
+}
+
 // CopyProposerSlashings copies the provided ProposerSlashing array.
 func CopyProposerSlashings(slashings []*ethpb.ProposerSlashing) []*ethpb.ProposerSlashing {
 	if slashings == nil {
@@ -366,3 +411,56 @@ func CopySyncAggregate(a *prysmv2.SyncAggregate) *prysmv2.SyncAggregate {
 		SyncCommitteeSignature: bytesutil.SafeCopyBytes(a.SyncCommitteeSignature),
 	}
 }
+
+// CopyExecutionPayload copies the provided execution payload.
+func CopyExecutionPayload(p *enginev1.ExecutionPayload) *enginev1.ExecutionPayload {
+	if p == nil {
+		return nil
+	}
+	var txs [][]byte
+	if p.Transactions != nil {
+		txs = make([][]byte, len(p.Transactions))
+		for i, tx := range p.Transactions {
+			txs[i] = bytesutil.SafeCopyBytes(tx)
+		}
+	}
+	return &enginev1.ExecutionPayload{
+		ParentHash:    bytesutil.SafeCopyBytes(p.ParentHash),
+		FeeRecipient:  bytesutil.SafeCopyBytes(p.FeeRecipient),
+		StateRoot:     bytesutil.SafeCopyBytes(p.StateRoot),
+		ReceiptsRoot:  bytesutil.SafeCopyBytes(p.ReceiptsRoot),
+		LogsBloom:     bytesutil.SafeCopyBytes(p.LogsBloom),
+		PrevRandao:    bytesutil.SafeCopyBytes(p.PrevRandao),
+		BlockNumber:   p.BlockNumber,
+		GasLimit:      p.GasLimit,
+		GasUsed:       p.GasUsed,
+		Timestamp:     p.Timestamp,
+		ExtraData:     bytesutil.SafeCopyBytes(p.ExtraData),
+		BaseFeePerGas: bytesutil.SafeCopyBytes(p.BaseFeePerGas),
+		BlockHash:     bytesutil.SafeCopyBytes(p.BlockHash),
+		Transactions:  txs,
+	}
+}
+
+// CopyExecutionPayloadHeader copies the provided execution payload header.
+func CopyExecutionPayloadHeader(h *enginev1.ExecutionPayloadHeader) *enginev1.ExecutionPayloadHeader {
+	if h == nil {
+		return nil
+	}
+	return &enginev1.ExecutionPayloadHeader{
+		ParentHash:       bytesutil.SafeCopyBytes(h.ParentHash),
+		FeeRecipient:     bytesutil.SafeCopyBytes(h.FeeRecipient),
+		StateRoot:        bytesutil.SafeCopyBytes(h.StateRoot),
+		ReceiptsRoot:     bytesutil.SafeCopyBytes(h.ReceiptsRoot),
+		LogsBloom:        bytesutil.SafeCopyBytes(h.LogsBloom),
+		PrevRandao:       bytesutil.SafeCopyBytes(h.PrevRandao),
+		BlockNumber:      h.BlockNumber,
+		GasLimit:         h.GasLimit,
+		GasUsed:          h.GasUsed,
+		Timestamp:        h.Timestamp,
+		ExtraData:        bytesutil.SafeCopyBytes(h.ExtraData),
+		BaseFeePerGas:    bytesutil.SafeCopyBytes(h.BaseFeePerGas),
+		BlockHash:        bytesutil.SafeCopyBytes(h.BlockHash),
+		TransactionsRoot: bytesutil.SafeCopyBytes(h.TransactionsRoot),
+	}
+}
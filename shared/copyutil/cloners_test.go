@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
@@ -120,6 +121,36 @@ func TestCopyBeaconBlockBodyAltair(t *testing.T) {
 	assert.NotEmpty(t, bb, "Copied beacon block body altair has empty fields")
 }
 
+func TestCopySignedBeaconBlockBellatrix(t *testing.T) {
+	sbb := genSignedBeaconBlockBellatrix()
+
+	got := CopySignedBeaconBlockBellatrix(sbb)
+	if !reflect.DeepEqual(got, sbb) {
+		t.Errorf("CopySignedBeaconBlockBellatrix() = %v, want %v", got, sbb)
+	}
+	assert.NotEmpty(t, sbb, "Copied signed beacon block bellatrix has empty fields")
+}
+
+func TestCopyExecutionPayload(t *testing.T) {
+	p := genExecutionPayload()
+
+	got := CopyExecutionPayload(p)
+	if !reflect.DeepEqual(got, p) {
+		t.Errorf("CopyExecutionPayload() = %v, want %v", got, p)
+	}
+	assert.NotEmpty(t, got, "Copied execution payload has empty fields")
+}
+
+func TestCopyExecutionPayloadHeader(t *testing.T) {
+	h := genExecutionPayloadHeader()
+
+	got := CopyExecutionPayloadHeader(h)
+	if !reflect.DeepEqual(got, h) {
+		t.Errorf("CopyExecutionPayloadHeader() = %v, want %v", got, h)
+	}
+	assert.NotEmpty(t, got, "Copied execution payload header has empty fields")
+}
+
 func TestCopyProposerSlashings(t *testing.T) {
 	ps := genProposerSlashings(10)
 
@@ -536,6 +567,72 @@ func genSignedBeaconBlockAltair() *prysmv2.SignedBeaconBlockAltair {
 	}
 }
 
+func genExecutionPayload() *enginev1.ExecutionPayload {
+	return &enginev1.ExecutionPayload{
+		ParentHash:    bytes(),
+		FeeRecipient:  bytes()[:20],
+		StateRoot:     bytes(),
+		ReceiptsRoot:  bytes(),
+		LogsBloom:     append(bytes(), bytes()...),
+		PrevRandao:    bytes(),
+		BlockNumber:   5432,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1638316800,
+		ExtraData:     bytes(),
+		BaseFeePerGas: bytes(),
+		BlockHash:     bytes(),
+		Transactions:  [][]byte{bytes(), bytes()},
+	}
+}
+
+func genExecutionPayloadHeader() *enginev1.ExecutionPayloadHeader {
+	return &enginev1.ExecutionPayloadHeader{
+		ParentHash:       bytes(),
+		FeeRecipient:     bytes()[:20],
+		StateRoot:        bytes(),
+		ReceiptsRoot:     bytes(),
+		LogsBloom:        append(bytes(), bytes()...),
+		PrevRandao:       bytes(),
+		BlockNumber:      5432,
+		GasLimit:         30000000,
+		GasUsed:          21000,
+		Timestamp:        1638316800,
+		ExtraData:        bytes(),
+		BaseFeePerGas:    bytes(),
+		BlockHash:        bytes(),
+		TransactionsRoot: bytes(),
+	}
+}
+
+func genBeaconBlockBodyBellatrix() *prysmv2.BeaconBlockBodyBellatrix {
+	return &prysmv2.BeaconBlockBodyBellatrix{
+		RandaoReveal:      bytes(),
+		Eth1Data:          genEth1Data(),
+		Graffiti:          bytes(),
+		ProposerSlashings: genProposerSlashings(5),
+		AttesterSlashings: genAttesterSlashings(5),
+		Attestations:      genAttestations(10),
+		Deposits:          genDeposits(5),
+		VoluntaryExits:    genSignedVoluntaryExits(12),
+		SyncAggregate:     genSyncAggregate(),
+		ExecutionPayload:  genExecutionPayload(),
+	}
+}
+
+func genSignedBeaconBlockBellatrix() *prysmv2.SignedBeaconBlockBellatrix {
+	return &prysmv2.SignedBeaconBlockBellatrix{
+		Block: &prysmv2.BeaconBlockBellatrix{
+			Slot:          123455,
+			ProposerIndex: 55433,
+			ParentRoot:    bytes(),
+			StateRoot:     bytes(),
+			Body:          genBeaconBlockBodyBellatrix(),
+		},
+		Signature: bytes(),
+	}
+}
+
 func genSyncCommitteeMessage() *prysmv2.SyncCommitteeMessage {
 	return &prysmv2.SyncCommitteeMessage{
 		Slot:           424555,
@@ -7,10 +7,12 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/httputils/authorizationmethod"
 )
 
-// Endpoint is an endpoint with authorization data.
+// Endpoint is an endpoint with authorization data, and additional headers to send with every
+// request.
 type Endpoint struct {
-	Url  string
-	Auth AuthorizationData
+	Url     string
+	Auth    AuthorizationData
+	Headers map[string]string
 }
 
 // AuthorizationData holds all information necessary to authorize with HTTP.
@@ -21,7 +23,15 @@ type AuthorizationData struct {
 
 // Equals compares two endpoints for equality.
 func (e Endpoint) Equals(other Endpoint) bool {
-	return e.Url == other.Url && e.Auth.Equals(other.Auth)
+	if e.Url != other.Url || !e.Auth.Equals(other.Auth) || len(e.Headers) != len(other.Headers) {
+		return false
+	}
+	for name, value := range e.Headers {
+		if otherValue, ok := other.Headers[name]; !ok || otherValue != value {
+			return false
+		}
+	}
+	return true
 }
 
 // Equals compares two authorization data objects for equality.
@@ -110,6 +110,16 @@ func TestEndpointEquals(t *testing.T) {
 		}
 		assert.Equal(t, false, e.Equals(other))
 	})
+	t.Run("different headers", func(t *testing.T) {
+		withHeaders := e
+		withHeaders.Headers = map[string]string{"X-Api-Key": "key"}
+		assert.Equal(t, false, e.Equals(withHeaders))
+		other := withHeaders
+		other.Headers = map[string]string{"X-Api-Key": "other key"}
+		assert.Equal(t, false, withHeaders.Equals(other))
+		other.Headers = map[string]string{"X-Api-Key": "key"}
+		assert.Equal(t, true, withHeaders.Equals(other))
+	})
 }
 
 func TestAuthorizationDataEquals(t *testing.T) {
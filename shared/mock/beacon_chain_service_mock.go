@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconChain_StreamChainHeadServer,BeaconChain_StreamAttestationsServer,BeaconChain_StreamBlocksServer,BeaconChain_StreamValidatorsInfoServer,BeaconChain_StreamIndexedAttestationsServer)
+// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconChain_StreamChainHeadServer,BeaconChain_StreamAttestationsServer,BeaconChain_StreamBlocksServer,BeaconChain_StreamValidatorsInfoServer,BeaconChain_StreamIndexedAttestationsServer,BeaconChain_StreamIndexedAttestationsWithProvenanceServer,BeaconChain_StreamBlocksWithProvenanceServer)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -622,3 +622,241 @@ func (mr *MockBeaconChain_StreamIndexedAttestationsServerMockRecorder) SetTraile
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsServer)(nil).SetTrailer), arg0)
 }
+
+// MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer is a mock of BeaconChain_StreamIndexedAttestationsWithProvenanceServer interface
+type MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder
+}
+
+// MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder is the mock recorder for MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer
+type MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder struct {
+	mock *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer
+}
+
+// NewMockBeaconChain_StreamIndexedAttestationsWithProvenanceServer creates a new mock instance
+func NewMockBeaconChain_StreamIndexedAttestationsWithProvenanceServer(ctrl *gomock.Controller) *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer {
+	mock := &MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) EXPECT() *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) Send(arg0 *v1alpha1.IndexedAttestationWithProvenance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceServer)(nil).SetTrailer), arg0)
+}
+
+// MockBeaconChain_StreamBlocksWithProvenanceServer is a mock of BeaconChain_StreamBlocksWithProvenanceServer interface
+type MockBeaconChain_StreamBlocksWithProvenanceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder
+}
+
+// MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder is the mock recorder for MockBeaconChain_StreamBlocksWithProvenanceServer
+type MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder struct {
+	mock *MockBeaconChain_StreamBlocksWithProvenanceServer
+}
+
+// NewMockBeaconChain_StreamBlocksWithProvenanceServer creates a new mock instance
+func NewMockBeaconChain_StreamBlocksWithProvenanceServer(ctrl *gomock.Controller) *MockBeaconChain_StreamBlocksWithProvenanceServer {
+	mock := &MockBeaconChain_StreamBlocksWithProvenanceServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) EXPECT() *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) Send(arg0 *v1alpha1.SignedBeaconBlockWithProvenance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceServer)(nil).SetTrailer), arg0)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconChainClient,BeaconChain_StreamChainHeadClient,BeaconChain_StreamAttestationsClient,BeaconChain_StreamBlocksClient,BeaconChain_StreamValidatorsInfoClient,BeaconChain_StreamIndexedAttestationsClient)
+// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconChainClient,BeaconChain_StreamChainHeadClient,BeaconChain_StreamAttestationsClient,BeaconChain_StreamBlocksClient,BeaconChain_StreamValidatorsInfoClient,BeaconChain_StreamIndexedAttestationsClient,BeaconChain_StreamIndexedAttestationsWithProvenanceClient,BeaconChain_StreamBlocksWithProvenanceClient)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -98,6 +98,26 @@ func (mr *MockBeaconChainClientMockRecorder) GetChainHead(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainHead", reflect.TypeOf((*MockBeaconChainClient)(nil).GetChainHead), varargs...)
 }
 
+// GetDepositSnapshot mocks base method
+func (m *MockBeaconChainClient) GetDepositSnapshot(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.DepositSnapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDepositSnapshot", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.DepositSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositSnapshot indicates an expected call of GetDepositSnapshot
+func (mr *MockBeaconChainClientMockRecorder) GetDepositSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositSnapshot", reflect.TypeOf((*MockBeaconChainClient)(nil).GetDepositSnapshot), varargs...)
+}
+
 // GetIndividualVotes mocks base method
 func (m *MockBeaconChainClient) GetIndividualVotes(arg0 context.Context, arg1 *v1alpha1.IndividualVotesRequest, arg2 ...grpc.CallOption) (*v1alpha1.IndividualVotesRespond, error) {
 	m.ctrl.T.Helper()
@@ -418,6 +438,26 @@ func (mr *MockBeaconChainClientMockRecorder) StreamBlocks(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamBlocks", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamBlocks), varargs...)
 }
 
+// StreamBlocksWithProvenance mocks base method
+func (m *MockBeaconChainClient) StreamBlocksWithProvenance(arg0 context.Context, arg1 *v1alpha1.StreamBlocksRequest, arg2 ...grpc.CallOption) (v1alpha1.BeaconChain_StreamBlocksWithProvenanceClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamBlocksWithProvenance", varargs...)
+	ret0, _ := ret[0].(v1alpha1.BeaconChain_StreamBlocksWithProvenanceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamBlocksWithProvenance indicates an expected call of StreamBlocksWithProvenance
+func (mr *MockBeaconChainClientMockRecorder) StreamBlocksWithProvenance(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamBlocksWithProvenance", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamBlocksWithProvenance), varargs...)
+}
+
 // StreamChainHead mocks base method
 func (m *MockBeaconChainClient) StreamChainHead(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (v1alpha1.BeaconChain_StreamChainHeadClient, error) {
 	m.ctrl.T.Helper()
@@ -458,6 +498,26 @@ func (mr *MockBeaconChainClientMockRecorder) StreamIndexedAttestations(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamIndexedAttestations", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamIndexedAttestations), varargs...)
 }
 
+// StreamIndexedAttestationsWithProvenance mocks base method
+func (m *MockBeaconChainClient) StreamIndexedAttestationsWithProvenance(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (v1alpha1.BeaconChain_StreamIndexedAttestationsWithProvenanceClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamIndexedAttestationsWithProvenance", varargs...)
+	ret0, _ := ret[0].(v1alpha1.BeaconChain_StreamIndexedAttestationsWithProvenanceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamIndexedAttestationsWithProvenance indicates an expected call of StreamIndexedAttestationsWithProvenance
+func (mr *MockBeaconChainClientMockRecorder) StreamIndexedAttestationsWithProvenance(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamIndexedAttestationsWithProvenance", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamIndexedAttestationsWithProvenance), varargs...)
+}
+
 // StreamValidatorsInfo mocks base method
 func (m *MockBeaconChainClient) StreamValidatorsInfo(arg0 context.Context, arg1 ...grpc.CallOption) (v1alpha1.BeaconChain_StreamValidatorsInfoClient, error) {
 	m.ctrl.T.Helper()
@@ -1146,3 +1206,249 @@ func (mr *MockBeaconChain_StreamIndexedAttestationsClientMockRecorder) Trailer()
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsClient)(nil).Trailer))
 }
+
+// MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient is a mock of BeaconChain_StreamIndexedAttestationsWithProvenanceClient interface
+type MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder
+}
+
+// MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder is the mock recorder for MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient
+type MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder struct {
+	mock *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient
+}
+
+// NewMockBeaconChain_StreamIndexedAttestationsWithProvenanceClient creates a new mock instance
+func NewMockBeaconChain_StreamIndexedAttestationsWithProvenanceClient(ctrl *gomock.Controller) *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient {
+	mock := &MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) EXPECT() *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient)(nil).Header))
+}
+
+// Recv mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) Recv() (*v1alpha1.IndexedAttestationWithProvenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v1alpha1.IndexedAttestationWithProvenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockBeaconChain_StreamIndexedAttestationsWithProvenanceClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconChain_StreamIndexedAttestationsWithProvenanceClient)(nil).Trailer))
+}
+
+// MockBeaconChain_StreamBlocksWithProvenanceClient is a mock of BeaconChain_StreamBlocksWithProvenanceClient interface
+type MockBeaconChain_StreamBlocksWithProvenanceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder
+}
+
+// MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder is the mock recorder for MockBeaconChain_StreamBlocksWithProvenanceClient
+type MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder struct {
+	mock *MockBeaconChain_StreamBlocksWithProvenanceClient
+}
+
+// NewMockBeaconChain_StreamBlocksWithProvenanceClient creates a new mock instance
+func NewMockBeaconChain_StreamBlocksWithProvenanceClient(ctrl *gomock.Controller) *MockBeaconChain_StreamBlocksWithProvenanceClient {
+	mock := &MockBeaconChain_StreamBlocksWithProvenanceClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) EXPECT() *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceClient)(nil).Header))
+}
+
+// Recv mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) Recv() (*v1alpha1.SignedBeaconBlockWithProvenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v1alpha1.SignedBeaconBlockWithProvenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockBeaconChain_StreamBlocksWithProvenanceClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockBeaconChain_StreamBlocksWithProvenanceClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconChain_StreamBlocksWithProvenanceClient)(nil).Trailer))
+}
@@ -2,6 +2,7 @@
 package p2putils
 
 import (
+	"bytes"
 	"math"
 	"sort"
 	"time"
@@ -149,7 +150,8 @@ func NextForkData(currEpoch types.Epoch) ([4]byte, types.Epoch) {
 }
 
 // SortedForkVersions sorts the provided fork schedule in ascending order
-// by epoch.
+// by epoch. Forks scheduled at the same epoch, such as forks which are not
+// scheduled yet, are sorted by version.
 func SortedForkVersions(forkSchedule map[[4]byte]types.Epoch) [][4]byte {
 	sortedVersions := make([][4]byte, len(forkSchedule))
 	i := 0
@@ -158,7 +160,11 @@ func SortedForkVersions(forkSchedule map[[4]byte]types.Epoch) [][4]byte {
 		i++
 	}
 	sort.Slice(sortedVersions, func(a, b int) bool {
-		return forkSchedule[sortedVersions[a]] < forkSchedule[sortedVersions[b]]
+		epochA, epochB := forkSchedule[sortedVersions[a]], forkSchedule[sortedVersions[b]]
+		if epochA == epochB {
+			return bytes.Compare(sortedVersions[a][:], sortedVersions[b][:]) < 0
+		}
+		return epochA < epochB
 	})
 	return sortedVersions
 }
@@ -349,6 +349,22 @@ func TestNextForkData(t *testing.T) {
 				params.OverrideBeaconConfig(cfg)
 			},
 		},
+		{
+			name:              "2 unscheduled forks",
+			currEpoch:         5,
+			wantedForkVerison: [4]byte{'A', 'B', 'C', 'F'},
+			wantedEpoch:       math.MaxUint64,
+			setConfg: func() {
+				cfg := params.BeaconConfig()
+				cfg.GenesisForkVersion = []byte{'A', 'B', 'C', 'D'}
+				cfg.ForkVersionSchedule = map[[4]byte]types.Epoch{
+					{'A', 'B', 'C', 'D'}: 0,
+					{'A', 'B', 'C', 'Z'}: math.MaxUint64,
+					{'A', 'B', 'C', 'F'}: math.MaxUint64,
+				}
+				params.OverrideBeaconConfig(cfg)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
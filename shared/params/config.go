@@ -2,6 +2,8 @@
 package params
 
 import (
+	"errors"
+	"math"
 	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
@@ -60,6 +62,10 @@ type BeaconChainConfig struct {
 	SafeSlotsToUpdateJustified       types.Slot  `yaml:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED" spec:"true"`      // SafeSlotsToUpdateJustified is the minimal slots needed to update justified check point.
 	SecondsPerETH1Block              uint64      `yaml:"SECONDS_PER_ETH1_BLOCK" spec:"true"`              // SecondsPerETH1Block is the approximate time for a single eth1 block to be produced.
 
+	// Fork choice algorithm constants.
+	ProposerScoreBoost uint64 `yaml:"PROPOSER_SCORE_BOOST" spec:"true"` // ProposerScoreBoost defines the percentage of the committee weight added to a timely block in fork choice.
+	IntervalsPerSlot   uint64 `yaml:"INTERVALS_PER_SLOT" spec:"true"`   // IntervalsPerSlot defines the number of fork choice intervals in a slot, a block is timely if it arrives within the first one.
+
 	// Ethereum PoW parameters.
 	DepositChainID         uint64 `yaml:"DEPOSIT_CHAIN_ID" spec:"true"`         // DepositChainID of the eth1 network. This used for replay protection.
 	DepositNetworkID       uint64 `yaml:"DEPOSIT_NETWORK_ID" spec:"true"`       // DepositNetworkID of the eth1 network. This used for replay protection.
@@ -103,21 +109,22 @@ type BeaconChainConfig struct {
 	DomainContributionAndProof        [4]byte `yaml:"DOMAIN_CONTRIBUTION_AND_PROOF" spec:"true"`         // DomainAggregateAndProof defines the BLS signature domain for contribution and proof.
 
 	// Prysm constants.
-	GweiPerEth                  uint64        // GweiPerEth is the amount of gwei corresponding to 1 eth.
-	BLSSecretKeyLength          int           // BLSSecretKeyLength defines the expected length of BLS secret keys in bytes.
-	BLSPubkeyLength             int           // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
-	BLSSignatureLength          int           // BLSSignatureLength defines the expected length of BLS signatures in bytes.
-	DefaultBufferSize           int           // DefaultBufferSize for channels across the Prysm repository.
-	ValidatorPrivkeyFileName    string        // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
-	WithdrawalPrivkeyFileName   string        // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
-	RPCSyncCheck                time.Duration // Number of seconds to query the sync service, to find out if the node is synced or not.
-	EmptySignature              [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
-	DefaultPageSize             int           // DefaultPageSize defines the default page size for RPC server request.
-	MaxPeersToSync              int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
-	SlotsPerArchivedPoint       types.Slot    // SlotsPerArchivedPoint defines the number of slots per one archived point.
-	GenesisCountdownInterval    time.Duration // How often to log the countdown until the genesis time is reached.
-	BeaconStateFieldCount       int           // BeaconStateFieldCount defines how many fields are in beacon state.
-	BeaconStateAltairFieldCount int           // BeaconStateAltairFieldCount defines how many fields are in beacon state hard fork 1.
+	GweiPerEth                     uint64        // GweiPerEth is the amount of gwei corresponding to 1 eth.
+	BLSSecretKeyLength             int           // BLSSecretKeyLength defines the expected length of BLS secret keys in bytes.
+	BLSPubkeyLength                int           // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
+	BLSSignatureLength             int           // BLSSignatureLength defines the expected length of BLS signatures in bytes.
+	DefaultBufferSize              int           // DefaultBufferSize for channels across the Prysm repository.
+	ValidatorPrivkeyFileName       string        // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
+	WithdrawalPrivkeyFileName      string        // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
+	RPCSyncCheck                   time.Duration // Number of seconds to query the sync service, to find out if the node is synced or not.
+	EmptySignature                 [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
+	DefaultPageSize                int           // DefaultPageSize defines the default page size for RPC server request.
+	MaxPeersToSync                 int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
+	SlotsPerArchivedPoint          types.Slot    // SlotsPerArchivedPoint defines the number of slots per one archived point.
+	GenesisCountdownInterval       time.Duration // How often to log the countdown until the genesis time is reached.
+	BeaconStateFieldCount          int           // BeaconStateFieldCount defines how many fields are in beacon state.
+	BeaconStateAltairFieldCount    int           // BeaconStateAltairFieldCount defines how many fields are in beacon state hard fork 1.
+	BeaconStateBellatrixFieldCount int           // BeaconStateBellatrixFieldCount defines how many fields are in the Bellatrix beacon state.
 
 	// Slasher constants.
 	WeakSubjectivityPeriod    types.Epoch // WeakSubjectivityPeriod defines the time period expressed in number of epochs were proof of stake network should validate block headers and attestations for slashable events.
@@ -127,10 +134,12 @@ type BeaconChainConfig struct {
 	SlashingProtectionPruningEpochs types.Epoch // SlashingProtectionPruningEpochs defines a period after which all prior epochs are pruned in the validator database.
 
 	// Fork-related values.
-	GenesisForkVersion  []byte                  `yaml:"GENESIS_FORK_VERSION" spec:"true"` // GenesisForkVersion is used to track fork version between state transitions.
-	AltairForkVersion   []byte                  `yaml:"ALTAIR_FORK_VERSION" spec:"true"`  // AltairForkVersion is used to represent the fork version for altair.
-	AltairForkEpoch     types.Epoch             `yaml:"ALTAIR_FORK_EPOCH" spec:"true"`    // AltairForkEpoch is used to represent the assigned fork epoch for altair.
-	ForkVersionSchedule map[[4]byte]types.Epoch // Schedule of fork epochs by version.
+	GenesisForkVersion   []byte                  `yaml:"GENESIS_FORK_VERSION" spec:"true"`   // GenesisForkVersion is used to track fork version between state transitions.
+	AltairForkVersion    []byte                  `yaml:"ALTAIR_FORK_VERSION" spec:"true"`    // AltairForkVersion is used to represent the fork version for altair.
+	AltairForkEpoch      types.Epoch             `yaml:"ALTAIR_FORK_EPOCH" spec:"true"`      // AltairForkEpoch is used to represent the assigned fork epoch for altair.
+	BellatrixForkVersion []byte                  `yaml:"BELLATRIX_FORK_VERSION" spec:"true"` // BellatrixForkVersion is used to represent the fork version for bellatrix.
+	BellatrixForkEpoch   types.Epoch             `yaml:"BELLATRIX_FORK_EPOCH" spec:"true"`   // BellatrixForkEpoch is used to represent the assigned fork epoch for bellatrix.
+	ForkVersionSchedule  map[[4]byte]types.Epoch // Schedule of fork epochs by version.
 
 	// Weak subjectivity values.
 	SafetyDecay uint64 // SafetyDecay is defined as the loss in the 1/3 consensus safety margin of the casper FFG mechanism.
@@ -164,6 +173,18 @@ type BeaconChainConfig struct {
 	InactivityPenaltyQuotientAltair      uint64 `yaml:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR" spec:"true"`      // InactivityPenaltyQuotientAltair for penalties during inactivity post Altair hard fork.
 	MinSlashingPenaltyQuotientAltair     uint64 `yaml:"MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR" spec:"true"`    // MinSlashingPenaltyQuotientAltair for slashing penalties post Altair hard fork.
 	ProportionalSlashingMultiplierAltair uint64 `yaml:"PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR" spec:"true"` // ProportionalSlashingMultiplierAltair for slashing penalties multiplier post Alair hard fork.
+
+	// New values introduced in the Bellatrix hard fork.
+	// Execution payload sizes.
+	MaxBytesPerTransaction    uint64 `yaml:"MAX_BYTES_PER_TRANSACTION" spec:"true"`    // MaxBytesPerTransaction is the maximum size of a transaction of an execution payload.
+	MaxTransactionsPerPayload uint64 `yaml:"MAX_TRANSACTIONS_PER_PAYLOAD" spec:"true"` // MaxTransactionsPerPayload is the maximum number of transactions of an execution payload.
+	BytesPerLogsBloom         uint64 `yaml:"BYTES_PER_LOGS_BLOOM" spec:"true"`         // BytesPerLogsBloom is the size of the logs bloom of an execution payload.
+	MaxExtraDataBytes         uint64 `yaml:"MAX_EXTRA_DATA_BYTES" spec:"true"`         // MaxExtraDataBytes is the maximum size of the extra data of an execution payload.
+
+	// Updated penalty values, moved to their final values in Bellatrix.
+	InactivityPenaltyQuotientBellatrix      uint64 `yaml:"INACTIVITY_PENALTY_QUOTIENT_BELLATRIX" spec:"true"`      // InactivityPenaltyQuotientBellatrix for penalties during inactivity post Bellatrix hard fork.
+	MinSlashingPenaltyQuotientBellatrix     uint64 `yaml:"MIN_SLASHING_PENALTY_QUOTIENT_BELLATRIX" spec:"true"`    // MinSlashingPenaltyQuotientBellatrix for slashing penalties post Bellatrix hard fork.
+	ProportionalSlashingMultiplierBellatrix uint64 `yaml:"PROPORTIONAL_SLASHING_MULTIPLIER_BELLATRIX" spec:"true"` // ProportionalSlashingMultiplierBellatrix for slashing penalties multiplier post Bellatrix hard fork.
 }
 
 // InitializeForkSchedule initializes the schedules forks baked into the config.
@@ -174,4 +195,19 @@ func (b *BeaconChainConfig) InitializeForkSchedule() {
 	b.ForkVersionSchedule[bytesutil.ToBytes4(b.GenesisForkVersion)] = b.GenesisEpoch
 	// Set Altair fork data.
 	b.ForkVersionSchedule[bytesutil.ToBytes4(b.AltairForkVersion)] = b.AltairForkEpoch
+	// Set Bellatrix fork data.
+	b.ForkVersionSchedule[bytesutil.ToBytes4(b.BellatrixForkVersion)] = b.BellatrixForkEpoch
+}
+
+// ErrBellatrixForkUnsupported is returned when a config schedules the Bellatrix fork
+// before gossip, RPC topics, the fork watchers and block proposals support it.
+var ErrBellatrixForkUnsupported = errors.New("bellatrix fork epoch must not be set: bellatrix is not yet supported by gossip, RPC, fork transitions or block proposals")
+
+// VerifySupportedForks returns an error if the config schedules a fork the node
+// cannot yet follow on the network.
+func (b *BeaconChainConfig) VerifySupportedForks() error {
+	if b.BellatrixForkEpoch != math.MaxUint64 {
+		return ErrBellatrixForkUnsupported
+	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 // Test cases can be executed in an arbitrary order. TestOverrideBeaconConfigTestTeardown checks
@@ -44,3 +45,13 @@ func TestConfig_DataRace(t *testing.T) {
 		}()
 	}
 }
+
+func TestConfig_VerifySupportedForks(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	require.NoError(t, params.BeaconConfig().VerifySupportedForks())
+
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+	require.ErrorContains(t, params.ErrBellatrixForkUnsupported.Error(), params.BeaconConfig().VerifySupportedForks())
+}
@@ -24,6 +24,9 @@ const (
 	// Altair Fork Epoch for mainnet config.
 	// Placeholder until fork epoch is decided.
 	mainnetAltairForkEpoch = math.MaxUint64
+	// Bellatrix Fork Epoch for mainnet config.
+	// Placeholder until fork epoch is decided.
+	mainnetBellatrixForkEpoch = math.MaxUint64
 )
 
 var mainnetNetworkConfig = &NetworkConfig{
@@ -108,6 +111,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	Eth1FollowDistance:               2048,
 	SafeSlotsToUpdateJustified:       8,
 
+	// Fork choice algorithm constants.
+	ProposerScoreBoost: 70,
+	IntervalsPerSlot:   3,
+
 	// Ethereum PoW parameters.
 	DepositChainID:         1, // Chain ID of eth1 mainnet.
 	DepositNetworkID:       1, // Network ID of eth1 mainnet.
@@ -159,22 +166,23 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DomainContributionAndProof:        bytesutil.ToBytes4(bytesutil.Bytes4(9)),
 
 	// Prysm constants.
-	GweiPerEth:                  1000000000,
-	BLSSecretKeyLength:          32,
-	BLSPubkeyLength:             48,
-	BLSSignatureLength:          96,
-	DefaultBufferSize:           10000,
-	WithdrawalPrivkeyFileName:   "/shardwithdrawalkey",
-	ValidatorPrivkeyFileName:    "/validatorprivatekey",
-	RPCSyncCheck:                1,
-	EmptySignature:              [96]byte{},
-	DefaultPageSize:             250,
-	MaxPeersToSync:              15,
-	SlotsPerArchivedPoint:       2048,
-	GenesisCountdownInterval:    time.Minute,
-	ConfigName:                  ConfigNames[Mainnet],
-	BeaconStateFieldCount:       21,
-	BeaconStateAltairFieldCount: 24,
+	GweiPerEth:                     1000000000,
+	BLSSecretKeyLength:             32,
+	BLSPubkeyLength:                48,
+	BLSSignatureLength:             96,
+	DefaultBufferSize:              10000,
+	WithdrawalPrivkeyFileName:      "/shardwithdrawalkey",
+	ValidatorPrivkeyFileName:       "/validatorprivatekey",
+	RPCSyncCheck:                   1,
+	EmptySignature:                 [96]byte{},
+	DefaultPageSize:                250,
+	MaxPeersToSync:                 15,
+	SlotsPerArchivedPoint:          2048,
+	GenesisCountdownInterval:       time.Minute,
+	ConfigName:                     ConfigNames[Mainnet],
+	BeaconStateFieldCount:          21,
+	BeaconStateAltairFieldCount:    24,
+	BeaconStateBellatrixFieldCount: 25,
 
 	// Slasher related values.
 	WeakSubjectivityPeriod:          54000,
@@ -185,12 +193,15 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	SafetyDecay: 10,
 
 	// Fork related values.
-	GenesisForkVersion: []byte{0, 0, 0, 0},
-	AltairForkVersion:  []byte{1, 0, 0, 0},
-	AltairForkEpoch:    mainnetAltairForkEpoch,
+	GenesisForkVersion:   []byte{0, 0, 0, 0},
+	AltairForkVersion:    []byte{1, 0, 0, 0},
+	AltairForkEpoch:      mainnetAltairForkEpoch,
+	BellatrixForkVersion: []byte{2, 0, 0, 0},
+	BellatrixForkEpoch:   mainnetBellatrixForkEpoch,
 	ForkVersionSchedule: map[[4]byte]types.Epoch{
 		{0, 0, 0, 0}: genesisForkEpoch,
 		{1, 0, 0, 0}: mainnetAltairForkEpoch,
+		{2, 0, 0, 0}: mainnetBellatrixForkEpoch,
 		// Any further forks must be specified here by their epoch number.
 	},
 
@@ -222,4 +233,16 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	InactivityPenaltyQuotientAltair:      3 * 1 << 24, //50331648
 	MinSlashingPenaltyQuotientAltair:     64,
 	ProportionalSlashingMultiplierAltair: 2,
+
+	// New values introduced in the Bellatrix hard fork.
+	// Execution payload sizes.
+	MaxBytesPerTransaction:    1 << 30,
+	MaxTransactionsPerPayload: 1 << 20,
+	BytesPerLogsBloom:         256,
+	MaxExtraDataBytes:         32,
+
+	// Updated penalty values.
+	InactivityPenaltyQuotientBellatrix:      1 << 24,
+	MinSlashingPenaltyQuotientBellatrix:     32,
+	ProportionalSlashingMultiplierBellatrix: 3,
 }
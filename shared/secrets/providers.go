@@ -0,0 +1,60 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// FileProvider reads secrets from files, the name of a secret being the file path.
+type FileProvider struct{}
+
+// Secret returns the content of the file.
+func (*FileProvider) Secret(_ context.Context, name string) ([]byte, error) {
+	path, err := fileutil.ExpandPath(name)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(path) // #nosec G304
+}
+
+// EnvProvider reads secrets from environment variables, the name of a secret being the
+// name of the variable.
+type EnvProvider struct{}
+
+// Secret returns the value of the environment variable, which must be set.
+func (*EnvProvider) Secret(_ context.Context, name string) ([]byte, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return nil, fmt.Errorf("environment variable %s is not set", name)
+	}
+	return []byte(value), nil
+}
+
+// ExecProvider reads secrets from the output of external commands, such as password
+// managers, the name of a secret being the command line. The command line is split on
+// white space and run without a shell.
+type ExecProvider struct{}
+
+// Secret returns the standard output of the command, without trailing newlines.
+func (*ExecProvider) Secret(ctx context.Context, name string) ([]byte, error) {
+	args := strings.Fields(name)
+	if len(args) == 0 {
+		return nil, errors.New("empty command")
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...) // #nosec G204
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("command %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return bytes.TrimRight(stdout.Bytes(), "\r\n"), nil
+}
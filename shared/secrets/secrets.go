@@ -0,0 +1,72 @@
+// Package secrets reads secrets, such as wallet passwords or TLS keys, from pluggable
+// providers so that they need not be stored in plaintext files. A secret is referenced
+// as <provider>:<name>, for example env:WALLET_PASSWORD, exec:pass show validator or
+// vault:https://vault.example.com:8200/v1/secret/data/validator#password. A reference
+// without a known provider is a file path, so plain paths keep working.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Provider retrieves secrets by name, the meaning of a name depending on the provider.
+type Provider interface {
+	Secret(ctx context.Context, name string) ([]byte, error)
+}
+
+var (
+	providersLock sync.RWMutex
+	providers     = map[string]Provider{
+		"file":  &FileProvider{},
+		"env":   &EnvProvider{},
+		"exec":  &ExecProvider{},
+		"vault": &VaultProvider{},
+	}
+)
+
+// Register a provider for secret references starting with <scheme>:, replacing any
+// provider already registered for the scheme.
+func Register(scheme string, p Provider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+	providers[scheme] = p
+}
+
+// Read the secret referenced by ref.
+func Read(ctx context.Context, ref string) ([]byte, error) {
+	scheme, name := parse(ref)
+	providersLock.RLock()
+	p := providers[scheme]
+	providersLock.RUnlock()
+	secret, err := p.Secret(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s secret: %w", scheme, err)
+	}
+	return secret, nil
+}
+
+// IsReference returns whether ref references a secret of a provider other than a file,
+// which should therefore not be handled as a path.
+func IsReference(ref string) bool {
+	scheme, _ := parse(ref)
+	return scheme != "file"
+}
+
+// parse splits a reference into the scheme of its provider and the name of the secret,
+// falling back to a file path when the prefix is no registered scheme, such as the
+// drive letter of a windows path.
+func parse(ref string) (string, string) {
+	i := strings.Index(ref, ":")
+	if i <= 0 {
+		return "file", ref
+	}
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+	if _, ok := providers[ref[:i]]; !ok {
+		return "file", ref
+	}
+	return ref[:i], ref[i+1:]
+}
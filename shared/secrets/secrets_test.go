@@ -0,0 +1,72 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type staticProvider map[string]string
+
+func (p staticProvider) Secret(_ context.Context, name string) ([]byte, error) {
+	secret, ok := p[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return []byte(secret), nil
+}
+
+func TestRead_Providers(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "password.txt")
+	require.NoError(t, ioutil.WriteFile(path, []byte("from-file\n"), 0600))
+
+	secret, err := Read(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, "from-file\n", string(secret))
+	secret, err = Read(ctx, "file:"+path)
+	require.NoError(t, err)
+	assert.Equal(t, "from-file\n", string(secret))
+
+	require.NoError(t, os.Setenv("PRYSM_TEST_SECRET", "from-env"))
+	defer func() {
+		require.NoError(t, os.Unsetenv("PRYSM_TEST_SECRET"))
+	}()
+	secret, err = Read(ctx, "env:PRYSM_TEST_SECRET")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", string(secret))
+	_, err = Read(ctx, "env:PRYSM_TEST_SECRET_UNSET")
+	assert.ErrorContains(t, "PRYSM_TEST_SECRET_UNSET is not set", err)
+
+	secret, err = Read(ctx, "exec:echo from-exec")
+	require.NoError(t, err)
+	assert.Equal(t, "from-exec", string(secret))
+	_, err = Read(ctx, "exec:false")
+	assert.ErrorContains(t, "command false failed", err)
+	_, err = Read(ctx, "exec:")
+	assert.ErrorContains(t, "empty command", err)
+}
+
+func TestRegister(t *testing.T) {
+	Register("test", staticProvider{"wallet": "secret"})
+	secret, err := Read(context.Background(), "test:wallet")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(secret))
+	_, err = Read(context.Background(), "test:other")
+	assert.ErrorContains(t, "could not read test secret: not found", err)
+}
+
+func TestIsReference(t *testing.T) {
+	assert.Equal(t, true, IsReference("env:WALLET_PASSWORD"))
+	assert.Equal(t, true, IsReference("vault:https://vault.example.com/v1/secret/data/validator"))
+	assert.Equal(t, false, IsReference("file:/tmp/password.txt"))
+	assert.Equal(t, false, IsReference("/tmp/password.txt"))
+	assert.Equal(t, false, IsReference(`C:\password.txt`))
+	assert.Equal(t, false, IsReference("unknown:name"))
+}
@@ -0,0 +1,27 @@
+package secrets
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// X509KeyPair loads a TLS certificate from a PEM encoded certificate file and a PEM encoded
+// private key read as the secret referenced by keyRef.
+func X509KeyPair(ctx context.Context, certPath, keyRef string) (tls.Certificate, error) {
+	path, err := fileutil.ExpandPath(certPath)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	cert, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	key, err := Read(ctx, keyRef)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair(cert, key)
+}
@@ -0,0 +1,105 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// VaultTokenEnv is the environment variable the vault token is read from when the
+// provider has no token configured.
+const VaultTokenEnv = "VAULT_TOKEN"
+
+// defaultVaultField is the field of the secret read when the reference has no fragment.
+const defaultVaultField = "value"
+
+// VaultProvider reads secrets from an HTTP key-value store with the API of Vault, the name
+// of a secret being the URL of the secret with the field to read as fragment, such as
+// https://vault.example.com:8200/v1/secret/data/validator#password. Both version 1 and 2
+// of the key-value secrets engine are supported.
+type VaultProvider struct {
+	// Client sends the requests, http.DefaultClient with a timeout if nil.
+	Client *http.Client
+	// Token authenticating the requests, read from VaultTokenEnv if empty.
+	Token string
+}
+
+type vaultResponse struct {
+	Data map[string]json.RawMessage `json:"data"`
+}
+
+// Secret requests the secret from the store and returns the value of its field.
+func (p *VaultProvider) Secret(ctx context.Context, name string) ([]byte, error) {
+	u, err := url.Parse(name)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("%s is not an http or https url", u.Redacted())
+	}
+	field := u.Fragment
+	if field == "" {
+		field = defaultVaultField
+	}
+	u.Fragment = ""
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	token := p.Token
+	if token == "" {
+		token = os.Getenv(VaultTokenEnv)
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	client := p.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close vault response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault responded with status %d for %s", resp.StatusCode, u.Path)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	res := &vaultResponse{}
+	if err := json.Unmarshal(body, res); err != nil {
+		return nil, fmt.Errorf("could not decode vault response: %w", err)
+	}
+	data := res.Data
+	// The version 2 engine nests the secret next to its metadata.
+	if nested, ok := data["data"]; ok {
+		if _, ok := data["metadata"]; ok {
+			data = make(map[string]json.RawMessage)
+			if err := json.Unmarshal(nested, &data); err != nil {
+				return nil, fmt.Errorf("could not decode vault secret: %w", err)
+			}
+		}
+	}
+	raw, ok := data[field]
+	if !ok {
+		return nil, fmt.Errorf("vault secret %s has no field %s", u.Path, field)
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("field %s of vault secret %s is not a string", field, u.Path)
+	}
+	return []byte(value), nil
+}
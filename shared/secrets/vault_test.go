@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestVaultProvider_Secret(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/validator":
+			_, err := w.Write([]byte(`{"data":{"data":{"password":"kv2","port":1},"metadata":{"version":3}}}`))
+			require.NoError(t, err)
+		case "/v1/kv/validator":
+			_, err := w.Write([]byte(`{"data":{"value":"kv1"}}`))
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	p := &VaultProvider{Token: "token"}
+
+	secret, err := p.Secret(ctx, srv.URL+"/v1/secret/data/validator#password")
+	require.NoError(t, err)
+	assert.Equal(t, "kv2", string(secret))
+	secret, err = p.Secret(ctx, srv.URL+"/v1/kv/validator")
+	require.NoError(t, err)
+	assert.Equal(t, "kv1", string(secret))
+
+	_, err = p.Secret(ctx, srv.URL+"/v1/secret/data/validator#user")
+	assert.ErrorContains(t, "has no field user", err)
+	_, err = p.Secret(ctx, srv.URL+"/v1/secret/data/validator#port")
+	assert.ErrorContains(t, "is not a string", err)
+	_, err = p.Secret(ctx, srv.URL+"/v1/secret/data/missing")
+	assert.ErrorContains(t, "status 404", err)
+	_, err = p.Secret(ctx, "ftp://vault.example.com/v1/secret")
+	assert.ErrorContains(t, "not an http or https url", err)
+
+	// Without a configured token, the token is read from the environment.
+	require.NoError(t, os.Setenv(VaultTokenEnv, "token"))
+	defer func() {
+		require.NoError(t, os.Unsetenv(VaultTokenEnv))
+	}()
+	secret, err = Read(ctx, "vault:"+srv.URL+"/v1/kv/validator")
+	require.NoError(t, err)
+	assert.Equal(t, "kv1", string(secret))
+	require.NoError(t, os.Setenv(VaultTokenEnv, "wrong"))
+	_, err = Read(ctx, "vault:"+srv.URL+"/v1/kv/validator")
+	assert.ErrorContains(t, "status 403", err)
+}
@@ -281,7 +281,7 @@ func BlockSignatureAltair(
 	if err != nil {
 		return nil, err
 	}
-	s, err := core.CalculateStateRoot(context.Background(), bState, wsb)
+	s, err := core.CalculateStateRoot(context.Background(), bState, wsb, nil)
 	if err != nil {
 		return nil, err
 	}
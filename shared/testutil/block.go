@@ -8,6 +8,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
@@ -557,3 +558,90 @@ func HydrateBeaconBlockBodyAltair(b *prysmv2.BeaconBlockBodyAltair) *prysmv2.Bea
 	}
 	return b
 }
+
+// HydrateSignedBeaconBlockBellatrix hydrates a signed beacon block with correct field length sizes
+// to comply with fssz marshalling and unmarshalling rules.
+func HydrateSignedBeaconBlockBellatrix(b *prysmv2.SignedBeaconBlockBellatrix) *prysmv2.SignedBeaconBlockBellatrix {
+	if b.Signature == nil {
+		b.Signature = make([]byte, params.BeaconConfig().BLSSignatureLength)
+	}
+	b.Block = HydrateBeaconBlockBellatrix(b.Block)
+	return b
+}
+
+// HydrateBeaconBlockBellatrix hydrates a beacon block with correct field length sizes
+// to comply with fssz marshalling and unmarshalling rules.
+func HydrateBeaconBlockBellatrix(b *prysmv2.BeaconBlockBellatrix) *prysmv2.BeaconBlockBellatrix {
+	if b == nil {
+		b = &prysmv2.BeaconBlockBellatrix{}
+	}
+	if b.ParentRoot == nil {
+		b.ParentRoot = make([]byte, 32)
+	}
+	if b.StateRoot == nil {
+		b.StateRoot = make([]byte, 32)
+	}
+	b.Body = HydrateBeaconBlockBodyBellatrix(b.Body)
+	return b
+}
+
+// HydrateBeaconBlockBodyBellatrix hydrates a beacon block body with correct field length sizes
+// to comply with fssz marshalling and unmarshalling rules.
+func HydrateBeaconBlockBodyBellatrix(b *prysmv2.BeaconBlockBodyBellatrix) *prysmv2.BeaconBlockBodyBellatrix {
+	if b == nil {
+		b = &prysmv2.BeaconBlockBodyBellatrix{}
+	}
+	if b.RandaoReveal == nil {
+		b.RandaoReveal = make([]byte, params.BeaconConfig().BLSSignatureLength)
+	}
+	if b.Graffiti == nil {
+		b.Graffiti = make([]byte, 32)
+	}
+	if b.Eth1Data == nil {
+		b.Eth1Data = &ethpb.Eth1Data{
+			DepositRoot: make([]byte, 32),
+			BlockHash:   make([]byte, 32),
+		}
+	}
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = &prysmv2.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, 64),
+			SyncCommitteeSignature: make([]byte, 96),
+		}
+	}
+	b.ExecutionPayload = HydrateExecutionPayload(b.ExecutionPayload)
+	return b
+}
+
+// HydrateExecutionPayload hydrates an execution payload with correct field length sizes
+// to comply with fssz marshalling and unmarshalling rules.
+func HydrateExecutionPayload(p *enginev1.ExecutionPayload) *enginev1.ExecutionPayload {
+	if p == nil {
+		p = &enginev1.ExecutionPayload{}
+	}
+	if p.ParentHash == nil {
+		p.ParentHash = make([]byte, 32)
+	}
+	if p.FeeRecipient == nil {
+		p.FeeRecipient = make([]byte, 20)
+	}
+	if p.StateRoot == nil {
+		p.StateRoot = make([]byte, 32)
+	}
+	if p.ReceiptsRoot == nil {
+		p.ReceiptsRoot = make([]byte, 32)
+	}
+	if p.LogsBloom == nil {
+		p.LogsBloom = make([]byte, params.BeaconConfig().BytesPerLogsBloom)
+	}
+	if p.PrevRandao == nil {
+		p.PrevRandao = make([]byte, 32)
+	}
+	if p.BaseFeePerGas == nil {
+		p.BaseFeePerGas = make([]byte, 32)
+	}
+	if p.BlockHash == nil {
+		p.BlockHash = make([]byte, 32)
+	}
+	return p
+}
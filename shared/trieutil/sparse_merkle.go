@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/bits"
 
 	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -42,6 +43,65 @@ func CreateTrieFromProto(trieObj *protodb.SparseMerkleTrie) *SparseMerkleTrie {
 	return trie
 }
 
+// CreateTrieFromSnapshot creates a Sparse Merkle Trie holding count items from the roots of the
+// complete subtrees of those items, as returned by FinalizedBranches. Only the roots are known,
+// so the trie can compute its own root and accept new items, but it has no proofs for the items
+// of the snapshot.
+func CreateTrieFromSnapshot(finalized [][]byte, count, depth uint64) (*SparseMerkleTrie, error) {
+	if depth >= 64 || count > 1<<depth {
+		return nil, fmt.Errorf("count %d does not fit in a trie of depth %d", count, depth)
+	}
+	if len(finalized) != bits.OnesCount64(count) {
+		return nil, fmt.Errorf("wanted %d finalized branches for %d items, received %d", bits.OnesCount64(count), count, len(finalized))
+	}
+	if count == 0 {
+		return NewTrie(depth)
+	}
+	// Nodes which are neither in the snapshot nor on the path to the next item are never read,
+	// so they share a zero placeholder.
+	placeholder := make([]byte, 32)
+	layers := make([][][]byte, depth+1)
+	for i := uint64(0); i <= depth; i++ {
+		layer := make([][]byte, (count+1<<i-1)>>i)
+		for j := range layer {
+			layer[j] = placeholder
+		}
+		layers[i] = layer
+	}
+	next := 0
+	for i := int(depth); i >= 0; i-- {
+		if (count>>uint(i))&1 == 0 {
+			continue
+		}
+		if len(finalized[next]) != 32 {
+			return nil, fmt.Errorf("finalized branch %d has length %d instead of 32", next, len(finalized[next]))
+		}
+		layers[i][(count>>uint(i))-1] = bytesutil.SafeCopyBytes(finalized[next])
+		next++
+	}
+	// Recompute the partially filled nodes on the path to the next item, the same way the
+	// deposit contract computes its root.
+	node := ZeroHashes[0]
+	for i := uint64(0); i < depth; i++ {
+		if (count>>i)&1 == 1 {
+			node = hashutil.Hash(append(bytesutil.SafeCopyBytes(layers[i][(count>>i)-1]), node[:]...))
+		} else {
+			node = hashutil.Hash(append(node[:], ZeroHashes[i][:]...))
+		}
+		if idx := count >> (i + 1); idx < uint64(len(layers[i+1])) {
+			parent := node
+			layers[i+1][idx] = parent[:]
+		}
+	}
+	items := make([][]byte, count)
+	copy(items, layers[0])
+	return &SparseMerkleTrie{
+		depth:         uint(depth),
+		branches:      layers,
+		originalItems: items,
+	}, nil
+}
+
 // GenerateTrieFromItems constructs a Merkle trie from a sequence of byte slices.
 func GenerateTrieFromItems(items [][]byte, depth uint64) (*SparseMerkleTrie, error) {
 	if len(items) == 0 {
@@ -198,6 +258,27 @@ func VerifyMerkleBranch(root, item []byte, merkleIndex int, proof [][]byte, dept
 	return bytes.Equal(root, node[:])
 }
 
+// FinalizedBranches returns the roots of the complete subtrees holding the first count items of
+// the trie, ordered from the largest subtree to the smallest. Together with count, they are
+// enough to recreate the trie with CreateTrieFromSnapshot.
+func (m *SparseMerkleTrie) FinalizedBranches(count uint64) ([][]byte, error) {
+	if count > uint64(m.NumOfItems()) {
+		return nil, fmt.Errorf("count %d is larger than the number of items %d in the trie", count, m.NumOfItems())
+	}
+	finalized := make([][]byte, 0, bits.OnesCount64(count))
+	for i := int(m.depth); i >= 0; i-- {
+		if (count>>uint(i))&1 == 0 {
+			continue
+		}
+		idx := (count >> uint(i)) - 1
+		if idx >= uint64(len(m.branches[i])) {
+			return nil, fmt.Errorf("trie has no node %d at depth %d", idx, i)
+		}
+		finalized = append(finalized, bytesutil.SafeCopyBytes(m.branches[i][idx]))
+	}
+	return finalized, nil
+}
+
 // Copy performs a deep copy of the trie.
 func (m *SparseMerkleTrie) Copy() *SparseMerkleTrie {
 	dstBranches := make([][][]byte, len(m.branches))
@@ -1,6 +1,7 @@
 package trieutil
 
 import (
+	"math/bits"
 	"strconv"
 	"testing"
 
@@ -257,3 +258,68 @@ func BenchmarkVerifyMerkleBranch(b *testing.B) {
 		}
 	}
 }
+
+func TestCreateTrieFromSnapshot(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := make([][]byte, 33)
+	for i := range items {
+		h := hashutil.Hash([]byte(strconv.Itoa(i)))
+		items[i] = h[:]
+	}
+	full, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+
+	for _, count := range []uint64{0, 1, 2, 3, 4, 7, 8, 16, 21, 32, 33} {
+		t.Run(strconv.Itoa(int(count)), func(t *testing.T) {
+			finalized, err := full.FinalizedBranches(count)
+			require.NoError(t, err)
+			require.Equal(t, bits.OnesCount64(count), len(finalized))
+			trie, err := CreateTrieFromSnapshot(finalized, count, depth)
+			require.NoError(t, err)
+			require.Equal(t, int(count), trie.NumOfItems())
+
+			if count > 0 {
+				wanted, err := GenerateTrieFromItems(items[:count], depth)
+				require.NoError(t, err)
+				require.Equal(t, wanted.HashTreeRoot(), trie.HashTreeRoot())
+			}
+
+			// Items inserted after the snapshot have the same proofs as in the full trie.
+			for i := count; i < uint64(len(items)); i++ {
+				trie.Insert(items[i], int(i))
+			}
+			require.Equal(t, full.HashTreeRoot(), trie.HashTreeRoot())
+			for i := count; i < uint64(len(items)); i++ {
+				proof, err := trie.MerkleProof(int(i))
+				require.NoError(t, err)
+				wanted, err := full.MerkleProof(int(i))
+				require.NoError(t, err)
+				require.DeepEqual(t, wanted, proof)
+			}
+
+			// The snapshot of a restored trie matches the one of the full trie.
+			for _, c := range []uint64{count, uint64(len(items))} {
+				got, err := trie.FinalizedBranches(c)
+				require.NoError(t, err)
+				wanted, err := full.FinalizedBranches(c)
+				require.NoError(t, err)
+				require.DeepEqual(t, wanted, got)
+			}
+		})
+	}
+}
+
+func TestCreateTrieFromSnapshot_Invalid(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	_, err := CreateTrieFromSnapshot([][]byte{make([]byte, 32)}, 3, depth)
+	require.ErrorContains(t, "wanted 2 finalized branches", err)
+	_, err = CreateTrieFromSnapshot([][]byte{make([]byte, 31)}, 1, depth)
+	require.ErrorContains(t, "has length 31", err)
+	_, err = CreateTrieFromSnapshot([][]byte{}, 1<<depth+1, depth)
+	require.ErrorContains(t, "does not fit", err)
+
+	trie, err := GenerateTrieFromItems([][]byte{{'A'}}, depth)
+	require.NoError(t, err)
+	_, err = trie.FinalizedBranches(2)
+	require.ErrorContains(t, "larger than the number of items", err)
+}
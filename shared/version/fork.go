@@ -3,4 +3,5 @@ package version
 const (
 	Phase0 = iota
 	Altair
+	Bellatrix
 )
@@ -8,7 +8,11 @@ import (
 	"time"
 
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -27,7 +31,7 @@ var reconnectPeriod = 5 * time.Second
 func (s *Service) ReceiveBlocks(ctx context.Context) {
 	ctx, span := trace.StartSpan(ctx, "beaconclient.ReceiveBlocks")
 	defer span.End()
-	stream, err := s.cfg.BeaconClient.StreamBlocks(ctx, &ethpb.StreamBlocksRequest{} /* Prefers unverified block to catch slashing */)
+	stream, err := s.cfg.BeaconClient.StreamBlocksWithProvenance(ctx, &ethpb.StreamBlocksRequest{} /* Prefers unverified block to catch slashing */)
 	if err != nil {
 		log.WithError(err).Error("Failed to retrieve blocks stream")
 		return
@@ -53,7 +57,7 @@ func (s *Service) ReceiveBlocks(ctx context.Context) {
 						log.WithError(err).Error("Could not restart beacon connection")
 						return
 					}
-					stream, err = s.cfg.BeaconClient.StreamBlocks(ctx, &ethpb.StreamBlocksRequest{} /* Prefers unverified block to catch slashing */)
+					stream, err = s.cfg.BeaconClient.StreamBlocksWithProvenance(ctx, &ethpb.StreamBlocksRequest{} /* Prefers unverified block to catch slashing */)
 					if err != nil {
 						log.WithError(err).Error("Could not restart block stream")
 						return
@@ -68,22 +72,24 @@ func (s *Service) ReceiveBlocks(ctx context.Context) {
 				return
 			}
 		}
-		if res == nil {
+		if res == nil || res.Block == nil || res.Block.Block == nil {
 			continue
 		}
-		root, err := res.Block.HashTreeRoot()
+		blk := res.Block
+		root, err := blk.Block.HashTreeRoot()
 		if err != nil {
 			log.WithError(err).Error("Could not hash block")
 			return
 		}
 
 		log.WithFields(logrus.Fields{
-			"slot":           res.Block.Slot,
-			"proposer_index": res.Block.ProposerIndex,
+			"slot":           blk.Block.Slot,
+			"proposer_index": blk.Block.ProposerIndex,
 			"root":           fmt.Sprintf("%#x...", root[:8]),
 		}).Info("Received block from beacon node")
+		s.saveBlockProvenance(ctx, blk, root, res.Provenance)
 		// We send the received block over the block feed.
-		s.blockFeed.Send(res)
+		s.blockFeed.Send(blk)
 	}
 }
 
@@ -93,7 +99,7 @@ func (s *Service) ReceiveBlocks(ctx context.Context) {
 func (s *Service) ReceiveAttestations(ctx context.Context) {
 	ctx, span := trace.StartSpan(ctx, "beaconclient.ReceiveAttestations")
 	defer span.End()
-	stream, err := s.cfg.BeaconClient.StreamIndexedAttestations(ctx, &emptypb.Empty{})
+	stream, err := s.cfg.BeaconClient.StreamIndexedAttestationsWithProvenance(ctx, &emptypb.Empty{})
 	if err != nil {
 		log.WithError(err).Error("Failed to retrieve attestations stream")
 		return
@@ -122,7 +128,7 @@ func (s *Service) ReceiveAttestations(ctx context.Context) {
 						log.WithError(err).Error("Could not restart beacon connection")
 						return
 					}
-					stream, err = s.cfg.BeaconClient.StreamIndexedAttestations(ctx, &emptypb.Empty{})
+					stream, err = s.cfg.BeaconClient.StreamIndexedAttestationsWithProvenance(ctx, &emptypb.Empty{})
 					if err != nil {
 						log.WithError(err).Error("Could not restart attestation stream")
 						return
@@ -137,7 +143,7 @@ func (s *Service) ReceiveAttestations(ctx context.Context) {
 				return
 			}
 		}
-		if res == nil {
+		if res == nil || res.Attestation == nil {
 			continue
 		}
 		s.receivedAttestationsBuffer <- res
@@ -149,18 +155,36 @@ func (s *Service) collectReceivedAttestations(ctx context.Context) {
 	defer span.End()
 
 	var atts []*ethpb.IndexedAttestation
+	var provenances []*dbtypes.AttestationProvenance
 	halfSlot := slotutil.DivideSlotBy(2 /* 1/2 slot duration */)
 	ticker := time.NewTicker(halfSlot)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
+			if len(provenances) > 0 {
+				if err := s.cfg.SlasherDB.SaveAttestationProvenances(ctx, provenances); err != nil {
+					log.WithError(err).Error("Could not save attestation provenances")
+				}
+				provenances = []*dbtypes.AttestationProvenance{}
+			}
 			if len(atts) > 0 {
 				s.collectedAttestationsBuffer <- atts
 				atts = []*ethpb.IndexedAttestation{}
 			}
-		case att := <-s.receivedAttestationsBuffer:
+		case res := <-s.receivedAttestationsBuffer:
+			att := res.Attestation
 			atts = append(atts, att)
+			provenances = append(provenances, &dbtypes.AttestationProvenance{
+				Data:      att.Data,
+				Signature: att.Signature,
+				Provenance: &slashpb.MessageProvenance{
+					Source:       slashpb.MessageProvenance_ATTESTATION_STREAM,
+					FirstSeen:    uint64(timeutils.Now().UnixNano() / int64(time.Millisecond)),
+					GossipTopic:  res.Provenance.GetTopic(),
+					GossipPeerId: res.Provenance.GetPeerId(),
+				},
+			})
 		case collectedAtts := <-s.collectedAttestationsBuffer:
 			if err := s.cfg.SlasherDB.SaveIndexedAttestations(ctx, collectedAtts); err != nil {
 				log.WithError(err).Error("Could not save indexed attestation")
@@ -214,3 +238,47 @@ func (s *Service) restartBeaconConnection(ctx context.Context) error {
 		}
 	}
 }
+
+// Records the header of a block received through the block stream as first seen there,
+// along with the gossip topic and peer the beacon node received the block from, and its
+// attestations as first seen included in it.
+func (s *Service) saveBlockProvenance(
+	ctx context.Context, blk *ethpb.SignedBeaconBlock, root [32]byte, gossip *ethpb.GossipProvenance,
+) {
+	now := uint64(timeutils.Now().UnixNano() / int64(time.Millisecond))
+	header, err := blockutil.SignedBeaconBlockHeaderFromBlock(blk)
+	if err != nil {
+		log.WithError(err).Error("Could not get block header from block")
+		return
+	}
+	if err := s.cfg.SlasherDB.SaveBlockHeaderProvenance(ctx, header, &slashpb.MessageProvenance{
+		Source:       slashpb.MessageProvenance_BLOCK_STREAM,
+		FirstSeen:    now,
+		GossipTopic:  gossip.GetTopic(),
+		GossipPeerId: gossip.GetPeerId(),
+	}); err != nil {
+		log.WithError(err).Error("Could not save block header provenance")
+	}
+	if blk.Block.Body == nil || len(blk.Block.Body.Attestations) == 0 {
+		return
+	}
+	provenances := make([]*dbtypes.AttestationProvenance, 0, len(blk.Block.Body.Attestations))
+	for _, att := range blk.Block.Body.Attestations {
+		if att == nil || att.Data == nil {
+			continue
+		}
+		provenances = append(provenances, &dbtypes.AttestationProvenance{
+			Data:      att.Data,
+			Signature: att.Signature,
+			Provenance: &slashpb.MessageProvenance{
+				Source:    slashpb.MessageProvenance_BLOCK_INCLUSION,
+				BlockRoot: root[:],
+				BlockSlot: blk.Block.Slot,
+				FirstSeen: now,
+			},
+		})
+	}
+	if err := s.cfg.SlasherDB.SaveAttestationProvenances(ctx, provenances); err != nil {
+		log.WithError(err).Error("Could not save attestation provenances")
+	}
+}
@@ -2,14 +2,20 @@ package beaconclient
 
 import (
 	"context"
+	"io"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -21,23 +27,40 @@ func TestService_ReceiveBlocks(t *testing.T) {
 	client := mock.NewMockBeaconChainClient(ctrl)
 
 	bs := Service{
-		cfg:       &Config{BeaconClient: client},
+		cfg: &Config{
+			BeaconClient: client,
+			SlasherDB:    testDB.SetupSlasherDB(t, false),
+		},
 		blockFeed: new(event.Feed),
 	}
-	stream := mock.NewMockBeaconChain_StreamBlocksClient(ctrl)
-	ctx, cancel := context.WithCancel(context.Background())
-	client.EXPECT().StreamBlocks(
+	stream := mock.NewMockBeaconChain_StreamBlocksWithProvenanceClient(ctrl)
+	ctx := context.Background()
+	blk := testutil.NewBeaconBlock()
+	client.EXPECT().StreamBlocksWithProvenance(
 		gomock.Any(),
 		&ethpb.StreamBlocksRequest{},
 	).Return(stream, nil)
 	stream.EXPECT().Context().Return(ctx).AnyTimes()
-	stream.EXPECT().Recv().Return(
-		&ethpb.SignedBeaconBlock{},
-		nil,
-	).Do(func() {
-		cancel()
-	})
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(
+			&ethpb.SignedBeaconBlockWithProvenance{
+				Block:      blk,
+				Provenance: &ethpb.GossipProvenance{Topic: "/eth2/blocks", PeerId: "peer1"},
+			},
+			nil,
+		),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
 	bs.ReceiveBlocks(ctx)
+
+	header, err := blockutil.SignedBeaconBlockHeaderFromBlock(blk)
+	require.NoError(t, err)
+	provenance, err := bs.cfg.SlasherDB.BlockHeaderProvenance(context.Background(), header)
+	require.NoError(t, err)
+	require.NotNil(t, provenance)
+	assert.Equal(t, slashpb.MessageProvenance_BLOCK_STREAM, provenance.Source)
+	assert.Equal(t, "/eth2/blocks", provenance.GossipTopic)
+	assert.Equal(t, "peer1", provenance.GossipPeerId)
 }
 
 func TestService_ReceiveAttestations(t *testing.T) {
@@ -48,23 +71,23 @@ func TestService_ReceiveAttestations(t *testing.T) {
 	bs := Service{
 		cfg:                         &Config{BeaconClient: client},
 		blockFeed:                   new(event.Feed),
-		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestation, 1),
+		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestationWithProvenance, 1),
 		collectedAttestationsBuffer: make(chan []*ethpb.IndexedAttestation, 1),
 	}
-	stream := mock.NewMockBeaconChain_StreamIndexedAttestationsClient(ctrl)
+	stream := mock.NewMockBeaconChain_StreamIndexedAttestationsWithProvenanceClient(ctrl)
 	ctx, cancel := context.WithCancel(context.Background())
 	att := &ethpb.IndexedAttestation{
 		Data: &ethpb.AttestationData{
 			Slot: 5,
 		},
 	}
-	client.EXPECT().StreamIndexedAttestations(
+	client.EXPECT().StreamIndexedAttestationsWithProvenance(
 		gomock.Any(),
 		&emptypb.Empty{},
 	).Return(stream, nil)
 	stream.EXPECT().Context().Return(ctx).AnyTimes()
 	stream.EXPECT().Recv().Return(
-		att,
+		&ethpb.IndexedAttestationWithProvenance{Attestation: att},
 		nil,
 	).Do(func() {
 		cancel()
@@ -84,28 +107,30 @@ func TestService_ReceiveAttestations_Batched(t *testing.T) {
 		},
 		blockFeed:                   new(event.Feed),
 		attestationFeed:             new(event.Feed),
-		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestation, 1),
+		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestationWithProvenance, 1),
 		collectedAttestationsBuffer: make(chan []*ethpb.IndexedAttestation, 1),
 	}
-	stream := mock.NewMockBeaconChain_StreamIndexedAttestationsClient(ctrl)
+	stream := mock.NewMockBeaconChain_StreamIndexedAttestationsWithProvenanceClient(ctrl)
 	ctx, cancel := context.WithCancel(context.Background())
 	att := &ethpb.IndexedAttestation{
 		Data: &ethpb.AttestationData{
-			Slot: 5,
+			Slot:            5,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
 			Target: &ethpb.Checkpoint{
 				Epoch: 5,
-				Root:  []byte("test root 1"),
+				Root:  bytesutil.PadTo([]byte("test root 1"), 32),
 			},
 		},
 		Signature: []byte{1, 2},
 	}
-	client.EXPECT().StreamIndexedAttestations(
+	client.EXPECT().StreamIndexedAttestationsWithProvenance(
 		gomock.Any(),
 		&emptypb.Empty{},
 	).Return(stream, nil)
 	stream.EXPECT().Context().Return(ctx).AnyTimes()
 	stream.EXPECT().Recv().Return(
-		att,
+		&ethpb.IndexedAttestationWithProvenance{Attestation: att},
 		nil,
 	).Do(func() {
 		// Let a slot pass for the ticker.
@@ -113,12 +138,20 @@ func TestService_ReceiveAttestations_Batched(t *testing.T) {
 		cancel()
 	})
 
+	gossip := &ethpb.GossipProvenance{Topic: "/eth2/beacon_attestation_1", PeerId: "peer1"}
 	go bs.ReceiveAttestations(ctx)
-	bs.receivedAttestationsBuffer <- att
-	att.Data.Target.Root = []byte("test root 2")
-	bs.receivedAttestationsBuffer <- att
-	att.Data.Target.Root = []byte("test root 3")
-	bs.receivedAttestationsBuffer <- att
+	bs.receivedAttestationsBuffer <- &ethpb.IndexedAttestationWithProvenance{Attestation: att, Provenance: gossip}
+	att.Data.Target.Root = bytesutil.PadTo([]byte("test root 2"), 32)
+	bs.receivedAttestationsBuffer <- &ethpb.IndexedAttestationWithProvenance{Attestation: att, Provenance: gossip}
+	att.Data.Target.Root = bytesutil.PadTo([]byte("test root 3"), 32)
+	bs.receivedAttestationsBuffer <- &ethpb.IndexedAttestationWithProvenance{Attestation: att, Provenance: gossip}
 	atts := <-bs.collectedAttestationsBuffer
 	require.Equal(t, 3, len(atts), "Unexpected number of attestations batched")
+
+	provenance, err := bs.cfg.SlasherDB.AttestationProvenance(context.Background(), att)
+	require.NoError(t, err)
+	require.NotNil(t, provenance)
+	assert.Equal(t, slashpb.MessageProvenance_ATTESTATION_STREAM, provenance.Source)
+	assert.Equal(t, gossip.Topic, provenance.GossipTopic)
+	assert.Equal(t, gossip.PeerId, provenance.GossipPeerId)
 }
@@ -48,7 +48,7 @@ type Service struct {
 	attestationFeed             *event.Feed
 	proposerSlashingsChan       chan *ethpb.ProposerSlashing
 	attesterSlashingsChan       chan *ethpb.AttesterSlashing
-	receivedAttestationsBuffer  chan *ethpb.IndexedAttestation
+	receivedAttestationsBuffer  chan *ethpb.IndexedAttestationWithProvenance
 	collectedAttestationsBuffer chan []*ethpb.IndexedAttestation
 	publicKeyCache              *cache.PublicKeyCache
 	genesisValidatorRoot        []byte
@@ -84,7 +84,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		attestationFeed:             new(event.Feed),
 		proposerSlashingsChan:       make(chan *ethpb.ProposerSlashing, 1),
 		attesterSlashingsChan:       make(chan *ethpb.AttesterSlashing, 1),
-		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestation, 1),
+		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestationWithProvenance, 1),
 		collectedAttestationsBuffer: make(chan []*ethpb.IndexedAttestation, 1),
 		publicKeyCache:              publicKeyCache,
 	}, nil
@@ -6,6 +6,7 @@ package iface
 import (
 	"context"
 	"io"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -49,6 +50,12 @@ type ReadOnlyDatabase interface {
 	// Chain data related methods.
 	ChainHead(ctx context.Context) (*ethpb.ChainHead, error)
 
+	// Provenance related methods.
+	AttestationProvenance(ctx context.Context, att *ethpb.IndexedAttestation) (*slashpb.MessageProvenance, error)
+	BlockHeaderProvenance(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) (*slashpb.MessageProvenance, error)
+	AttesterSlashingDetectedAt(ctx context.Context, slashing *ethpb.AttesterSlashing) (time.Time, error)
+	ProposerSlashingDetectedAt(ctx context.Context, slashing *ethpb.ProposerSlashing) (time.Time, error)
+
 	// Cache management methods.
 	RemoveOldestFromCache(ctx context.Context) uint64
 }
@@ -88,6 +95,10 @@ type WriteAccessDatabase interface {
 
 	// Chain data related methods.
 	SaveChainHead(ctx context.Context, head *ethpb.ChainHead) error
+
+	// Provenance related methods.
+	SaveAttestationProvenances(ctx context.Context, provenances []*dbtypes.AttestationProvenance) error
+	SaveBlockHeaderProvenance(ctx context.Context, header *ethpb.SignedBeaconBlockHeader, provenance *slashpb.MessageProvenance) error
 }
 
 // FullAccessDatabase represents a full access database with only DB interaction functions.
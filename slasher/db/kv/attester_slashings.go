@@ -75,6 +75,9 @@ func (s *Store) DeleteAttesterSlashing(ctx context.Context, attesterSlashing *et
 		if err := bucket.Delete(k); err != nil {
 			return errors.Wrap(err, "failed to delete the slashing proof from slashing bucket")
 		}
+		if err := tx.Bucket(slashingDetectionTimeBucket).Delete(k); err != nil {
+			return errors.Wrap(err, "failed to delete the slashing detection time")
+		}
 		return nil
 	})
 }
@@ -117,8 +120,10 @@ func (s *Store) SaveAttesterSlashing(ctx context.Context, status slashertypes.Sl
 	key := encodeTypeRoot(slashertypes.SlashingType(slashertypes.Attestation), root)
 	return s.update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(slashingBucket)
-		e := b.Put(key, append([]byte{byte(status)}, enc...))
-		return e
+		if err := b.Put(key, append([]byte{byte(status)}, enc...)); err != nil {
+			return err
+		}
+		return saveDetectionTimes(tx, [][]byte{key})
 	})
 }
 
@@ -149,7 +154,7 @@ func (s *Store) SaveAttesterSlashings(ctx context.Context, status slashertypes.S
 				return e
 			}
 		}
-		return nil
+		return saveDetectionTimes(tx, key)
 	})
 }
 
@@ -129,6 +129,9 @@ func (s *Store) PruneBlockHistory(ctx context.Context, currentEpoch, pruningEpoc
 				return errors.Wrap(err, "failed to delete the block header from historical bucket")
 			}
 		}
+		if err := pruneProvenance(tx, blockHeaderProvenanceBucket, types.Epoch(pruneTill)); err != nil {
+			return errors.Wrap(err, "failed to delete block header provenance")
+		}
 		return nil
 	})
 }
@@ -180,6 +180,9 @@ func (s *Store) PruneAttHistory(ctx context.Context, currentEpoch, pruningEpochA
 				return errors.Wrap(err, "failed to delete indexed attestation from historical bucket")
 			}
 		}
+		if err := pruneProvenance(tx, attestationProvenanceBucket, types.Epoch(pruneFromEpoch)); err != nil {
+			return errors.Wrap(err, "failed to delete attestation provenance")
+		}
 		return nil
 	})
 }
@@ -137,6 +137,9 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 			slashingBucket,
 			chainDataBucket,
 			highestAttestationBucket,
+			attestationProvenanceBucket,
+			blockHeaderProvenanceBucket,
+			slashingDetectionTimeBucket,
 		)
 	}); err != nil {
 		return nil, err
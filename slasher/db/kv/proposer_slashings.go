@@ -72,6 +72,9 @@ func (s *Store) DeleteProposerSlashing(ctx context.Context, slashing *ethpb.Prop
 		if err := bucket.Delete(k); err != nil {
 			return errors.Wrap(err, "failed to delete the slashing proof from slashing bucket")
 		}
+		if err := tx.Bucket(slashingDetectionTimeBucket).Delete(k); err != nil {
+			return errors.Wrap(err, "failed to delete the slashing detection time")
+		}
 		return nil
 	})
 	return err
@@ -116,8 +119,10 @@ func (s *Store) SaveProposerSlashing(ctx context.Context, status types.SlashingS
 	key := encodeTypeRoot(types.SlashingType(types.Proposal), root)
 	return s.update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(slashingBucket)
-		e := b.Put(key, append([]byte{byte(status)}, enc...))
-		return e
+		if err := b.Put(key, append([]byte{byte(status)}, enc...)); err != nil {
+			return err
+		}
+		return saveDetectionTimes(tx, [][]byte{key})
 	})
 }
 
@@ -148,6 +153,6 @@ func (s *Store) SaveProposerSlashings(ctx context.Context, status types.Slashing
 				return err
 			}
 		}
-		return nil
+		return saveDetectionTimes(tx, keys)
 	})
 }
@@ -0,0 +1,221 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// Attestations are keyed by their target epoch followed by the hash of their data root
+// and signature, so the provenance of an attestation included in a block can be found
+// with the attestation data and signature alone.
+func attestationProvenanceKey(data *ethpb.AttestationData, signature []byte) ([]byte, error) {
+	if data == nil || data.Target == nil {
+		return nil, errors.New("nil attestation data")
+	}
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hash root of attestation data")
+	}
+	root := hashutil.Hash(append(dataRoot[:], signature...))
+	return append(bytesutil.EpochToBytesBigEndian(data.Target.Epoch), root[:]...), nil
+}
+
+// Block headers are keyed by the epoch of their slot followed by their hash root.
+func blockHeaderProvenanceKey(header *ethpb.SignedBeaconBlockHeader) ([]byte, error) {
+	if header == nil || header.Header == nil {
+		return nil, errors.New("nil block header")
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hash root of block header")
+	}
+	epoch := helpers.SlotToEpoch(header.Header.Slot)
+	return append(bytesutil.EpochToBytesBigEndian(epoch), root[:]...), nil
+}
+
+func unmarshalProvenance(enc []byte) (*slashpb.MessageProvenance, error) {
+	if enc == nil {
+		return nil, nil
+	}
+	provenance := &slashpb.MessageProvenance{}
+	if err := proto.Unmarshal(enc, provenance); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encoding")
+	}
+	return provenance, nil
+}
+
+// AttestationProvenance returns where and when an attestation was first seen.
+// Returns nil if its provenance was not recorded.
+func (s *Store) AttestationProvenance(ctx context.Context, att *ethpb.IndexedAttestation) (*slashpb.MessageProvenance, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.AttestationProvenance")
+	defer span.End()
+	key, err := attestationProvenanceKey(att.Data, att.Signature)
+	if err != nil {
+		return nil, err
+	}
+	var enc []byte
+	err = s.view(func(tx *bolt.Tx) error {
+		enc = tx.Bucket(attestationProvenanceBucket).Get(key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalProvenance(enc)
+}
+
+// SaveAttestationProvenances records where and when attestations were seen. The provenance
+// of attestations seen before is kept, as only where they were first seen is of interest.
+func (s *Store) SaveAttestationProvenances(ctx context.Context, provenances []*dbtypes.AttestationProvenance) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveAttestationProvenances")
+	defer span.End()
+	keys := make([][]byte, len(provenances))
+	encoded := make([][]byte, len(provenances))
+	for i, p := range provenances {
+		key, err := attestationProvenanceKey(p.Data, p.Signature)
+		if err != nil {
+			return err
+		}
+		enc, err := proto.Marshal(p.Provenance)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal")
+		}
+		keys[i] = key
+		encoded[i] = enc
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(attestationProvenanceBucket)
+		for i := range keys {
+			if bkt.Get(keys[i]) != nil {
+				continue
+			}
+			if err := bkt.Put(keys[i], encoded[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// BlockHeaderProvenance returns where and when a block header was first seen.
+// Returns nil if its provenance was not recorded.
+func (s *Store) BlockHeaderProvenance(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) (*slashpb.MessageProvenance, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.BlockHeaderProvenance")
+	defer span.End()
+	key, err := blockHeaderProvenanceKey(header)
+	if err != nil {
+		return nil, err
+	}
+	var enc []byte
+	err = s.view(func(tx *bolt.Tx) error {
+		enc = tx.Bucket(blockHeaderProvenanceBucket).Get(key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalProvenance(enc)
+}
+
+// SaveBlockHeaderProvenance records where and when a block header was seen, unless
+// it was seen before.
+func (s *Store) SaveBlockHeaderProvenance(
+	ctx context.Context, header *ethpb.SignedBeaconBlockHeader, provenance *slashpb.MessageProvenance,
+) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveBlockHeaderProvenance")
+	defer span.End()
+	key, err := blockHeaderProvenanceKey(header)
+	if err != nil {
+		return err
+	}
+	enc, err := proto.Marshal(provenance)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal")
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blockHeaderProvenanceBucket)
+		if bkt.Get(key) != nil {
+			return nil
+		}
+		return bkt.Put(key, enc)
+	})
+}
+
+// Deletes all provenance records in a bucket up to and including the given epoch.
+func pruneProvenance(tx *bolt.Tx, bucket []byte, pruneFromEpoch types.Epoch) error {
+	bkt := tx.Bucket(bucket)
+	c := bkt.Cursor()
+	max := bytesutil.EpochToBytesBigEndian(pruneFromEpoch)
+	for k, _ := c.First(); k != nil && bytes.Compare(k[:8], max) <= 0; k, _ = c.Next() {
+		if err := bkt.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AttesterSlashingDetectedAt returns the time an attester slashing was detected, which is
+// when it was first saved. Returns the zero time if it is not known.
+func (s *Store) AttesterSlashingDetectedAt(ctx context.Context, slashing *ethpb.AttesterSlashing) (time.Time, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.AttesterSlashingDetectedAt")
+	defer span.End()
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to get hash root of attesterSlashing")
+	}
+	return s.slashingDetectedAt(encodeTypeRoot(dbtypes.SlashingType(dbtypes.Attestation), root))
+}
+
+// ProposerSlashingDetectedAt returns the time a proposer slashing was detected, which is
+// when it was first saved. Returns the zero time if it is not known.
+func (s *Store) ProposerSlashingDetectedAt(ctx context.Context, slashing *ethpb.ProposerSlashing) (time.Time, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.ProposerSlashingDetectedAt")
+	defer span.End()
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to get hash root of proposerSlashing")
+	}
+	return s.slashingDetectedAt(encodeTypeRoot(dbtypes.SlashingType(dbtypes.Proposal), root))
+}
+
+func (s *Store) slashingDetectedAt(key []byte) (time.Time, error) {
+	var detectedAt time.Time
+	err := s.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slashingDetectionTimeBucket).Get(key)
+		if enc != nil {
+			detectedAt = time.Unix(0, int64(bytesutil.BytesToUint64BigEndian(enc)))
+		}
+		return nil
+	})
+	return detectedAt, err
+}
+
+// Records the current time as the detection time of the slashings with the given keys,
+// keeping the time of slashings which were saved before, such as when their status changes.
+func saveDetectionTimes(tx *bolt.Tx, keys [][]byte) error {
+	bkt := tx.Bucket(slashingDetectionTimeBucket)
+	now := bytesutil.Uint64ToBytesBigEndian(uint64(timeutils.Now().UnixNano()))
+	for _, key := range keys {
+		if bkt.Get(key) != nil {
+			continue
+		}
+		if err := bkt.Put(key, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+)
+
+func TestStore_AttestationProvenance(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+		},
+		Signature: bytesutil.PadTo([]byte{1}, 96),
+	}
+	p, err := db.AttestationProvenance(ctx, att)
+	require.NoError(t, err)
+	assert.Equal(t, (*slashpb.MessageProvenance)(nil), p)
+
+	streamed := &slashpb.MessageProvenance{
+		Source:    slashpb.MessageProvenance_ATTESTATION_STREAM,
+		FirstSeen: 100,
+	}
+	require.NoError(t, db.SaveAttestationProvenances(ctx, []*types.AttestationProvenance{
+		{Data: att.Data, Signature: att.Signature, Provenance: streamed},
+	}))
+	// Only where the attestation was first seen is kept.
+	require.NoError(t, db.SaveAttestationProvenances(ctx, []*types.AttestationProvenance{
+		{
+			Data:      att.Data,
+			Signature: att.Signature,
+			Provenance: &slashpb.MessageProvenance{
+				Source:    slashpb.MessageProvenance_BLOCK_INCLUSION,
+				BlockRoot: bytesutil.PadTo([]byte{2}, 32),
+				FirstSeen: 200,
+			},
+		},
+	}))
+	p, err = db.AttestationProvenance(ctx, att)
+	require.NoError(t, err)
+	assert.DeepEqual(t, streamed, p)
+
+	// The same data with another signature is another attestation.
+	other := &ethpb.IndexedAttestation{Data: att.Data, Signature: bytesutil.PadTo([]byte{2}, 96)}
+	p, err = db.AttestationProvenance(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, (*slashpb.MessageProvenance)(nil), p)
+
+	currentEpoch := 2 + params.BeaconConfig().WeakSubjectivityPeriod
+	require.NoError(t, db.PruneAttHistory(ctx, currentEpoch, params.BeaconConfig().WeakSubjectivityPeriod-1))
+	p, err = db.AttestationProvenance(ctx, att)
+	require.NoError(t, err)
+	assert.Equal(t, (*slashpb.MessageProvenance)(nil), p)
+}
+
+func TestStore_BlockHeaderProvenance(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	header := testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{Slot: 40, ProposerIndex: 3},
+	})
+	p, err := db.BlockHeaderProvenance(ctx, header)
+	require.NoError(t, err)
+	assert.Equal(t, (*slashpb.MessageProvenance)(nil), p)
+
+	submitted := &slashpb.MessageProvenance{
+		Source:    slashpb.MessageProvenance_RPC,
+		Peer:      "127.0.0.1:4000",
+		FirstSeen: 100,
+	}
+	require.NoError(t, db.SaveBlockHeaderProvenance(ctx, header, submitted))
+	require.NoError(t, db.SaveBlockHeaderProvenance(ctx, header, &slashpb.MessageProvenance{
+		Source:    slashpb.MessageProvenance_BLOCK_STREAM,
+		FirstSeen: 200,
+	}))
+	p, err = db.BlockHeaderProvenance(ctx, header)
+	require.NoError(t, err)
+	assert.DeepEqual(t, submitted, p)
+}
+
+func TestStore_SlashingDetectedAt(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	ps := &ethpb.ProposerSlashing{
+		Header_1: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{ProposerIndex: 1},
+		}),
+		Header_2: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{ProposerIndex: 1, BodyRoot: bytesutil.PadTo([]byte{1}, 32)},
+		}),
+	}
+	detectedAt, err := db.ProposerSlashingDetectedAt(ctx, ps)
+	require.NoError(t, err)
+	assert.Equal(t, true, detectedAt.IsZero())
+
+	require.NoError(t, db.SaveProposerSlashing(ctx, types.Active, ps))
+	detectedAt, err = db.ProposerSlashingDetectedAt(ctx, ps)
+	require.NoError(t, err)
+	require.Equal(t, false, detectedAt.IsZero())
+
+	// Updating the status of a slashing keeps its detection time.
+	require.NoError(t, db.SaveProposerSlashing(ctx, types.Included, ps))
+	includedAt, err := db.ProposerSlashingDetectedAt(ctx, ps)
+	require.NoError(t, err)
+	assert.Equal(t, true, detectedAt.Equal(includedAt))
+
+	require.NoError(t, db.DeleteProposerSlashing(ctx, ps))
+	detectedAt, err = db.ProposerSlashingDetectedAt(ctx, ps)
+	require.NoError(t, err)
+	assert.Equal(t, true, detectedAt.IsZero())
+
+	as := &ethpb.AttesterSlashing{
+		Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte{1}, 32)},
+		}),
+	}
+	require.NoError(t, db.SaveAttesterSlashings(ctx, types.Active, []*ethpb.AttesterSlashing{as}))
+	detectedAt, err = db.AttesterSlashingDetectedAt(ctx, as)
+	require.NoError(t, err)
+	assert.Equal(t, false, detectedAt.IsZero())
+}
@@ -22,6 +22,11 @@ var (
 	chainDataBucket                   = []byte("chain-data-bucket")
 	compressedIdxAttsBucket           = []byte("compressed-idx-atts-bucket")
 	validatorsPublicKeysBucket        = []byte("validators-public-keys-bucket")
+	// Where and when slasher first saw attestations and block headers, and when it
+	// detected slashings, to provide context along with detected slashings.
+	attestationProvenanceBucket = []byte("attestation-provenance-bucket")
+	blockHeaderProvenanceBucket = []byte("block-header-provenance-bucket")
+	slashingDetectionTimeBucket = []byte("slashing-detection-time-bucket")
 	// In order to quickly detect surround and surrounded attestations we need to store
 	// the min and max span for each validator for each epoch.
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
@@ -0,0 +1,14 @@
+package types
+
+import (
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+)
+
+// AttestationProvenance pairs an attestation, identified by its data and
+// signature, with where and when slasher first saw it.
+type AttestationProvenance struct {
+	Data       *ethpb.AttestationData
+	Signature  []byte
+	Provenance *slashpb.MessageProvenance
+}
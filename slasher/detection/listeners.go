@@ -11,6 +11,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"go.opencensus.io/trace"
 )
 
@@ -26,6 +27,8 @@ func (s *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Signe
 	for {
 		select {
 		case signedBlock := <-ch:
+			// Slashings included in the block are marked regardless of the detection on the block.
+			s.markIncludedSlashings(ctx, signedBlock.GetBlock().GetBody())
 			signedBlkHdr, err := blockutil.SignedBeaconBlockHeaderFromBlock(signedBlock)
 			if err != nil {
 				log.WithError(err).Error("Could not get block header from block")
@@ -83,3 +86,37 @@ func (s *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethpb
 		}
 	}
 }
+
+// markIncludedSlashings updates the status of the detected slashings which
+// a block includes, so their inclusion on chain can be reported.
+func (s *Service) markIncludedSlashings(ctx context.Context, body *ethpb.BeaconBlockBody) {
+	if body == nil {
+		return
+	}
+	for _, slashing := range body.AttesterSlashings {
+		found, _, err := s.cfg.SlasherDB.HasAttesterSlashing(ctx, slashing)
+		if err != nil {
+			log.WithError(err).Error("Could not check attester slashing in db")
+			continue
+		}
+		if !found {
+			continue
+		}
+		if err := s.cfg.SlasherDB.SaveAttesterSlashing(ctx, status.Included, slashing); err != nil {
+			log.WithError(err).Error("Could not mark attester slashing as included")
+		}
+	}
+	for _, slashing := range body.ProposerSlashings {
+		found, _, err := s.cfg.SlasherDB.HasProposerSlashing(ctx, slashing)
+		if err != nil {
+			log.WithError(err).Error("Could not check proposer slashing in db")
+			continue
+		}
+		if !found {
+			continue
+		}
+		if err := s.cfg.SlasherDB.SaveProposerSlashing(ctx, status.Included, slashing); err != nil {
+			log.WithError(err).Error("Could not mark proposer slashing as included")
+		}
+	}
+}
@@ -2,13 +2,17 @@ package detection
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
 	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
 	"github.com/sirupsen/logrus"
@@ -58,6 +62,54 @@ func TestService_DetectIncomingBlocks(t *testing.T) {
 	require.LogsContain(t, hook, "Context canceled")
 }
 
+// failingProposalsDetector fails the detection on every block.
+type failingProposalsDetector struct{}
+
+func (d *failingProposalsDetector) DetectDoublePropose(_ context.Context, _ *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error) {
+	return nil, errors.New("detection failed")
+}
+
+func (d *failingProposalsDetector) DetectDoubleProposeNoUpdate(_ context.Context, _ *ethpb.BeaconBlockHeader) (bool, error) {
+	return false, errors.New("detection failed")
+}
+
+func TestService_DetectIncomingBlocks_MarksIncludedSlashingsOnDetectionError(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ds := Service{
+		cfg:               &Config{Notifier: &mockNotifier{}, SlasherDB: db},
+		proposalsDetector: &failingProposalsDetector{},
+	}
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data:             &ethpb.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("other"), 32)},
+		}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, db.SaveAttesterSlashing(ctx, status.Active, slashing))
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Body.AttesterSlashings = []*ethpb.AttesterSlashing{slashing}
+	blocksChan := make(chan *ethpb.SignedBeaconBlock)
+	exited := make(chan struct{})
+	go func() {
+		ds.detectIncomingBlocks(ctx, blocksChan)
+		close(exited)
+	}()
+	blocksChan <- blk
+	// The routine only receives the second block once it is done with the first.
+	blocksChan <- testutil.NewBeaconBlock()
+	cancel()
+	<-exited
+
+	found, st, err := db.HasAttesterSlashing(ctx, slashing)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, status.SlashingStatus(status.Included), st)
+}
+
 func TestService_DetectIncomingAttestations(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ds := Service{
@@ -3,12 +3,16 @@ package detection
 import (
 	"context"
 	"errors"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
 	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
@@ -166,6 +170,7 @@ func (s *Service) detectHistoricalChainData(ctx context.Context) {
 			log.WithError(err).Error("could not save indexed attestations")
 			return
 		}
+		s.saveHistoricalProvenance(ctx, indexedAtts)
 
 		for _, att := range indexedAtts {
 			if ctx.Err() == context.Canceled {
@@ -208,6 +213,26 @@ func (s *Service) detectHistoricalChainData(ctx context.Context) {
 	log.Infof("Completed slashing detection on historical chain data up to epoch %d", storedEpoch)
 }
 
+// Records attestations retrieved from historical chain data as first seen included in
+// a block. The beacon node does not tell which block included them.
+func (s *Service) saveHistoricalProvenance(ctx context.Context, atts []*ethpb.IndexedAttestation) {
+	now := uint64(timeutils.Now().UnixNano() / int64(time.Millisecond))
+	provenances := make([]*dbtypes.AttestationProvenance, len(atts))
+	for i, att := range atts {
+		provenances[i] = &dbtypes.AttestationProvenance{
+			Data:      att.Data,
+			Signature: att.Signature,
+			Provenance: &slashpb.MessageProvenance{
+				Source:    slashpb.MessageProvenance_BLOCK_INCLUSION,
+				FirstSeen: now,
+			},
+		}
+	}
+	if err := s.cfg.SlasherDB.SaveAttestationProvenances(ctx, provenances); err != nil {
+		log.WithError(err).Error("Could not save attestation provenances")
+	}
+}
+
 func (s *Service) submitAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
 	ctx, span := trace.StartSpan(ctx, "detection.submitAttesterSlashings")
 	defer span.End()
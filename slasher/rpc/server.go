@@ -2,7 +2,9 @@ package rpc
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
@@ -13,12 +15,16 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/p2putils"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -127,6 +133,14 @@ func (s *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.IndexedA
 		return nil, status.Errorf(codes.Internal, "could not verify indexed attestation signature: %v: %v", req, err)
 	}
 
+	if err := s.slasherDB.SaveAttestationProvenances(ctx, []*dbtypes.AttestationProvenance{{
+		Data:       req.Data,
+		Signature:  req.Signature,
+		Provenance: rpcProvenance(ctx),
+	}}); err != nil {
+		log.WithError(err).Error("Could not save attestation provenance")
+	}
+
 	s.attestationLock.Lock()
 	defer s.attestationLock.Unlock()
 
@@ -193,6 +207,10 @@ func (s *Server) IsSlashableBlock(ctx context.Context, req *ethpb.SignedBeaconBl
 		return nil, err
 	}
 
+	if err := s.slasherDB.SaveBlockHeaderProvenance(ctx, req, rpcProvenance(ctx)); err != nil {
+		log.WithError(err).Error("Could not save block header provenance")
+	}
+
 	s.proposeLock.Lock()
 	defer s.proposeLock.Unlock()
 
@@ -236,3 +254,163 @@ func (s *Server) IsSlashableBlockNoUpdate(ctx context.Context, req *ethpb.Beacon
 	sl.Slashable = slash
 	return sl, nil
 }
+
+// AttesterSlashingEvidence returns the attester slashings detected within an epoch range,
+// optionally only the ones of the requested validators, along with where their attestations
+// were first seen, when they were detected and whether they were included on chain.
+func (s *Server) AttesterSlashingEvidence(
+	ctx context.Context, req *slashpb.SlashingEvidenceRequest,
+) (*slashpb.AttesterSlashingEvidenceResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "history.AttesterSlashingEvidence")
+	defer span.End()
+
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "start epoch %d cannot be after end epoch %d", req.StartEpoch, req.EndEpoch)
+	}
+	validators := make(map[uint64]bool, len(req.ValidatorIndices))
+	for _, idx := range req.ValidatorIndices {
+		validators[idx] = true
+	}
+	evidence := make([]*slashpb.AttesterSlashingEvidence, 0)
+	for _, st := range []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Included, dbtypes.Reverted} {
+		slashings, err := s.slasherDB.AttesterSlashings(ctx, st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve attester slashings: %v", err)
+		}
+		for _, slashing := range slashings {
+			if !attesterSlashingMatches(slashing, req.StartEpoch, req.EndEpoch, validators) {
+				continue
+			}
+			e := &slashpb.AttesterSlashingEvidence{
+				Slashing: slashing,
+				Included: st == dbtypes.Included,
+			}
+			if e.Attestation_1Provenance, err = s.slasherDB.AttestationProvenance(ctx, slashing.Attestation_1); err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve attestation provenance: %v", err)
+			}
+			if e.Attestation_2Provenance, err = s.slasherDB.AttestationProvenance(ctx, slashing.Attestation_2); err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve attestation provenance: %v", err)
+			}
+			detectedAt, err := s.slasherDB.AttesterSlashingDetectedAt(ctx, slashing)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve slashing detection time: %v", err)
+			}
+			e.DetectedAt = unixMillis(detectedAt)
+			evidence = append(evidence, e)
+		}
+	}
+	sort.SliceStable(evidence, func(i, j int) bool {
+		return evidence[i].DetectedAt < evidence[j].DetectedAt
+	})
+	return &slashpb.AttesterSlashingEvidenceResponse{
+		Evidence: evidence,
+	}, nil
+}
+
+// ProposerSlashingEvidence returns the proposer slashings detected within an epoch range,
+// optionally only the ones of the requested validators, along with where their block headers
+// were first seen, when they were detected and whether they were included on chain.
+func (s *Server) ProposerSlashingEvidence(
+	ctx context.Context, req *slashpb.SlashingEvidenceRequest,
+) (*slashpb.ProposerSlashingEvidenceResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "history.ProposerSlashingEvidence")
+	defer span.End()
+
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "start epoch %d cannot be after end epoch %d", req.StartEpoch, req.EndEpoch)
+	}
+	validators := make(map[uint64]bool, len(req.ValidatorIndices))
+	for _, idx := range req.ValidatorIndices {
+		validators[idx] = true
+	}
+	evidence := make([]*slashpb.ProposerSlashingEvidence, 0)
+	for _, st := range []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Included, dbtypes.Reverted} {
+		slashings, err := s.slasherDB.ProposalSlashingsByStatus(ctx, st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve proposer slashings: %v", err)
+		}
+		for _, slashing := range slashings {
+			if slashing.Header_1 == nil || slashing.Header_1.Header == nil || slashing.Header_2 == nil {
+				continue
+			}
+			header := slashing.Header_1.Header
+			epoch := helpers.SlotToEpoch(header.Slot)
+			if epoch < req.StartEpoch || epoch > req.EndEpoch {
+				continue
+			}
+			if len(validators) > 0 && !validators[uint64(header.ProposerIndex)] {
+				continue
+			}
+			e := &slashpb.ProposerSlashingEvidence{
+				Slashing: slashing,
+				Included: st == dbtypes.Included,
+			}
+			if e.Header_1Provenance, err = s.slasherDB.BlockHeaderProvenance(ctx, slashing.Header_1); err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve block header provenance: %v", err)
+			}
+			if e.Header_2Provenance, err = s.slasherDB.BlockHeaderProvenance(ctx, slashing.Header_2); err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve block header provenance: %v", err)
+			}
+			detectedAt, err := s.slasherDB.ProposerSlashingDetectedAt(ctx, slashing)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve slashing detection time: %v", err)
+			}
+			e.DetectedAt = unixMillis(detectedAt)
+			evidence = append(evidence, e)
+		}
+	}
+	sort.SliceStable(evidence, func(i, j int) bool {
+		return evidence[i].DetectedAt < evidence[j].DetectedAt
+	})
+	return &slashpb.ProposerSlashingEvidenceResponse{
+		Evidence: evidence,
+	}, nil
+}
+
+// An attester slashing matches if the target epoch of either of its attestations is within
+// the epoch range and, if validators are given, one of them is slashed by it.
+func attesterSlashingMatches(
+	slashing *ethpb.AttesterSlashing, startEpoch, endEpoch types.Epoch, validators map[uint64]bool,
+) bool {
+	att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+	if att1 == nil || att1.Data == nil || att1.Data.Target == nil ||
+		att2 == nil || att2.Data == nil || att2.Data.Target == nil {
+		return false
+	}
+	inRange := func(epoch types.Epoch) bool {
+		return epoch >= startEpoch && epoch <= endEpoch
+	}
+	if !inRange(att1.Data.Target.Epoch) && !inRange(att2.Data.Target.Epoch) {
+		return false
+	}
+	if len(validators) == 0 {
+		return true
+	}
+	for _, idx := range sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices) {
+		if validators[idx] {
+			return true
+		}
+	}
+	return false
+}
+
+// Records that a message was submitted to the RPC server, along with the address of the
+// client which submitted it.
+func rpcProvenance(ctx context.Context) *slashpb.MessageProvenance {
+	provenance := &slashpb.MessageProvenance{
+		Source:    slashpb.MessageProvenance_RPC,
+		FirstSeen: unixMillis(timeutils.Now()),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		provenance.Peer = p.Addr.String()
+	}
+	return provenance
+}
+
+// Returns a time in unix milliseconds, or 0 for the zero time.
+func unixMillis(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano() / int64(time.Millisecond))
+}
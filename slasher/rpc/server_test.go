@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/copyutil"
@@ -22,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 )
 
@@ -324,3 +326,119 @@ func TestServer_IsSlashableBlockNoUpdate(t *testing.T) {
 	require.NoError(t, err, "Got error while trying to detect slashing")
 	require.Equal(t, true, sl.Slashable, "Block should be found to be slashable")
 }
+
+func TestServer_AttesterSlashingEvidence(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	server := Server{ctx: ctx, slasherDB: db}
+
+	newSlashing := func(targetEpoch types.Epoch, indices []uint64) *ethpb.AttesterSlashing {
+		return &ethpb.AttesterSlashing{
+			Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+				AttestingIndices: indices,
+				Data: &ethpb.AttestationData{
+					Target: &ethpb.Checkpoint{Epoch: targetEpoch},
+				},
+			}),
+			Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+				AttestingIndices: indices,
+				Data: &ethpb.AttestationData{
+					BeaconBlockRoot: bytesutil.PadTo([]byte("other root"), 32),
+					Target:          &ethpb.Checkpoint{Epoch: targetEpoch},
+				},
+			}),
+		}
+	}
+	slashing1 := newSlashing(3, []uint64{1, 2})
+	slashing2 := newSlashing(5, []uint64{3})
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Active, slashing1))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Included, slashing2))
+	provenance := &slashpb.MessageProvenance{
+		Source:       slashpb.MessageProvenance_ATTESTATION_STREAM,
+		FirstSeen:    100,
+		GossipTopic:  "/eth2/b5303f2a/beacon_attestation_1/ssz_snappy",
+		GossipPeerId: "16Uiu2HAm7CPVVpSVD2YdxNaPsyTcf8Ud6ZqPjgnpEk4hsz8Pfzjv",
+	}
+	require.NoError(t, db.SaveAttestationProvenances(ctx, []*dbtypes.AttestationProvenance{{
+		Data:       slashing1.Attestation_1.Data,
+		Signature:  slashing1.Attestation_1.Signature,
+		Provenance: provenance,
+	}}))
+
+	res, err := server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 0, EndEpoch: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Evidence))
+
+	res, err = server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 0, EndEpoch: 4})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	evidence := res.Evidence[0]
+	assert.DeepEqual(t, slashing1, evidence.Slashing)
+	assert.DeepEqual(t, provenance, evidence.Attestation_1Provenance)
+	assert.Equal(t, (*slashpb.MessageProvenance)(nil), evidence.Attestation_2Provenance)
+	assert.NotEqual(t, uint64(0), evidence.DetectedAt)
+	assert.Equal(t, false, evidence.Included)
+
+	res, err = server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{
+		StartEpoch:       0,
+		EndEpoch:         10,
+		ValidatorIndices: []uint64{3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, slashing2, res.Evidence[0].Slashing)
+	assert.Equal(t, true, res.Evidence[0].Included)
+
+	_, err = server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 5, EndEpoch: 4})
+	assert.ErrorContains(t, "cannot be after end epoch", err)
+}
+
+func TestServer_ProposerSlashingEvidence(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	server := Server{ctx: ctx, slasherDB: db}
+
+	newSlashing := func(slot types.Slot, proposer types.ValidatorIndex) *ethpb.ProposerSlashing {
+		return &ethpb.ProposerSlashing{
+			Header_1: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+				Header: &ethpb.BeaconBlockHeader{Slot: slot, ProposerIndex: proposer},
+			}),
+			Header_2: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+				Header: &ethpb.BeaconBlockHeader{
+					Slot:          slot,
+					ProposerIndex: proposer,
+					BodyRoot:      bytesutil.PadTo([]byte("other body"), 32),
+				},
+			}),
+		}
+	}
+	slashing1 := newSlashing(1, 1)
+	slashing2 := newSlashing(params.BeaconConfig().SlotsPerEpoch*4, 2)
+	require.NoError(t, db.SaveProposerSlashings(ctx, dbtypes.Active, []*ethpb.ProposerSlashing{slashing1, slashing2}))
+	provenance := &slashpb.MessageProvenance{
+		Source:    slashpb.MessageProvenance_RPC,
+		Peer:      "127.0.0.1:4000",
+		FirstSeen: 100,
+	}
+	require.NoError(t, db.SaveBlockHeaderProvenance(ctx, slashing2.Header_2, provenance))
+
+	res, err := server.ProposerSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 0, EndEpoch: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Evidence))
+
+	res, err = server.ProposerSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 4, EndEpoch: 4})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, slashing2, res.Evidence[0].Slashing)
+	assert.Equal(t, (*slashpb.MessageProvenance)(nil), res.Evidence[0].Header_1Provenance)
+	assert.DeepEqual(t, provenance, res.Evidence[0].Header_2Provenance)
+
+	res, err = server.ProposerSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{
+		StartEpoch:       0,
+		EndEpoch:         10,
+		ValidatorIndices: []uint64{1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, slashing1, res.Evidence[0].Slashing)
+}
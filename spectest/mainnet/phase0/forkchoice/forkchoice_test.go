@@ -0,0 +1,11 @@
+package forkchoice
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/spectest/shared/phase0/forkchoice"
+)
+
+func TestMainnet_Phase0_ForkChoice(t *testing.T) {
+	forkchoice.RunForkChoiceTest(t, "mainnet")
+}
@@ -62,7 +62,7 @@ func RunFinalityTest(t *testing.T, config string) {
 				require.NoError(t, block.UnmarshalSSZ(blockSSZ), "Failed to unmarshal")
 				wsb, err := wrapper.WrappedAltairSignedBeaconBlock(block)
 				require.NoError(t, err)
-				processedState, err = core.ExecuteStateTransition(context.Background(), beaconState, wsb)
+				processedState, err = core.ExecuteStateTransition(context.Background(), beaconState, wsb, nil)
 				require.NoError(t, err)
 				beaconState, ok = processedState.(*stateAltair.BeaconState)
 				require.Equal(t, true, ok)
@@ -97,7 +97,7 @@ func RunForkTransitionTest(t *testing.T, config string) {
 			ctx := context.Background()
 			var ok bool
 			for _, b := range preforkBlocks {
-				st, err := core.ExecuteStateTransition(ctx, beaconState, wrapperv1.WrappedPhase0SignedBeaconBlock(b))
+				st, err := core.ExecuteStateTransition(ctx, beaconState, wrapperv1.WrappedPhase0SignedBeaconBlock(b), nil)
 				require.NoError(t, err)
 				beaconState, ok = st.(*v1.BeaconState)
 				require.Equal(t, true, ok)
@@ -106,7 +106,7 @@ func RunForkTransitionTest(t *testing.T, config string) {
 			for _, b := range postforkBlocks {
 				wsb, err := wrapper.WrappedAltairSignedBeaconBlock(b)
 				require.NoError(t, err)
-				st, err := core.ExecuteStateTransition(ctx, altairState, wsb)
+				st, err := core.ExecuteStateTransition(ctx, altairState, wsb, nil)
 				require.NoError(t, err)
 				altairState, ok = st.(*stateAltair.BeaconState)
 				require.Equal(t, true, ok)
@@ -64,7 +64,7 @@ func RunBlockProcessingTest(t *testing.T, config string) {
 				require.NoError(t, block.UnmarshalSSZ(blockSSZ), "Failed to unmarshal")
 				wsb, err := wrapper.WrappedAltairSignedBeaconBlock(block)
 				require.NoError(t, err)
-				processedState, transitionError = core.ExecuteStateTransition(context.Background(), beaconState, wsb)
+				processedState, transitionError = core.ExecuteStateTransition(context.Background(), beaconState, wsb, nil)
 				if transitionError != nil {
 					break
 				}
@@ -0,0 +1,244 @@
+package forkchoice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/snappy"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	core "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/spectest/utils"
+)
+
+func init() {
+	core.SkipSlotCache.Disable()
+}
+
+// Step is a single step of a fork choice test, as listed in steps.yaml. Exactly one of its
+// fields is set, except for valid which qualifies a block or an attestation step.
+type Step struct {
+	Tick        *uint64 `json:"tick"`
+	Block       *string `json:"block"`
+	Attestation *string `json:"attestation"`
+	Valid       *bool   `json:"valid"`
+	Checks      *Check  `json:"checks"`
+}
+
+// Check lists the expected values of the fork choice store after the previous steps. Only the
+// values which are set are checked.
+type Check struct {
+	Time                    *uint64    `json:"time"`
+	Head                    *SlotRoot  `json:"head"`
+	JustifiedCheckpoint     *EpochRoot `json:"justified_checkpoint"`
+	FinalizedCheckpoint     *EpochRoot `json:"finalized_checkpoint"`
+	JustifiedCheckpointRoot *string    `json:"justified_checkpoint_root"`
+	FinalizedCheckpointRoot *string    `json:"finalized_checkpoint_root"`
+	ProposerBoostRoot       *string    `json:"proposer_boost_root"`
+	BestJustifiedCheckpoint *EpochRoot `json:"best_justified_checkpoint"`
+}
+
+// SlotRoot is a block root and its slot.
+type SlotRoot struct {
+	Slot uint64 `json:"slot"`
+	Root string `json:"root"`
+}
+
+// EpochRoot is a checkpoint, with its root hex encoded.
+type EpochRoot struct {
+	Epoch uint64 `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// RunForkChoiceTest executes fork choice spec tests.
+func RunForkChoiceTest(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	for _, handler := range []string{"get_head", "on_block"} {
+		t.Run(handler, func(t *testing.T) {
+			folderPath := path.Join("fork_choice", handler, "pyspec_tests")
+			// Fork choice tests are only released with more recent spec test versions than the one
+			// pinned by eth2_spec_version in the WORKSPACE, see the TODO there.
+			if _, err := bazel.Runfile(path.Join("tests", config, "phase0", folderPath)); err != nil {
+				t.Skipf("No fork choice tests for handler %s in the pinned spec tests, eth2_spec_version must be bumped: %v", handler, err)
+			}
+			testFolders, testsFolderPath := utils.TestFolders(t, config, "phase0", folderPath)
+			for _, folder := range testFolders {
+				t.Run(folder.Name(), func(t *testing.T) {
+					runTest(t, path.Join(testsFolderPath, folder.Name()))
+				})
+			}
+		})
+	}
+}
+
+func runTest(t *testing.T, testFolderPath string) {
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	anchorStateBase := &statepb.BeaconState{}
+	require.NoError(t, anchorStateBase.UnmarshalSSZ(snappyFile(t, testFolderPath, "anchor_state.ssz_snappy")), "Failed to unmarshal")
+	anchorState, err := v1.InitializeFromProto(anchorStateBase)
+	require.NoError(t, err)
+	anchorBlock := &ethpb.BeaconBlock{}
+	require.NoError(t, anchorBlock.UnmarshalSSZ(snappyFile(t, testFolderPath, "anchor_block.ssz_snappy")), "Failed to unmarshal")
+	anchorRoot, err := anchorBlock.HashTreeRoot()
+	require.NoError(t, err)
+
+	stepsFile, err := testutil.BazelFileBytes(testFolderPath, "steps.yaml")
+	require.NoError(t, err)
+	var steps []*Step
+	require.NoError(t, utils.UnmarshalYaml(stepsFile, &steps), "Failed to Unmarshal")
+
+	genesisTime := anchorState.GenesisTime()
+	// The clock of the service is the time of the last tick, as the wall time spent processing
+	// the steps does not count. It starts at the slot of the anchor.
+	currentTime := genesisTime + uint64(anchorState.Slot())*params.BeaconConfig().SecondsPerSlot
+
+	beaconDB := testDB.SetupDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	service, err := blockchain.NewService(ctx, &blockchain.Config{
+		BeaconDB:        beaconDB,
+		DepositCache:    depositCache,
+		AttPool:         attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		SlashingPool:    slashings.NewPool(),
+		StateNotifier:   &mock.MockStateNotifier{},
+		ForkChoiceStore: protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+		StateGen:        stategen.New(beaconDB),
+		Clock: func() time.Time {
+			return time.Unix(int64(currentTime), 0)
+		},
+	})
+	require.NoError(t, err)
+	// Anchor blocks are not signed, the signature is never checked as the anchor is trusted.
+	signedAnchorBlock := &ethpb.SignedBeaconBlock{Block: anchorBlock, Signature: make([]byte, params.BeaconConfig().BLSSignatureLength)}
+	require.NoError(t, service.StartFromAnchor(ctx, anchorState, wrapper.WrappedPhase0SignedBeaconBlock(signedAnchorBlock)))
+	headRoot, err := service.HeadRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, anchorRoot[:], headRoot, "Head is not the anchor block")
+
+	for i, step := range steps {
+		switch {
+		case step.Tick != nil:
+			require.Equal(t, true, *step.Tick >= currentTime, "Step %d: time can't go backwards", i)
+			previousSlot := (currentTime - genesisTime) / params.BeaconConfig().SecondsPerSlot
+			currentTime = *step.Tick
+			if (currentTime-genesisTime)/params.BeaconConfig().SecondsPerSlot > previousSlot {
+				require.NoError(t, service.NewSlot(ctx), "Step %d", i)
+			}
+		case step.Block != nil:
+			blk := &ethpb.SignedBeaconBlock{}
+			require.NoError(t, blk.UnmarshalSSZ(snappyFile(t, testFolderPath, *step.Block+".ssz_snappy")), "Failed to unmarshal")
+			root, err := blk.Block.HashTreeRoot()
+			require.NoError(t, err)
+			err = service.ReceiveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk), root)
+			checkValidity(t, i, step, err)
+		case step.Attestation != nil:
+			att := &ethpb.Attestation{}
+			require.NoError(t, att.UnmarshalSSZ(snappyFile(t, testFolderPath, *step.Attestation+".ssz_snappy")), "Failed to unmarshal")
+			err := service.ReceiveAttestationNoPubsub(ctx, att)
+			checkValidity(t, i, step, err)
+		case step.Checks != nil:
+			runChecks(t, i, service, step.Checks, currentTime, anchorRoot)
+		default:
+			t.Fatalf("Step %d: unknown step", i)
+		}
+	}
+}
+
+// checkValidity checks the outcome of a block or an attestation step, which is expected to
+// succeed unless the step is marked as invalid.
+func checkValidity(t *testing.T, i int, step *Step, err error) {
+	if step.Valid != nil && !*step.Valid {
+		require.NotNil(t, err, "Step %d: expected an error", i)
+		return
+	}
+	require.NoError(t, err, "Step %d", i)
+}
+
+func runChecks(t *testing.T, i int, service *blockchain.Service, c *Check, currentTime uint64, anchorRoot [32]byte) {
+	ctx := context.Background()
+	require.NoError(t, service.UpdateHead(ctx), "Step %d", i)
+
+	if c.Time != nil {
+		assert.Equal(t, *c.Time, currentTime, "Step %d: unexpected time", i)
+	}
+	if c.Head != nil {
+		headRoot, err := service.HeadRoot(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, decodeRoot(t, c.Head.Root), headRoot, "Step %d: unexpected head root", i)
+		assert.Equal(t, types.Slot(c.Head.Slot), service.HeadSlot(), "Step %d: unexpected head slot", i)
+	}
+	if c.JustifiedCheckpoint != nil {
+		cp := service.CurrentJustifiedCheckpt()
+		assert.Equal(t, types.Epoch(c.JustifiedCheckpoint.Epoch), cp.Epoch, "Step %d: unexpected justified epoch", i)
+		assert.DeepEqual(t, decodeRoot(t, c.JustifiedCheckpoint.Root), checkpointRoot(cp, anchorRoot), "Step %d: unexpected justified root", i)
+	}
+	if c.JustifiedCheckpointRoot != nil {
+		cp := service.CurrentJustifiedCheckpt()
+		assert.DeepEqual(t, decodeRoot(t, *c.JustifiedCheckpointRoot), checkpointRoot(cp, anchorRoot), "Step %d: unexpected justified root", i)
+	}
+	if c.FinalizedCheckpoint != nil {
+		cp := service.FinalizedCheckpt()
+		assert.Equal(t, types.Epoch(c.FinalizedCheckpoint.Epoch), cp.Epoch, "Step %d: unexpected finalized epoch", i)
+		assert.DeepEqual(t, decodeRoot(t, c.FinalizedCheckpoint.Root), checkpointRoot(cp, anchorRoot), "Step %d: unexpected finalized root", i)
+	}
+	if c.FinalizedCheckpointRoot != nil {
+		cp := service.FinalizedCheckpt()
+		assert.DeepEqual(t, decodeRoot(t, *c.FinalizedCheckpointRoot), checkpointRoot(cp, anchorRoot), "Step %d: unexpected finalized root", i)
+	}
+	if c.ProposerBoostRoot != nil {
+		boostRoot := service.ProtoArrayStore().ProposerBoostRoot()
+		assert.DeepEqual(t, decodeRoot(t, *c.ProposerBoostRoot), boostRoot[:], "Step %d: unexpected proposer boost root", i)
+	}
+	// The best justified checkpoint is not exposed by the service, it is not checked.
+}
+
+// checkpointRoot returns the root of a checkpoint of the service. The service uses the zero hash
+// for the genesis checkpoints, while the spec uses the root of the anchor block.
+func checkpointRoot(cp *ethpb.Checkpoint, anchorRoot [32]byte) []byte {
+	if cp.Epoch == 0 && bytes.Equal(cp.Root, params.BeaconConfig().ZeroHash[:]) {
+		return anchorRoot[:]
+	}
+	return cp.Root
+}
+
+func decodeRoot(t *testing.T, s string) []byte {
+	root, err := hexutil.Decode(s)
+	require.NoError(t, err)
+	require.Equal(t, 32, len(root), "Invalid root %s", s)
+	return root
+}
+
+func snappyFile(t *testing.T, testFolderPath, filename string) []byte {
+	file, err := testutil.BazelFileBytes(testFolderPath, filename)
+	require.NoError(t, err)
+	ssz, err := snappy.Decode(nil /* dst */, file)
+	require.NoError(t, err, fmt.Sprintf("Failed to decompress %s", filename))
+	return ssz
+}
@@ -0,0 +1,100 @@
+// This binary replays a req/resp session recorded by a beacon node running with
+// --rpc-recorder-file against a local beacon node. The session is replayed from a single
+// libp2p host with a fresh identity, whatever the number of recorded peers: the requests
+// the node received are sent again and the responses are compared with the recorded ones,
+// while the requests of the node are answered with the recorded responses.
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	noise "github.com/libp2p/go-libp2p-noise"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	recording = flag.String("recording", "", "Path to the req/resp recording file")
+	nodeAddr  = flag.String("node", "", "Multiaddress of the local beacon node, including its peer id")
+	speed     = flag.Float64("speed", 1, "Speed at which the recorded session is replayed, 0 replays without any delay")
+	serve     = flag.Bool("serve", true, "Answer the requests of the node with the recorded responses")
+)
+
+func main() {
+	flag.Parse()
+	if *recording == "" {
+		log.Fatal("No recording file given")
+	}
+	if *nodeAddr == "" {
+		log.Fatal("No node address given")
+	}
+	exchanges, err := regularsync.ReadRecordedExchanges(*recording)
+	if err != nil {
+		log.Fatalf("Could not read recording: %v", err)
+	}
+	addr, err := ma.NewMultiaddr(*nodeAddr)
+	if err != nil {
+		log.Fatalf("Invalid node address: %v", err)
+	}
+	info, err := peer.AddrInfoFromP2pAddr(addr)
+	if err != nil {
+		log.Fatalf("Invalid node address: %v", err)
+	}
+
+	ctx := context.Background()
+	h, err := libp2p.New(ctx, libp2p.Security(noise.ID, noise.New))
+	if err != nil {
+		log.Fatalf("Could not create libp2p host: %v", err)
+	}
+	// The node only sees the replaying host, so any per-peer state of the node, such as rate
+	// limits and peer scores, is shared by all the recorded peers.
+	recordedPeers := make(map[string]bool)
+	for _, e := range exchanges {
+		recordedPeers[e.Peer] = true
+	}
+	log.WithFields(log.Fields{
+		"peer":          h.ID().String(),
+		"recordedPeers": len(recordedPeers),
+	}).Info("Replaying the recorded exchanges of all peers from a single identity")
+
+	replayer := regularsync.NewRPCReplayer(h, encoder.SszNetworkEncoder{}, exchanges, *speed)
+	if *serve {
+		replayer.Serve()
+	}
+	if err := h.Connect(ctx, *info); err != nil {
+		log.Fatalf("Could not connect to node: %v", err)
+	}
+
+	results, err := replayer.Replay(ctx, info.ID)
+	if err != nil {
+		log.WithError(err).Error("Replay stopped")
+	}
+	diverged := 0
+	for _, r := range results {
+		fields := log.Fields{
+			"topic":          r.Recorded.Topic,
+			"recordedChunks": len(r.Recorded.Chunks),
+			"replayedChunks": len(r.Replayed.Chunks),
+			"duration":       r.Replayed.Duration,
+		}
+		if r.Replayed.Error != "" {
+			fields["error"] = r.Replayed.Error
+		}
+		if r.Diverged() {
+			diverged++
+			log.WithFields(fields).Warn("Node responded differently than recorded")
+			continue
+		}
+		log.WithFields(fields).Debug("Node responded as recorded")
+	}
+	log.WithFields(log.Fields{
+		"exchanges": len(results),
+		"diverged":  diverged,
+	}).Info("Replay done")
+}
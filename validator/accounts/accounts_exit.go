@@ -228,14 +228,25 @@ func prepareAllKeys(validatingKeys [][48]byte) (raw [][]byte, formatted []string
 }
 
 func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *ethpb.NodeClient, error) {
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
+	nodeClient := ethpb.NewNodeClient(conn)
+	return &validatorClient, &nodeClient, nil
+}
+
+func dialBeaconNode(cliCtx *cli.Context) (*grpc.ClientConn, error) {
 	dialOpts := client.ConstructDialOptions(
 		cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 		cliCtx.String(flags.CertFlag.Name),
 		cliCtx.Uint(flags.GrpcRetriesFlag.Name),
 		cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
+		client.WithMultipleEndpoints(),
 	)
 	if dialOpts == nil {
-		return nil, nil, errors.New("failed to construct dial options")
+		return nil, errors.New("failed to construct dial options")
 	}
 
 	grpcHeaders := strings.Split(cliCtx.String(flags.GrpcHeadersFlag.Name), ",")
@@ -243,11 +254,9 @@ func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *eth
 
 	conn, err := grpc.DialContext(cliCtx.Context, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts...)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
+		return nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
 	}
-	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
-	nodeClient := ethpb.NewNodeClient(conn)
-	return &validatorClient, &nodeClient, nil
+	return conn, nil
 }
 
 func displayExitInfo(rawExitedKeys [][]byte, trimmedExitedKeys []string) {
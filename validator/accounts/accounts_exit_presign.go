@@ -0,0 +1,299 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PreSignedExit is a signed voluntary exit which has not been broadcast, in the JSON
+// format of the beacon node API along with the public key of the exiting validator.
+type PreSignedExit struct {
+	PublicKey string                `json:"public_key"`
+	Message   *PreSignedExitMessage `json:"message"`
+	Signature string                `json:"signature"`
+}
+
+// PreSignedExitMessage is the voluntary exit message of a pre-signed exit.
+type PreSignedExitMessage struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// PreSignExitCfg for pre-signing voluntary exits.
+type PreSignExitCfg struct {
+	ValidatorClient ethpb.BeaconNodeValidatorClient
+	Keymanager      keymanager.IKeymanager
+	RawPubKeys      [][]byte
+	Epoch           types.Epoch
+}
+
+// SubmitExitCfg for submitting pre-signed voluntary exits.
+type SubmitExitCfg struct {
+	ValidatorClient ethpb.BeaconNodeValidatorClient
+	NodeClient      ethpb.NodeClient
+	BeaconClient    ethpb.BeaconChainClient
+	Exits           []*PreSignedExit
+}
+
+// ExitSubmission is the outcome of submitting a pre-signed voluntary exit.
+type ExitSubmission struct {
+	PublicKey []byte
+	Status    ethpb.ValidatorStatus
+	Submitted bool
+	// Reason the exit was not submitted, if it was not.
+	Reason string
+	// QueuePosition is the 1-based position of the validator in the exit queue, or 0 if the
+	// exit has not been included in a block yet.
+	QueuePosition uint64
+}
+
+// PreSignExitsCli pre-signs voluntary exits for one or more accounts and writes them to a
+// file, without broadcasting them.
+func PreSignExitsCli(cliCtx *cli.Context, r io.Reader) error {
+	outputPath := cliCtx.String(flags.PreSignedExitsFileFlag.Name)
+	if outputPath == "" {
+		return fmt.Errorf("no file specified to write pre-signed exits to, use --%s", flags.PreSignedExitsFileFlag.Name)
+	}
+	validatingPublicKeys, kManager, err := prepareWallet(cliCtx)
+	if err != nil {
+		return err
+	}
+	rawPubKeys, trimmedPubKeys, err := interact(cliCtx, r, validatingPublicKeys)
+	if err != nil {
+		return err
+	}
+	// User decided to cancel the voluntary exit.
+	if rawPubKeys == nil && trimmedPubKeys == nil {
+		return nil
+	}
+
+	validatorClient, nodeClient, err := prepareClients(cliCtx)
+	if err != nil {
+		return err
+	}
+	var epoch types.Epoch
+	if cliCtx.IsSet(flags.ExitEpochFlag.Name) {
+		epoch = types.Epoch(cliCtx.Uint64(flags.ExitEpochFlag.Name))
+	} else {
+		epoch, err = currentEpoch(cliCtx.Context, *nodeClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	exits, err := PreSignVoluntaryExits(cliCtx.Context, PreSignExitCfg{
+		ValidatorClient: *validatorClient,
+		Keymanager:      kManager,
+		RawPubKeys:      rawPubKeys,
+		Epoch:           epoch,
+	})
+	if err != nil {
+		return err
+	}
+	enc, err := json.MarshalIndent(exits, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode pre-signed exits")
+	}
+	if err := fileutil.WriteFile(outputPath, enc); err != nil {
+		return errors.Wrapf(err, "could not write pre-signed exits to %s", outputPath)
+	}
+	log.WithField("epoch", epoch).Infof("Wrote %d pre-signed voluntary exits to %s", len(exits), outputPath)
+	return nil
+}
+
+// PreSignVoluntaryExits signs voluntary exits at the configured epoch for the given keys.
+func PreSignVoluntaryExits(ctx context.Context, cfg PreSignExitCfg) ([]*PreSignedExit, error) {
+	exits := make([]*PreSignedExit, len(cfg.RawPubKeys))
+	for i, key := range cfg.RawPubKeys {
+		signedExit, err := client.SignExit(ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, key, cfg.Epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not pre-sign voluntary exit for account %#x", bytesutil.Trunc(key))
+		}
+		exits[i] = preSignedExitFromProto(key, signedExit)
+	}
+	return exits, nil
+}
+
+// SubmitExitsCli submits the pre-signed voluntary exits of a file to a beacon node and
+// reports the position of each validator in the exit queue.
+func SubmitExitsCli(cliCtx *cli.Context) error {
+	inputPath := cliCtx.String(flags.PreSignedExitsFileFlag.Name)
+	if inputPath == "" {
+		return fmt.Errorf("no file of pre-signed exits specified, use --%s", flags.PreSignedExitsFileFlag.Name)
+	}
+	expanded, err := fileutil.ExpandPath(inputPath)
+	if err != nil {
+		return err
+	}
+	enc, err := ioutil.ReadFile(expanded) // #nosec G304
+	if err != nil {
+		return errors.Wrapf(err, "could not read pre-signed exits from %s", inputPath)
+	}
+	var exits []*PreSignedExit
+	if err := json.Unmarshal(enc, &exits); err != nil {
+		return errors.Wrapf(err, "could not decode pre-signed exits from %s", inputPath)
+	}
+
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return err
+	}
+	submissions, err := SubmitPreSignedExits(cliCtx.Context, SubmitExitCfg{
+		ValidatorClient: ethpb.NewBeaconNodeValidatorClient(conn),
+		NodeClient:      ethpb.NewNodeClient(conn),
+		BeaconClient:    ethpb.NewBeaconChainClient(conn),
+		Exits:           exits,
+	})
+	if err != nil {
+		return err
+	}
+	displaySubmittedExits(submissions)
+	return nil
+}
+
+// SubmitPreSignedExits broadcasts pre-signed voluntary exits through a beacon node. Exits of
+// validators which are not active, or which are not valid yet, are not submitted.
+func SubmitPreSignedExits(ctx context.Context, cfg SubmitExitCfg) ([]*ExitSubmission, error) {
+	pubKeys := make([][]byte, len(cfg.Exits))
+	signedExits := make([]*ethpb.SignedVoluntaryExit, len(cfg.Exits))
+	for i, e := range cfg.Exits {
+		var err error
+		pubKeys[i], signedExits[i], err = e.toProto()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pre-signed exit at position %d", i)
+		}
+	}
+	epoch, err := currentEpoch(ctx, cfg.NodeClient)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := cfg.ValidatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: pubKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get validator statuses failed")
+	}
+	if len(statuses.Statuses) != len(pubKeys) || len(statuses.Indices) != len(pubKeys) {
+		return nil, errors.New("beacon node returned a status for a different number of validators than requested")
+	}
+
+	submissions := make([]*ExitSubmission, len(pubKeys))
+	for i, signedExit := range signedExits {
+		s := &ExitSubmission{PublicKey: pubKeys[i], Status: statuses.Statuses[i].Status}
+		submissions[i] = s
+		switch {
+		case s.Status != ethpb.ValidatorStatus_ACTIVE:
+			s.Reason = fmt.Sprintf("validator status is %s", s.Status)
+		case statuses.Indices[i] != signedExit.Exit.ValidatorIndex:
+			s.Reason = fmt.Sprintf(
+				"exit is signed for validator index %d, but the validator has index %d",
+				signedExit.Exit.ValidatorIndex, statuses.Indices[i],
+			)
+		case signedExit.Exit.Epoch > epoch:
+			s.Reason = fmt.Sprintf("exit is not valid before epoch %d, current epoch is %d", signedExit.Exit.Epoch, epoch)
+		default:
+			if _, err := cfg.ValidatorClient.ProposeExit(ctx, signedExit); err != nil {
+				s.Reason = err.Error()
+				continue
+			}
+			s.Submitted = true
+		}
+	}
+
+	queue, err := cfg.BeaconClient.GetValidatorQueue(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).Warn("Could not get exit queue of submitted voluntary exits")
+		return submissions, nil
+	}
+	positions := make(map[types.ValidatorIndex]uint64, len(queue.ExitValidatorIndices))
+	for i, index := range queue.ExitValidatorIndices {
+		positions[index] = uint64(i) + 1
+	}
+	for i, s := range submissions {
+		s.QueuePosition = positions[statuses.Indices[i]]
+	}
+	return submissions, nil
+}
+
+func currentEpoch(ctx context.Context, nodeClient ethpb.NodeClient) (types.Epoch, error) {
+	genesisResponse, err := nodeClient.GetGenesis(ctx, &emptypb.Empty{})
+	if err != nil {
+		return 0, errors.Wrap(err, "gRPC call to get genesis time failed")
+	}
+	return slotutil.EpochsSinceGenesis(time.Unix(genesisResponse.GenesisTime.Seconds, 0)), nil
+}
+
+func preSignedExitFromProto(pubKey []byte, signedExit *ethpb.SignedVoluntaryExit) *PreSignedExit {
+	return &PreSignedExit{
+		PublicKey: hexutil.Encode(pubKey),
+		Message: &PreSignedExitMessage{
+			Epoch:          strconv.FormatUint(uint64(signedExit.Exit.Epoch), 10),
+			ValidatorIndex: strconv.FormatUint(uint64(signedExit.Exit.ValidatorIndex), 10),
+		},
+		Signature: hexutil.Encode(signedExit.Signature),
+	}
+}
+
+func (e *PreSignedExit) toProto() ([]byte, *ethpb.SignedVoluntaryExit, error) {
+	if e == nil || e.Message == nil {
+		return nil, nil, errors.New("missing exit message")
+	}
+	pubKey, err := hexutil.Decode(e.PublicKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode public key")
+	}
+	if len(pubKey) != 48 {
+		return nil, nil, fmt.Errorf("public key %#x is not 48 bytes long", pubKey)
+	}
+	epoch, err := strconv.ParseUint(e.Message.Epoch, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse epoch")
+	}
+	index, err := strconv.ParseUint(e.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse validator index")
+	}
+	sig, err := hexutil.Decode(e.Signature)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode signature")
+	}
+	if len(sig) != 96 {
+		return nil, nil, fmt.Errorf("signature %#x is not 96 bytes long", sig)
+	}
+	return pubKey, &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: types.Epoch(epoch), ValidatorIndex: types.ValidatorIndex(index)},
+		Signature: sig,
+	}, nil
+}
+
+func displaySubmittedExits(submissions []*ExitSubmission) {
+	for _, s := range submissions {
+		fields := log.WithField("publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(s.PublicKey))).WithField("status", s.Status)
+		switch {
+		case !s.Submitted:
+			fields.Warnf("Did not submit voluntary exit: %s", s.Reason)
+		case s.QueuePosition == 0:
+			fields.Info("Submitted voluntary exit, waiting for it to be included in a block")
+		default:
+			fields.WithField("queuePosition", s.QueuePosition).Info("Submitted voluntary exit")
+		}
+	}
+}
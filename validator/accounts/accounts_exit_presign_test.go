@@ -0,0 +1,107 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPreSignedExit_RoundTrip(t *testing.T) {
+	pubKey := bytesutil.PadTo([]byte("key"), 48)
+	signedExit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 1024, ValidatorIndex: 7},
+		Signature: bytesutil.PadTo([]byte("sig"), 96),
+	}
+	e := preSignedExitFromProto(pubKey, signedExit)
+	assert.Equal(t, "1024", e.Message.Epoch)
+	assert.Equal(t, "7", e.Message.ValidatorIndex)
+	decodedKey, decoded, err := e.toProto()
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKey, decodedKey)
+	assert.DeepEqual(t, signedExit, decoded)
+
+	e.Signature = "0x01"
+	_, _, err = e.toProto()
+	assert.ErrorContains(t, "is not 96 bytes long", err)
+	_, _, err = (&PreSignedExit{PublicKey: e.PublicKey}).toProto()
+	assert.ErrorContains(t, "missing exit message", err)
+}
+
+func TestSubmitPreSignedExits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	mockNodeClient := mock.NewMockNodeClient(ctrl)
+	mockBeaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	// Genesis is 10 epochs ago.
+	epochDuration := time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	genesisTime := time.Now().Add(-10*epochDuration - time.Second)
+	mockNodeClient.EXPECT().
+		GetGenesis(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Genesis{GenesisTime: timestamppb.New(genesisTime)}, nil)
+
+	exit := func(key byte, index types.ValidatorIndex, epoch types.Epoch) *PreSignedExit {
+		return preSignedExitFromProto(bytesutil.PadTo([]byte{key}, 48), &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: index},
+			Signature: make([]byte, 96),
+		})
+	}
+	exits := []*PreSignedExit{
+		exit(1, 1, 5),
+		exit(2, 2, 5),
+		exit(3, 3, 11),
+		exit(4, 40, 5),
+		exit(5, 5, 0),
+	}
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{
+			Statuses: []*ethpb.ValidatorStatusResponse{
+				{Status: ethpb.ValidatorStatus_ACTIVE},
+				{Status: ethpb.ValidatorStatus_EXITING},
+				{Status: ethpb.ValidatorStatus_ACTIVE},
+				{Status: ethpb.ValidatorStatus_ACTIVE},
+				{Status: ethpb.ValidatorStatus_ACTIVE},
+			},
+			Indices: []types.ValidatorIndex{1, 2, 3, 4, 5},
+		}, nil)
+	mockValidatorClient.EXPECT().
+		ProposeExit(gomock.Any(), gomock.AssignableToTypeOf(&ethpb.SignedVoluntaryExit{})).
+		Return(&ethpb.ProposeExitResponse{}, nil).
+		Times(2)
+	mockBeaconClient.EXPECT().
+		GetValidatorQueue(gomock.Any(), gomock.Any()).
+		Return(&ethpb.ValidatorQueue{ExitValidatorIndices: []types.ValidatorIndex{9, 2, 1}}, nil)
+
+	submissions, err := SubmitPreSignedExits(context.Background(), SubmitExitCfg{
+		ValidatorClient: mockValidatorClient,
+		NodeClient:      mockNodeClient,
+		BeaconClient:    mockBeaconClient,
+		Exits:           exits,
+	})
+	require.NoError(t, err)
+	require.Equal(t, len(exits), len(submissions))
+
+	assert.Equal(t, true, submissions[0].Submitted)
+	assert.Equal(t, uint64(3), submissions[0].QueuePosition)
+	assert.Equal(t, false, submissions[1].Submitted)
+	assert.Equal(t, "validator status is EXITING", submissions[1].Reason)
+	assert.Equal(t, uint64(2), submissions[1].QueuePosition)
+	assert.Equal(t, false, submissions[2].Submitted)
+	assert.Equal(t, "exit is not valid before epoch 11, current epoch is 10", submissions[2].Reason)
+	assert.Equal(t, false, submissions[3].Submitted)
+	assert.Equal(t, "exit is signed for validator index 40, but the validator has index 4", submissions[3].Reason)
+	assert.Equal(t, true, submissions[4].Submitted)
+	assert.Equal(t, uint64(0), submissions[4].QueuePosition)
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -73,6 +75,22 @@ func ListAccountsCli(cliCtx *cli.Context) error {
 		if err := listRemoteKeymanagerAccounts(cliCtx.Context, w, km, km.KeymanagerOpts()); err != nil {
 			return errors.Wrap(err, "could not list validator accounts with remote keymanager")
 		}
+	case keymanager.Web3Signer:
+		km, ok := km.(*web3signer.Keymanager)
+		if !ok {
+			return errors.New("could not assert keymanager interface to concrete type")
+		}
+		if err := listRemoteKeymanagerAccounts(cliCtx.Context, w, km, km.KeymanagerOpts()); err != nil {
+			return errors.Wrap(err, "could not list validator accounts with web3signer keymanager")
+		}
+	case keymanager.Threshold:
+		km, ok := km.(*threshold.Keymanager)
+		if !ok {
+			return errors.New("could not assert keymanager interface to concrete type")
+		}
+		if err := listRemoteKeymanagerAccounts(cliCtx.Context, w, km, km.KeymanagerOpts()); err != nil {
+			return errors.Wrap(err, "could not list validator accounts with threshold keymanager")
+		}
 	default:
 		return fmt.Errorf(errKeymanagerNotSupported, w.KeymanagerKind().String())
 	}
@@ -189,7 +207,7 @@ func listRemoteKeymanagerAccounts(
 	ctx context.Context,
 	w *wallet.Wallet,
 	keymanager keymanager.IKeymanager,
-	opts *remote.KeymanagerOpts,
+	opts fmt.Stringer,
 ) error {
 	au := aurora.NewAurora(true)
 	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("remote signer").Bold())
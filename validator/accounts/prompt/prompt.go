@@ -11,7 +11,10 @@ import (
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/shared/secrets"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -92,8 +95,8 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	if requireTls && key == "" {
 		key, err = promptutil.ValidatePrompt(
 			os.Stdin,
-			"Path to TLS key (such as /path/to/client.key)",
-			validateCertPath)
+			"Path to TLS key (such as /path/to/client.key) or secret reference (such as env:CLIENT_KEY)",
+			validateKeyPath)
 		if err != nil {
 			return nil, err
 		}
@@ -115,7 +118,9 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 			return nil, errors.Wrapf(err, "could not determine absolute path for %s", crt)
 		}
 	}
-	if key != "" {
+	if key != "" && secrets.IsReference(key) {
+		keyPath = strings.TrimRight(key, "\r\n")
+	} else if key != "" {
 		keyPath, err = fileutil.ExpandPath(strings.TrimRight(key, "\r\n"))
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not determine absolute path for %s", crt)
@@ -141,6 +146,101 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	url := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	gvr := cliCtx.String(flags.Web3SignerGenesisValidatorsRootFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if url == "" {
+		url, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as https://signer.example.com:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if gvr == "" {
+		gvr, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Genesis validators root of the chain (such as 0x4b36...)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	newCfg := &web3signer.KeymanagerOpts{
+		URL:                   strings.TrimSpace(url),
+		PublicKeys:            cliCtx.StringSlice(flags.Web3SignerPublicKeysFlag.Name),
+		GenesisValidatorsRoot: strings.TrimSpace(gvr),
+	}
+	// Certificates are optional, as the signer may be trusted by the system roots.
+	tlsCfg := &web3signer.TLSConfig{}
+	paths := []struct {
+		flag *cli.StringFlag
+		dst  *string
+	}{
+		{flags.RemoteSignerCertPathFlag, &tlsCfg.ClientCertPath},
+		{flags.RemoteSignerKeyPathFlag, &tlsCfg.ClientKeyPath},
+		{flags.RemoteSignerCACertPathFlag, &tlsCfg.CACertPath},
+	}
+	for _, p := range paths {
+		raw := cliCtx.String(p.flag.Name)
+		if raw == "" {
+			continue
+		}
+		newCfg.TLS = tlsCfg
+		if secrets.IsReference(raw) {
+			*p.dst = strings.TrimRight(raw, "\r\n")
+			continue
+		}
+		*p.dst, err = fileutil.ExpandPath(strings.TrimRight(raw, "\r\n"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", raw)
+		}
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+// InputThresholdKeymanagerConfig via the cli, read from a JSON configuration file.
+func InputThresholdKeymanagerConfig(cliCtx *cli.Context) (*threshold.KeymanagerOpts, error) {
+	configPath := cliCtx.String(flags.ThresholdKeymanagerConfigFlag.Name)
+	var err error
+	if configPath == "" {
+		configPath, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Path to the threshold keymanager configuration file (such as /path/to/threshold.json)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	configPath, err = fileutil.ExpandPath(strings.TrimRight(configPath, "\r\n"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine absolute path for %s", configPath)
+	}
+	f, err := os.Open(configPath) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open threshold keymanager configuration")
+	}
+	newCfg, err := threshold.UnmarshalOptionsFile(f)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+// validateKeyPath accepts references to secrets of secret providers besides key files.
+func validateKeyPath(input string) error {
+	if secrets.IsReference(input) {
+		return nil
+	}
+	return validateCertPath(input)
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
@@ -13,18 +13,21 @@ import (
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/shared/secrets"
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
 const (
-	// KeymanagerConfigFileName for the keymanager used by the wallet: imported, derived, or remote.
+	// KeymanagerConfigFileName for the keymanager used by the wallet: imported, derived, remote, web3signer or threshold.
 	KeymanagerConfigFileName = "keymanageropts.json"
 	// NewWalletPasswordPromptText for wallet creation.
 	NewWalletPasswordPromptText = "New wallet password"
@@ -52,9 +55,11 @@ var (
 	)
 	// KeymanagerKindSelections as friendly text.
 	KeymanagerKindSelections = map[keymanager.Kind]string{
-		keymanager.Imported: "Imported Wallet (Recommended)",
-		keymanager.Derived:  "HD Wallet",
-		keymanager.Remote:   "Remote Signing Wallet (Advanced)",
+		keymanager.Imported:   "Imported Wallet (Recommended)",
+		keymanager.Derived:    "HD Wallet",
+		keymanager.Remote:     "Remote Signing Wallet (Advanced)",
+		keymanager.Web3Signer: "Web3Signer HTTP Remote Signing Wallet (Advanced)",
+		keymanager.Threshold:  "Threshold Signing Wallet (Advanced)",
 	}
 	// ValidateExistingPass checks that an input cannot be empty.
 	ValidateExistingPass = func(input string) error {
@@ -290,6 +295,32 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize remote keymanager")
 		}
+	case keymanager.Web3Signer:
+		configFile, err := w.ReadKeymanagerConfigFromDisk(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keymanager config")
+		}
+		opts, err := web3signer.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		km, err = web3signer.NewKeymanager(ctx, &web3signer.SetupConfig{Opts: opts})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
+		}
+	case keymanager.Threshold:
+		configFile, err := w.ReadKeymanagerConfigFromDisk(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keymanager config")
+		}
+		opts, err := threshold.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		km, err = threshold.NewKeymanager(ctx, &threshold.SetupConfig{Opts: opts})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize threshold keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
@@ -424,8 +455,8 @@ func InputPassword(
 	passwordValidator func(input string) error,
 ) (string, error) {
 	if cliCtx.IsSet(passwordFileFlag.Name) {
-		passwordFilePathInput := cliCtx.String(passwordFileFlag.Name)
-		data, err := fileutil.ReadFileAsBytes(passwordFilePathInput)
+		// The flag may reference a secret of a secret provider instead of a file.
+		data, err := secrets.Read(cliCtx.Context, cliCtx.String(passwordFileFlag.Name))
 		if err != nil {
 			return "", errors.Wrap(err, "could not read file as bytes")
 		}
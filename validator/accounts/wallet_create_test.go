@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"io/ioutil"
 	"os"
@@ -18,6 +19,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
@@ -270,3 +273,117 @@ func TestCreateWallet_Remote(t *testing.T) {
 	// We assert the created configuration was as desired.
 	assert.DeepEqual(t, wantCfg, cfg)
 }
+
+func TestCreateWallet_Web3Signer(t *testing.T) {
+	walletDir, _, walletPasswordFile := setupWalletAndPasswordsDir(t)
+	wantCfg := &web3signer.KeymanagerOpts{
+		URL:                   "https://signer.example.com:9000",
+		PublicKeys:            []string{"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"},
+		GenesisValidatorsRoot: "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673",
+		TLS: &web3signer.TLSConfig{
+			CACertPath: "/tmp/ca.crt",
+		},
+	}
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	keymanagerKind := "web3signer"
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, walletDir, "")
+	set.String(flags.KeymanagerKindFlag.Name, keymanagerKind, "")
+	set.String(flags.Web3SignerURLFlag.Name, wantCfg.URL, "")
+	set.Var(cli.NewStringSlice(), flags.Web3SignerPublicKeysFlag.Name, "")
+	set.String(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot, "")
+	set.String(flags.RemoteSignerCACertPathFlag.Name, wantCfg.TLS.CACertPath, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, walletPasswordFile))
+	assert.NoError(t, set.Set(flags.KeymanagerKindFlag.Name, keymanagerKind))
+	assert.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, wantCfg.URL))
+	assert.NoError(t, set.Set(flags.Web3SignerPublicKeysFlag.Name, wantCfg.PublicKeys[0]))
+	assert.NoError(t, set.Set(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot))
+	assert.NoError(t, set.Set(flags.RemoteSignerCACertPathFlag.Name, wantCfg.TLS.CACertPath))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	// We attempt to create the wallet.
+	_, err := CreateAndSaveWalletCli(cliCtx)
+	require.NoError(t, err)
+
+	// We attempt to open the newly created wallet.
+	ctx := context.Background()
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir: walletDir,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, keymanager.Web3Signer, w.KeymanagerKind())
+
+	// We read the keymanager config for the newly created wallet.
+	encoded, err := w.ReadKeymanagerConfigFromDisk(ctx)
+	assert.NoError(t, err)
+	cfg, err := web3signer.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+
+	// We assert the created configuration was as desired.
+	assert.DeepEqual(t, wantCfg, cfg)
+}
+
+func TestCreateWallet_Threshold(t *testing.T) {
+	walletDir, _, walletPasswordFile := setupWalletAndPasswordsDir(t)
+	wantCfg := &threshold.KeymanagerOpts{
+		Validators: []*threshold.ValidatorOpts{
+			{
+				PublicKey: "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c",
+				Threshold: 1,
+				Peers: []*threshold.PeerOpts{
+					{
+						Index:     1,
+						PublicKey: "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b",
+						Address:   "signer-1.example.com:4000",
+					},
+				},
+			},
+		},
+		RemoteCertificate: &remote.CertificateConfig{
+			RequireTls:     true,
+			ClientCertPath: "/tmp/client.crt",
+			ClientKeyPath:  "/tmp/client.key",
+			CACertPath:     "/tmp/ca.crt",
+		},
+	}
+	enc, err := json.Marshal(wantCfg)
+	require.NoError(t, err)
+	configPath := filepath.Join(t.TempDir(), "threshold.json")
+	require.NoError(t, ioutil.WriteFile(configPath, enc, os.ModePerm))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	keymanagerKind := "threshold"
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, walletDir, "")
+	set.String(flags.KeymanagerKindFlag.Name, keymanagerKind, "")
+	set.String(flags.ThresholdKeymanagerConfigFlag.Name, configPath, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, walletPasswordFile))
+	assert.NoError(t, set.Set(flags.KeymanagerKindFlag.Name, keymanagerKind))
+	assert.NoError(t, set.Set(flags.ThresholdKeymanagerConfigFlag.Name, configPath))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	// We attempt to create the wallet.
+	_, err = CreateAndSaveWalletCli(cliCtx)
+	require.NoError(t, err)
+
+	// We attempt to open the newly created wallet.
+	ctx := context.Background()
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir: walletDir,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, keymanager.Threshold, w.KeymanagerKind())
+
+	// We read the keymanager config for the newly created wallet.
+	encoded, err := w.ReadKeymanagerConfigFromDisk(ctx)
+	assert.NoError(t, err)
+	cfg, err := threshold.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+
+	// We assert the created configuration was as desired.
+	assert.DeepEqual(t, wantCfg, cfg)
+}
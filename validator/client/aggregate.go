@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,12 +27,15 @@ import (
 func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot, pubKey [48]byte) {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitAggregateAndProof")
 	defer span.End()
+	entry := v.startDuty(iface.RoleAggregator, slot, pubKey)
+	defer entry.done()
 
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		entry.fail(err)
 		log.Errorf("Could not fetch validator assignment: %v", err)
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -51,6 +55,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 
 	slotSig, err := v.signSlotWithSelectionProof(ctx, pubKey, slot)
 	if err != nil {
+		entry.fail(err)
 		log.Errorf("Could not sign slot: %v", err)
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -74,6 +79,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		if ok && s.Code() == codes.NotFound {
 			log.WithField("slot", slot).WithError(err).Warn("No attestations to aggregate")
 		} else {
+			entry.fail(err)
 			log.WithField("slot", slot).WithError(err).Error("Could not submit slot signature to beacon node")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -85,9 +91,11 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 
 	sig, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof)
 	if err != nil {
+		entry.fail(err)
 		log.Errorf("Could not sign aggregate and proof: %v", err)
 		return
 	}
+	entry.signed()
 	_, err = v.validatorClient.SubmitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
 		SignedAggregateAndProof: &ethpb.SignedAggregateAttestationAndProof{
 			Message:   res.AggregateAndProof,
@@ -95,14 +103,17 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		},
 	})
 	if err != nil {
+		entry.fail(err)
 		log.Errorf("Could not submit signed aggregate and proof to beacon node: %v", err)
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	entry.submitted()
 
 	if err := v.addIndicesToLog(duty); err != nil {
+		entry.fail(err)
 		log.Errorf("Could not add aggregator indices to logs: %v", err)
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
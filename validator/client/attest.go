@@ -34,18 +34,22 @@ import (
 func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubKey [48]byte) {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitAttestation")
 	defer span.End()
+	entry := v.startDuty(iface.RoleAttester, slot, pubKey)
+	defer entry.done()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
 	v.waitOneThirdOrValidBlock(ctx, slot)
 
 	var b strings.Builder
 	if err := b.WriteByte(byte(iface.RoleAttester)); err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not write role byte for lock key")
 		traceutil.AnnotateError(span, err)
 		return
 	}
 	_, err := b.Write(pubKey[:])
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not write pubkey bytes for lock key")
 		traceutil.AnnotateError(span, err)
 		return
@@ -58,6 +62,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).WithField("slot", slot)
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not fetch validator assignment")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -76,6 +81,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	}
 	data, err := v.validatorClient.GetAttestationData(ctx, req)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not request attestation to sign at slot")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -91,6 +97,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 
 	_, signingRoot, err := v.getDomainAndSigningRoot(ctx, indexedAtt.Data)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not get domain and signing root from attestation")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -101,6 +108,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 
 	sig, _, err := v.signAtt(ctx, pubKey, data)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not sign attestation")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -108,6 +116,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		traceutil.AnnotateError(span, err)
 		return
 	}
+	entry.signed()
 
 	var indexInCommittee uint64
 	var found bool
@@ -119,6 +128,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		}
 	}
 	if !found {
+		entry.fail(fmt.Errorf("validator ID %d not found in committee", duty.ValidatorIndex))
 		log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -137,6 +147,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	// Set the signature of the attestation and send it out to the beacon node.
 	indexedAtt.Signature = sig
 	if err := v.slashableAttestationCheck(ctx, indexedAtt, pubKey, signingRoot); err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed attestation slashing protection check")
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
@@ -146,6 +157,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	}
 	attResp, err := v.validatorClient.ProposeAttestation(ctx, attestation)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not submit attestation to beacon node")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -153,8 +165,10 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		traceutil.AnnotateError(span, err)
 		return
 	}
+	entry.submitted()
 
 	if err := v.saveAttesterIndexToData(data, duty.ValidatorIndex); err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not save validator index for logging")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -0,0 +1,338 @@
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxHeadSlotLag is the number of slots a beacon node's head may trail the best
+// head among the configured beacon nodes before it is considered unhealthy.
+const maxHeadSlotLag = types.Slot(2)
+
+// broadcastMethods are the gRPC methods which publish duties to the network. When
+// broadcasting is enabled, they are sent through every healthy beacon node.
+var broadcastMethods = map[string]bool{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock":                        true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeAttestation":                  true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitSignedAggregateSelectionProof": true,
+	"/ethereum.prysm.v2.BeaconNodeValidator/ProposeBlock":                            true,
+	"/ethereum.prysm.v2.BeaconNodeValidator/ProposeBlockAltair":                      true,
+	"/ethereum.prysm.v2.BeaconNodeValidator/ProposeAttestation":                      true,
+	"/ethereum.prysm.v2.BeaconNodeValidator/SubmitSignedAggregateSelectionProof":     true,
+}
+
+// beaconNodeHealth is the outcome of the latest health check of a beacon node.
+type beaconNodeHealth struct {
+	reachable bool
+	syncing   bool
+	headSlot  types.Slot
+	// peers is -1 when the beacon node did not report its peers.
+	peers   int
+	latency time.Duration
+}
+
+// beaconNode is a single beacon node the validator client is connected to.
+type beaconNode struct {
+	endpoint     string
+	conn         grpc.ClientConnInterface
+	closer       io.Closer
+	nodeClient   ethpb.NodeClient
+	beaconClient ethpb.BeaconChainClient
+	health       beaconNodeHealth
+}
+
+func newBeaconNode(endpoint string, conn *grpc.ClientConn) *beaconNode {
+	return &beaconNode{
+		endpoint:     endpoint,
+		conn:         conn,
+		closer:       conn,
+		nodeClient:   ethpb.NewNodeClient(conn),
+		beaconClient: ethpb.NewBeaconChainClient(conn),
+	}
+}
+
+// failoverConn is a gRPC client connection routing every call to the healthiest of
+// several beacon nodes. The beacon nodes are health checked a few times per slot, so
+// that duties move to another beacon node in the middle of an epoch as soon as the
+// active one stops syncing, falls behind the head of the others or becomes unreachable.
+// Optionally, blocks and attestations are published through all healthy beacon nodes.
+type failoverConn struct {
+	nodes         []*beaconNode
+	broadcast     bool
+	checkInterval time.Duration
+	lock          sync.RWMutex
+	active        int
+}
+
+func newFailoverConn(nodes []*beaconNode, broadcast bool) *failoverConn {
+	return &failoverConn{
+		nodes:         nodes,
+		broadcast:     broadcast,
+		checkInterval: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 3,
+	}
+}
+
+// Invoke sends a unary call to the active beacon node, or to all healthy beacon
+// nodes for broadcast methods.
+func (f *failoverConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	if f.broadcast && broadcastMethods[method] {
+		return f.invokeAll(ctx, method, args, reply, opts...)
+	}
+	n := f.activeNode()
+	err := n.conn.Invoke(ctx, method, args, reply, opts...)
+	if status.Code(err) != codes.Unavailable {
+		return err
+	}
+	// Fail over right away rather than waiting for the next health check.
+	next := f.failover(n)
+	if next == nil {
+		return err
+	}
+	return next.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream opens a stream on the active beacon node. Streams stay on the beacon node
+// they were opened on and are re-opened on the active one once they fail.
+func (f *failoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return f.activeNode().conn.NewStream(ctx, desc, method, opts...)
+}
+
+// Close the connections to all beacon nodes.
+func (f *failoverConn) Close() error {
+	var firstErr error
+	for _, n := range f.nodes {
+		if n.closer == nil {
+			continue
+		}
+		if err := n.closer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// invokeAll sends the call to the active beacon node and every other healthy beacon
+// node in parallel. The call succeeds if any of the beacon nodes accepted it, the reply
+// of the active beacon node being preferred.
+func (f *failoverConn) invokeAll(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	f.lock.RLock()
+	active := f.nodes[f.active]
+	targets := []*beaconNode{active}
+	for _, n := range f.nodes {
+		if n != active && f.isHealthy(n) {
+			targets = append(targets, n)
+		}
+	}
+	f.lock.RUnlock()
+	if len(targets) == 1 {
+		return active.conn.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return active.conn.Invoke(ctx, method, args, reply, opts...)
+	}
+	replies := make([]proto.Message, len(targets))
+	errs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, n := range targets {
+		replies[i] = proto.Clone(msg)
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			errs[i] = n.conn.Invoke(ctx, method, args, replies[i], opts...)
+			if errs[i] != nil {
+				log.WithError(errs[i]).WithFields(logrus.Fields{
+					"endpoint": n.endpoint,
+					"method":   method,
+				}).Debug("Could not broadcast through beacon node")
+			}
+		}(i, n)
+	}
+	wg.Wait()
+	for i := range targets {
+		if errs[i] == nil {
+			proto.Reset(msg)
+			proto.Merge(msg, replies[i])
+			return nil
+		}
+	}
+	return errs[0]
+}
+
+func (f *failoverConn) activeNode() *beaconNode {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.nodes[f.active]
+}
+
+// failover marks the given beacon node unreachable and switches to the best other one.
+// Nil is returned when there is no other beacon node to fail over to.
+func (f *failoverConn) failover(failed *beaconNode) *beaconNode {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	failed.health.reachable = false
+	f.selectActive()
+	n := f.nodes[f.active]
+	if n == failed {
+		return nil
+	}
+	return n
+}
+
+// run health checks the beacon nodes until the context is canceled.
+func (f *failoverConn) run(ctx context.Context) {
+	if len(f.nodes) < 2 {
+		return
+	}
+	ticker := time.NewTicker(f.checkInterval)
+	defer ticker.Stop()
+	for {
+		f.checkHealth(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkHealth queries all beacon nodes and selects the active one.
+func (f *failoverConn) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, f.checkInterval)
+	defer cancel()
+	results := make([]beaconNodeHealth, len(f.nodes))
+	var wg sync.WaitGroup
+	for i, n := range f.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			results[i] = queryBeaconNodeHealth(ctx, n)
+		}(i, n)
+	}
+	wg.Wait()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for i, n := range f.nodes {
+		n.health = results[i]
+	}
+	f.selectActive()
+	for _, n := range f.nodes {
+		healthy := 0.0
+		if f.isHealthy(n) {
+			healthy = 1
+		}
+		beaconNodeHealthyGaugeVec.WithLabelValues(n.endpoint).Set(healthy)
+		beaconNodeHeadSlotGaugeVec.WithLabelValues(n.endpoint).Set(float64(n.health.headSlot))
+		beaconNodeLatencyGaugeVec.WithLabelValues(n.endpoint).Set(n.health.latency.Seconds())
+	}
+}
+
+func queryBeaconNodeHealth(ctx context.Context, n *beaconNode) beaconNodeHealth {
+	h := beaconNodeHealth{peers: -1}
+	start := time.Now()
+	syncStatus, err := n.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get sync status of beacon node")
+		return h
+	}
+	h.latency = time.Since(start)
+	h.syncing = syncStatus.Syncing
+	head, err := n.beaconClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get chain head of beacon node")
+		return h
+	}
+	h.reachable = true
+	h.headSlot = head.HeadSlot
+	peers, err := n.nodeClient.ListPeers(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not list peers of beacon node")
+		return h
+	}
+	h.peers = len(peers.Peers)
+	return h
+}
+
+// bestHeadSlot returns the highest head slot among the reachable and synced beacon nodes.
+func (f *failoverConn) bestHeadSlot() types.Slot {
+	var best types.Slot
+	for _, n := range f.nodes {
+		if n.health.reachable && !n.health.syncing && n.health.headSlot > best {
+			best = n.health.headSlot
+		}
+	}
+	return best
+}
+
+// isHealthy returns whether the beacon node can be given duties: it is reachable, synced,
+// connected to the network and its head does not trail the best head. The lock must be held.
+func (f *failoverConn) isHealthy(n *beaconNode) bool {
+	h := n.health
+	if !h.reachable || h.syncing || h.peers == 0 {
+		return false
+	}
+	return h.headSlot+maxHeadSlotLag >= f.bestHeadSlot()
+}
+
+// selectActive keeps the active beacon node as long as it is healthy, so that duties do
+// not move back and forth between beacon nodes of similar health. Otherwise, the healthy
+// beacon node with the highest head is picked, ties being broken by peer count and then
+// latency. The lock must be held.
+func (f *failoverConn) selectActive() {
+	current := f.nodes[f.active]
+	if f.isHealthy(current) {
+		return
+	}
+	best := -1
+	for i, n := range f.nodes {
+		if !n.health.reachable {
+			continue
+		}
+		if best == -1 || betterBeaconNode(f.isHealthy(n), n.health, f.isHealthy(f.nodes[best]), f.nodes[best].health) {
+			best = i
+		}
+	}
+	if best == -1 || best == f.active {
+		return
+	}
+	next := f.nodes[best]
+	log.WithFields(logrus.Fields{
+		"previous": current.endpoint,
+		"endpoint": next.endpoint,
+		"headSlot": next.health.headSlot,
+		"peers":    next.health.peers,
+		"latency":  next.health.latency,
+	}).Warn("Switched to another beacon node")
+	beaconNodeFailoversCounter.Inc()
+	f.active = best
+}
+
+func betterBeaconNode(aHealthy bool, a beaconNodeHealth, bHealthy bool, b beaconNodeHealth) bool {
+	if aHealthy != bHealthy {
+		return aHealthy
+	}
+	if a.syncing != b.syncing {
+		return !a.syncing
+	}
+	if a.headSlot != b.headSlot {
+		return a.headSlot > b.headSlot
+	}
+	if a.peers != b.peers {
+		return a.peers > b.peers
+	}
+	return a.latency < b.latency
+}
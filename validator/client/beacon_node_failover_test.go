@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeBeaconNodeConn struct {
+	lock    sync.Mutex
+	err     error
+	root    []byte
+	methods []string
+}
+
+func (c *fakeBeaconNodeConn) Invoke(_ context.Context, method string, _, reply interface{}, _ ...grpc.CallOption) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.methods = append(c.methods, method)
+	if c.err != nil {
+		return c.err
+	}
+	if resp, ok := reply.(*ethpb.AttestResponse); ok {
+		resp.AttestationDataRoot = c.root
+	}
+	return nil
+}
+
+func (*fakeBeaconNodeConn) NewStream(_ context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *fakeBeaconNodeConn) calls() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.methods)
+}
+
+func testBeaconNodes(n int) ([]*beaconNode, []*fakeBeaconNodeConn) {
+	nodes := make([]*beaconNode, n)
+	conns := make([]*fakeBeaconNodeConn, n)
+	for i := range nodes {
+		conns[i] = &fakeBeaconNodeConn{root: []byte{byte(i)}}
+		nodes[i] = &beaconNode{endpoint: string(rune('a' + i)), conn: conns[i]}
+	}
+	return nodes, conns
+}
+
+func TestFailoverConn_SelectActive(t *testing.T) {
+	healthy := func(head types.Slot) beaconNodeHealth {
+		return beaconNodeHealth{reachable: true, headSlot: head, peers: 10}
+	}
+	tests := []struct {
+		name   string
+		health []beaconNodeHealth
+		active int
+	}{
+		{
+			name:   "keeps healthy active node",
+			health: []beaconNodeHealth{healthy(99), healthy(100)},
+			active: 0,
+		},
+		{
+			name:   "unreachable",
+			health: []beaconNodeHealth{{}, healthy(100)},
+			active: 1,
+		},
+		{
+			name:   "syncing",
+			health: []beaconNodeHealth{{reachable: true, syncing: true, headSlot: 100, peers: 10}, healthy(90)},
+			active: 1,
+		},
+		{
+			name:   "stalled head",
+			health: []beaconNodeHealth{healthy(97), healthy(90), healthy(100)},
+			active: 2,
+		},
+		{
+			name:   "no peers",
+			health: []beaconNodeHealth{{reachable: true, headSlot: 100}, healthy(100)},
+			active: 1,
+		},
+		{
+			name: "prefers lower latency",
+			health: []beaconNodeHealth{
+				{},
+				{reachable: true, headSlot: 100, peers: 10, latency: 20},
+				{reachable: true, headSlot: 100, peers: 10, latency: 10},
+			},
+			active: 2,
+		},
+		{
+			name:   "no healthy node",
+			health: []beaconNodeHealth{{}, {reachable: true, syncing: true, headSlot: 50}, {reachable: true, syncing: true, headSlot: 60}},
+			active: 2,
+		},
+		{
+			name:   "no reachable node",
+			health: []beaconNodeHealth{{}, {}},
+			active: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, _ := testBeaconNodes(len(tt.health))
+			for i, h := range tt.health {
+				nodes[i].health = h
+			}
+			f := newFailoverConn(nodes, false)
+			f.selectActive()
+			assert.Equal(t, tt.active, f.active)
+		})
+	}
+}
+
+func TestFailoverConn_CheckHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	nodes, _ := testBeaconNodes(2)
+	heads := []types.Slot{100, 120}
+	for i, n := range nodes {
+		nodeClient := mock.NewMockNodeClient(ctrl)
+		beaconClient := mock.NewMockBeaconChainClient(ctrl)
+		nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{}, nil)
+		nodeClient.EXPECT().ListPeers(gomock.Any(), gomock.Any()).Return(&ethpb.Peers{Peers: []*ethpb.Peer{{}}}, nil)
+		beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: heads[i]}, nil)
+		n.nodeClient = nodeClient
+		n.beaconClient = beaconClient
+	}
+	f := newFailoverConn(nodes, false)
+	f.checkHealth(ctx)
+	assert.Equal(t, 1, f.active, "the first beacon node stalled")
+	assert.Equal(t, true, nodes[0].health.reachable)
+	assert.Equal(t, 1, nodes[1].health.peers)
+
+	// A beacon node which does not answer is unreachable.
+	nodeClient := mock.NewMockNodeClient(ctrl)
+	nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+	nodes[0].nodeClient = nodeClient
+	assert.Equal(t, false, queryBeaconNodeHealth(ctx, nodes[0]).reachable)
+}
+
+func TestFailoverConn_Invoke_FailsOver(t *testing.T) {
+	nodes, conns := testBeaconNodes(2)
+	for _, n := range nodes {
+		n.health = beaconNodeHealth{reachable: true, headSlot: 10, peers: 1}
+	}
+	f := newFailoverConn(nodes, false)
+	client := ethpb.NewBeaconNodeValidatorClient(f)
+
+	_, err := client.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, conns[0].calls())
+	assert.Equal(t, 0, conns[1].calls())
+
+	conns[0].err = status.Error(codes.Unavailable, "connection refused")
+	_, err = client.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.active)
+	assert.Equal(t, 1, conns[1].calls())
+
+	// Other errors are returned as is.
+	conns[1].err = status.Error(codes.InvalidArgument, "bad request")
+	_, err = client.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{})
+	assert.ErrorContains(t, "bad request", err)
+	assert.Equal(t, 1, f.active)
+}
+
+func TestFailoverConn_Invoke_Broadcast(t *testing.T) {
+	nodes, conns := testBeaconNodes(3)
+	for _, n := range nodes {
+		n.health = beaconNodeHealth{reachable: true, headSlot: 10, peers: 1}
+	}
+	nodes[2].health.syncing = true
+	f := newFailoverConn(nodes, true)
+	client := ethpb.NewBeaconNodeValidatorClient(f)
+
+	resp, err := client.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{0}, resp.AttestationDataRoot, "reply of the active node should be used")
+	assert.Equal(t, 1, conns[0].calls())
+	assert.Equal(t, 1, conns[1].calls())
+	assert.Equal(t, 0, conns[2].calls(), "unhealthy nodes should not be used")
+
+	// The broadcast succeeds as long as one beacon node accepted it.
+	conns[0].err = errors.New("rejected")
+	resp, err = client.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1}, resp.AttestationDataRoot)
+
+	// Other methods are only sent to the active beacon node.
+	conns[0].err = nil
+	_, err = client.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, conns[0].calls())
+	assert.Equal(t, 2, conns[1].calls())
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// dutyEntry tracks a duty while it is performed, to record it in the duty journal of
+// the validator database once done.
+type dutyEntry struct {
+	v      *validator
+	record *kv.DutyRecord
+}
+
+// startDuty starts tracking a duty. The entry must be completed with done, which is
+// usually deferred right away.
+func (v *validator) startDuty(role iface.ValidatorRole, slot types.Slot, pubKey [48]byte) *dutyEntry {
+	return &dutyEntry{
+		v: v,
+		record: &kv.DutyRecord{
+			PubKey:    pubKey,
+			Role:      role.String(),
+			Slot:      slot,
+			StartedAt: timeutils.Now(),
+		},
+	}
+}
+
+// signed marks the object of the duty as signed.
+func (d *dutyEntry) signed() {
+	now := timeutils.Now()
+	d.record.SignedAt = &now
+}
+
+// submitted marks the object of the duty as submitted to the beacon node.
+func (d *dutyEntry) submitted() {
+	now := timeutils.Now()
+	d.record.SubmittedAt = &now
+	d.record.BeaconNode = d.v.beaconNodeEndpoint()
+}
+
+// fail marks the duty as failed. Only the first error is kept, as it is the one that
+// aborted the duty.
+func (d *dutyEntry) fail(err error) {
+	if d.record.Error == "" && err != nil {
+		d.record.Error = err.Error()
+	}
+}
+
+// done saves the duty record and reports the delay of a submitted duty.
+func (d *dutyEntry) done() {
+	r := d.record
+	// An error after the submission, such as failing to log the attestation, does not
+	// undo the duty.
+	switch {
+	case r.SubmittedAt != nil:
+		r.Result = kv.DutySubmitted
+	case r.Error != "":
+		r.Result = kv.DutyFailed
+	default:
+		r.Result = kv.DutySkipped
+	}
+	if r.SubmittedAt != nil {
+		delay := r.SubmittedAt.Sub(slotutil.SlotStartTime(d.v.genesisTime, r.Slot))
+		dutyDelayHistogramVec.WithLabelValues(r.Role).Observe(delay.Seconds())
+	}
+	if r.BeaconNode == "" {
+		r.BeaconNode = d.v.beaconNodeEndpoint()
+	}
+	if d.v.db == nil {
+		return
+	}
+	// The duty context is usually expired by now, which must not prevent saving the record.
+	if err := d.v.db.SaveDutyRecord(context.Background(), r); err != nil {
+		log.WithError(err).WithField("slot", r.Slot).Error("Could not save duty record")
+	}
+}
+
+// recordScheduledDuties records the duties assigned to the validating keys for an epoch and
+// the next one in the duty journal. Duties which are never performed, because their deadline
+// passed or the client was down, are then part of the journal as well.
+func (v *validator) recordScheduledDuties(ctx context.Context, epoch types.Epoch, resp *ethpb.DutiesResponse) {
+	if v.db == nil {
+		return
+	}
+	var records []*kv.DutyRecord
+	schedule := func(role iface.ValidatorRole, slot types.Slot, pubKey [48]byte) {
+		records = append(records, &kv.DutyRecord{
+			PubKey: pubKey,
+			Role:   role.String(),
+			Slot:   slot,
+			Result: kv.DutyScheduled,
+		})
+	}
+	performsDuties := func(duty *ethpb.DutiesResponse_Duty) bool {
+		if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+			return false
+		}
+		return v.proposerConfig == nil || v.proposerConfig.Settings(bytesutil.ToBytes48(duty.PublicKey)).Enabled
+	}
+
+	nextSyncCommittee := make(map[[48]byte]bool, len(resp.NextEpochDuties))
+	for _, duty := range resp.NextEpochDuties {
+		if !performsDuties(duty) {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(duty.PublicKey)
+		schedule(iface.RoleAttester, duty.AttesterSlot, pubKey)
+		nextSyncCommittee[pubKey] = duty.IsSyncCommittee
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		log.WithError(err).Error("Could not record scheduled duties")
+		return
+	}
+	for _, duty := range resp.CurrentEpochDuties {
+		if !performsDuties(duty) {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(duty.PublicKey)
+		schedule(iface.RoleAttester, duty.AttesterSlot, pubKey)
+		for _, slot := range duty.ProposerSlots {
+			if slot != 0 {
+				schedule(iface.RoleProposer, slot, pubKey)
+			}
+		}
+		// As in RolesAt, the last slot of the epoch signs for the sync committee of the next epoch.
+		for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+			inSyncCommittee := duty.IsSyncCommittee
+			if helpers.IsEpochEnd(slot) {
+				inSyncCommittee = nextSyncCommittee[pubKey]
+			}
+			if inSyncCommittee {
+				schedule(iface.RoleSyncCommittee, slot, pubKey)
+			}
+		}
+	}
+	for _, r := range records {
+		if err := v.db.SaveDutyRecord(ctx, r); err != nil {
+			log.WithError(err).WithField("slot", r.Slot).Error("Could not save scheduled duty record")
+		}
+	}
+}
+
+// beaconNodeEndpoint returns the endpoint of the beacon node duties are sent to.
+func (v *validator) beaconNodeEndpoint() string {
+	if v.beaconNodes == nil {
+		return ""
+	}
+	return v.beaconNodes.activeNode().endpoint
+}
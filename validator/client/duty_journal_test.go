@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestDutyEntry_Done(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	v := &validator{
+		db:          dbtest.SetupDB(t, [][48]byte{pubKey}),
+		beaconNodes: newFailoverConn([]*beaconNode{{endpoint: "localhost:4000"}}, false),
+	}
+
+	entry := v.startDuty(iface.RoleAttester, 1, pubKey)
+	entry.signed()
+	entry.submitted()
+	// Failing after the submission does not undo the duty.
+	entry.fail(errors.New("could not save validator index"))
+	entry.done()
+
+	entry = v.startDuty(iface.RoleProposer, 2, pubKey)
+	entry.fail(errors.New("failed to sign randao reveal"))
+	entry.fail(errors.New("ignored"))
+	entry.done()
+
+	v.startDuty(iface.RoleAggregator, 2, pubKey).done()
+
+	records, err := v.db.DutyRecords(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, "attester", records[0].Role)
+	assert.Equal(t, kv.DutySubmitted, records[0].Result)
+	assert.Equal(t, "localhost:4000", records[0].BeaconNode)
+	assert.NotNil(t, records[0].SignedAt)
+	assert.NotNil(t, records[0].SubmittedAt)
+	results := map[string]*kv.DutyRecord{records[1].Role: records[1], records[2].Role: records[2]}
+	assert.Equal(t, kv.DutyFailed, results["proposer"].Result)
+	assert.Equal(t, "failed to sign randao reveal", results["proposer"].Error)
+	assert.Equal(t, kv.DutySkipped, results["aggregator"].Result)
+}
+
+func TestRecordScheduledDuties(t *testing.T) {
+	ctx := context.Background()
+	active, inactive, syncMember := [48]byte{1}, [48]byte{2}, [48]byte{3}
+	v := &validator{db: dbtest.SetupDB(t, [][48]byte{active, inactive, syncMember})}
+	epochEnd := params.BeaconConfig().SlotsPerEpoch - 1
+	resp := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: active[:], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: 3, ProposerSlots: []types.Slot{5}},
+			{PublicKey: inactive[:], Status: ethpb.ValidatorStatus_PENDING, AttesterSlot: 4},
+			{PublicKey: syncMember[:], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: 6, IsSyncCommittee: true},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: active[:], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: epochEnd + 2},
+			{PublicKey: syncMember[:], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: epochEnd + 3},
+		},
+	}
+	// The attestation of slot 3 was performed before the duties are recorded again.
+	v.startDuty(iface.RoleAttester, 3, active).done()
+	v.recordScheduledDuties(ctx, 0, resp)
+
+	records, err := v.db.DutyRecords(ctx, 0, 2*params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	scheduled := make(map[[48]byte][]string)
+	for _, r := range records {
+		assert.NotEqual(t, inactive, r.PubKey, "Expected no duties for an inactive validator")
+		if r.Result == kv.DutyScheduled {
+			scheduled[r.PubKey] = append(scheduled[r.PubKey], fmt.Sprintf("%s@%d", r.Role, r.Slot))
+		}
+	}
+	assert.DeepEqual(t, []string{"proposer@5", fmt.Sprintf("attester@%d", epochEnd+2)}, scheduled[active])
+	// The sync committee member signs at every slot of the epoch but the last one, as it is
+	// not part of the sync committee of the next epoch.
+	assert.Equal(t, int(epochEnd)+2, len(scheduled[syncMember]))
+	assert.Equal(t, "sync_committee@0", scheduled[syncMember][0])
+}
@@ -30,6 +30,24 @@ const (
 	RoleSyncCommitteeAggregator
 )
 
+// String returns the name of the role.
+func (r ValidatorRole) String() string {
+	switch r {
+	case RoleAttester:
+		return "attester"
+	case RoleProposer:
+		return "proposer"
+	case RoleAggregator:
+		return "aggregator"
+	case RoleSyncCommittee:
+		return "sync_committee"
+	case RoleSyncCommitteeAggregator:
+		return "sync_committee_aggregator"
+	default:
+		return "unknown"
+	}
+}
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
@@ -194,6 +194,54 @@ var (
 			"pubkey",
 		},
 	)
+	beaconNodeHealthyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "1 if the beacon node can be given duties, 0 otherwise",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeHeadSlotGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_head_slot",
+			Help:      "head slot reported by the beacon node",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeLatencyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_latency_seconds",
+			Help:      "latency of the last health check of the beacon node",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeFailoversCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failovers_total",
+			Help:      "number of times duties were moved to another beacon node",
+		},
+	)
+	dutyDelayHistogramVec = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "duty_delay_seconds",
+			Help:      "time between the start of the slot of a duty and its submission to the beacon node",
+			Buckets:   []float64{0.5, 1, 2, 3, 4, 5, 6, 8, 10, 12, 16, 24},
+		},
+		[]string{
+			"role",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
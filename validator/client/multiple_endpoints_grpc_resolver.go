@@ -3,6 +3,7 @@ package client
 import (
 	"strings"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/resolver"
 )
 
@@ -14,6 +15,12 @@ import (
 // grpc.WithDefaultServiceConfig("{\"loadBalancingConfig\":[{\"round_robin\":{}}]}")
 type multipleEndpointsGrpcResolverBuilder struct{}
 
+// WithMultipleEndpoints returns a dial option which connects to every endpoint of a comma-separated
+// list. The validator service does not use it, as it fails over between the endpoints itself.
+func WithMultipleEndpoints() grpc.DialOption {
+	return grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{})
+}
+
 // Build creates and starts multiple endpoints resolver.
 func (*multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
 	r := &multipleEndpointsGrpcResolver{
@@ -38,7 +45,7 @@ func (r *multipleEndpointsGrpcResolver) start() {
 	endpoints := strings.Split(r.target.Endpoint, ",")
 	var addrs []resolver.Address
 	for _, endpoint := range endpoints {
-		addrs = append(addrs, resolver.Address{Addr: endpoint})
+		addrs = append(addrs, resolver.Address{Addr: strings.TrimSpace(endpoint)})
 	}
 	r.cc.UpdateState(resolver.State{Addresses: addrs})
 }
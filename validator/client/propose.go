@@ -59,6 +59,8 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	defer lock.Unlock()
 	ctx, span := trace.StartSpan(ctx, "validator.proposeBlock")
 	defer span.End()
+	entry := v.startDuty(iface.RoleProposer, slot, pubKey)
+	defer entry.done()
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
@@ -68,6 +70,7 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	epoch := types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to sign randao reveal")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -90,6 +93,7 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 		Graffiti:     g,
 	})
 	if err != nil {
+		entry.fail(err)
 		log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -100,12 +104,14 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	// Sign returned block from beacon node
 	sig, domain, err := v.signBlock(ctx, pubKey, epoch, wrapperv1.WrappedPhase0BeaconBlock(b))
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to sign block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	entry.signed()
 	blk := &ethpb.SignedBeaconBlock{
 		Block:     b,
 		Signature: sig,
@@ -113,6 +119,7 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 
 	signingRoot, err := helpers.ComputeSigningRoot(b, domain.SignatureDomain)
 	if err != nil {
+		entry.fail(err)
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -121,6 +128,7 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	}
 
 	if err := v.preBlockSignValidations(ctx, pubKey, wrapperv1.WrappedPhase0BeaconBlock(b), signingRoot); err != nil {
+		entry.fail(err)
 		log.WithFields(
 			blockLogFields(pubKey, wrapperv1.WrappedPhase0BeaconBlock(b), nil),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -128,6 +136,7 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	}
 
 	if err := v.postBlockSignUpdate(ctx, pubKey, wrapperv1.WrappedPhase0SignedBeaconBlock(blk), signingRoot); err != nil {
+		entry.fail(err)
 		log.WithFields(
 			blockLogFields(pubKey, wrapperv1.WrappedPhase0BeaconBlock(b), sig),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -137,12 +146,14 @@ func (v *validator) proposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	// Propose and broadcast block via beacon node
 	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	entry.submitted()
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -175,6 +186,8 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 	defer lock.Unlock()
 	ctx, span := trace.StartSpan(ctx, "validator.proposeBlockV2")
 	defer span.End()
+	entry := v.startDuty(iface.RoleProposer, slot, pubKey)
+	defer entry.done()
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
@@ -184,6 +197,7 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 	epoch := types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to sign randao reveal")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -206,6 +220,7 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 		Graffiti:     g,
 	})
 	if err != nil {
+		entry.fail(err)
 		log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -216,6 +231,7 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 	// Sign returned block from beacon node
 	wb, err := wrapperv2.WrappedAltairBeaconBlock(b)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to wrap block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -224,12 +240,14 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 	}
 	sig, domain, err := v.signBlock(ctx, pubKey, epoch, wb)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to sign block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	entry.signed()
 	blk := &prysmv2.SignedBeaconBlockAltair{
 		Block:     b,
 		Signature: sig,
@@ -237,6 +255,7 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 
 	signingRoot, err := helpers.ComputeSigningRoot(b, domain.SignatureDomain)
 	if err != nil {
+		entry.fail(err)
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -245,6 +264,7 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 	}
 
 	if err := v.preBlockSignValidations(ctx, pubKey, wb, signingRoot); err != nil {
+		entry.fail(err)
 		log.WithFields(
 			blockLogFields(pubKey, wb, nil),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -253,6 +273,7 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 
 	wsb, err := wrapperv2.WrappedAltairSignedBeaconBlock(blk)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to wrap signed block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -260,6 +281,7 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 		return
 	}
 	if err := v.postBlockSignUpdate(ctx, pubKey, wsb, signingRoot); err != nil {
+		entry.fail(err)
 		log.WithFields(
 			blockLogFields(pubKey, wb, sig),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -269,12 +291,14 @@ func (v *validator) proposeBlockV2(ctx context.Context, slot types.Slot, pubKey
 	// Propose and broadcast block via beacon node
 	blkResp, err := v.validatorClientV2.ProposeBlock(ctx, blk)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	entry.submitted()
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -339,6 +363,30 @@ func ProposeExit(
 	return nil
 }
 
+// SignExit signs a voluntary exit at the given epoch for the validator with the given public key
+// without broadcasting it, so that it can be kept and submitted through any beacon node later.
+func SignExit(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	signer signingFunc,
+	pubKey []byte,
+	epoch types.Epoch,
+) (*ethpb.SignedVoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.SignExit")
+	defer span.End()
+
+	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get validator index failed")
+	}
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: indexResponse.Index}
+	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
+}
+
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch types.Epoch) ([]byte, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao[:])
@@ -467,11 +515,19 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 		return v.graffiti, nil
 	}
 
+	// When specified, graffiti of the proposer config takes the second priority, as the config is
+	// keyed by public key and so also applies to keys that are not active yet.
+	if v.proposerConfig != nil {
+		if g := v.proposerConfig.Settings(pubKey).Graffiti; len(g) != 0 {
+			return g, nil
+		}
+	}
+
 	if v.graffitiStruct == nil {
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
+	// When specified, individual validator specified graffiti from the file takes the third priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return []byte{}, err
@@ -481,7 +537,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 		return []byte(g), nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take fourth priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
@@ -492,7 +548,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 		return []byte(graffiti), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take fifth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
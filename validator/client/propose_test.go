@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -25,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/proposerconfig"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -974,3 +979,42 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		require.DeepEqual(t, want, got)
 	}
 }
+
+// newProposerConfig loads a proposer config file with the given content.
+func newProposerConfig(t *testing.T, content string) *proposerconfig.Store {
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), os.ModePerm))
+	s, err := proposerconfig.NewStore(path)
+	require.NoError(t, err)
+	return s
+}
+
+func TestGetGraffiti_ProposerConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+	}
+	pubKey := [48]byte{'a'}
+	v := &validator{
+		validatorClient: m.validatorClient,
+		graffitiStruct: &graffiti.Graffiti{
+			Specific: map[types.ValidatorIndex]string{
+				2: "g",
+			},
+		},
+		proposerConfig: newProposerConfig(t, fmt.Sprintf("proposers:\n  \"%#x\":\n    graffiti: \"p\"\n", pubKey)),
+	}
+	// The proposer config is keyed by public key, so the validator index is not needed.
+	got, err := v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'p'}, got)
+
+	// Other keys fall back to the graffiti file.
+	otherKey := [48]byte{'b'}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: otherKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+	got, err = v.getGraffiti(context.Background(), otherKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'g'}, got)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/proposerconfig"
 	slashingiface "github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -54,7 +55,8 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
-	conn                  *grpc.ClientConn
+	conn                  *failoverConn
+	broadcast             bool
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -71,6 +73,7 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	proposerConfig        *proposerconfig.Store
 }
 
 // Config for the validator service.
@@ -93,6 +96,8 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	BroadcastToAllBeaconNodes  bool
+	ProposerConfig             *proposerconfig.Store
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -120,6 +125,8 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		broadcast:             cfg.BroadcastToAllBeaconNodes,
+		proposerConfig:        cfg.ProposerConfig,
 	}, nil
 }
 
@@ -138,16 +145,27 @@ func (v *ValidatorService) Start() {
 
 	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
+	endpoints := splitEndpoints(v.endpoint)
+	nodes := make([]*beaconNode, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		conn, err := grpc.DialContext(v.ctx, endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", endpoint, err)
+			for _, n := range nodes {
+				if err := n.closer.Close(); err != nil {
+					log.WithError(err).Error("Could not close connection")
+				}
+			}
+			return
+		}
+		nodes = append(nodes, newBeaconNode(endpoint, conn))
 	}
 	if v.withCert != "" {
 		log.Info("Established secure gRPC connection")
 	}
 
-	v.conn = conn
+	v.conn = newFailoverConn(nodes, v.broadcast)
+	go v.conn.run(v.ctx)
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -203,6 +221,8 @@ func (v *ValidatorService) Start() {
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		proposerConfig:                 v.proposerConfig,
+		beaconNodes:                    v.conn,
 	}
 	// To resolve a race condition at startup due to the interface
 	// nature of the abstracted block type. We initialize
@@ -214,11 +234,24 @@ func (v *ValidatorService) Start() {
 	sub.Unsubscribe()
 	close(tempChan)
 
+	if v.proposerConfig != nil {
+		go v.proposerConfig.Watch(v.ctx)
+	}
+
 	v.validator = valStruct
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
 }
 
+// splitEndpoints returns the beacon node endpoints of a comma-separated list.
+func splitEndpoints(endpoint string) []string {
+	endpoints := strings.Split(endpoint, ",")
+	for i := range endpoints {
+		endpoints[i] = strings.TrimSpace(endpoints[i])
+	}
+	return endpoints
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
@@ -316,7 +349,6 @@ func ConstructDialOptions(
 			grpc_prometheus.StreamClientInterceptor,
 			grpc_retry.StreamClientInterceptor(),
 		),
-		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}),
 	}
 
 	dialOpts = append(dialOpts, extraOpts...)
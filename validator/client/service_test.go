@@ -104,3 +104,9 @@ func TestStart_GrpcHeaders(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitEndpoints(t *testing.T) {
+	assert.DeepEqual(t, []string{"127.0.0.1:4000"}, splitEndpoints("127.0.0.1:4000"))
+	assert.DeepEqual(t, []string{"127.0.0.1:4000", "127.0.0.1:4001"}, splitEndpoints("127.0.0.1:4000, 127.0.0.1:4001"))
+	assert.DeepEqual(t, []string{"127.0.0.1:4000", "127.0.0.1:4001"}, splitEndpoints(" 127.0.0.1:4000 ,127.0.0.1:4001 "))
+}
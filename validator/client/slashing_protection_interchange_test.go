@@ -5,17 +5,23 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/block"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
 )
 
 type eip3076TestCase struct {
@@ -184,3 +190,61 @@ func TestEIP3076SpecTests(t *testing.T) {
 		})
 	}
 }
+
+func TestImportMinimal_RefusesSigningBelowImportedHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	v := &validator{db: dbtest.SetupDB(t, [][48]byte{pubKey})}
+	newAtt := func(source, target types.Epoch) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+			},
+		}
+	}
+	newBlock := func(slot types.Slot) block.BeaconBlock {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		return wrapper.WrappedPhase0BeaconBlock(b.Block)
+	}
+
+	// The local history is older than the imported one.
+	require.NoError(t, v.db.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, newAtt(1, 2)))
+	require.NoError(t, v.db.SaveProposalHistoryForSlot(ctx, pubKey, 2, bytesutil.PadTo([]byte{1}, 32)))
+
+	interchange := &format.EIPSlashingProtectionFormat{
+		Data: []*format.ProtectionData{{
+			Pubkey: fmt.Sprintf("%#x", pubKey),
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "5"},
+				{Slot: "12"},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "3", TargetEpoch: "4"},
+				{SourceEpoch: "9", TargetEpoch: "10"},
+			},
+		}},
+	}
+	interchange.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchange.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{})
+	enc, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	_, err = interchangeformat.ImportProtectionJSON(ctx, v.db, bytes.NewBuffer(enc), &interchangeformat.ImportOptions{
+		Minimal: true,
+	})
+	require.NoError(t, err)
+
+	// Only the highest imported block and epochs are kept, but signing between the local
+	// history and them is refused, as the dropped history could conflict.
+	err = v.preBlockSignValidations(ctx, pubKey, newBlock(8), [32]byte{2})
+	require.ErrorContains(t, "could not sign block with slot <= lowest signed", err)
+	err = v.slashableAttestationCheck(ctx, newAtt(5, 6), pubKey, [32]byte{2})
+	require.ErrorContains(t, "could not sign attestation lower than lowest source epoch", err)
+	err = v.slashableAttestationCheck(ctx, newAtt(9, 10), pubKey, [32]byte{2})
+	require.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", err)
+
+	require.NoError(t, v.preBlockSignValidations(ctx, pubKey, newBlock(13), [32]byte{2}))
+	require.NoError(t, v.slashableAttestationCheck(ctx, newAtt(10, 11), pubKey, [32]byte{2}))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -22,12 +23,15 @@ import (
 func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.Slot, pubKey [48]byte) {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitSyncCommitteeMessage")
 	defer span.End()
+	entry := v.startDuty(iface.RoleSyncCommittee, slot, pubKey)
+	defer entry.done()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
 	v.waitOneThirdOrValidBlock(ctx, slot)
 
 	res, err := v.validatorClientV2.GetSyncMessageBlockRoot(ctx, &emptypb.Empty{})
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not request sync message block root to sign")
 		traceutil.AnnotateError(span, err)
 		return
@@ -35,18 +39,21 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not fetch validator assignment")
 		return
 	}
 
 	d, err := v.domainData(ctx, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainSyncCommittee[:])
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not get sync committee domain data")
 		return
 	}
 	sszRoot := types.SSZBytes(res.Root)
 	r, err := helpers.ComputeSigningRoot(&sszRoot, d.SignatureDomain)
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not get sync committee message signing root")
 		return
 	}
@@ -54,11 +61,15 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 		PublicKey:       pubKey[:],
 		SigningRoot:     r[:],
 		SignatureDomain: d.SignatureDomain,
+		SigningSlot:     slot,
+		Object:          &prysmv2.SignRequest_SyncMessageBlockRoot{SyncMessageBlockRoot: res.Root},
 	})
 	if err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not sign sync committee message")
 		return
 	}
+	entry.signed()
 
 	msg := &prysmv2.SyncCommitteeMessage{
 		Slot:           slot,
@@ -67,9 +78,11 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 		Signature:      sig.Marshal(),
 	}
 	if _, err := v.validatorClientV2.SubmitSyncMessage(ctx, msg); err != nil {
+		entry.fail(err)
 		log.WithError(err).Error("Could not submit sync committee message")
 		return
 	}
+	entry.submitted()
 
 	log.WithFields(logrus.Fields{
 		"slot":           msg.Slot,
@@ -82,10 +95,13 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot types.Slot, pubKey [48]byte) {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitSignedContributionAndProof")
 	defer span.End()
+	entry := v.startDuty(iface.RoleSyncCommitteeAggregator, slot, pubKey)
+	defer entry.done()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		entry.fail(err)
 		log.Errorf("Could not fetch validator assignment: %v", err)
 		return
 	}
@@ -95,6 +111,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		Slot:      slot,
 	})
 	if err != nil {
+		entry.fail(err)
 		log.Errorf("Could not get sync subcommittee index: %v", err)
 		return
 	}
@@ -109,6 +126,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		subnet := uint64(index) / subCommitteeSize
 		selectionProof, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
 		if err != nil {
+			entry.fail(err)
 			log.Errorf("Could not sign selection data: %v", err)
 			return
 		}
@@ -129,6 +147,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 			SubnetId:  subnet,
 		})
 		if err != nil {
+			entry.fail(err)
 			log.Errorf("Could not get sync committee contribution: %v", err)
 			return
 		}
@@ -140,17 +159,21 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		}
 		sig, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof)
 		if err != nil {
+			entry.fail(err)
 			log.Errorf("Could not sign contribution and proof: %v", err)
 			return
 		}
+		entry.signed()
 
 		if _, err := v.validatorClientV2.SubmitSignedContributionAndProof(ctx, &prysmv2.SignedContributionAndProof{
 			Message:   contributionAndProof,
 			Signature: sig,
 		}); err != nil {
+			entry.fail(err)
 			log.Errorf("Could not submit signed contribution and proof: %v", err)
 			return
 		}
+		entry.submitted()
 
 		log.WithFields(logrus.Fields{
 			"slot":              contributionAndProof.Contribution.Slot,
@@ -181,6 +204,8 @@ func (v *validator) signSyncSelectionData(ctx context.Context, pubKey [48]byte,
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
+		SigningSlot:     slot,
+		Object:          &prysmv2.SignRequest_SyncAggregatorSelectionData{SyncAggregatorSelectionData: data},
 	})
 	if err != nil {
 		return nil, err
@@ -204,6 +229,8 @@ func (v *validator) signContributionAndProof(ctx context.Context, pubKey [48]byt
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: d.SignatureDomain,
+		SigningSlot:     c.Contribution.Slot,
+		Object:          &prysmv2.SignRequest_ContributionAndProof{ContributionAndProof: c},
 	})
 	if err != nil {
 		return nil, err
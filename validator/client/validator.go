@@ -38,6 +38,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/proposerconfig"
 	slashingiface "github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -91,6 +92,8 @@ type validator struct {
 	graffitiStruct                     *graffiti.Graffiti
 	graffitiOrderedIndex               uint64
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
+	proposerConfig                     *proposerconfig.Store
+	beaconNodes                        *failoverConn
 }
 
 type validatorStatus struct {
@@ -539,6 +542,7 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 
 	v.duties = resp
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
+	v.recordScheduledDuties(ctx, req.Epoch, resp)
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
 	go func() {
@@ -627,6 +631,10 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 		if duty == nil {
 			continue
 		}
+		// Keys disabled in the proposer config are kept in the key manager but perform no duties.
+		if v.proposerConfig != nil && !v.proposerConfig.Settings(bytesutil.ToBytes48(duty.PublicKey)).Enabled {
+			continue
+		}
 		if len(duty.ProposerSlots) > 0 {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
@@ -708,6 +708,26 @@ func TestRolesAt_OK(t *testing.T) {
 	assert.Equal(t, iface.RoleSyncCommittee, roleMap[bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())][0])
 }
 
+func TestRolesAt_DisabledInProposerConfig(t *testing.T) {
+	pubKey := [48]byte{'a'}
+	v := &validator{
+		duties: &ethpb.DutiesResponse{
+			Duties: []*ethpb.DutiesResponse_Duty{
+				{
+					CommitteeIndex: 1,
+					AttesterSlot:   1,
+					ProposerSlots:  []types.Slot{1},
+					PublicKey:      pubKey[:],
+				},
+			},
+		},
+		proposerConfig: newProposerConfig(t, fmt.Sprintf("proposers:\n  \"%#x\":\n    enabled: false\n", pubKey)),
+	}
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roleMap[pubKey]))
+}
+
 func TestRolesAt_DoesNotAssignProposer_Slot0(t *testing.T) {
 	v, m, validatorKey, finish := setup(t)
 	defer finish()
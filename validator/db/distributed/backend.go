@@ -0,0 +1,43 @@
+package distributed
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Backend is a key-value store shared by all the validator client replicas which
+// protect the same validator keys. Every value carries a version incremented on
+// each write, so that concurrent updates of a key are serialized through
+// compare-and-set.
+type Backend interface {
+	// Get returns the value of a key along with its version. A missing key has
+	// a nil value and version 0.
+	Get(ctx context.Context, key string) ([]byte, uint64, error)
+	// CompareAndSet writes the value of a key if its version is still the given
+	// one. False is returned if the key was written by someone else in the meantime.
+	CompareAndSet(ctx context.Context, key string, version uint64, value []byte) (bool, error)
+	// Keys lists all the keys of the store.
+	Keys(ctx context.Context) ([]string, error)
+	Close() error
+}
+
+// NewBackend opens the backend for the given URL. PostgreSQL is used for postgres://
+// and postgresql:// URLs, while embedded:// keeps the records in memory, which is
+// only meant for tests as the records are neither shared nor persisted.
+func NewBackend(ctx context.Context, rawURL string) (Backend, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse slashing protection backend url")
+	}
+	switch u.Scheme {
+	case "postgres", "postgresql":
+		return NewPostgresBackend(ctx, rawURL)
+	case "embedded":
+		log.Warn("Using an embedded slashing protection backend, records are lost on restart")
+		return NewEmbeddedBackend(), nil
+	default:
+		return nil, errors.Errorf("unsupported slashing protection backend %q", u.Scheme)
+	}
+}
@@ -0,0 +1,12 @@
+/*
+Package distributed defines a validator database which shares the slashing protection
+history of validator keys across several replicas of a validator client, so that the
+replicas can run at the same time without risking slashing.
+
+The history of every public key is a single record of a Backend supporting atomic
+compare-and-set, such as a PostgreSQL table. Recording an attestation or a proposal
+checks it against the latest record and only succeeds if no other replica wrote the
+record in the meantime, which serializes the slashing protection checks of all replicas.
+A replica only signs an attestation, or publishes a block, once it has been recorded.
+*/
+package distributed
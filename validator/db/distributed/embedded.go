@@ -0,0 +1,75 @@
+package distributed
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+type embeddedValue struct {
+	value   []byte
+	version uint64
+}
+
+// EmbeddedBackend is an in-memory backend for a single process, used in tests.
+type EmbeddedBackend struct {
+	lock   sync.Mutex
+	values map[string]*embeddedValue
+}
+
+// NewEmbeddedBackend creates an empty in-memory backend.
+func NewEmbeddedBackend() *EmbeddedBackend {
+	return &EmbeddedBackend{values: make(map[string]*embeddedValue)}
+}
+
+// Get the value and version of a key.
+func (b *EmbeddedBackend) Get(_ context.Context, key string) ([]byte, uint64, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	v, ok := b.values[key]
+	if !ok {
+		return nil, 0, nil
+	}
+	return copyBytes(v.value), v.version, nil
+}
+
+// CompareAndSet writes the value of a key if its version did not change.
+func (b *EmbeddedBackend) CompareAndSet(_ context.Context, key string, version uint64, value []byte) (bool, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	var current uint64
+	if v, ok := b.values[key]; ok {
+		current = v.version
+	}
+	if current != version {
+		return false, nil
+	}
+	b.values[key] = &embeddedValue{value: copyBytes(value), version: version + 1}
+	return true, nil
+}
+
+// Keys lists all keys in lexicographic order.
+func (b *EmbeddedBackend) Keys(_ context.Context) ([]string, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	keys := make([]string, 0, len(b.values))
+	for k := range b.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Close --
+func (*EmbeddedBackend) Close() error {
+	return nil
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
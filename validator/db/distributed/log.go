@@ -0,0 +1,5 @@
+package distributed
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "db")
@@ -0,0 +1,108 @@
+package distributed
+
+import (
+	"context"
+	"database/sql"
+
+	// Registers the postgres driver of database/sql.
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const createTableQuery = `CREATE TABLE IF NOT EXISTS slashing_protection (
+	key TEXT PRIMARY KEY,
+	version BIGINT NOT NULL,
+	value BYTEA NOT NULL
+)`
+
+// PostgresBackend stores the records in a PostgreSQL table. Compare-and-set is a
+// conditional insert or update, which PostgreSQL applies atomically.
+type PostgresBackend struct {
+	db *sql.DB
+}
+
+// NewPostgresBackend connects to PostgreSQL and creates the table of the records
+// if it does not exist yet.
+func NewPostgresBackend(ctx context.Context, url string) (*PostgresBackend, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open postgres connection")
+	}
+	if _, err := db.ExecContext(ctx, createTableQuery); err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close postgres connection")
+		}
+		return nil, errors.Wrap(err, "could not create slashing protection table")
+	}
+	return &PostgresBackend{db: db}, nil
+}
+
+// Get the value and version of a key.
+func (b *PostgresBackend) Get(ctx context.Context, key string) ([]byte, uint64, error) {
+	var value []byte
+	var version uint64
+	err := b.db.QueryRowContext(
+		ctx, "SELECT value, version FROM slashing_protection WHERE key = $1", key,
+	).Scan(&value, &version)
+	if err == sql.ErrNoRows {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not read key %s", key)
+	}
+	return value, version, nil
+}
+
+// CompareAndSet writes the value of a key if its version did not change.
+func (b *PostgresBackend) CompareAndSet(ctx context.Context, key string, version uint64, value []byte) (bool, error) {
+	var res sql.Result
+	var err error
+	if version == 0 {
+		res, err = b.db.ExecContext(
+			ctx,
+			"INSERT INTO slashing_protection (key, version, value) VALUES ($1, 1, $2) ON CONFLICT (key) DO NOTHING",
+			key, value,
+		)
+	} else {
+		res, err = b.db.ExecContext(
+			ctx,
+			"UPDATE slashing_protection SET value = $3, version = version + 1 WHERE key = $1 AND version = $2",
+			key, version, value,
+		)
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "could not write key %s", key)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// Keys lists all keys in lexicographic order.
+func (b *PostgresBackend) Keys(ctx context.Context) ([]string, error) {
+	rows, err := b.db.QueryContext(ctx, "SELECT key FROM slashing_protection ORDER BY key")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list keys")
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.WithError(err).Error("Could not close rows")
+		}
+	}()
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// Close the connections to PostgreSQL.
+func (b *PostgresBackend) Close() error {
+	return b.db.Close()
+}
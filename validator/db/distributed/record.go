@@ -0,0 +1,148 @@
+package distributed
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slashutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+var (
+	doubleVoteMessage      = "double vote found, existing attestation at target epoch %d with conflicting signing root %#x"
+	surroundingVoteMessage = "attestation with (source %d, target %d) surrounds another with (source %d, target %d)"
+	surroundedVoteMessage  = "attestation with (source %d, target %d) is surrounded by another with (source %d, target %d)"
+	doubleProposalMessage  = "double proposal found, existing proposal at slot %d with conflicting signing root %#x"
+)
+
+// protectionRecord is the slashing protection history of a validator public key, stored
+// as a single value of the backend so that it is always updated atomically.
+type protectionRecord struct {
+	Attestations        []*attestation `json:"attestations"`
+	Proposals           []*kv.Proposal `json:"proposals"`
+	LowestSourceEpoch   *types.Epoch   `json:"lowest_source_epoch,omitempty"`
+	LowestTargetEpoch   *types.Epoch   `json:"lowest_target_epoch,omitempty"`
+	LowestProposalSlot  *types.Slot    `json:"lowest_proposal_slot,omitempty"`
+	HighestProposalSlot *types.Slot    `json:"highest_proposal_slot,omitempty"`
+}
+
+// attestation signed by a validator. Attestations of a record are sorted by target epoch.
+type attestation struct {
+	Source      types.Epoch `json:"source"`
+	Target      types.Epoch `json:"target"`
+	SigningRoot []byte      `json:"signing_root"`
+}
+
+func (r *protectionRecord) signingRootAtTarget(target types.Epoch) ([32]byte, bool) {
+	var root [32]byte
+	for _, a := range r.Attestations {
+		if a.Target == target {
+			copy(root[:], a.SigningRoot)
+			return root, true
+		}
+	}
+	return root, false
+}
+
+// checkAttestation returns whether an attestation would be a double vote or surround
+// another attestation of the record, following the rules of the local validator DB.
+func (r *protectionRecord) checkAttestation(signingRoot [32]byte, att *ethpb.IndexedAttestation) (kv.SlashingKind, error) {
+	source, target := att.Data.Source.Epoch, att.Data.Target.Epoch
+	if existing, ok := r.signingRootAtTarget(target); ok && slashutil.SigningRootsDiffer(existing, signingRoot) {
+		return kv.DoubleVote, fmt.Errorf(doubleVoteMessage, target, existing)
+	}
+	for _, a := range r.Attestations {
+		existing := &ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: a.Source},
+				Target: &ethpb.Checkpoint{Epoch: a.Target},
+			},
+		}
+		if slashutil.IsSurround(att, existing) {
+			return kv.SurroundingVote, fmt.Errorf(surroundingVoteMessage, source, target, a.Source, a.Target)
+		}
+		if slashutil.IsSurround(existing, att) {
+			return kv.SurroundedVote, fmt.Errorf(surroundedVoteMessage, source, target, a.Source, a.Target)
+		}
+	}
+	return kv.NotSlashable, nil
+}
+
+// addAttestation records an attestation, updating the lowest signed epochs and pruning
+// the attestations older than the slashing protection pruning window.
+func (r *protectionRecord) addAttestation(source, target types.Epoch, signingRoot [32]byte) {
+	for _, a := range r.Attestations {
+		if a.Source == source && a.Target == target && bytes.Equal(a.SigningRoot, signingRoot[:]) {
+			return
+		}
+	}
+	r.Attestations = append(r.Attestations, &attestation{
+		Source:      source,
+		Target:      target,
+		SigningRoot: append([]byte{}, signingRoot[:]...),
+	})
+	sort.SliceStable(r.Attestations, func(i, j int) bool {
+		return r.Attestations[i].Target < r.Attestations[j].Target
+	})
+	if r.LowestSourceEpoch == nil || source < *r.LowestSourceEpoch {
+		r.LowestSourceEpoch = &source
+	}
+	if r.LowestTargetEpoch == nil || target < *r.LowestTargetEpoch {
+		r.LowestTargetEpoch = &target
+	}
+
+	newest := r.Attestations[len(r.Attestations)-1].Target
+	window := params.BeaconConfig().SlashingProtectionPruningEpochs
+	if newest <= window {
+		return
+	}
+	i := 0
+	for i < len(r.Attestations) && r.Attestations[i].Target < newest-window {
+		i++
+	}
+	r.Attestations = r.Attestations[i:]
+}
+
+func (r *protectionRecord) proposalAtSlot(slot types.Slot) ([]byte, bool) {
+	for _, p := range r.Proposals {
+		if p.Slot == slot {
+			return p.SigningRoot, true
+		}
+	}
+	return nil, false
+}
+
+// addProposal records a proposal, rejecting a different signing root than an existing
+// proposal at the same slot. The lowest and highest signed slots are updated and the
+// proposals older than the weak subjectivity period are pruned.
+func (r *protectionRecord) addProposal(slot types.Slot, signingRoot []byte) error {
+	if existing, ok := r.proposalAtSlot(slot); ok {
+		if !bytes.Equal(existing, signingRoot) {
+			return fmt.Errorf(doubleProposalMessage, slot, existing)
+		}
+		return nil
+	}
+	r.Proposals = append(r.Proposals, &kv.Proposal{Slot: slot, SigningRoot: append([]byte{}, signingRoot...)})
+	sort.SliceStable(r.Proposals, func(i, j int) bool {
+		return r.Proposals[i].Slot < r.Proposals[j].Slot
+	})
+	if r.LowestProposalSlot == nil || slot < *r.LowestProposalSlot {
+		r.LowestProposalSlot = &slot
+	}
+	if r.HighestProposalSlot == nil || slot > *r.HighestProposalSlot {
+		r.HighestProposalSlot = &slot
+	}
+
+	newestEpoch := helpers.SlotToEpoch(r.Proposals[len(r.Proposals)-1].Slot)
+	i := 0
+	for i < len(r.Proposals) && helpers.SlotToEpoch(r.Proposals[i].Slot)+params.BeaconConfig().WeakSubjectivityPeriod <= newestEpoch {
+		i++
+	}
+	r.Proposals = r.Proposals[i:]
+	return nil
+}
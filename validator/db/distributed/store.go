@@ -0,0 +1,336 @@
+package distributed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"go.opencensus.io/trace"
+)
+
+// maxUpdateAttempts bounds the number of compare-and-set retries of an update racing
+// with other replicas.
+const maxUpdateAttempts = 10
+
+// errRecordConflict is returned when a record kept changing under a write.
+var errRecordConflict = errors.New("slashing protection record updated concurrently by other replicas")
+
+// Store is a validator database whose slashing protection history lives in a backend
+// shared by all the replicas of a validator client. Other data, such as the genesis
+// validators root or the graffiti index, stays in the local database.
+type Store struct {
+	*kv.Store
+	backend Backend
+}
+
+// NewStore wraps the local database of a validator client replica.
+func NewStore(local *kv.Store, backend Backend) *Store {
+	return &Store{
+		Store:   local,
+		backend: backend,
+	}
+}
+
+// Close the local database and the backend.
+func (s *Store) Close() error {
+	if err := s.backend.Close(); err != nil {
+		return errors.Wrap(err, "could not close slashing protection backend")
+	}
+	return s.Store.Close()
+}
+
+func recordKey(pubKey [48]byte) string {
+	return hexutil.Encode(pubKey[:])
+}
+
+func (s *Store) record(ctx context.Context, pubKey [48]byte) (*protectionRecord, uint64, error) {
+	value, version, err := s.backend.Get(ctx, recordKey(pubKey))
+	if err != nil {
+		return nil, 0, err
+	}
+	r := &protectionRecord{}
+	if value == nil {
+		return r, version, nil
+	}
+	if err := json.Unmarshal(value, r); err != nil {
+		return nil, 0, errors.Wrapf(err, "could not decode slashing protection record of %#x", pubKey)
+	}
+	return r, version, nil
+}
+
+// update applies a change to the record of a public key with compare-and-set, retrying
+// on top of the latest record when another replica wrote it in the meantime. As the
+// change is checked against the latest record, checks made in it are serialized across
+// replicas.
+func (s *Store) update(ctx context.Context, pubKey [48]byte, change func(r *protectionRecord) error) error {
+	for i := 0; i < maxUpdateAttempts; i++ {
+		r, version, err := s.record(ctx, pubKey)
+		if err != nil {
+			return err
+		}
+		if err := change(r); err != nil {
+			return err
+		}
+		value, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		ok, err := s.backend.CompareAndSet(ctx, recordKey(pubKey), version, value)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return errRecordConflict
+}
+
+func (s *Store) publicKeys(ctx context.Context, has func(r *protectionRecord) bool) ([][48]byte, error) {
+	keys, err := s.backend.Keys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pubKeys := make([][48]byte, 0, len(keys))
+	for _, k := range keys {
+		b, err := hexutil.Decode(k)
+		if err != nil || len(b) != 48 {
+			log.WithField("key", k).Warn("Skipping invalid key in slashing protection backend")
+			continue
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], b)
+		r, _, err := s.record(ctx, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if has(r) {
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	return pubKeys, nil
+}
+
+// ProposedPublicKeys retrieves all public keys with a proposal history.
+func (s *Store) ProposedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.ProposedPublicKeys")
+	defer span.End()
+	return s.publicKeys(ctx, func(r *protectionRecord) bool {
+		return len(r.Proposals) > 0
+	})
+}
+
+// ProposalHistoryForSlot returns the signing root of the proposal at a slot, if any.
+func (s *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [48]byte, slot types.Slot) ([32]byte, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.ProposalHistoryForSlot")
+	defer span.End()
+	var signingRoot [32]byte
+	r, _, err := s.record(ctx, publicKey)
+	if err != nil {
+		return signingRoot, false, err
+	}
+	root, ok := r.proposalAtSlot(slot)
+	copy(signingRoot[:], root)
+	return signingRoot, ok, nil
+}
+
+// ProposalHistoryForPubKey returns the entire proposal history for a given public key.
+func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [48]byte) ([]*kv.Proposal, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.ProposalHistoryForPubKey")
+	defer span.End()
+	r, _, err := s.record(ctx, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	if r.Proposals == nil {
+		return make([]*kv.Proposal, 0), nil
+	}
+	return r.Proposals, nil
+}
+
+// SaveProposalHistoryForSlot atomically records a proposal for the public key. Unlike the
+// local database, a proposal with another signing root than an already recorded proposal
+// at the same slot is rejected, so that at most one replica gets to publish a block.
+func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.SaveProposalHistoryForSlot")
+	defer span.End()
+	return s.update(ctx, pubKey, func(r *protectionRecord) error {
+		return r.addProposal(slot, signingRoot)
+	})
+}
+
+// LowestSignedProposal returns the lowest signed proposal slot for a validator public key.
+func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.LowestSignedProposal")
+	defer span.End()
+	r, _, err := s.record(ctx, publicKey)
+	if err != nil || r.LowestProposalSlot == nil {
+		return 0, false, err
+	}
+	return *r.LowestProposalSlot, true, nil
+}
+
+// RaiseLowestSignedProposal raises the shared lowest signed proposal slot for a validator
+// public key to the given slot, if it is lower.
+func (s *Store) RaiseLowestSignedProposal(ctx context.Context, pubKey [48]byte, slot types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.RaiseLowestSignedProposal")
+	defer span.End()
+	return s.update(ctx, pubKey, func(r *protectionRecord) error {
+		if r.LowestProposalSlot == nil || *r.LowestProposalSlot < slot {
+			r.LowestProposalSlot = &slot
+		}
+		return nil
+	})
+}
+
+// HighestSignedProposal returns the highest signed proposal slot for a validator public key.
+func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.HighestSignedProposal")
+	defer span.End()
+	r, _, err := s.record(ctx, publicKey)
+	if err != nil || r.HighestProposalSlot == nil {
+		return 0, false, err
+	}
+	return *r.HighestProposalSlot, true, nil
+}
+
+// AttestedPublicKeys retrieves all public keys with an attestation history.
+func (s *Store) AttestedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.AttestedPublicKeys")
+	defer span.End()
+	return s.publicKeys(ctx, func(r *protectionRecord) bool {
+		return len(r.Attestations) > 0
+	})
+}
+
+// AttestationHistoryForPubKey retrieves the attestation history of a public key.
+func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [48]byte) ([]*kv.AttestationRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.AttestationHistoryForPubKey")
+	defer span.End()
+	r, _, err := s.record(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*kv.AttestationRecord, len(r.Attestations))
+	for i, a := range r.Attestations {
+		records[i] = &kv.AttestationRecord{
+			PubKey: pubKey,
+			Source: a.Source,
+			Target: a.Target,
+		}
+		copy(records[i].SigningRoot[:], a.SigningRoot)
+	}
+	return records, nil
+}
+
+// CheckSlashableAttestation verifies an incoming attestation is not a double vote nor a
+// surround vote given the shared history of the public key.
+func (s *Store) CheckSlashableAttestation(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.CheckSlashableAttestation")
+	defer span.End()
+	r, _, err := s.record(ctx, pubKey)
+	if err != nil {
+		return kv.NotSlashable, err
+	}
+	return r.checkAttestation(signingRoot, att)
+}
+
+// SaveAttestationForPubKey atomically checks and records an attestation for the public key.
+// Another replica may have recorded a conflicting attestation since CheckSlashableAttestation
+// was called, in which case the attestation is rejected here.
+func (s *Store) SaveAttestationForPubKey(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.SaveAttestationForPubKey")
+	defer span.End()
+	return s.update(ctx, pubKey, func(r *protectionRecord) error {
+		if _, err := r.checkAttestation(signingRoot, att); err != nil {
+			return err
+		}
+		r.addAttestation(att.Data.Source.Epoch, att.Data.Target.Epoch, signingRoot)
+		return nil
+	})
+}
+
+// SaveAttestationsForPubKey records a batch of attestations all at once. As for the local
+// database, the attestations are not checked, which allows importing any history.
+func (s *Store) SaveAttestationsForPubKey(
+	ctx context.Context, pubKey [48]byte, signingRoots [][32]byte, atts []*ethpb.IndexedAttestation,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.SaveAttestationsForPubKey")
+	defer span.End()
+	if len(signingRoots) != len(atts) {
+		return fmt.Errorf(
+			"number of signing roots %d does not match number of attestations %d",
+			len(signingRoots),
+			len(atts),
+		)
+	}
+	return s.update(ctx, pubKey, func(r *protectionRecord) error {
+		for i, att := range atts {
+			r.addAttestation(att.Data.Source.Epoch, att.Data.Target.Epoch, signingRoots[i])
+		}
+		return nil
+	})
+}
+
+// SigningRootAtTargetEpoch returns the signing root of the attestation at a target epoch,
+// or a zero root if there is none.
+func (s *Store) SigningRootAtTargetEpoch(ctx context.Context, pubKey [48]byte, target types.Epoch) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.SigningRootAtTargetEpoch")
+	defer span.End()
+	r, _, err := s.record(ctx, pubKey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	root, _ := r.signingRootAtTarget(target)
+	return root, nil
+}
+
+// LowestSignedSourceEpoch returns the lowest signed source epoch for a validator public key.
+func (s *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.LowestSignedSourceEpoch")
+	defer span.End()
+	r, _, err := s.record(ctx, publicKey)
+	if err != nil || r.LowestSourceEpoch == nil {
+		return 0, false, err
+	}
+	return *r.LowestSourceEpoch, true, nil
+}
+
+// LowestSignedTargetEpoch returns the lowest signed target epoch for a validator public key.
+func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.LowestSignedTargetEpoch")
+	defer span.End()
+	r, _, err := s.record(ctx, publicKey)
+	if err != nil || r.LowestTargetEpoch == nil {
+		return 0, false, err
+	}
+	return *r.LowestTargetEpoch, true, nil
+}
+
+// RaiseLowestSignedEpochs raises the shared lowest signed source and target epochs for a
+// validator public key to the given epochs, if they are lower.
+func (s *Store) RaiseLowestSignedEpochs(ctx context.Context, pubKey [48]byte, source, target types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.Distributed.RaiseLowestSignedEpochs")
+	defer span.End()
+	return s.update(ctx, pubKey, func(r *protectionRecord) error {
+		if r.LowestSourceEpoch == nil || *r.LowestSourceEpoch < source {
+			r.LowestSourceEpoch = &source
+		}
+		if r.LowestTargetEpoch == nil || *r.LowestTargetEpoch < target {
+			r.LowestTargetEpoch = &target
+		}
+		return nil
+	})
+}
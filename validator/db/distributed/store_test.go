@@ -0,0 +1,230 @@
+package distributed
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// setupReplicas returns validator databases of replicas sharing the same backend. Only
+// one local database may be open at a time, which the replicas share as it does not
+// hold any slashing protection history.
+func setupReplicas(t *testing.T, n int) []*Store {
+	local, err := kv.NewKVStore(context.Background(), t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, local.Close())
+	})
+	backend := NewEmbeddedBackend()
+	stores := make([]*Store, n)
+	for i := range stores {
+		stores[i] = NewStore(local, backend)
+	}
+	return stores
+}
+
+func createAttestation(source, target types.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+func TestStore_SaveAttestationForPubKey_SharedAcrossReplicas(t *testing.T) {
+	ctx := context.Background()
+	replicas := setupReplicas(t, 2)
+	pubKey := [48]byte{1}
+
+	require.NoError(t, replicas[0].SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(2, 3)))
+	// Saving the same attestation again is fine.
+	require.NoError(t, replicas[1].SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(2, 3)))
+
+	kind, err := replicas[1].CheckSlashableAttestation(ctx, pubKey, [32]byte{2}, createAttestation(2, 3))
+	assert.ErrorContains(t, "double vote", err)
+	assert.Equal(t, kv.DoubleVote, kind)
+	err = replicas[1].SaveAttestationForPubKey(ctx, pubKey, [32]byte{2}, createAttestation(2, 3))
+	assert.ErrorContains(t, "double vote", err)
+
+	kind, err = replicas[1].CheckSlashableAttestation(ctx, pubKey, [32]byte{3}, createAttestation(1, 4))
+	assert.ErrorContains(t, "surrounds", err)
+	assert.Equal(t, kv.SurroundingVote, kind)
+
+	require.NoError(t, replicas[0].SaveAttestationForPubKey(ctx, pubKey, [32]byte{4}, createAttestation(3, 10)))
+	kind, err = replicas[1].CheckSlashableAttestation(ctx, pubKey, [32]byte{5}, createAttestation(4, 5))
+	assert.ErrorContains(t, "surrounded", err)
+	assert.Equal(t, kv.SurroundedVote, kind)
+
+	history, err := replicas[1].AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, types.Epoch(3), history[0].Target)
+	assert.Equal(t, [32]byte{4}, history[1].SigningRoot)
+
+	lowestSource, exists, err := replicas[1].LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(2), lowestSource)
+	lowestTarget, exists, err := replicas[1].LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(3), lowestTarget)
+	root, err := replicas[1].SigningRootAtTargetEpoch(ctx, pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{4}, root)
+
+	keys, err := replicas[1].AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKey}, keys)
+	keys, err = replicas[1].ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+}
+
+func TestStore_SaveAttestationForPubKey_ConcurrentReplicas(t *testing.T) {
+	ctx := context.Background()
+	replicas := setupReplicas(t, 4)
+	pubKey := [48]byte{1}
+
+	// Every replica tries to record a conflicting attestation for the same target at once.
+	errs := make([]error, len(replicas))
+	var wg sync.WaitGroup
+	for i, r := range replicas {
+		wg.Add(1)
+		go func(i int, r *Store) {
+			defer wg.Done()
+			errs[i] = r.SaveAttestationForPubKey(ctx, pubKey, [32]byte{byte(i + 1)}, createAttestation(1, 2))
+		}(i, r)
+	}
+	wg.Wait()
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+	history, err := replicas[0].AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(history))
+}
+
+func TestStore_SaveAttestationsForPubKey_Prunes(t *testing.T) {
+	ctx := context.Background()
+	replicas := setupReplicas(t, 1)
+	pubKey := [48]byte{1}
+	window := params.BeaconConfig().SlashingProtectionPruningEpochs
+
+	atts := []*ethpb.IndexedAttestation{createAttestation(0, 1), createAttestation(window, window+1), createAttestation(window+1, window+2)}
+	require.NoError(t, replicas[0].SaveAttestationsForPubKey(ctx, pubKey, make([][32]byte, len(atts)), atts))
+	history, err := replicas[0].AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, window+1, history[0].Target)
+	lowestTarget, _, err := replicas[0].LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), lowestTarget, "the lowest signed target is kept after pruning")
+
+	err = replicas[0].SaveAttestationsForPubKey(ctx, pubKey, nil, atts)
+	assert.ErrorContains(t, "does not match", err)
+}
+
+func TestStore_SaveProposalHistoryForSlot(t *testing.T) {
+	ctx := context.Background()
+	replicas := setupReplicas(t, 2)
+	pubKey := [48]byte{1}
+
+	require.NoError(t, replicas[0].SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+	require.NoError(t, replicas[1].SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+	err := replicas[1].SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{2})
+	assert.ErrorContains(t, "double proposal", err)
+	require.NoError(t, replicas[1].SaveProposalHistoryForSlot(ctx, pubKey, 5, []byte{3}))
+
+	root, exists, err := replicas[0].ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, [32]byte{1}, root)
+	_, exists, err = replicas[0].ProposalHistoryForSlot(ctx, pubKey, 11)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	lowest, exists, err := replicas[0].LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(5), lowest)
+	highest, exists, err := replicas[0].HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(10), highest)
+
+	proposals, err := replicas[0].ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(proposals))
+	assert.Equal(t, types.Slot(5), proposals[0].Slot)
+
+	_, exists, err = replicas[0].LowestSignedProposal(ctx, [48]byte{2})
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+}
+
+func TestStore_RaiseLowestSigned(t *testing.T) {
+	ctx := context.Background()
+	replicas := setupReplicas(t, 2)
+	pubKey := [48]byte{1}
+	require.NoError(t, replicas[0].SaveProposalHistoryForSlot(ctx, pubKey, 1, []byte{1}))
+	require.NoError(t, replicas[0].SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(1, 2)))
+
+	require.NoError(t, replicas[0].RaiseLowestSignedProposal(ctx, pubKey, 5))
+	require.NoError(t, replicas[0].RaiseLowestSignedProposal(ctx, pubKey, 4))
+	require.NoError(t, replicas[0].RaiseLowestSignedEpochs(ctx, pubKey, 3, 1))
+	slot, _, err := replicas[1].LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), slot)
+	source, _, err := replicas[1].LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), source)
+	target, _, err := replicas[1].LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), target)
+}
+
+func TestEmbeddedBackend_CompareAndSet(t *testing.T) {
+	ctx := context.Background()
+	b := NewEmbeddedBackend()
+
+	ok, err := b.CompareAndSet(ctx, "a", 1, []byte("x"))
+	require.NoError(t, err)
+	assert.Equal(t, false, ok, "a missing key has version 0")
+	ok, err = b.CompareAndSet(ctx, "a", 0, []byte("x"))
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	ok, err = b.CompareAndSet(ctx, "a", 0, []byte("y"))
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	value, version, err := b.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("x"), value)
+	assert.Equal(t, uint64(1), version)
+	keys, err := b.Keys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"a"}, keys)
+}
+
+func TestNewBackend(t *testing.T) {
+	ctx := context.Background()
+	b, err := NewBackend(ctx, "embedded://")
+	require.NoError(t, err)
+	_, ok := b.(*EmbeddedBackend)
+	assert.Equal(t, true, ok)
+	_, err = NewBackend(ctx, "etcd://localhost:2379")
+	assert.ErrorContains(t, "unsupported slashing protection backend", err)
+}
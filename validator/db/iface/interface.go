@@ -8,12 +8,16 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
+	"github.com/prysmaticlabs/prysm/validator/db/distributed"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 )
 
 // Ensure the kv store implements the interface.
 var _ = ValidatorDB(&kv.Store{})
 
+// Ensure the distributed store implements the interface.
+var _ = ValidatorDB(&distributed.Store{})
+
 // ValidatorDB defines the necessary methods for a Prysm validator DB.
 type ValidatorDB interface {
 	io.Closer
@@ -31,6 +35,7 @@ type ValidatorDB interface {
 	// Proposer protection related methods.
 	HighestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error)
 	LowestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error)
+	RaiseLowestSignedProposal(ctx context.Context, pubKey [48]byte, slot types.Slot) error
 	ProposalHistoryForPubKey(ctx context.Context, publicKey [48]byte) ([]*kv.Proposal, error)
 	ProposalHistoryForSlot(ctx context.Context, publicKey [48]byte, slot types.Slot) ([32]byte, bool, error)
 	SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot []byte) error
@@ -44,6 +49,7 @@ type ValidatorDB interface {
 	SigningRootAtTargetEpoch(ctx context.Context, publicKey [48]byte, target types.Epoch) ([32]byte, error)
 	LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error)
 	LowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error)
+	RaiseLowestSignedEpochs(ctx context.Context, pubKey [48]byte, source, target types.Epoch) error
 	AttestedPublicKeys(ctx context.Context) ([][48]byte, error)
 	CheckSlashableAttestation(
 		ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
@@ -61,4 +67,8 @@ type ValidatorDB interface {
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
+
+	// Duty journal related methods.
+	SaveDutyRecord(ctx context.Context, record *kv.DutyRecord) error
+	DutyRecords(ctx context.Context, start, end types.Slot) ([]*kv.DutyRecord, error)
 }
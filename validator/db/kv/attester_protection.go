@@ -576,3 +576,28 @@ func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte)
 	})
 	return lowestSignedTargetEpoch, exists, err
 }
+
+// RaiseLowestSignedEpochs raises the lowest signed source and target epochs for a validator
+// public key to the given epochs, if they are lower. Attestations below the source epoch or at
+// or below the target epoch are then refused even if they are not part of the history, as
+// needed after importing a minimal history.
+func (s *Store) RaiseLowestSignedEpochs(ctx context.Context, pubKey [48]byte, source, target types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedEpochs")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		sourceBucket := tx.Bucket(lowestSignedSourceBucket)
+		lowestSignedSourceBytes := sourceBucket.Get(pubKey[:])
+		if len(lowestSignedSourceBytes) < 8 || bytesutil.BytesToEpochBigEndian(lowestSignedSourceBytes) < source {
+			if err := sourceBucket.Put(pubKey[:], bytesutil.EpochToBytesBigEndian(source)); err != nil {
+				return err
+			}
+		}
+		targetBucket := tx.Bucket(lowestSignedTargetBucket)
+		lowestSignedTargetBytes := targetBucket.Get(pubKey[:])
+		if len(lowestSignedTargetBytes) < 8 || bytesutil.BytesToEpochBigEndian(lowestSignedTargetBytes) < target {
+			return targetBucket.Put(pubKey[:], bytesutil.EpochToBytesBigEndian(target))
+		}
+		return nil
+	})
+}
@@ -329,6 +329,32 @@ func TestLowestSignedTargetEpoch_SaveRetrieveReplace(t *testing.T) {
 	require.Equal(t, types.Epoch(199), got)
 }
 
+func TestStore_RaiseLowestSignedEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(1, 2)))
+
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 5, 2))
+	source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(5), source)
+	target, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(2), target)
+
+	// The lowest signed epochs are never lowered.
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 4, 6))
+	source, _, err = validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(5), source)
+	target, _, err = validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(6), target)
+}
+
 func TestStore_SaveAttestationsForPubKey(t *testing.T) {
 	ctx := context.Background()
 	numValidators := 1
@@ -48,6 +48,7 @@ var blockedBuckets = [][]byte{
 	attestationSigningRootsBucket,
 	attestationSourceEpochsBucket,
 	attestationTargetEpochsBucket,
+	dutyJournalBucket,
 }
 
 // Config represents store's config object.
@@ -65,10 +66,14 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecord
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	dutyRecords                        dutyJournalBuffer
 }
 
 // Close closes the underlying boltdb database.
 func (s *Store) Close() error {
+	if err := s.flushDutyRecords(context.Background()); err != nil {
+		log.WithError(err).Error("Could not write duty records")
+	}
 	prometheus.Unregister(createBoltCollector(s.db))
 	return s.db.Close()
 }
@@ -150,6 +155,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			dutyJournalBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -172,6 +178,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	// Batch save attestation records for slashing protection at timed
 	// intervals to our database.
 	go kv.batchAttestationWrites(ctx)
+	go kv.batchDutyRecordWrites(ctx)
 
 	return kv, prometheus.Register(createBoltCollector(kv.db))
 }
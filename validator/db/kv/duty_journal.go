@@ -0,0 +1,196 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// dutyJournalRetentionEpochs is the number of epochs duty records are kept for.
+	dutyJournalRetentionEpochs = 1024
+	// dutyJournalWriteInterval is how often the buffered duty records are written to the
+	// database, so that they do not contend with slashing protection writes for every duty.
+	dutyJournalWriteInterval = 2 * time.Second
+	// dutyJournalPruneInterval is how often the records older than the retention period
+	// are deleted.
+	dutyJournalPruneInterval = 10 * time.Minute
+)
+
+// Results of a duty in the duty journal.
+const (
+	// DutyScheduled means the duty was assigned to the validator but was not performed. It
+	// was missed if its slot has passed, usually because the client was down.
+	DutyScheduled = "scheduled"
+	// DutySubmitted means the signed object was submitted to the beacon node.
+	DutySubmitted = "submitted"
+	// DutyFailed means the duty was aborted by an error.
+	DutyFailed = "failed"
+	// DutySkipped means there was nothing to submit, without any error.
+	DutySkipped = "skipped"
+)
+
+// dutyJournalBuffer holds the duty records waiting to be written to the database, by key.
+type dutyJournalBuffer struct {
+	sync.Mutex
+	records map[string]*DutyRecord
+}
+
+// DutyRecord is an entry of the duty journal, recording what happened to a duty
+// scheduled for a validator public key.
+type DutyRecord struct {
+	PubKey      [48]byte   `json:"pubkey"`
+	Role        string     `json:"role"`
+	Slot        types.Slot `json:"slot"`
+	BeaconNode  string     `json:"beacon_node,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	SignedAt    *time.Time `json:"signed_at,omitempty"`
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	Result      string     `json:"result"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// dutyRecordKey sorts the records by slot, then public key and role.
+func dutyRecordKey(r *DutyRecord) []byte {
+	key := make([]byte, 0, 8+48+len(r.Role))
+	key = append(key, bytesutil.Uint64ToBytesBigEndian(uint64(r.Slot))...)
+	key = append(key, r.PubKey[:]...)
+	return append(key, r.Role...)
+}
+
+// SaveDutyRecord adds a record to the duty journal, replacing a record of the same duty.
+// The record is buffered and written to the database at the next flush. A scheduled record
+// never replaces the record of a duty which was performed.
+func (s *Store) SaveDutyRecord(_ context.Context, record *DutyRecord) error {
+	key := string(dutyRecordKey(record))
+	s.dutyRecords.Lock()
+	defer s.dutyRecords.Unlock()
+	if s.dutyRecords.records == nil {
+		s.dutyRecords.records = make(map[string]*DutyRecord)
+	}
+	if existing, ok := s.dutyRecords.records[key]; ok && record.Result == DutyScheduled && existing.Result != DutyScheduled {
+		return nil
+	}
+	s.dutyRecords.records[key] = record
+	return nil
+}
+
+// flushDutyRecords writes the buffered duty records to the database in a single transaction.
+func (s *Store) flushDutyRecords(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "Validator.flushDutyRecords")
+	defer span.End()
+	s.dutyRecords.Lock()
+	records := s.dutyRecords.records
+	s.dutyRecords.records = nil
+	s.dutyRecords.Unlock()
+	if len(records) == 0 {
+		return nil
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(dutyJournalBucket)
+		for key, record := range records {
+			if record.Result == DutyScheduled && bkt.Get([]byte(key)) != nil {
+				continue
+			}
+			enc, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put([]byte(key), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// pruneDutyRecords deletes the records older than the retention period of the journal,
+// counted back from the latest recorded slot.
+func (s *Store) pruneDutyRecords(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "Validator.pruneDutyRecords")
+	defer span.End()
+	retentionSlots := params.BeaconConfig().SlotsPerEpoch.Mul(dutyJournalRetentionEpochs)
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(dutyJournalBucket)
+		c := bkt.Cursor()
+		last, _ := c.Last()
+		if last == nil {
+			return nil
+		}
+		latest := types.Slot(bytesutil.BytesToUint64BigEndian(last[:8]))
+		if latest <= retentionSlots {
+			return nil
+		}
+		oldest := bytesutil.Uint64ToBytesBigEndian(uint64(latest - retentionSlots))
+		// Keys are collected first, as deleting while iterating with a cursor skips keys.
+		var expired [][]byte
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], oldest) < 0; k, _ = c.Next() {
+			expired = append(expired, k)
+		}
+		for _, k := range expired {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// batchDutyRecordWrites writes the buffered duty records to the database at every
+// dutyJournalWriteInterval, and prunes the journal at every dutyJournalPruneInterval.
+func (s *Store) batchDutyRecordWrites(ctx context.Context) {
+	writeTicker := time.NewTicker(dutyJournalWriteInterval)
+	defer writeTicker.Stop()
+	pruneTicker := time.NewTicker(dutyJournalPruneInterval)
+	defer pruneTicker.Stop()
+	for {
+		select {
+		case <-writeTicker.C:
+			if err := s.flushDutyRecords(ctx); err != nil {
+				log.WithError(err).Error("Could not write duty records")
+			}
+		case <-pruneTicker.C:
+			if err := s.pruneDutyRecords(ctx); err != nil {
+				log.WithError(err).Error("Could not prune duty records")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// DutyRecords returns the records of the duty journal for the slots between start and
+// end, inclusive, ordered by slot.
+func (s *Store) DutyRecords(ctx context.Context, start, end types.Slot) ([]*DutyRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.DutyRecords")
+	defer span.End()
+	// The buffered records are written first, so that they are part of the result.
+	if err := s.flushDutyRecords(ctx); err != nil {
+		return nil, err
+	}
+	records := make([]*DutyRecord, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(dutyJournalBucket).Cursor()
+		startKey := bytesutil.Uint64ToBytesBigEndian(uint64(start))
+		for k, v := c.Seek(startKey); k != nil; k, v = c.Next() {
+			if types.Slot(bytesutil.BytesToUint64BigEndian(k[:8])) > end {
+				break
+			}
+			r := &DutyRecord{}
+			if err := json.Unmarshal(v, r); err != nil {
+				return err
+			}
+			records = append(records, r)
+		}
+		return nil
+	})
+	return records, err
+}
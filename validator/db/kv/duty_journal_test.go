@@ -0,0 +1,106 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_DutyRecords(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+	signedAt := time.Unix(100, 0).UTC()
+	records := []*DutyRecord{
+		{PubKey: [48]byte{1}, Role: "attester", Slot: 5, Result: DutySubmitted, SignedAt: &signedAt, SubmittedAt: &signedAt},
+		{PubKey: [48]byte{1}, Role: "proposer", Slot: 5, Result: DutyFailed, Error: "could not sign"},
+		{PubKey: [48]byte{2}, Role: "attester", Slot: 6, Result: DutySkipped},
+		{PubKey: [48]byte{1}, Role: "attester", Slot: 7, Result: DutySubmitted},
+	}
+	for _, r := range records {
+		require.NoError(t, db.SaveDutyRecord(ctx, r))
+	}
+
+	got, err := db.DutyRecords(ctx, 5, 6)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(got))
+	assert.DeepEqual(t, records[0], got[0])
+	assert.Equal(t, "could not sign", got[1].Error)
+	assert.Equal(t, types.Slot(6), got[2].Slot)
+
+	// Saving the record of a duty again replaces it.
+	require.NoError(t, db.SaveDutyRecord(ctx, &DutyRecord{PubKey: [48]byte{2}, Role: "attester", Slot: 6, Result: DutyFailed}))
+	got, err = db.DutyRecords(ctx, 6, 6)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	assert.Equal(t, DutyFailed, got[0].Result)
+
+	got, err = db.DutyRecords(ctx, 8, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+}
+
+func TestStore_SaveDutyRecord_Buffered(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+	require.NoError(t, db.SaveDutyRecord(ctx, &DutyRecord{Role: "attester", Slot: 1}))
+	require.NoError(t, db.view(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(dutyJournalBucket).Cursor().First()
+		assert.Equal(t, true, k == nil, "Expected the record not to be written before a flush")
+		return nil
+	}))
+
+	require.NoError(t, db.flushDutyRecords(ctx))
+	require.NoError(t, db.view(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(dutyJournalBucket).Cursor().First()
+		assert.NotNil(t, k)
+		return nil
+	}))
+}
+
+func TestStore_SaveDutyRecord_Scheduled(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+	scheduled := func(slot types.Slot) *DutyRecord {
+		return &DutyRecord{PubKey: [48]byte{1}, Role: "attester", Slot: slot, Result: DutyScheduled}
+	}
+	require.NoError(t, db.SaveDutyRecord(ctx, scheduled(1)))
+	require.NoError(t, db.SaveDutyRecord(ctx, scheduled(2)))
+	// A performed duty replaces its scheduled record, both in the buffer and in the database.
+	require.NoError(t, db.SaveDutyRecord(ctx, &DutyRecord{PubKey: [48]byte{1}, Role: "attester", Slot: 1, Result: DutySubmitted}))
+	require.NoError(t, db.flushDutyRecords(ctx))
+	require.NoError(t, db.SaveDutyRecord(ctx, &DutyRecord{PubKey: [48]byte{1}, Role: "attester", Slot: 2, Result: DutyFailed}))
+	// Duties scheduled again, as assignments are fetched again, do not replace performed duties.
+	require.NoError(t, db.SaveDutyRecord(ctx, scheduled(1)))
+	require.NoError(t, db.SaveDutyRecord(ctx, scheduled(2)))
+	require.NoError(t, db.SaveDutyRecord(ctx, scheduled(3)))
+
+	got, err := db.DutyRecords(ctx, 0, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(got))
+	assert.Equal(t, DutySubmitted, got[0].Result)
+	assert.Equal(t, DutyFailed, got[1].Result)
+	assert.Equal(t, DutyScheduled, got[2].Result)
+}
+
+func TestStore_PruneDutyRecords(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+	retentionSlots := params.BeaconConfig().SlotsPerEpoch.Mul(dutyJournalRetentionEpochs)
+	for _, slot := range []types.Slot{1, 2, 3} {
+		require.NoError(t, db.SaveDutyRecord(ctx, &DutyRecord{Role: "attester", Slot: slot}))
+	}
+	require.NoError(t, db.SaveDutyRecord(ctx, &DutyRecord{Role: "attester", Slot: retentionSlots + 3}))
+	require.NoError(t, db.flushDutyRecords(ctx))
+	require.NoError(t, db.pruneDutyRecords(ctx))
+
+	got, err := db.DutyRecords(ctx, 0, retentionSlots+3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	assert.Equal(t, types.Slot(3), got[0].Slot)
+}
@@ -166,6 +166,23 @@ func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [48]byte) (t
 	return lowestSignedProposalSlot, exists, err
 }
 
+// RaiseLowestSignedProposal raises the lowest signed proposal slot for a validator public key
+// to the given slot, if it is lower. Proposals at or below the slot are then refused even if
+// they are not part of the history, as needed after importing a minimal history.
+func (s *Store) RaiseLowestSignedProposal(ctx context.Context, pubKey [48]byte, slot types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedProposal")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(lowestSignedProposalsBucket)
+		lowestSignedProposalBytes := bucket.Get(pubKey[:])
+		if len(lowestSignedProposalBytes) >= 8 && bytesutil.BytesToSlotBigEndian(lowestSignedProposalBytes) >= slot {
+			return nil
+		}
+		return bucket.Put(pubKey[:], bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
+
 // HighestSignedProposal returns the highest signed proposal slot for a validator public key.
 // If no data exists, a boolean of value false is returned.
 func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error) {
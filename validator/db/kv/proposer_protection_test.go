@@ -241,6 +241,31 @@ func TestStore_LowestSignedProposal(t *testing.T) {
 	assert.Equal(t, types.Slot(1), slot)
 }
 
+func TestStore_RaiseLowestSignedProposal(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [48]byte{3}
+	validatorDB := setupDB(t, [][48]byte{pubkey})
+
+	// A key without history gets the slot as lowest signed slot.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 2))
+	slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(2), slot)
+
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubkey, 1, []byte{1}))
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 5))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), slot)
+
+	// The lowest signed slot is never lowered.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 4))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), slot)
+}
+
 func TestStore_HighestSignedProposal(t *testing.T) {
 	ctx := context.Background()
 	pubkey := [48]byte{3}
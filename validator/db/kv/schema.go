@@ -37,4 +37,7 @@ var (
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
+
+	// Duty journal of the validator client, keyed by slot, public key and role.
+	dutyJournalBucket = []byte("duty-journal")
 )
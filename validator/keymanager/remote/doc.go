@@ -52,7 +52,7 @@ which requires the following schema:
    "remote_cert": {
      "crt_path": "/home/eth2/certs/client.crt", // Client certificate path.
      "ca_crt_path": "/home/eth2/certs/ca.crt",  // Certificate authority cert path.
-     "key_path": "/home/eth2/certs/client.key", // Client key path.
+     "key_path": "/home/eth2/certs/client.key", // Client key path or secret reference.
    }
  }
 */
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/secrets"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -71,56 +72,21 @@ type Keymanager struct {
 }
 
 // NewKeymanager instantiates a new imported keymanager from configuration options.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	// Load the client certificates.
 	if cfg.Opts.RemoteCertificate == nil {
 		return nil, errors.New("certificate configuration is missing")
 	}
 
-	var clientCreds credentials.TransportCredentials
-
-	if cfg.Opts.RemoteCertificate.RequireTls {
-		if cfg.Opts.RemoteCertificate.ClientCertPath == "" {
-			return nil, errors.New("client certificate is required")
-		}
-		if cfg.Opts.RemoteCertificate.ClientKeyPath == "" {
-			return nil, errors.New("client key is required")
-		}
-		clientPair, err := tls.LoadX509KeyPair(cfg.Opts.RemoteCertificate.ClientCertPath, cfg.Opts.RemoteCertificate.ClientKeyPath)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
-		}
-
-		// Load the CA for the server certificate if present.
-		cp := x509.NewCertPool()
-		if cfg.Opts.RemoteCertificate.CACertPath != "" {
-			serverCA, err := ioutil.ReadFile(cfg.Opts.RemoteCertificate.CACertPath)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
-			}
-			if !cp.AppendCertsFromPEM(serverCA) {
-				return nil, errors.Wrap(err, "failed to add server's CA certificate to pool")
-			}
-		}
-
-		tlsCfg := &tls.Config{
-			Certificates: []tls.Certificate{clientPair},
-			RootCAs:      cp,
-			MinVersion:   tls.VersionTLS13,
-		}
-		clientCreds = credentials.NewTLS(tlsCfg)
-	}
-
 	grpcOpts := []grpc.DialOption{
 		// Receive large messages without erroring.
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cfg.MaxMessageSize)),
 	}
-	if cfg.Opts.RemoteCertificate.RequireTls {
-		// Require TLS with client certificate.
-		grpcOpts = append(grpcOpts, grpc.WithTransportCredentials(clientCreds))
-	} else {
-		grpcOpts = append(grpcOpts, grpc.WithInsecure())
+	transportOpt, err := cfg.Opts.RemoteCertificate.TransportOption(ctx)
+	if err != nil {
+		return nil, err
 	}
+	grpcOpts = append(grpcOpts, transportOpt)
 
 	conn, err := grpc.Dial(cfg.Opts.RemoteAddr, grpcOpts...)
 	if err != nil {
@@ -136,6 +102,44 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	return k, nil
 }
 
+// TransportOption returns the gRPC dial option securing connections to a remote signer,
+// which requires TLS with a client certificate unless TLS is disabled. The client key
+// path may reference a secret of a secret provider instead of a file.
+func (c *CertificateConfig) TransportOption(ctx context.Context) (grpc.DialOption, error) {
+	if !c.RequireTls {
+		return grpc.WithInsecure(), nil
+	}
+	if c.ClientCertPath == "" {
+		return nil, errors.New("client certificate is required")
+	}
+	if c.ClientKeyPath == "" {
+		return nil, errors.New("client key is required")
+	}
+	clientPair, err := secrets.X509KeyPair(ctx, c.ClientCertPath, c.ClientKeyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+	}
+
+	// Load the CA for the server certificate if present.
+	cp := x509.NewCertPool()
+	if c.CACertPath != "" {
+		serverCA, err := ioutil.ReadFile(c.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+		}
+		if !cp.AppendCertsFromPEM(serverCA) {
+			return nil, errors.New("failed to add server's CA certificate to pool")
+		}
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{clientPair},
+		RootCAs:      cp,
+		MinVersion:   tls.VersionTLS13,
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)), nil
+}
+
 // UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
 // options file into a struct.
 func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
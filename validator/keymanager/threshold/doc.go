@@ -0,0 +1,42 @@
+/*
+Package threshold defines a keymanager implementation for validator keys split with
+Shamir's secret sharing across several signers, so that no single host holds a validator
+secret key. The keymanager may hold a share of each key itself and requests partial
+signatures for the other shares from peer signers, which are remote signers reached over
+gRPC with mutual TLS. Any threshold number of valid partial signatures is combined into
+the validator signature using Lagrange interpolation.
+
+Every partial signature is verified against the public key of its share, and the combined
+signature against the validator public key, so that a faulty or malicious peer can only
+make signing fail, never produce an invalid signature. A peer refusing to sign, such as
+because of its own slashing protection, counts as a missing share.
+
+Keys are split into shares with bls.SplitSecretKey, share i of the returned slice having
+index i+1. The keymanager can be customized via a keymanageropts.json file which requires
+the following schema:
+
+ {
+   "validators": [
+     {
+       "public_key": "0xa99a...", // Validator public key.
+       "threshold": 2,            // Number of shares needed to sign.
+       "share": {                 // Optional share held by the keymanager.
+         "index": 1,
+         "keystore_path": "/home/eth2/shares/share-1.json", // EIP-2335 keystore of the share.
+         "password_path": "/home/eth2/shares/password.txt"  // Keystore password file or secret reference.
+       },
+       "peers": [                 // Peer signers holding the other shares.
+         {"index": 2, "public_key": "0xb89b...", "address": "signer-2.example.com:4000"},
+         {"index": 3, "public_key": "0x8a21...", "address": "signer-3.example.com:4000"}
+       ]
+     }
+   ],
+   "remote_cert": {
+     "require_tls": true,                        // Require TLS with peer signers.
+     "crt_path": "/home/eth2/certs/client.crt",  // Client certificate path.
+     "key_path": "/home/eth2/certs/client.key",  // Client key path or secret reference.
+     "ca_crt_path": "/home/eth2/certs/ca.crt"    // Certificate authority cert path.
+   }
+ }
+*/
+package threshold
@@ -0,0 +1,435 @@
+package threshold
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/secrets"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/sirupsen/logrus"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotEnoughShares is returned when fewer partial signatures than the threshold
+// could be obtained for a signing request.
+var ErrNotEnoughShares = errors.New("not enough partial signatures to reach the threshold")
+
+// KeymanagerOpts for a threshold keymanager.
+type KeymanagerOpts struct {
+	Validators        []*ValidatorOpts          `json:"validators"`
+	RemoteCertificate *remote.CertificateConfig `json:"remote_cert,omitempty"`
+}
+
+// ValidatorOpts defines the shares of a validator key and the number of them needed to sign.
+type ValidatorOpts struct {
+	PublicKey string      `json:"public_key"`
+	Threshold uint64      `json:"threshold"`
+	Share     *ShareOpts  `json:"share,omitempty"`
+	Peers     []*PeerOpts `json:"peers"`
+}
+
+// ShareOpts defines a share of a validator key held by the keymanager in an EIP-2335 keystore.
+type ShareOpts struct {
+	Index        uint64 `json:"index"`
+	KeystorePath string `json:"keystore_path"`
+	PasswordPath string `json:"password_path"`
+}
+
+// PeerOpts defines a peer signer holding a share of a validator key, which signs for the
+// public key of its share.
+type PeerOpts struct {
+	Index     uint64 `json:"index"`
+	PublicKey string `json:"public_key"`
+	Address   string `json:"address"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a threshold keymanager.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+}
+
+// Keymanager implementation combining partial signatures of validator key shares.
+type Keymanager struct {
+	opts                *KeymanagerOpts
+	keys                map[[48]byte]*sharedKey
+	orderedPubKeys      [][48]byte
+	accountsChangedFeed *event.Feed
+}
+
+// sharedKey is a validator key split into shares.
+type sharedKey struct {
+	publicKey bls.PublicKey
+	threshold uint64
+	shares    []*share
+}
+
+// share of a validator key, held either by the keymanager or by a peer signer.
+type share struct {
+	index     uint64
+	publicKey bls.PublicKey
+	secretKey bls.SecretKey
+	peer      validatorpb.RemoteSignerClient
+	address   string
+}
+
+type partialSignature struct {
+	index     uint64
+	signature bls.Signature
+	err       error
+}
+
+// NewKeymanager instantiates a new threshold keymanager from configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg == nil || cfg.Opts == nil {
+		return nil, errors.New("keymanager options are missing")
+	}
+	if err := validateOpts(cfg.Opts); err != nil {
+		return nil, err
+	}
+	var transportOpt grpc.DialOption
+	if hasPeers(cfg.Opts) {
+		if cfg.Opts.RemoteCertificate == nil {
+			return nil, errors.New("certificate configuration is missing")
+		}
+		var err error
+		transportOpt, err = cfg.Opts.RemoteCertificate.TransportOption(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clients := make(map[string]validatorpb.RemoteSignerClient)
+	km := &Keymanager{
+		opts:                cfg.Opts,
+		keys:                make(map[[48]byte]*sharedKey, len(cfg.Opts.Validators)),
+		orderedPubKeys:      make([][48]byte, 0, len(cfg.Opts.Validators)),
+		accountsChangedFeed: new(event.Feed),
+	}
+	for _, v := range cfg.Opts.Validators {
+		enc, err := hexutil.Decode(v.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := bls.PublicKeyFromBytes(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator public key %s", v.PublicKey)
+		}
+		key := &sharedKey{
+			publicKey: pubKey,
+			threshold: v.Threshold,
+			shares:    make([]*share, 0, len(v.Peers)+1),
+		}
+		if v.Share != nil {
+			secretKey, err := loadShare(ctx, v.Share)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not load key share of %s", v.PublicKey)
+			}
+			key.shares = append(key.shares, &share{
+				index:     v.Share.Index,
+				publicKey: secretKey.PublicKey(),
+				secretKey: secretKey,
+			})
+		}
+		for _, p := range v.Peers {
+			enc, err := hexutil.Decode(p.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			sharePubKey, err := bls.PublicKeyFromBytes(enc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid public key of share %d of %s", p.Index, v.PublicKey)
+			}
+			client, ok := clients[p.Address]
+			if !ok {
+				conn, err := grpc.Dial(p.Address, transportOpt)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not connect to peer signer %s", p.Address)
+				}
+				client = validatorpb.NewRemoteSignerClient(conn)
+				clients[p.Address] = client
+			}
+			key.shares = append(key.shares, &share{
+				index:     p.Index,
+				publicKey: sharePubKey,
+				peer:      client,
+				address:   p.Address,
+			})
+		}
+		pk := bytesutil.ToBytes48(enc)
+		km.keys[pk] = key
+		km.orderedPubKeys = append(km.orderedPubKeys, pk)
+	}
+	return km, nil
+}
+
+// validateOpts checks the options are consistent before any key is parsed, such as every
+// validator having enough distinct shares to reach its threshold.
+func validateOpts(opts *KeymanagerOpts) error {
+	seen := make(map[string]bool, len(opts.Validators))
+	for _, v := range opts.Validators {
+		if err := validateHexKey(v.PublicKey); err != nil {
+			return errors.Wrap(err, "invalid validator public key")
+		}
+		if seen[v.PublicKey] {
+			return fmt.Errorf("duplicate validator public key %s", v.PublicKey)
+		}
+		seen[v.PublicKey] = true
+		if v.Threshold == 0 {
+			return fmt.Errorf("threshold of %s must be at least 1", v.PublicKey)
+		}
+		indices := make(map[uint64]bool, len(v.Peers)+1)
+		addIndex := func(i uint64) error {
+			if i == 0 {
+				return fmt.Errorf("share index of %s must be at least 1", v.PublicKey)
+			}
+			if indices[i] {
+				return fmt.Errorf("duplicate share index %d for %s", i, v.PublicKey)
+			}
+			indices[i] = true
+			return nil
+		}
+		if v.Share != nil {
+			if err := addIndex(v.Share.Index); err != nil {
+				return err
+			}
+			if v.Share.KeystorePath == "" || v.Share.PasswordPath == "" {
+				return fmt.Errorf("keystore and password paths of the share of %s are required", v.PublicKey)
+			}
+		}
+		for _, p := range v.Peers {
+			if err := addIndex(p.Index); err != nil {
+				return err
+			}
+			if err := validateHexKey(p.PublicKey); err != nil {
+				return errors.Wrapf(err, "invalid public key of share %d of %s", p.Index, v.PublicKey)
+			}
+			if p.Address == "" {
+				return fmt.Errorf("address of peer signer for share %d of %s is required", p.Index, v.PublicKey)
+			}
+		}
+		if uint64(len(indices)) < v.Threshold {
+			return fmt.Errorf("%s has %d shares, fewer than its threshold %d", v.PublicKey, len(indices), v.Threshold)
+		}
+	}
+	return nil
+}
+
+func validateHexKey(key string) error {
+	enc, err := hexutil.Decode(key)
+	if err != nil {
+		return err
+	}
+	if len(enc) != 48 {
+		return fmt.Errorf("%s is not 48 bytes long", key)
+	}
+	return nil
+}
+
+func hasPeers(opts *KeymanagerOpts) bool {
+	for _, v := range opts.Validators {
+		if len(v.Peers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// loadShare decrypts the EIP-2335 keystore of a key share, whose password path may
+// reference a secret of a secret provider instead of a file.
+func loadShare(ctx context.Context, opts *ShareOpts) (bls.SecretKey, error) {
+	keystorePath, err := fileutil.ExpandPath(opts.KeystorePath)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := ioutil.ReadFile(keystorePath) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read keystore")
+	}
+	ks := &keymanager.Keystore{}
+	if err := json.Unmarshal(enc, ks); err != nil {
+		return nil, errors.Wrap(err, "could not decode keystore")
+	}
+	password, err := secrets.Read(ctx, opts.PasswordPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read keystore password")
+	}
+	secretKey, err := keystorev4.New().Decrypt(ks.Crypto, strings.TrimRight(string(password), "\r\n"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	return bls.SecretKeyFromBytes(secretKey)
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{
+		RemoteCertificate: &remote.CertificateConfig{RequireTls: true},
+	}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of threshold keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	for _, v := range opts.Validators {
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Validator public key"), v.PublicKey)
+		fmt.Fprintf(&b, "  %s: %d\n", au.BrightMagenta("Threshold"), v.Threshold)
+		if v.Share != nil {
+			fmt.Fprintf(&b, "  %s: %d (%s)\n", au.BrightMagenta("Local share"), v.Share.Index, v.Share.KeystorePath)
+		}
+		for _, p := range v.Peers {
+			fmt.Fprintf(&b, "  %s: %d (%s)\n", au.BrightMagenta("Peer share"), p.Index, p.Address)
+		}
+	}
+	if opts.RemoteCertificate != nil {
+		fmt.Fprintf(&b, "%s: %t\n", au.BrightMagenta("Require TLS"), opts.RemoteCertificate.RequireTls)
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Client cert path"), opts.RemoteCertificate.ClientCertPath)
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Client key path"), opts.RemoteCertificate.ClientKeyPath)
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("CA cert path"), opts.RemoteCertificate.CACertPath)
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the threshold keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// FetchValidatingPublicKeys returns the public keys of the configured validators.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][48]byte, error) {
+	pubKeys := make([][48]byte, len(km.orderedPubKeys))
+	copy(pubKeys, km.orderedPubKeys)
+	return pubKeys, nil
+}
+
+// Sign requests partial signatures from all the shares of a validator key at once and
+// combines the first threshold valid ones into the validator signature.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	key, ok := km.keys[bytesutil.ToBytes48(req.PublicKey)]
+	if !ok {
+		return nil, fmt.Errorf("no key shares for public key %#x", req.PublicKey)
+	}
+	// Requests still in flight are canceled once the threshold is reached.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan *partialSignature, len(key.shares))
+	for _, s := range key.shares {
+		go func(s *share) {
+			results <- s.sign(ctx, req)
+		}(s)
+	}
+
+	indices := make([]uint64, 0, key.threshold)
+	partials := make([]bls.Signature, 0, key.threshold)
+	failures := make([]string, 0)
+	for range key.shares {
+		r := <-results
+		if r.err != nil {
+			log.WithError(r.err).WithFields(logrus.Fields{
+				"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(req.PublicKey)),
+				"share":     r.index,
+			}).Debug("Could not obtain partial signature")
+			failures = append(failures, fmt.Sprintf("share %d: %v", r.index, r.err))
+			continue
+		}
+		indices = append(indices, r.index)
+		partials = append(partials, r.signature)
+		if uint64(len(partials)) < key.threshold {
+			continue
+		}
+		sig, err := bls.RecoverSignature(indices, partials)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not combine partial signatures")
+		}
+		if !sig.Verify(key.publicKey, req.SigningRoot) {
+			return nil, errors.New("combined signature does not verify against the validator public key")
+		}
+		return sig, nil
+	}
+	return nil, errors.Wrapf(
+		ErrNotEnoughShares,
+		"got %d of %d for %#x (%s)",
+		len(partials),
+		key.threshold,
+		bytesutil.Trunc(req.PublicKey),
+		strings.Join(failures, ", "),
+	)
+}
+
+// sign returns the partial signature of the share, which is verified against the public
+// key of the share so that an invalid partial signature does not spoil the combination.
+func (s *share) sign(ctx context.Context, req *validatorpb.SignRequest) *partialSignature {
+	res := &partialSignature{index: s.index}
+	if s.secretKey != nil {
+		res.signature = s.secretKey.Sign(req.SigningRoot)
+	} else {
+		res.signature, res.err = s.requestPeer(ctx, req)
+	}
+	if res.err == nil && !res.signature.Verify(s.publicKey, req.SigningRoot) {
+		res.err = errors.New("invalid partial signature")
+	}
+	return res
+}
+
+// requestPeer asks the peer signer for the signature of its share, through the same
+// request as the validator signature but for the public key of the share.
+func (s *share) requestPeer(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	peerReq, ok := proto.Clone(req).(*validatorpb.SignRequest)
+	if !ok {
+		return nil, errors.New("could not copy sign request")
+	}
+	peerReq.PublicKey = s.publicKey.Marshal()
+	resp, err := s.peer.Sign(ctx, peerReq)
+	if err != nil {
+		return nil, errors.Wrapf(err, "peer signer %s", s.address)
+	}
+	switch resp.Status {
+	case validatorpb.SignResponse_DENIED:
+		return nil, remote.ErrSigningDenied
+	case validatorpb.SignResponse_FAILED:
+		return nil, remote.ErrSigningFailed
+	}
+	return bls.SignatureFromBytes(resp.Signature)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime. The keys of a threshold
+// keymanager only change with its configuration.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
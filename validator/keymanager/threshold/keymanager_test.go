@@ -0,0 +1,182 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+)
+
+func TestNewKeymanager_InvalidOpts(t *testing.T) {
+	pubKey := fmt.Sprintf("%#x", [48]byte{1})
+	sharePubKey := fmt.Sprintf("%#x", [48]byte{2})
+	peers := func(indices ...uint64) []*PeerOpts {
+		p := make([]*PeerOpts, len(indices))
+		for i, index := range indices {
+			p[i] = &PeerOpts{Index: index, PublicKey: sharePubKey, Address: "localhost:4000"}
+		}
+		return p
+	}
+	tests := []struct {
+		name       string
+		validators []*ValidatorOpts
+		wantErr    string
+	}{
+		{
+			name:       "invalid public key",
+			validators: []*ValidatorOpts{{PublicKey: "0x01", Threshold: 1, Peers: peers(1)}},
+			wantErr:    "is not 48 bytes long",
+		},
+		{
+			name: "duplicate validator",
+			validators: []*ValidatorOpts{
+				{PublicKey: pubKey, Threshold: 1, Peers: peers(1)},
+				{PublicKey: pubKey, Threshold: 1, Peers: peers(1)},
+			},
+			wantErr: "duplicate validator public key",
+		},
+		{
+			name:       "zero threshold",
+			validators: []*ValidatorOpts{{PublicKey: pubKey, Peers: peers(1)}},
+			wantErr:    "must be at least 1",
+		},
+		{
+			name:       "zero share index",
+			validators: []*ValidatorOpts{{PublicKey: pubKey, Threshold: 1, Peers: peers(0)}},
+			wantErr:    "share index of",
+		},
+		{
+			name: "duplicate share index",
+			validators: []*ValidatorOpts{{
+				PublicKey: pubKey,
+				Threshold: 2,
+				Share:     &ShareOpts{Index: 2, KeystorePath: "share.json", PasswordPath: "password.txt"},
+				Peers:     peers(1, 2),
+			}},
+			wantErr: "duplicate share index 2",
+		},
+		{
+			name: "missing keystore",
+			validators: []*ValidatorOpts{{
+				PublicKey: pubKey,
+				Threshold: 1,
+				Share:     &ShareOpts{Index: 1, PasswordPath: "password.txt"},
+			}},
+			wantErr: "keystore and password paths",
+		},
+		{
+			name: "missing peer address",
+			validators: []*ValidatorOpts{{
+				PublicKey: pubKey,
+				Threshold: 1,
+				Peers:     []*PeerOpts{{Index: 1, PublicKey: sharePubKey}},
+			}},
+			wantErr: "address of peer signer",
+		},
+		{
+			name:       "threshold above shares",
+			validators: []*ValidatorOpts{{PublicKey: pubKey, Threshold: 3, Peers: peers(1, 2)}},
+			wantErr:    "has 2 shares, fewer than its threshold 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+				Validators:        tt.validators,
+				RemoteCertificate: &remote.CertificateConfig{},
+			}})
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestUnmarshalOptionsFile_RequiresTLSByDefault(t *testing.T) {
+	opts := &KeymanagerOpts{
+		Validators: []*ValidatorOpts{{
+			PublicKey: fmt.Sprintf("%#x", [48]byte{1}),
+			Threshold: 1,
+			Peers:     []*PeerOpts{{Index: 1, PublicKey: fmt.Sprintf("%#x", [48]byte{2}), Address: "localhost:4000"}},
+		}},
+	}
+	enc, err := MarshalOptionsFile(context.Background(), opts)
+	require.NoError(t, err)
+	decoded, err := UnmarshalOptionsFile(ioutil.NopCloser(bytes.NewReader(enc)))
+	require.NoError(t, err)
+	assert.Equal(t, true, decoded.RemoteCertificate.RequireTls)
+	assert.DeepEqual(t, opts.Validators, decoded.Validators)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, 2, 3)
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+
+	peer := mock.NewMockRemoteSignerClient(ctrl)
+	peerShare := func(index uint64) *share {
+		return &share{index: index, publicKey: shares[index-1].PublicKey(), peer: peer, address: "localhost:4000"}
+	}
+	km := &Keymanager{
+		keys: map[[48]byte]*sharedKey{
+			pubKey: {
+				publicKey: secretKey.PublicKey(),
+				threshold: 2,
+				shares: []*share{
+					{index: 1, publicKey: shares[0].PublicKey(), secretKey: shares[0]},
+					peerShare(2),
+					peerShare(3),
+				},
+			},
+		},
+	}
+	root := bytesutil.PadTo([]byte("root"), 32)
+	req := &validatorpb.SignRequest{PublicKey: pubKey[:], SigningRoot: root}
+
+	// The peer holding share 2 refuses to sign and the one holding share 3 returns the
+	// partial signature of another share, which is discarded.
+	peer.EXPECT().Sign(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *validatorpb.SignRequest, _ ...interface{}) (*validatorpb.SignResponse, error) {
+			if bytes.Equal(r.PublicKey, shares[1].PublicKey().Marshal()) {
+				return &validatorpb.SignResponse{Status: validatorpb.SignResponse_DENIED}, nil
+			}
+			return &validatorpb.SignResponse{
+				Status:    validatorpb.SignResponse_SUCCEEDED,
+				Signature: shares[1].Sign(root).Marshal(),
+			}, nil
+		},
+	).Times(2)
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, ErrNotEnoughShares.Error(), err)
+
+	peer.EXPECT().Sign(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *validatorpb.SignRequest, _ ...interface{}) (*validatorpb.SignResponse, error) {
+			if bytes.Equal(r.PublicKey, shares[1].PublicKey().Marshal()) {
+				return nil, errors.New("connection refused")
+			}
+			return &validatorpb.SignResponse{
+				Status:    validatorpb.SignResponse_SUCCEEDED,
+				Signature: shares[2].Sign(root).Marshal(),
+			}, nil
+		},
+	).Times(2)
+	sig, err := km.Sign(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(root).Marshal(), sig.Marshal())
+
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{PublicKey: make([]byte, 48), SigningRoot: root})
+	assert.ErrorContains(t, "no key shares for public key", err)
+}
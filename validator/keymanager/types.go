@@ -28,8 +28,8 @@ type Keystore struct {
 	Name    string                 `json:"name"`
 }
 
-// Kind defines an enum for either imported, derived, or remote-signing
-// keystores for Prysm wallets.
+// Kind defines an enum for either imported, derived, remote-signing, web3signer or
+// threshold keystores for Prysm wallets.
 type Kind int
 
 const (
@@ -39,6 +39,10 @@ const (
 	Derived
 	// Remote keymanager capable of remote-signing data.
 	Remote
+	// Web3Signer keymanager signing through the standard HTTP remote-signing API.
+	Web3Signer
+	// Threshold keymanager combining partial signatures of key shares held by several signers.
+	Threshold
 )
 
 // String marshals a keymanager kind to a string value.
@@ -50,6 +54,10 @@ func (k Kind) String() string {
 		return "direct"
 	case Remote:
 		return "remote"
+	case Web3Signer:
+		return "web3signer"
+	case Threshold:
+		return "threshold"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -64,6 +72,10 @@ func ParseKind(k string) (Kind, error) {
 		return Imported, nil
 	case "remote":
 		return Remote, nil
+	case "web3signer":
+		return Web3Signer, nil
+	case "threshold":
+		return Threshold, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -5,10 +5,17 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 )
 
 var (
 	_ = keymanager.IKeymanager(&imported.Keymanager{})
 	_ = keymanager.IKeymanager(&derived.Keymanager{})
 	_ = keymanager.IKeymanager(&remote.Keymanager{})
+	_ = keymanager.IKeymanager(&web3signer.Keymanager{})
+	_ = keymanager.IKeymanager(&threshold.Keymanager{})
+
+	// The web3signer keymanager reloads its keys like the remote keymanager.
+	_ = remote.RemoteKeymanager(&web3signer.Keymanager{})
 )
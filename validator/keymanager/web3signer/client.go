@@ -0,0 +1,170 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/secrets"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	// Signing is on the critical path of duties, so requests are bounded well below a slot.
+	requestTimeout = 5 * time.Second
+	maxBodySize    = 1 << 20
+)
+
+var (
+	// ErrSigningFailed defines a failure from the remote signer
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a signing operation refused by the slashing
+	// protection of the remote signer.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrUnknownPublicKey defines a signing operation for a key the remote
+	// signer does not manage.
+	ErrUnknownPublicKey = errors.New("public key is not managed by remote signer")
+)
+
+// client of the HTTP remote signing API.
+type client struct {
+	baseURL *url.URL
+	http    *http.Client
+}
+
+func newClient(ctx context.Context, rawURL string, tlsOpts *TLSConfig) (*client, error) {
+	u, err := url.Parse(strings.TrimRight(rawURL, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("remote signer url must use http or https, got %q", u.Scheme)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsOpts != nil {
+		tlsCfg, err := tlsOpts.config(ctx)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsCfg
+	}
+	return &client{
+		baseURL: u,
+		http:    &http.Client{Transport: transport, Timeout: requestTimeout},
+	}, nil
+}
+
+// config builds the TLS configuration of the client, trusting the configured CA and
+// authenticating with the client certificate if one is set. The client key path may
+// reference a secret of a secret provider instead of a file.
+func (c *TLSConfig) config(ctx context.Context) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CACertPath != "" {
+		ca, err := ioutil.ReadFile(c.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain remote signer's CA certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("failed to add remote signer's CA certificate to pool")
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if c.ClientCertPath != "" || c.ClientKeyPath != "" {
+		pair, err := secrets.X509KeyPair(ctx, c.ClientCertPath, c.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+		}
+		tlsCfg.Certificates = []tls.Certificate{pair}
+	}
+	return tlsCfg, nil
+}
+
+// publicKeys lists the public keys the remote signer can sign with.
+func (c *client) publicKeys(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL.String()+publicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public keys from remote signer")
+	}
+	defer closeBody(resp)
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read public keys response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer returned status %d listing public keys: %s", resp.StatusCode, body)
+	}
+	var keys []string
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys response")
+	}
+	return keys, nil
+}
+
+// sign requests a signature of the public key over the typed request.
+func (c *client) sign(ctx context.Context, pubKey []byte, r *SignRequest) ([]byte, error) {
+	enc, err := json.Marshal(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode sign request")
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, c.baseURL.String()+signPath+hexutil.Encode(pubKey), bytes.NewReader(enc),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send sign request to remote signer")
+	}
+	defer closeBody(resp)
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read sign response")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrUnknownPublicKey
+	case http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	default:
+		return nil, errors.Wrapf(ErrSigningFailed, "status %d: %s", resp.StatusCode, body)
+	}
+	// Signers answer either with a JSON object or with the bare hex signature.
+	sig := strings.TrimSpace(string(body))
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		var sr SignResponse
+		if err := json.Unmarshal(body, &sr); err != nil {
+			return nil, errors.Wrap(err, "could not decode sign response")
+		}
+		sig = sr.Signature
+	}
+	return hexutil.Decode(sig)
+}
+
+func closeBody(resp *http.Response) {
+	if err := resp.Body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close response body")
+	}
+}
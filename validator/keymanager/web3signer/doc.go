@@ -0,0 +1,26 @@
+/*
+Package web3signer defines a keymanager implementation which signs through a remote
+signer speaking the standard HTTP remote-signing API, such as Web3Signer. Public keys
+are either configured statically or listed from the signer, and are reloaded every slot
+so that keys added to or removed from the signer are picked up at runtime.
+
+Every sign request is sent along with the object being signed and the fork info of the
+chain, which allows the remote signer to verify the signing root and to apply its own
+slashing protection. A request refused by the signer's slashing protection surfaces as
+ErrSigningDenied.
+
+The keymanager can be customized via a keymanageropts.json file
+which requires the following schema:
+
+ {
+   "url": "https://signer.example.com:9000",  // Remote signer URL.
+   "genesis_validators_root": "0x4b36...",    // Genesis validators root of the chain.
+   "public_keys": ["0xa99a...", "0xb89b..."], // Optional static list of public keys.
+   "tls": {
+     "ca_crt_path": "/home/eth2/certs/ca.crt",  // Certificate authority cert path.
+     "crt_path": "/home/eth2/certs/client.crt", // Optional client certificate path.
+     "key_path": "/home/eth2/certs/client.key"  // Optional client key path or secret reference.
+   }
+ }
+*/
+package web3signer
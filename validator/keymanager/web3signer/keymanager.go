@@ -0,0 +1,208 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+// KeymanagerOpts for a web3signer keymanager.
+type KeymanagerOpts struct {
+	// URL of the remote signer, such as https://signer.example.com:9000.
+	URL string `json:"url"`
+	// PublicKeys to validate with. When empty, the keys are listed from the remote signer.
+	PublicKeys []string `json:"public_keys,omitempty"`
+	// GenesisValidatorsRoot of the chain, sent along every signing request.
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	// TLS options for https connections to the remote signer.
+	TLS *TLSConfig `json:"tls,omitempty"`
+}
+
+// TLSConfig defines the certificate authority cert, client cert and
+// client key used for TLS connections to the remote signer.
+type TLSConfig struct {
+	CACertPath     string `json:"ca_crt_path,omitempty"`
+	ClientCertPath string `json:"crt_path,omitempty"`
+	ClientKeyPath  string `json:"key_path,omitempty"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a web3signer keymanager.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+}
+
+// Keymanager implementation signing through an HTTP remote signer.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	client                *client
+	genesisValidatorsRoot []byte
+	staticPubKeys         [][48]byte
+	lock                  sync.Mutex
+	orderedPubKeys        [][48]byte
+	accountsChangedFeed   *event.Feed
+}
+
+// NewKeymanager instantiates a new web3signer keymanager from configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg == nil || cfg.Opts == nil {
+		return nil, errors.New("keymanager options are missing")
+	}
+	if cfg.Opts.URL == "" {
+		return nil, errors.New("remote signer url is required")
+	}
+	gvr, err := hexutil.Decode(cfg.Opts.GenesisValidatorsRoot)
+	if err != nil || len(gvr) != 32 {
+		return nil, fmt.Errorf("invalid genesis validators root %q", cfg.Opts.GenesisValidatorsRoot)
+	}
+	c, err := newClient(ctx, cfg.Opts.URL, cfg.Opts.TLS)
+	if err != nil {
+		return nil, err
+	}
+	var staticKeys [][48]byte
+	if len(cfg.Opts.PublicKeys) > 0 {
+		staticKeys, err = decodePublicKeys(cfg.Opts.PublicKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Keymanager{
+		opts:                  cfg.Opts,
+		client:                c,
+		genesisValidatorsRoot: gvr,
+		staticPubKeys:         staticKeys,
+		orderedPubKeys:        make([][48]byte, 0),
+		accountsChangedFeed:   new(event.Feed),
+	}, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of web3signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Remote signer URL"), opts.URL)
+	fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Genesis validators root"), opts.GenesisValidatorsRoot)
+	if len(opts.PublicKeys) > 0 {
+		fmt.Fprintf(&b, "%s: %d\n", au.BrightMagenta("Static public keys"), len(opts.PublicKeys))
+	}
+	if opts.TLS != nil {
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Client cert path"), opts.TLS.ClientCertPath)
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Client key path"), opts.TLS.ClientKeyPath)
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("CA cert path"), opts.TLS.CACertPath)
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the web3signer keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// ReloadPublicKeys fetches the public keys again and notifies subscribers when the
+// key set of the remote signer changed.
+func (km *Keymanager) ReloadPublicKeys(ctx context.Context) ([][48]byte, error) {
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reload public keys")
+	}
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	changed := len(km.orderedPubKeys) != len(pubKeys)
+	for i := 0; !changed && i < len(pubKeys); i++ {
+		changed = km.orderedPubKeys[i] != pubKeys[i]
+	}
+	if changed {
+		log.Info(keymanager.KeysReloaded)
+		km.accountsChangedFeed.Send(pubKeys)
+	}
+	km.orderedPubKeys = pubKeys
+	return km.orderedPubKeys, nil
+}
+
+// FetchValidatingPublicKeys returns the configured static public keys, or else
+// the public keys listed by the remote signer.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	if len(km.staticPubKeys) > 0 {
+		pubKeys := make([][48]byte, len(km.staticPubKeys))
+		copy(pubKeys, km.staticPubKeys)
+		return pubKeys, nil
+	}
+	keys, err := km.client.publicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decodePublicKeys(keys)
+}
+
+// Sign signs a message for a validator key via an HTTP request to the remote signer.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	r, err := newSignRequest(req, km.genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := km.client.sign(ctx, req.PublicKey, r)
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when keys are
+// added to or removed from the remote signer.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+func decodePublicKeys(keys []string) ([][48]byte, error) {
+	pubKeys := make([][48]byte, len(keys))
+	for i, k := range keys {
+		enc, err := hexutil.Decode(strings.TrimSpace(k))
+		if err != nil || len(enc) != 48 {
+			return nil, fmt.Errorf("invalid public key %q", k)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(enc)
+	}
+	return pubKeys, nil
+}
@@ -0,0 +1,215 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const testGenesisValidatorsRoot = "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+
+type mockSigner struct {
+	lock      sync.Mutex
+	keys      []string
+	requests  []*SignRequest
+	status    int
+	signature string
+}
+
+func (m *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == publicKeysPath:
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(m.keys); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, signPath):
+		req := &SignRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.requests = append(m.requests, req)
+		if m.status != 0 {
+			w.WriteHeader(m.status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&SignResponse{Signature: m.signature}); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testPubKey(b byte) string {
+	k := make([]byte, 48)
+	k[0] = b
+	return hexutil.Encode(k)
+}
+
+func TestNewKeymanager_InvalidOptions(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{GenesisValidatorsRoot: testGenesisValidatorsRoot}})
+	assert.ErrorContains(t, "remote signer url is required", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{URL: "http://localhost:9000", GenesisValidatorsRoot: "0x01"}})
+	assert.ErrorContains(t, "invalid genesis validators root", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{URL: "ftp://localhost", GenesisValidatorsRoot: testGenesisValidatorsRoot}})
+	assert.ErrorContains(t, "must use http or https", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{
+		URL:                   "http://localhost:9000",
+		GenesisValidatorsRoot: testGenesisValidatorsRoot,
+		PublicKeys:            []string{"0x1234"},
+	}})
+	assert.ErrorContains(t, "invalid public key", err)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	signer := &mockSigner{keys: []string{testPubKey(1), testPubKey(2)}}
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	ctx := context.Background()
+
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{URL: srv.URL, GenesisValidatorsRoot: testGenesisValidatorsRoot}})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	assert.Equal(t, testPubKey(1), hexutil.Encode(keys[0][:]))
+	assert.Equal(t, testPubKey(2), hexutil.Encode(keys[1][:]))
+
+	// A static list takes precedence over the keys of the signer.
+	km, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{
+		URL:                   srv.URL,
+		GenesisValidatorsRoot: testGenesisValidatorsRoot,
+		PublicKeys:            []string{testPubKey(3)},
+	}})
+	require.NoError(t, err)
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.Equal(t, testPubKey(3), hexutil.Encode(keys[0][:]))
+}
+
+func TestKeymanager_ReloadPublicKeys(t *testing.T) {
+	signer := &mockSigner{keys: []string{testPubKey(2), testPubKey(1)}}
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	ctx := context.Background()
+
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{URL: srv.URL, GenesisValidatorsRoot: testGenesisValidatorsRoot}})
+	require.NoError(t, err)
+	changes := make(chan [][48]byte, 3)
+	sub := km.SubscribeAccountChanges(changes)
+	defer sub.Unsubscribe()
+
+	keys, err := km.ReloadPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	assert.Equal(t, testPubKey(1), hexutil.Encode(keys[0][:]), "keys should be sorted")
+	require.Equal(t, 1, len(changes))
+	<-changes
+
+	// An unchanged key set is not announced.
+	_, err = km.ReloadPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(changes))
+
+	signer.lock.Lock()
+	signer.keys = []string{testPubKey(1), testPubKey(3)}
+	signer.lock.Unlock()
+	keys, err = km.ReloadPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+	assert.DeepEqual(t, keys, <-changes)
+}
+
+func TestKeymanager_Sign_Denied(t *testing.T) {
+	signer := &mockSigner{status: http.StatusPreconditionFailed}
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	ctx := context.Background()
+
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{URL: srv.URL, GenesisValidatorsRoot: testGenesisValidatorsRoot}})
+	require.NoError(t, err)
+	pubKey, err := hexutil.Decode(testPubKey(1))
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	require.Equal(t, 1, len(signer.requests))
+	assert.Equal(t, RandaoRevealType, signer.requests[0].Type)
+	assert.Equal(t, testGenesisValidatorsRoot, signer.requests[0].ForkInfo.GenesisValidatorsRoot)
+
+	signer.status = http.StatusNotFound
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKey, Object: &validatorpb.SignRequest_Epoch{Epoch: 1}})
+	assert.ErrorContains(t, ErrUnknownPublicKey.Error(), err)
+
+	// Requests the remote signer cannot verify are not sent.
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKey, SigningRoot: make([]byte, 32)})
+	assert.ErrorContains(t, ErrUnsupportedSignRequest.Error(), err)
+	assert.Equal(t, 2, len(signer.requests))
+}
+
+func TestClient_Sign(t *testing.T) {
+	sig := make([]byte, 96)
+	sig[0] = 0xb0
+	signer := &mockSigner{signature: hexutil.Encode(sig)}
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+
+	c, err := newClient(context.Background(), srv.URL, nil)
+	require.NoError(t, err)
+	got, err := c.sign(context.Background(), make([]byte, 48), &SignRequest{Type: RandaoRevealType})
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig, got)
+
+	// Signers may answer with the bare signature.
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, err := w.Write([]byte(hexutil.Encode(sig)))
+		require.NoError(t, err)
+	})
+	got, err = c.sign(context.Background(), make([]byte, 48), &SignRequest{Type: RandaoRevealType})
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig, got)
+}
+
+func TestClient_TLS(t *testing.T) {
+	signer := &mockSigner{keys: []string{testPubKey(1)}}
+	srv := httptest.NewTLSServer(signer)
+	defer srv.Close()
+
+	// The certificate of the test server is not trusted by default.
+	c, err := newClient(context.Background(), srv.URL, nil)
+	require.NoError(t, err)
+	_, err = c.publicKeys(context.Background())
+	require.ErrorContains(t, "certificate", err)
+
+	caPath := filepath.Join(t.TempDir(), "ca.crt")
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(caPath, ca, 0600))
+	c, err = newClient(context.Background(), srv.URL, &TLSConfig{CACertPath: caPath})
+	require.NoError(t, err)
+	keys, err := c.publicKeys(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{testPubKey(1)}, keys)
+}
@@ -0,0 +1,310 @@
+package web3signer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+)
+
+// ErrUnsupportedSignRequest is returned for sign requests that do not carry an object
+// the remote signing API can sign.
+var ErrUnsupportedSignRequest = errors.New("unsupported sign request object")
+
+// newSignRequest maps a sign request of the validator client onto the typed request
+// of the remote signing API, which allows the signer to apply its own slashing protection.
+func newSignRequest(req *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*SignRequest, error) {
+	r := &SignRequest{SigningRoot: hexutil.Encode(req.SigningRoot)}
+	var epoch types.Epoch
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		if o.Block == nil {
+			return nil, errors.New("nil block in sign request")
+		}
+		r.Type = BlockType
+		r.Block = blockFromPhase0(o.Block)
+		epoch = helpers.SlotToEpoch(o.Block.Slot)
+	case *validatorpb.SignRequest_BlockV2:
+		if o.BlockV2 == nil {
+			return nil, errors.New("nil block in sign request")
+		}
+		r.Type = BlockV2Type
+		r.BeaconBlock = &BeaconBlockV2{Version: "ALTAIR", Block: blockFromAltair(o.BlockV2)}
+		epoch = helpers.SlotToEpoch(o.BlockV2.Slot)
+	case *validatorpb.SignRequest_AttestationData:
+		if o.AttestationData == nil {
+			return nil, errors.New("nil attestation data in sign request")
+		}
+		r.Type = AttestationType
+		r.Attestation = attestationDataFromProto(o.AttestationData)
+		epoch = helpers.SlotToEpoch(o.AttestationData.Slot)
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		agg := o.AggregateAttestationAndProof
+		if agg == nil || agg.Aggregate == nil || agg.Aggregate.Data == nil {
+			return nil, errors.New("nil aggregate in sign request")
+		}
+		r.Type = AggregateAndProofType
+		r.AggregateAndProof = &AggregateAndProof{
+			AggregatorIndex: uintString(uint64(agg.AggregatorIndex)),
+			Aggregate:       attestationFromProto(agg.Aggregate),
+			SelectionProof:  hexutil.Encode(agg.SelectionProof),
+		}
+		epoch = helpers.SlotToEpoch(agg.Aggregate.Data.Slot)
+	case *validatorpb.SignRequest_Slot:
+		r.Type = AggregationSlotType
+		r.AggregationSlot = &AggregationSlot{Slot: uintString(uint64(o.Slot))}
+		epoch = helpers.SlotToEpoch(o.Slot)
+	case *validatorpb.SignRequest_Epoch:
+		r.Type = RandaoRevealType
+		r.RandaoReveal = &RandaoReveal{Epoch: uintString(uint64(o.Epoch))}
+		epoch = o.Epoch
+	case *validatorpb.SignRequest_Exit:
+		if o.Exit == nil {
+			return nil, errors.New("nil exit in sign request")
+		}
+		r.Type = VoluntaryExitType
+		r.VoluntaryExit = voluntaryExitFromProto(o.Exit)
+		epoch = o.Exit.Epoch
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		r.Type = SyncCommitteeMessageType
+		r.SyncCommitteeMessage = &SyncCommitteeMessage{
+			BeaconBlockRoot: hexutil.Encode(o.SyncMessageBlockRoot),
+			Slot:            uintString(uint64(req.SigningSlot)),
+		}
+		epoch = helpers.SlotToEpoch(req.SigningSlot)
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		data := o.SyncAggregatorSelectionData
+		if data == nil {
+			return nil, errors.New("nil sync aggregator selection data in sign request")
+		}
+		r.Type = SyncCommitteeSelectionProofType
+		r.SyncAggregatorSelectionData = &SyncAggregatorSelectionData{
+			Slot:              uintString(uint64(data.Slot)),
+			SubcommitteeIndex: uintString(data.SubcommitteeIndex),
+		}
+		epoch = helpers.SlotToEpoch(data.Slot)
+	case *validatorpb.SignRequest_ContributionAndProof:
+		c := o.ContributionAndProof
+		if c == nil || c.Contribution == nil {
+			return nil, errors.New("nil contribution in sign request")
+		}
+		r.Type = SyncCommitteeContributionAndProofType
+		r.ContributionAndProof = &ContributionAndProof{
+			AggregatorIndex: uintString(uint64(c.AggregatorIndex)),
+			SelectionProof:  hexutil.Encode(c.SelectionProof),
+			Contribution: &SyncCommitteeContribution{
+				Slot:              uintString(uint64(c.Contribution.Slot)),
+				BeaconBlockRoot:   hexutil.Encode(c.Contribution.BlockRoot),
+				SubcommitteeIndex: uintString(c.Contribution.SubcommitteeIndex),
+				AggregationBits:   hexutil.Encode(c.Contribution.AggregationBits),
+				Signature:         hexutil.Encode(c.Contribution.Signature),
+			},
+		}
+		epoch = helpers.SlotToEpoch(c.Contribution.Slot)
+	default:
+		return nil, errors.Wrap(ErrUnsupportedSignRequest, fmt.Sprintf("%T", req.Object))
+	}
+	fork, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine fork")
+	}
+	r.ForkInfo = &ForkInfo{
+		Fork: &Fork{
+			PreviousVersion: hexutil.Encode(fork.PreviousVersion),
+			CurrentVersion:  hexutil.Encode(fork.CurrentVersion),
+			Epoch:           uintString(uint64(fork.Epoch)),
+		},
+		GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+	}
+	return r, nil
+}
+
+func blockFromPhase0(b *ethpb.BeaconBlock) *BeaconBlock {
+	block := &BeaconBlock{
+		Slot:          uintString(uint64(b.Slot)),
+		ProposerIndex: uintString(uint64(b.ProposerIndex)),
+		ParentRoot:    hexutil.Encode(b.ParentRoot),
+		StateRoot:     hexutil.Encode(b.StateRoot),
+	}
+	if b.Body != nil {
+		block.Body = blockBody(
+			b.Body.RandaoReveal, b.Body.Eth1Data, b.Body.Graffiti, b.Body.ProposerSlashings,
+			b.Body.AttesterSlashings, b.Body.Attestations, b.Body.Deposits, b.Body.VoluntaryExits,
+		)
+	}
+	return block
+}
+
+func blockFromAltair(b *validatorpb.BeaconBlockAltair) *BeaconBlock {
+	block := &BeaconBlock{
+		Slot:          uintString(uint64(b.Slot)),
+		ProposerIndex: uintString(uint64(b.ProposerIndex)),
+		ParentRoot:    hexutil.Encode(b.ParentRoot),
+		StateRoot:     hexutil.Encode(b.StateRoot),
+	}
+	if b.Body != nil {
+		block.Body = blockBody(
+			b.Body.RandaoReveal, b.Body.Eth1Data, b.Body.Graffiti, b.Body.ProposerSlashings,
+			b.Body.AttesterSlashings, b.Body.Attestations, b.Body.Deposits, b.Body.VoluntaryExits,
+		)
+		if b.Body.SyncAggregate != nil {
+			block.Body.SyncAggregate = &SyncAggregate{
+				SyncCommitteeBits:      hexutil.Encode(b.Body.SyncAggregate.SyncCommitteeBits),
+				SyncCommitteeSignature: hexutil.Encode(b.Body.SyncAggregate.SyncCommitteeSignature),
+			}
+		}
+	}
+	return block
+}
+
+func blockBody(
+	randaoReveal []byte,
+	eth1Data *ethpb.Eth1Data,
+	graffiti []byte,
+	proposerSlashings []*ethpb.ProposerSlashing,
+	attesterSlashings []*ethpb.AttesterSlashing,
+	attestations []*ethpb.Attestation,
+	deposits []*ethpb.Deposit,
+	exits []*ethpb.SignedVoluntaryExit,
+) *BeaconBlockBody {
+	body := &BeaconBlockBody{
+		RandaoReveal:      hexutil.Encode(randaoReveal),
+		Graffiti:          hexutil.Encode(graffiti),
+		ProposerSlashings: make([]*ProposerSlashing, len(proposerSlashings)),
+		AttesterSlashings: make([]*AttesterSlashing, len(attesterSlashings)),
+		Attestations:      make([]*Attestation, len(attestations)),
+		Deposits:          make([]*Deposit, len(deposits)),
+		VoluntaryExits:    make([]*SignedVoluntaryExit, len(exits)),
+	}
+	if eth1Data != nil {
+		body.Eth1Data = &Eth1Data{
+			DepositRoot:  hexutil.Encode(eth1Data.DepositRoot),
+			DepositCount: uintString(eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(eth1Data.BlockHash),
+		}
+	}
+	for i, s := range proposerSlashings {
+		body.ProposerSlashings[i] = &ProposerSlashing{
+			SignedHeader1: signedHeaderFromProto(s.Header_1),
+			SignedHeader2: signedHeaderFromProto(s.Header_2),
+		}
+	}
+	for i, s := range attesterSlashings {
+		body.AttesterSlashings[i] = &AttesterSlashing{
+			Attestation1: indexedAttestationFromProto(s.Attestation_1),
+			Attestation2: indexedAttestationFromProto(s.Attestation_2),
+		}
+	}
+	for i, a := range attestations {
+		body.Attestations[i] = attestationFromProto(a)
+	}
+	for i, d := range deposits {
+		proof := make([]string, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = hexutil.Encode(p)
+		}
+		body.Deposits[i] = &Deposit{Proof: proof}
+		if d.Data != nil {
+			body.Deposits[i].Data = &DepositData{
+				Pubkey:                hexutil.Encode(d.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(d.Data.WithdrawalCredentials),
+				Amount:                uintString(d.Data.Amount),
+				Signature:             hexutil.Encode(d.Data.Signature),
+			}
+		}
+	}
+	for i, e := range exits {
+		body.VoluntaryExits[i] = &SignedVoluntaryExit{
+			Message:   voluntaryExitFromProto(e.Exit),
+			Signature: hexutil.Encode(e.Signature),
+		}
+	}
+	return body
+}
+
+func signedHeaderFromProto(h *ethpb.SignedBeaconBlockHeader) *SignedBeaconBlockHeader {
+	if h == nil {
+		return nil
+	}
+	header := &SignedBeaconBlockHeader{Signature: hexutil.Encode(h.Signature)}
+	if h.Header != nil {
+		header.Message = &BeaconBlockHeader{
+			Slot:          uintString(uint64(h.Header.Slot)),
+			ProposerIndex: uintString(uint64(h.Header.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(h.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Header.BodyRoot),
+		}
+	}
+	return header
+}
+
+func indexedAttestationFromProto(a *ethpb.IndexedAttestation) *IndexedAttestation {
+	if a == nil {
+		return nil
+	}
+	indices := make([]string, len(a.AttestingIndices))
+	for i, idx := range a.AttestingIndices {
+		indices[i] = uintString(idx)
+	}
+	return &IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             attestationDataFromProto(a.Data),
+		Signature:        hexutil.Encode(a.Signature),
+	}
+}
+
+func attestationFromProto(a *ethpb.Attestation) *Attestation {
+	if a == nil {
+		return nil
+	}
+	return &Attestation{
+		AggregationBits: hexutil.Encode(a.AggregationBits),
+		Data:            attestationDataFromProto(a.Data),
+		Signature:       hexutil.Encode(a.Signature),
+	}
+}
+
+func attestationDataFromProto(d *ethpb.AttestationData) *AttestationData {
+	if d == nil {
+		return nil
+	}
+	return &AttestationData{
+		Slot:            uintString(uint64(d.Slot)),
+		Index:           uintString(uint64(d.CommitteeIndex)),
+		BeaconBlockRoot: hexutil.Encode(d.BeaconBlockRoot),
+		Source:          checkpointFromProto(d.Source),
+		Target:          checkpointFromProto(d.Target),
+	}
+}
+
+func checkpointFromProto(c *ethpb.Checkpoint) *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &Checkpoint{
+		Epoch: uintString(uint64(c.Epoch)),
+		Root:  hexutil.Encode(c.Root),
+	}
+}
+
+func voluntaryExitFromProto(e *ethpb.VoluntaryExit) *VoluntaryExit {
+	if e == nil {
+		return nil
+	}
+	return &VoluntaryExit{
+		Epoch:          uintString(uint64(e.Epoch)),
+		ValidatorIndex: uintString(uint64(e.ValidatorIndex)),
+	}
+}
+
+// uintString formats integers as decimal strings, as the API does for all uint64 values.
+func uintString(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
@@ -0,0 +1,161 @@
+package web3signer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupForkSchedule(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.GenesisForkVersion = []byte{0, 0, 0, 0}
+	cfg.AltairForkVersion = []byte{1, 0, 0, 0}
+	cfg.AltairForkEpoch = 10
+	cfg.ForkVersionSchedule = map[[4]byte]types.Epoch{
+		{0, 0, 0, 0}: 0,
+		{1, 0, 0, 0}: 10,
+	}
+	params.OverrideBeaconConfig(cfg)
+}
+
+func TestNewSignRequest_Types(t *testing.T) {
+	setupForkSchedule(t)
+	gvr := make([]byte, 32)
+	gvr[0] = 0xaa
+	root := make([]byte, 32)
+	root[31] = 0x01
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	tests := []struct {
+		name  string
+		req   *validatorpb.SignRequest
+		typ   SignRequestType
+		field string
+	}{
+		{
+			name:  "block",
+			req:   &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Block{Block: &ethpb.BeaconBlock{Slot: 3, Body: &ethpb.BeaconBlockBody{}}}},
+			typ:   BlockType,
+			field: "block",
+		},
+		{
+			name:  "altair block",
+			req:   &validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlockV2{BlockV2: &validatorpb.BeaconBlockAltair{Slot: 11 * slotsPerEpoch}}},
+			typ:   BlockV2Type,
+			field: "beacon_block",
+		},
+		{
+			name: "attestation",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Root: root}, Target: &ethpb.Checkpoint{Epoch: 1, Root: root},
+			}}},
+			typ:   AttestationType,
+			field: "attestation",
+		},
+		{
+			name: "aggregate and proof",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{
+				Aggregate: &ethpb.Attestation{Data: &ethpb.AttestationData{}},
+			}}},
+			typ:   AggregateAndProofType,
+			field: "aggregate_and_proof",
+		},
+		{
+			name:  "aggregation slot",
+			req:   &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 5}},
+			typ:   AggregationSlotType,
+			field: "aggregation_slot",
+		},
+		{
+			name:  "randao reveal",
+			req:   &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 2}},
+			typ:   RandaoRevealType,
+			field: "randao_reveal",
+		},
+		{
+			name:  "voluntary exit",
+			req:   &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 12, ValidatorIndex: 4}}},
+			typ:   VoluntaryExitType,
+			field: "voluntary_exit",
+		},
+		{
+			name: "sync committee message",
+			req: &validatorpb.SignRequest{
+				SigningSlot: 10 * slotsPerEpoch,
+				Object:      &validatorpb.SignRequest_SyncMessageBlockRoot{SyncMessageBlockRoot: root},
+			},
+			typ:   SyncCommitteeMessageType,
+			field: "sync_committee_message",
+		},
+		{
+			name: "sync committee selection proof",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_SyncAggregatorSelectionData{SyncAggregatorSelectionData: &statepb.SyncAggregatorSelectionData{
+				Slot: 10 * slotsPerEpoch, SubcommitteeIndex: 2,
+			}}},
+			typ:   SyncCommitteeSelectionProofType,
+			field: "sync_aggregator_selection_data",
+		},
+		{
+			name: "sync committee contribution and proof",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_ContributionAndProof{ContributionAndProof: &validatorpb.ContributionAndProof{
+				Contribution: &validatorpb.SyncCommitteeContribution{Slot: 10 * slotsPerEpoch, BlockRoot: root},
+			}}},
+			typ:   SyncCommitteeContributionAndProofType,
+			field: "contribution_and_proof",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.SigningRoot = root
+			r, err := newSignRequest(tt.req, gvr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.typ, r.Type)
+			assert.Equal(t, hexutil.Encode(root), r.SigningRoot)
+			assert.Equal(t, hexutil.Encode(gvr), r.ForkInfo.GenesisValidatorsRoot)
+
+			enc, err := json.Marshal(r)
+			require.NoError(t, err)
+			fields := make(map[string]json.RawMessage)
+			require.NoError(t, json.Unmarshal(enc, &fields))
+			_, ok := fields[tt.field]
+			assert.Equal(t, true, ok, "missing %s in %s", tt.field, enc)
+			// Type, fork info, signing root and the typed object.
+			assert.Equal(t, 4, len(fields))
+		})
+	}
+}
+
+func TestNewSignRequest_ForkInfo(t *testing.T) {
+	setupForkSchedule(t)
+	gvr := make([]byte, 32)
+
+	r, err := newSignRequest(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 9}}, gvr)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &Fork{PreviousVersion: "0x00000000", CurrentVersion: "0x00000000", Epoch: "0"}, r.ForkInfo.Fork)
+
+	r, err = newSignRequest(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 10}}, gvr)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &Fork{PreviousVersion: "0x00000000", CurrentVersion: "0x01000000", Epoch: "10"}, r.ForkInfo.Fork)
+
+	slot := 10*params.BeaconConfig().SlotsPerEpoch + 1
+	r, err = newSignRequest(&validatorpb.SignRequest{
+		SigningSlot: slot,
+		Object:      &validatorpb.SignRequest_SyncMessageBlockRoot{SyncMessageBlockRoot: make([]byte, 32)},
+	}, gvr)
+	require.NoError(t, err)
+	assert.Equal(t, "0x01000000", r.ForkInfo.Fork.CurrentVersion)
+	assert.Equal(t, uintString(uint64(slot)), r.SyncCommitteeMessage.Slot)
+}
+
+func TestNewSignRequest_Unsupported(t *testing.T) {
+	_, err := newSignRequest(&validatorpb.SignRequest{SigningRoot: make([]byte, 32)}, make([]byte, 32))
+	assert.ErrorContains(t, ErrUnsupportedSignRequest.Error(), err)
+}
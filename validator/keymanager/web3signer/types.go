@@ -0,0 +1,222 @@
+package web3signer
+
+// SignRequestType is the type of the object a remote signing request is made for.
+type SignRequestType string
+
+// Signing request types defined by the remote signing API.
+const (
+	BlockType                             SignRequestType = "BLOCK"
+	BlockV2Type                           SignRequestType = "BLOCK_V2"
+	AttestationType                       SignRequestType = "ATTESTATION"
+	AggregateAndProofType                 SignRequestType = "AGGREGATE_AND_PROOF"
+	AggregationSlotType                   SignRequestType = "AGGREGATION_SLOT"
+	RandaoRevealType                      SignRequestType = "RANDAO_REVEAL"
+	VoluntaryExitType                     SignRequestType = "VOLUNTARY_EXIT"
+	SyncCommitteeMessageType              SignRequestType = "SYNC_COMMITTEE_MESSAGE"
+	SyncCommitteeSelectionProofType       SignRequestType = "SYNC_COMMITTEE_SELECTION_PROOF"
+	SyncCommitteeContributionAndProofType SignRequestType = "SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF"
+)
+
+// SignRequest is the JSON body of a request to the signing endpoint. Exactly one of
+// the typed objects is set, matching the request type.
+type SignRequest struct {
+	Type                        SignRequestType              `json:"type"`
+	ForkInfo                    *ForkInfo                    `json:"fork_info"`
+	SigningRoot                 string                       `json:"signingRoot,omitempty"`
+	Block                       *BeaconBlock                 `json:"block,omitempty"`
+	BeaconBlock                 *BeaconBlockV2               `json:"beacon_block,omitempty"`
+	Attestation                 *AttestationData             `json:"attestation,omitempty"`
+	AggregateAndProof           *AggregateAndProof           `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot             *AggregationSlot             `json:"aggregation_slot,omitempty"`
+	RandaoReveal                *RandaoReveal                `json:"randao_reveal,omitempty"`
+	VoluntaryExit               *VoluntaryExit               `json:"voluntary_exit,omitempty"`
+	SyncCommitteeMessage        *SyncCommitteeMessage        `json:"sync_committee_message,omitempty"`
+	SyncAggregatorSelectionData *SyncAggregatorSelectionData `json:"sync_aggregator_selection_data,omitempty"`
+	ContributionAndProof        *ContributionAndProof        `json:"contribution_and_proof,omitempty"`
+}
+
+// SignResponse is the JSON response of the signing endpoint.
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// ForkInfo identifies the chain and the fork the signed object belongs to.
+type ForkInfo struct {
+	Fork                  *Fork  `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// Fork of the beacon chain.
+type Fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// BeaconBlockV2 wraps a beacon block of any fork along with the name of the fork.
+type BeaconBlockV2 struct {
+	Version string       `json:"version"`
+	Block   *BeaconBlock `json:"block"`
+}
+
+// BeaconBlock of the beacon chain. The sync aggregate of the body is only set from Altair.
+type BeaconBlock struct {
+	Slot          string           `json:"slot"`
+	ProposerIndex string           `json:"proposer_index"`
+	ParentRoot    string           `json:"parent_root"`
+	StateRoot     string           `json:"state_root"`
+	Body          *BeaconBlockBody `json:"body"`
+}
+
+// BeaconBlockBody of the beacon chain.
+type BeaconBlockBody struct {
+	RandaoReveal      string                 `json:"randao_reveal"`
+	Eth1Data          *Eth1Data              `json:"eth1_data"`
+	Graffiti          string                 `json:"graffiti"`
+	ProposerSlashings []*ProposerSlashing    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashing    `json:"attester_slashings"`
+	Attestations      []*Attestation         `json:"attestations"`
+	Deposits          []*Deposit             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExit `json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregate         `json:"sync_aggregate,omitempty"`
+}
+
+// Eth1Data voted for in a block.
+type Eth1Data struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+// ProposerSlashing included in a block.
+type ProposerSlashing struct {
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// SignedBeaconBlockHeader included in a proposer slashing.
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// BeaconBlockHeader of a block.
+type BeaconBlockHeader struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+// AttesterSlashing included in a block.
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+// IndexedAttestation included in an attester slashing.
+type IndexedAttestation struct {
+	AttestingIndices []string         `json:"attesting_indices"`
+	Data             *AttestationData `json:"data"`
+	Signature        string           `json:"signature"`
+}
+
+// Attestation included in a block or an aggregate.
+type Attestation struct {
+	AggregationBits string           `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       string           `json:"signature"`
+}
+
+// AttestationData signed by attesters.
+type AttestationData struct {
+	Slot            string      `json:"slot"`
+	Index           string      `json:"index"`
+	BeaconBlockRoot string      `json:"beacon_block_root"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+// Checkpoint of an attestation.
+type Checkpoint struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// Deposit included in a block.
+type Deposit struct {
+	Proof []string     `json:"proof"`
+	Data  *DepositData `json:"data"`
+}
+
+// DepositData of a deposit.
+type DepositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+// SignedVoluntaryExit included in a block.
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature string         `json:"signature"`
+}
+
+// VoluntaryExit of a validator.
+type VoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// SyncAggregate included in an Altair block.
+type SyncAggregate struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"`
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
+
+// AggregateAndProof published by an attestation aggregator.
+type AggregateAndProof struct {
+	AggregatorIndex string       `json:"aggregator_index"`
+	Aggregate       *Attestation `json:"aggregate"`
+	SelectionProof  string       `json:"selection_proof"`
+}
+
+// AggregationSlot signed to prove the selection as an attestation aggregator.
+type AggregationSlot struct {
+	Slot string `json:"slot"`
+}
+
+// RandaoReveal signed by a block proposer.
+type RandaoReveal struct {
+	Epoch string `json:"epoch"`
+}
+
+// SyncCommitteeMessage signed by a sync committee member.
+type SyncCommitteeMessage struct {
+	BeaconBlockRoot string `json:"beacon_block_root"`
+	Slot            string `json:"slot"`
+}
+
+// SyncAggregatorSelectionData signed to prove the selection as a sync committee aggregator.
+type SyncAggregatorSelectionData struct {
+	Slot              string `json:"slot"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+}
+
+// ContributionAndProof published by a sync committee aggregator.
+type ContributionAndProof struct {
+	AggregatorIndex string                     `json:"aggregator_index"`
+	SelectionProof  string                     `json:"selection_proof"`
+	Contribution    *SyncCommitteeContribution `json:"contribution"`
+}
+
+// SyncCommitteeContribution aggregated by a sync committee aggregator.
+type SyncCommitteeContribution struct {
+	Slot              string `json:"slot"`
+	BeaconBlockRoot   string `json:"beacon_block_root"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	AggregationBits   string `json:"aggregation_bits"`
+	Signature         string `json:"signature"`
+}
@@ -34,10 +34,13 @@ import (
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/distributed"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/proposerconfig"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
@@ -53,7 +56,7 @@ type ValidatorClient struct {
 	cliCtx            *cli.Context
 	ctx               context.Context
 	cancel            context.CancelFunc
-	db                *kv.Store
+	db                db.Database
 	services          *shared.ServiceRegistry // Lifecycle and service store.
 	lock              sync.RWMutex
 	wallet            *wallet.Wallet
@@ -119,6 +122,9 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 
 	// Initializes any forks here.
 	params.BeaconConfig().InitializeForkSchedule()
+	if err := params.BeaconConfig().VerifySupportedForks(); err != nil {
+		return nil, err
+	}
 
 	if err := validatorClient.initializeFromCLI(cliCtx); err != nil {
 		return nil, err
@@ -172,6 +178,23 @@ func (c *ValidatorClient) Close() {
 	close(c.stop)
 }
 
+// setupDistributedSlashingProtection keeps the slashing protection history in the backend
+// shared by all replicas of the validator client, when one is configured.
+func (c *ValidatorClient) setupDistributedSlashingProtection(cliCtx *cli.Context, valDB *kv.Store) error {
+	url := cliCtx.String(flags.DistributedSlashingProtectionURLFlag.Name)
+	if url == "" {
+		return nil
+	}
+	backend, err := distributed.NewBackend(cliCtx.Context, url)
+	if err != nil {
+		return errors.Wrap(err, "could not open distributed slashing protection backend")
+	}
+	c.db = distributed.NewStore(valDB, backend)
+	log.Warn("Using distributed slashing protection, the history in the local database is not used. " +
+		"Export it and import it with --" + flags.DistributedSlashingProtectionURLFlag.Name + " if needed")
+	return nil
+}
+
 func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	var keyManager keymanager.IKeymanager
 	var err error
@@ -244,6 +267,9 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if err := valDB.RunUpMigrations(cliCtx.Context); err != nil {
 		return errors.Wrap(err, "could not run database migration")
 	}
+	if err := c.setupDistributedSlashingProtection(cliCtx, valDB); err != nil {
+		return err
+	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := c.registerPrometheusService(cliCtx); err != nil {
@@ -333,6 +359,9 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	if err := valDB.RunUpMigrations(cliCtx.Context); err != nil {
 		return errors.Wrap(err, "could not run database migration")
 	}
+	if err := c.setupDistributedSlashingProtection(cliCtx, valDB); err != nil {
+		return err
+	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := c.registerPrometheusService(cliCtx); err != nil {
@@ -410,6 +439,14 @@ func (c *ValidatorClient) registerValidatorService(
 		}
 	}
 
+	var proposerConfig *proposerconfig.Store
+	if c.cliCtx.IsSet(flags.ProposerConfigFileFlag.Name) {
+		proposerConfig, err = proposerconfig.NewStore(c.cliCtx.String(flags.ProposerConfigFileFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not load proposer config file")
+		}
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -428,6 +465,8 @@ func (c *ValidatorClient) registerValidatorService(
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		BroadcastToAllBeaconNodes:  c.cliCtx.Bool(flags.BroadcastToAllBeaconNodesFlag.Name),
+		ProposerConfig:             proposerConfig,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
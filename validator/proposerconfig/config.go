@@ -0,0 +1,150 @@
+// Package proposerconfig parses the proposer configuration file of the validator client,
+// which holds per public key settings such as graffiti, and reloads it when it changes.
+package proposerconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"gopkg.in/yaml.v2"
+)
+
+// maxExtraDataLength is the maximum size in bytes of the extra data of an execution payload.
+const maxExtraDataLength = 32
+
+// Options of a proposer as written in the file. Fields left unset fall back to the
+// default section.
+//
+// Example:
+//
+//   default:
+//     graffiti: "Prysm"
+//   proposers:
+//     "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a":
+//       graffiti: "hex:0x1234"
+//       enabled: false
+type Options struct {
+	Graffiti   *string `yaml:"graffiti,omitempty"`
+	Enabled    *bool   `yaml:"enabled,omitempty"`
+	BuilderURL *string `yaml:"builder_url,omitempty"`
+	ExtraData  *string `yaml:"extra_data,omitempty"`
+}
+
+// Config is the content of a proposer configuration file.
+type Config struct {
+	Default   *Options            `yaml:"default,omitempty"`
+	Proposers map[string]*Options `yaml:"proposers,omitempty"`
+
+	proposers map[[48]byte]*Options
+}
+
+// Settings of a proposer, resolved from its own options and the default section.
+type Settings struct {
+	// Graffiti included in proposed blocks, if any.
+	Graffiti []byte
+	// Enabled is false when the validator client must not perform duties for the key.
+	Enabled bool
+	// BuilderURL is the URL of the block builder preferred for the key, if any.
+	BuilderURL string
+	// ExtraData to include in execution payloads built for the key, if any.
+	ExtraData []byte
+}
+
+// ParseFile reads and validates a proposer configuration file.
+func ParseFile(path string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Parse and validate the content of a proposer configuration file.
+func Parse(b []byte) (*Config, error) {
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(b, c); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer config")
+	}
+	if err := c.Default.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid default proposer options")
+	}
+	c.proposers = make(map[[48]byte]*Options, len(c.Proposers))
+	for k, o := range c.Proposers {
+		pubKey, err := hexutil.Decode(k)
+		if err != nil || len(pubKey) != 48 {
+			return nil, fmt.Errorf("%s is not a valid public key", k)
+		}
+		if err := o.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid options of proposer %s", k)
+		}
+		var key [48]byte
+		copy(key[:], pubKey)
+		if _, ok := c.proposers[key]; ok {
+			return nil, fmt.Errorf("proposer %s is configured more than once", k)
+		}
+		c.proposers[key] = o
+	}
+	return c, nil
+}
+
+func (o *Options) validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.Graffiti != nil {
+		if g := graffiti.ParseHexGraffiti(*o.Graffiti); len(g) > 32 {
+			return fmt.Errorf("graffiti is %d bytes long, more than 32 bytes", len(g))
+		}
+	}
+	if o.BuilderURL != nil {
+		u, err := url.Parse(*o.BuilderURL)
+		if err != nil {
+			return errors.Wrap(err, "invalid builder URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("builder URL %s is not an http or https URL", *o.BuilderURL)
+		}
+	}
+	if o.ExtraData != nil {
+		extraData, err := hexutil.Decode(*o.ExtraData)
+		if err != nil {
+			return errors.Wrap(err, "extra data is not a 0x prefixed hex string")
+		}
+		if len(extraData) > maxExtraDataLength {
+			return fmt.Errorf("extra data is %d bytes long, more than %d bytes", len(extraData), maxExtraDataLength)
+		}
+	}
+	return nil
+}
+
+// Settings of the proposer with a public key. A key absent from the file gets the
+// settings of the default section.
+func (c *Config) Settings(pubKey [48]byte) *Settings {
+	s := &Settings{Enabled: true}
+	if c == nil {
+		return s
+	}
+	// Options were validated on parsing, so they are applied without checks.
+	for _, o := range []*Options{c.Default, c.proposers[pubKey]} {
+		if o == nil {
+			continue
+		}
+		if o.Graffiti != nil {
+			s.Graffiti = []byte(graffiti.ParseHexGraffiti(*o.Graffiti))
+		}
+		if o.Enabled != nil {
+			s.Enabled = *o.Enabled
+		}
+		if o.BuilderURL != nil {
+			s.BuilderURL = *o.BuilderURL
+		}
+		if o.ExtraData != nil {
+			s.ExtraData = hexutil.MustDecode(*o.ExtraData)
+		}
+	}
+	return s
+}
@@ -0,0 +1,96 @@
+package proposerconfig
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const (
+	pubKeyA = "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+	pubKeyB = "0x8f4e6a7a3bbf5e0bf7b1ab5d18ba3fe0da5bf0b1ea94bbed6bd5c1d8d8a3e2dcd6b0a08b8e9b9e0a85c5ec2f73a4f1b2"
+)
+
+func toPubKey(t *testing.T, s string) [48]byte {
+	b, err := hexutil.Decode(s)
+	require.NoError(t, err)
+	var pubKey [48]byte
+	copy(pubKey[:], b)
+	return pubKey
+}
+
+func TestParse_Settings(t *testing.T) {
+	input := []byte(`default:
+  graffiti: "Prysm"
+  builder_url: "http://builder.local:18550"
+proposers:
+  "` + pubKeyA + `":
+    graffiti: "hex:0x4d722054"
+    enabled: false
+    extra_data: "0x1234"
+`)
+	c, err := Parse(input)
+	require.NoError(t, err)
+
+	a := c.Settings(toPubKey(t, pubKeyA))
+	assert.DeepEqual(t, &Settings{
+		Graffiti:   []byte("Mr T"),
+		Enabled:    false,
+		BuilderURL: "http://builder.local:18550",
+		ExtraData:  []byte{0x12, 0x34},
+	}, a)
+
+	b := c.Settings(toPubKey(t, pubKeyB))
+	assert.DeepEqual(t, &Settings{
+		Graffiti:   []byte("Prysm"),
+		Enabled:    true,
+		BuilderURL: "http://builder.local:18550",
+	}, b)
+}
+
+func TestConfig_Settings_Nil(t *testing.T) {
+	var c *Config
+	assert.DeepEqual(t, &Settings{Enabled: true}, c.Settings(toPubKey(t, pubKeyA)))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "unknown field",
+			input: "default:\n  grafiti: \"typo\"\n",
+			err:   "could not unmarshal proposer config",
+		},
+		{
+			name:  "invalid public key",
+			input: "proposers:\n  \"0x1234\":\n    enabled: false\n",
+			err:   "0x1234 is not a valid public key",
+		},
+		{
+			name:  "graffiti too long",
+			input: "default:\n  graffiti: \"" + pubKeyA + "\"\n",
+			err:   "more than 32 bytes",
+		},
+		{
+			name:  "builder URL scheme",
+			input: "default:\n  builder_url: \"ftp://builder\"\n",
+			err:   "is not an http or https URL",
+		},
+		{
+			name:  "extra data not hex",
+			input: "proposers:\n  \"" + pubKeyA + "\":\n    extra_data: \"Mr T\"\n",
+			err:   "invalid options of proposer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.input))
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package proposerconfig
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "proposerconfig")
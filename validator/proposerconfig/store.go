@@ -0,0 +1,106 @@
+package proposerconfig
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/prysmaticlabs/prysm/shared/asyncutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// Store holds the proposer configuration loaded from a file, which is replaced
+// whenever the file changes while the store is watching it.
+type Store struct {
+	path   string
+	lock   sync.RWMutex
+	config *Config
+}
+
+// NewStore loads the proposer configuration file at path.
+func NewStore(path string) (*Store, error) {
+	c, err := ParseFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		path:   path,
+		config: c,
+	}, nil
+}
+
+// Settings of the proposer with a public key in the current configuration.
+func (s *Store) Settings(pubKey [48]byte) *Settings {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.config.Settings(pubKey)
+}
+
+// reload parses the file again and replaces the current configuration. An invalid or
+// empty file, as seen while it is being written, is ignored so that the validator client
+// keeps running with the last valid configuration.
+func (s *Store) reload() {
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		log.WithError(err).Errorf("Could not read proposer config file %s", s.path)
+		return
+	}
+	if len(b) == 0 {
+		log.Errorf("Loaded in an empty proposer config file %s, keeping previous settings", s.path)
+		return
+	}
+	c, err := Parse(b)
+	if err != nil {
+		log.WithError(err).Errorf("Could not reload proposer config file %s, keeping previous settings", s.path)
+		return
+	}
+	s.lock.Lock()
+	s.config = c
+	s.lock.Unlock()
+	log.WithField("proposers", len(c.proposers)).Info("Reloaded proposer config file")
+}
+
+// Watch the proposer configuration file and reload it on changes until the context is
+// canceled. The directory of the file is watched rather than the file itself, as most
+// editors replace a file on save rather than writing to it. Events are debounced so that
+// a burst of writes results in a single reload.
+func (s *Store) Watch(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	dir := filepath.Dir(s.path)
+	if err := watcher.Add(dir); err != nil {
+		log.WithError(err).Errorf("Could not add directory %s to file watcher", dir)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fileChangesChan := make(chan interface{}, 100)
+
+	go asyncutil.Debounce(ctx, featureconfig.Get().KeystoreImportDebounceInterval, fileChangesChan, func(interface{}) {
+		s.reload()
+	})
+	name := filepath.Clean(s.path)
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != name || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			fileChangesChan <- event
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", s.path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package proposerconfig
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// waitFor polls a condition until it holds, failing the test after a second.
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("Condition not met in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestStore_Watch(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{
+		KeystoreImportDebounceInterval: 50 * time.Millisecond,
+	})
+	defer resetCfg()
+	hook := logTest.NewGlobal()
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("default:\n  graffiti: \"before\"\n"), os.ModePerm))
+	s, err := NewStore(path)
+	require.NoError(t, err)
+	pubKey := toPubKey(t, pubKeyA)
+	assert.DeepEqual(t, []byte("before"), s.Settings(pubKey).Graffiti)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Watch(ctx)
+	// Give the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+
+	// An invalid file keeps the previous settings.
+	require.NoError(t, ioutil.WriteFile(path, []byte("default:\n  enabled: maybe\n"), os.ModePerm))
+	waitFor(t, func() bool {
+		e := hook.LastEntry()
+		return e != nil && e.Message == "Could not reload proposer config file "+path+", keeping previous settings"
+	})
+	assert.DeepEqual(t, []byte("before"), s.Settings(pubKey).Graffiti)
+
+	// Replacing the file, as editors do, applies the new settings.
+	tmp := filepath.Join(filepath.Dir(path), "tmp.yaml")
+	require.NoError(t, ioutil.WriteFile(tmp, []byte("proposers:\n  \""+pubKeyA+"\":\n    enabled: false\n"), os.ModePerm))
+	require.NoError(t, os.Rename(tmp, path))
+	waitFor(t, func() bool {
+		return !s.Settings(pubKey).Enabled
+	})
+	require.LogsContain(t, hook, "Reloaded proposer config file")
+	settings := s.Settings(pubKey)
+	assert.Equal(t, false, settings.Enabled)
+	assert.Equal(t, 0, len(settings.Graffiti))
+}
@@ -209,3 +209,32 @@ func (s *Server) VoluntaryExit(
 		ExitedKeys: rawExitedKeys,
 	}, nil
 }
+
+// PreSignVoluntaryExits signs voluntary exits for the validator keys specified in a request
+// without broadcasting them, returning them as JSON to be kept and submitted later.
+func (s *Server) PreSignVoluntaryExits(
+	ctx context.Context, req *pb.PreSignVoluntaryExitsRequest,
+) (*pb.PreSignVoluntaryExitsResponse, error) {
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified to pre-sign voluntary exits for")
+	}
+	if s.wallet == nil || s.keymanager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No wallet found")
+	}
+	exits, err := accounts.PreSignVoluntaryExits(ctx, accounts.PreSignExitCfg{
+		ValidatorClient: s.beaconNodeValidatorClient,
+		Keymanager:      s.keymanager,
+		RawPubKeys:      req.PublicKeys,
+		Epoch:           req.Epoch,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not pre-sign voluntary exits: %v", err)
+	}
+	enc, err := json.Marshal(exits)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode pre-signed voluntary exits: %v", err)
+	}
+	return &pb.PreSignVoluntaryExitsResponse{
+		File: string(enc),
+	}, nil
+}
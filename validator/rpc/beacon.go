@@ -31,6 +31,7 @@ func (s *Server) registerBeaconClient() error {
 		s.clientGrpcRetries,
 		s.clientGrpcRetryDelay,
 		streamInterceptor,
+		client.WithMultipleEndpoints(),
 	)
 	if dialOpts == nil {
 		return errors.New("no dial options for beacon chain gRPC client")
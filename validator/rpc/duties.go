@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDutyJournal returns the duties recorded in the duty journal of the validator
+// database for a range of slots, which shows when and why duties were missed.
+func (s *Server) GetDutyJournal(ctx context.Context, req *pb.DutyJournalRequest) (*pb.DutyJournalResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not available")
+	}
+	if req.EndSlot < req.StartSlot {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "End slot %d is lower than start slot %d", req.EndSlot, req.StartSlot,
+		)
+	}
+	records, err := s.valDB.DutyRecords(ctx, req.StartSlot, req.EndSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read duty journal: %v", err)
+	}
+	currentSlot, slotKnown := s.currentSlot(ctx)
+	duties := make([]*pb.DutyJournalResponse_Duty, 0, len(records))
+	for _, r := range records {
+		if !containsPubKey(req.PublicKeys, r.PubKey) {
+			continue
+		}
+		if req.MissedOnly && r.Result == kv.DutySubmitted {
+			continue
+		}
+		// A scheduled duty is only missed once its slot has passed.
+		if req.MissedOnly && r.Result == kv.DutyScheduled && slotKnown && r.Slot >= currentSlot {
+			continue
+		}
+		duties = append(duties, &pb.DutyJournalResponse_Duty{
+			PublicKey:          r.PubKey[:],
+			Role:               r.Role,
+			Slot:               r.Slot,
+			BeaconNodeEndpoint: r.BeaconNode,
+			StartedAt:          unixMillis(&r.StartedAt),
+			SignedAt:           unixMillis(r.SignedAt),
+			SubmittedAt:        unixMillis(r.SubmittedAt),
+			Result:             r.Result,
+			Error:              r.Error,
+		})
+	}
+	return &pb.DutyJournalResponse{Duties: duties}, nil
+}
+
+// currentSlot returns the current slot, if the genesis time of the chain is known.
+func (s *Server) currentSlot(ctx context.Context) (types.Slot, bool) {
+	if s.genesisFetcher == nil {
+		return 0, false
+	}
+	genesis, err := s.genesisFetcher.GenesisInfo(ctx)
+	if err != nil || genesis.GenesisTime == nil {
+		return 0, false
+	}
+	return slotutil.SlotsSinceGenesis(time.Unix(genesis.GenesisTime.Seconds, 0)), true
+}
+
+// containsPubKey returns whether a public key is part of a filter, an empty filter
+// containing all public keys.
+func containsPubKey(filter [][]byte, pubKey [48]byte) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, k := range filter {
+		if bytes.Equal(k, pubKey[:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func unixMillis(t *time.Time) uint64 {
+	if t == nil || t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano() / int64(time.Millisecond))
+}
@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestServer_GetDutyJournal(t *testing.T) {
+	ctx := context.Background()
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	s := &Server{valDB: valDB}
+
+	started := time.Unix(100, 0)
+	submitted := started.Add(1500 * time.Millisecond)
+	records := []*kv.DutyRecord{
+		{PubKey: [48]byte{1}, Role: "attester", Slot: 1, StartedAt: started, SubmittedAt: &submitted, Result: kv.DutySubmitted},
+		{PubKey: [48]byte{2}, Role: "attester", Slot: 1, StartedAt: started, Result: kv.DutyFailed, Error: "boom"},
+		{PubKey: [48]byte{1}, Role: "proposer", Slot: 2, StartedAt: started, Result: kv.DutySkipped},
+		{PubKey: [48]byte{1}, Role: "attester", Slot: 5, StartedAt: started, Result: kv.DutyFailed},
+	}
+	for _, r := range records {
+		require.NoError(t, valDB.SaveDutyRecord(ctx, r))
+	}
+
+	res, err := s.GetDutyJournal(ctx, &pb.DutyJournalRequest{StartSlot: 1, EndSlot: 2})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Duties))
+	assert.Equal(t, uint64(100000), res.Duties[0].StartedAt)
+	assert.Equal(t, uint64(101500), res.Duties[0].SubmittedAt)
+	assert.Equal(t, uint64(0), res.Duties[0].SignedAt)
+
+	pubKey := [48]byte{1}
+	res, err = s.GetDutyJournal(ctx, &pb.DutyJournalRequest{EndSlot: 10, PublicKeys: [][]byte{pubKey[:]}, MissedOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Duties))
+	assert.Equal(t, "proposer", res.Duties[0].Role)
+	assert.Equal(t, kv.DutyFailed, res.Duties[1].Result)
+
+	_, err = s.GetDutyJournal(ctx, &pb.DutyJournalRequest{StartSlot: 3, EndSlot: 2})
+	assert.ErrorContains(t, "lower than start slot", err)
+}
+
+type genesisTimeFetcher struct {
+	genesis time.Time
+}
+
+func (g *genesisTimeFetcher) GenesisInfo(_ context.Context) (*ethpb.Genesis, error) {
+	return &ethpb.Genesis{GenesisTime: timestamppb.New(g.genesis)}, nil
+}
+
+func TestServer_GetDutyJournal_Scheduled(t *testing.T) {
+	ctx := context.Background()
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	s := &Server{
+		valDB:          valDB,
+		genesisFetcher: &genesisTimeFetcher{genesis: time.Now().Add(-3 * secondsPerSlot)},
+	}
+	for _, slot := range []types.Slot{1, 8} {
+		require.NoError(t, valDB.SaveDutyRecord(ctx, &kv.DutyRecord{PubKey: [48]byte{1}, Role: "attester", Slot: slot, Result: kv.DutyScheduled}))
+	}
+
+	res, err := s.GetDutyJournal(ctx, &pb.DutyJournalRequest{EndSlot: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Duties))
+
+	// Only the scheduled duty whose slot has passed was missed.
+	res, err = s.GetDutyJournal(ctx, &pb.DutyJournalRequest{EndSlot: 10, MissedOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Duties))
+	assert.Equal(t, types.Slot(1), res.Duties[0].Slot)
+	assert.Equal(t, kv.DutyScheduled, res.Duties[0].Result)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
@@ -27,7 +28,32 @@ func (s *Server) ExportSlashingProtection(ctx context.Context, _ *empty.Empty) (
 		return nil, errors.New("err finding validator database at path")
 	}
 
-	eipJSON, err := slashing.ExportStandardProtectionJSON(ctx, s.valDB)
+	return s.exportSlashingProtection(ctx, &slashing.ExportOptions{})
+}
+
+// ExportSlashingProtectionSubset handles the rpc call returning the json slashing history
+// of some public keys, or its minimal form keeping only the highest signed block and
+// attestation of each public key. This allows migrating a subset of the keys of a validator.
+func (s *Server) ExportSlashingProtectionSubset(
+	ctx context.Context, req *pb.ExportSlashingProtectionRequest,
+) (*pb.ExportSlashingProtectionResponse, error) {
+	if s.valDB == nil {
+		return nil, errors.New("err finding validator database at path")
+	}
+	pubKeys, err := publicKeysFromRequest(req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	return s.exportSlashingProtection(ctx, &slashing.ExportOptions{
+		PublicKeys: pubKeys,
+		Minimal:    req.Minimal,
+	})
+}
+
+func (s *Server) exportSlashingProtection(
+	ctx context.Context, opts *slashing.ExportOptions,
+) (*pb.ExportSlashingProtectionResponse, error) {
+	eipJSON, err := slashing.ExportProtectionJSON(ctx, s.valDB, opts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not export slashing protection history")
 	}
@@ -40,7 +66,6 @@ func (s *Server) ExportSlashingProtection(ctx context.Context, _ *empty.Empty) (
 	return &pb.ExportSlashingProtectionResponse{
 		File: string(encoded),
 	}, nil
-
 }
 
 // ImportSlashingProtection reads an input slashing protection EIP-3076
@@ -53,15 +78,75 @@ func (s *Server) ImportSlashingProtection(ctx context.Context, req *pb.ImportSla
 		return nil, errors.New("err finding validator database at path")
 	}
 
+	report, err := s.importSlashingProtection(ctx, req, false /* dry run */)
+	if err != nil {
+		return nil, err
+	}
+	for _, conflict := range report.Conflicts {
+		log.WithField("pubKey", fmt.Sprintf("%#x", conflict.PubKey)).Warnf(
+			"Slashing protection history not imported: %s", conflict.Reason,
+		)
+	}
+	log.Info("Slashing protection JSON successfully imported")
+	return &empty.Empty{}, nil
+}
+
+// DryRunImportSlashingProtection reports what importing an EIP-3076 standard JSON string
+// would do, without writing to the validator DB. It previews the history merged with the
+// existing history and the conflicts between them, whose public keys would stop signing.
+func (s *Server) DryRunImportSlashingProtection(
+	ctx context.Context, req *pb.ImportSlashingProtectionRequest,
+) (*pb.ImportSlashingProtectionReport, error) {
+	if s.valDB == nil {
+		return nil, errors.New("err finding validator database at path")
+	}
+	report, err := s.importSlashingProtection(ctx, req, true /* dry run */)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ImportSlashingProtectionReport{
+		PublicKeys:         make([][]byte, len(report.PublicKeys)),
+		SignedBlocks:       uint64(report.SignedBlocks),
+		SignedAttestations: uint64(report.SignedAttestations),
+		Conflicts:          make([]*pb.ImportSlashingProtectionReport_Conflict, len(report.Conflicts)),
+	}
+	for i := range report.PublicKeys {
+		res.PublicKeys[i] = report.PublicKeys[i][:]
+	}
+	for i, conflict := range report.Conflicts {
+		res.Conflicts[i] = &pb.ImportSlashingProtectionReport_Conflict{
+			PublicKey: conflict.PubKey[:],
+			Reason:    conflict.Reason,
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) importSlashingProtection(
+	ctx context.Context, req *pb.ImportSlashingProtectionRequest, dryRun bool,
+) (*slashing.ImportReport, error) {
 	if req.SlashingProtectionJson == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "empty slashing_protection json specified")
 	}
-	enc := []byte(req.SlashingProtectionJson)
-
-	buf := bytes.NewBuffer(enc)
-	if err := slashing.ImportStandardProtectionJSON(ctx, s.valDB, buf); err != nil {
+	pubKeys, err := publicKeysFromRequest(req.PublicKeys)
+	if err != nil {
 		return nil, err
 	}
-	log.Info("Slashing protection JSON successfully imported")
-	return &empty.Empty{}, nil
+	buf := bytes.NewBufferString(req.SlashingProtectionJson)
+	return slashing.ImportProtectionJSON(ctx, s.valDB, buf, &slashing.ImportOptions{
+		PublicKeys: pubKeys,
+		Minimal:    req.Minimal,
+		DryRun:     dryRun,
+	})
+}
+
+func publicKeysFromRequest(keys [][]byte) ([][48]byte, error) {
+	pubKeys := make([][48]byte, len(keys))
+	for i, key := range keys {
+		if len(key) != len(pubKeys[i]) {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key %#x is not %d bytes long", key, len(pubKeys[i]))
+		}
+		copy(pubKeys[i][:], key)
+	}
+	return pubKeys, nil
 }
@@ -7,10 +7,12 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
 	mocks "github.com/prysmaticlabs/prysm/validator/testing"
@@ -174,3 +176,48 @@ func TestImportExportSlashingProtection_RoundTrip(t *testing.T) {
 
 	require.DeepEqual(t, mockJSON.Metadata, receivedJSON.Metadata)
 }
+
+func TestDryRunImportSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][48]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	s := &Server{valDB: validatorDB}
+
+	attestingHistory := [][]*kv.AttestationRecord{
+		{{PubKey: pubKeys[0], Source: 1, Target: 2}},
+		{{PubKey: pubKeys[1], Source: 1, Target: 2}, {PubKey: pubKeys[1], Source: 0, Target: 3}},
+	}
+	proposalHistory := make([]kv.ProposalHistoryForPubkey, len(pubKeys))
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+
+	req := &pb.ImportSlashingProtectionRequest{
+		SlashingProtectionJson: string(encoded),
+	}
+	res, err := s.DryRunImportSlashingProtection(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKeys[0][:]}, res.PublicKeys)
+	assert.Equal(t, uint64(1), res.SignedAttestations)
+	require.Equal(t, 1, len(res.Conflicts))
+	assert.DeepEqual(t, pubKeys[1][:], res.Conflicts[0].PublicKey)
+
+	req.PublicKeys = [][]byte{{1}}
+	_, err = s.DryRunImportSlashingProtection(ctx, req)
+	assert.ErrorContains(t, "is not 48 bytes long", err)
+
+	req.PublicKeys = [][]byte{pubKeys[0][:]}
+	_, err = s.ImportSlashingProtection(ctx, req)
+	require.NoError(t, err)
+	res2, err := s.ExportSlashingProtectionSubset(ctx, &pb.ExportSlashingProtectionRequest{
+		PublicKeys: [][]byte{pubKeys[0][:]},
+		Minimal:    true,
+	})
+	require.NoError(t, err)
+	exported := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(res2.File), exported))
+	require.Equal(t, 1, len(exported.Data))
+	require.Equal(t, 1, len(exported.Data[0].SignedAttestations))
+	assert.Equal(t, "2", exported.Data[0].SignedAttestations[0].TargetEpoch)
+}
@@ -0,0 +1,32 @@
+package slashingprotection
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/distributed"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+// openValidatorDB opens the validator database in the data directory. The slashing
+// protection history is read from and written to the distributed backend instead
+// when --distributed-slashing-protection-url is set.
+func openValidatorDB(cliCtx *cli.Context, dataDir string) (db.Database, error) {
+	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return nil, err
+	}
+	url := cliCtx.String(flags.DistributedSlashingProtectionURLFlag.Name)
+	if url == "" {
+		return valDB, nil
+	}
+	backend, err := distributed.NewBackend(cliCtx.Context, url)
+	if err != nil {
+		if closeErr := valDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close validator DB")
+		}
+		return nil, errors.Wrap(err, "could not open distributed slashing protection backend")
+	}
+	return distributed.NewStore(valDB, backend), nil
+}
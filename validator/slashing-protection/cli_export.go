@@ -3,6 +3,7 @@ package slashingprotection
 import (
 	"encoding/json"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
@@ -18,6 +19,23 @@ const (
 	jsonExportFileName = "slashing_protection.json"
 )
 
+// publicKeysFromFlag parses the public keys of --slashing-protection-public-keys, which are
+// none when the flag is not set.
+func publicKeysFromFlag(cliCtx *cli.Context) ([][48]byte, error) {
+	if !cliCtx.IsSet(flags.SlashingProtectionPublicKeysFlag.Name) {
+		return nil, nil
+	}
+	var pubKeys [][48]byte
+	for _, str := range strings.Split(cliCtx.String(flags.SlashingProtectionPublicKeysFlag.Name), ",") {
+		pubKey, err := export.PubKeyFromHex(strings.TrimSpace(str))
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid public key", str)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
 // ExportSlashingProtectionJSONCli extracts a validator's slashing protection
 // history from their database and formats it into an EIP-3076 standard JSON
 // file via a CLI entrypoint to make it easy to migrate machines or Ethereum consensus clients.
@@ -26,7 +44,8 @@ const (
 // 1. Parse a path to the validator's datadir from the CLI context.
 // 2. Open the validator database.
 // 3. Call the function which actually exports the data from
-// from the validator's db into an EIP standard slashing protection format
+// from the validator's db into an EIP standard slashing protection format, only
+// for the public keys of --slashing-protection-public-keys if set.
 // 4. Format and save the JSON file to a user's specified output directory.
 func ExportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
 	var err error
@@ -47,7 +66,7 @@ func ExportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
 		return errors.Wrapf(err, "validator database not found at path %s", dataDir)
 	}
 
-	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	validatorDB, err := openValidatorDB(cliCtx, dataDir)
 	if err != nil {
 		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
 	}
@@ -56,7 +75,14 @@ func ExportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
 			log.WithError(err).Errorf("Could not close validator DB")
 		}
 	}()
-	eipJSON, err := export.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	pubKeys, err := publicKeysFromFlag(cliCtx)
+	if err != nil {
+		return err
+	}
+	eipJSON, err := export.ExportProtectionJSON(cliCtx.Context, validatorDB, &export.ExportOptions{
+		PublicKeys: pubKeys,
+		Minimal:    cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name),
+	})
 	if err != nil {
 		return errors.Wrap(err, "could not export slashing protection history")
 	}
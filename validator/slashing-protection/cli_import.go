@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	slashingProtectionFormat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
@@ -22,7 +23,8 @@ import (
 // 2. Open the validator database.
 // 3. Read the JSON file from user input.
 // 4. Call the function which actually imports the data from
-// from the standard slashing protection JSON file into our database.
+// from the standard slashing protection JSON file into our database, merging
+// it with the existing history, or only reports what it would import in a dry run.
 func ImportSlashingProtectionCLI(cliCtx *cli.Context) error {
 	var err error
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
@@ -41,7 +43,7 @@ func ImportSlashingProtectionCLI(cliCtx *cli.Context) error {
 		return errors.Wrapf(err, "err finding validator database at path %s", dataDir)
 	}
 
-	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	valDB, err := openValidatorDB(cliCtx, dataDir)
 	if err != nil {
 		return errors.Wrapf(err, "could not access validator database at path: %s", dataDir)
 	}
@@ -65,12 +67,35 @@ func ImportSlashingProtectionCLI(cliCtx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	pubKeys, err := publicKeysFromFlag(cliCtx)
+	if err != nil {
+		return err
+	}
+	dryRun := cliCtx.Bool(flags.SlashingProtectionDryRunFlag.Name)
 	buf := bytes.NewBuffer(enc)
-	if err := slashingProtectionFormat.ImportStandardProtectionJSON(
-		cliCtx.Context, valDB, buf,
-	); err != nil {
+	report, err := slashingProtectionFormat.ImportProtectionJSON(cliCtx.Context, valDB, buf, &slashingProtectionFormat.ImportOptions{
+		PublicKeys: pubKeys,
+		Minimal:    cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name),
+		DryRun:     dryRun,
+	})
+	if err != nil {
 		return err
 	}
-	log.Info("Slashing protection JSON successfully imported")
+	for _, conflict := range report.Conflicts {
+		log.WithField("pubKey", fmt.Sprintf("%#x", conflict.PubKey)).Warnf(
+			"Slashing protection history not imported: %s", conflict.Reason,
+		)
+	}
+	fields := logrus.Fields{
+		"publicKeys":         len(report.PublicKeys),
+		"signedBlocks":       report.SignedBlocks,
+		"signedAttestations": report.SignedAttestations,
+		"conflicts":          len(report.Conflicts),
+	}
+	if dryRun {
+		log.WithFields(fields).Info("Dry run of slashing protection JSON import, nothing was imported")
+		return nil
+	}
+	log.WithFields(fields).Info("Slashing protection JSON successfully imported")
 	return nil
 }
@@ -14,9 +14,27 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
 )
 
+// ExportOptions select the slashing protection history to export.
+type ExportOptions struct {
+	// PublicKeys to export the history of, all of them if empty.
+	PublicKeys [][48]byte
+	// Minimal only exports the highest signed block and an attestation with the highest
+	// source and target epochs of each public key. It is enough to protect the validator
+	// from slashing, as signing at or below these is refused after importing them.
+	Minimal bool
+}
+
 // ExportStandardProtectionJSON extracts all slashing protection data from a validator database
 // and packages it into an EIP-3076 compliant, standard
 func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database) (*format.EIPSlashingProtectionFormat, error) {
+	return ExportProtectionJSON(ctx, validatorDB, &ExportOptions{})
+}
+
+// ExportProtectionJSON extracts the slashing protection data selected by the options from a
+// validator database and packages it into an EIP-3076 compliant, standard
+func ExportProtectionJSON(
+	ctx context.Context, validatorDB db.Database, opts *ExportOptions,
+) (*format.EIPSlashingProtectionFormat, error) {
 	interchangeJSON := &format.EIPSlashingProtectionFormat{}
 	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
@@ -38,6 +56,10 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	if err != nil {
 		return nil, err
 	}
+	if len(opts.PublicKeys) > 0 {
+		proposedPublicKeys = filterPublicKeys(proposedPublicKeys, opts.PublicKeys)
+		attestedPublicKeys = filterPublicKeys(attestedPublicKeys, opts.PublicKeys)
+	}
 	dataByPubKey := make(map[[48]byte]*format.ProtectionData)
 
 	// Extract the signed proposals by public key.
@@ -99,6 +121,14 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 		if item.SignedBlocks == nil {
 			item.SignedBlocks = make([]*format.SignedBlock, 0)
 		}
+		if opts.Minimal {
+			if item.SignedBlocks, err = minimalSignedBlocks(item.SignedBlocks); err != nil {
+				return nil, err
+			}
+			if item.SignedAttestations, err = minimalSignedAttestations(item.SignedAttestations); err != nil {
+				return nil, err
+			}
+		}
 		dataList = append(dataList, item)
 	}
 	sort.Slice(dataList, func(i, j int) bool {
@@ -195,3 +195,27 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func Test_minimalSignedAttestations(t *testing.T) {
+	atts, err := minimalSignedAttestations([]*format.SignedAttestation{
+		{SourceEpoch: "1", TargetEpoch: "4", SigningRoot: "0x01"},
+		{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: "0x02"},
+		{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: "0x03"},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*format.SignedAttestation{{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: "0x02"}}, atts)
+
+	// The highest epochs are from different attestations, so no signing root applies.
+	atts, err = minimalSignedAttestations([]*format.SignedAttestation{
+		{SourceEpoch: "1", TargetEpoch: "5", SigningRoot: "0x01"},
+		{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: "0x02"},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*format.SignedAttestation{{SourceEpoch: "3", TargetEpoch: "5"}}, atts)
+
+	atts, err = minimalSignedAttestations(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(atts))
+	_, err = minimalSignedAttestations([]*format.SignedAttestation{{SourceEpoch: "a", TargetEpoch: "1"}})
+	assert.ErrorContains(t, "not a valid epoch", err)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
@@ -18,38 +19,112 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
 )
 
+// ImportOptions select the slashing protection history to import and how.
+type ImportOptions struct {
+	// PublicKeys to import the history of, all of them if empty.
+	PublicKeys [][48]byte
+	// Minimal only imports the highest signed block and an attestation with the highest
+	// source and target epochs of each public key, as in a minimal export. The lowest signed
+	// slot and epochs of the key are raised to these, so that the validator refuses to sign
+	// anything below them, even where the local history is older than the imported one.
+	Minimal bool
+	// DryRun only reports what would be imported, without writing to the database.
+	DryRun bool
+}
+
+// ImportReport summarizes the history of an import.
+type ImportReport struct {
+	// PublicKeys whose history is imported.
+	PublicKeys [][48]byte
+	// Number of signed blocks and attestations imported.
+	SignedBlocks       int
+	SignedAttestations int
+	// Conflicts found in the imported history, or between the imported history and the
+	// local history. The history of public keys in conflict is not imported and the
+	// validator client refuses to sign with them.
+	Conflicts []*ImportConflict
+}
+
+// ImportConflict describes why the history of a public key could not be imported.
+type ImportConflict struct {
+	PubKey [48]byte
+	Reason string
+}
+
 // ImportStandardProtectionJSON takes in EIP-3076 compliant JSON file used for slashing protection
 // by Ethereum validators and imports its data into Prysm's internal representation of slashing
 // protection in the validator client's database. For more information, see the EIP document here:
 // https://eips.ethereum.org/EIPS/eip-3076.
 func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
+	_, err := ImportProtectionJSON(ctx, validatorDB, r, &ImportOptions{})
+	return err
+}
+
+// ImportProtectionJSON imports the history selected by the options from an EIP-3076 compliant
+// JSON file, merging it with the history of the validator client's database. It reports the
+// history imported and the conflicts found, which is all it does in a dry run.
+func ImportProtectionJSON(
+	ctx context.Context, validatorDB db.Database, r io.Reader, opts *ImportOptions,
+) (*ImportReport, error) {
+	report := &ImportReport{
+		PublicKeys: make([][48]byte, 0),
+		Conflicts:  make([]*ImportConflict, 0),
+	}
 	encodedJSON, err := ioutil.ReadAll(r)
 	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
 	}
 	interchangeJSON := &format.EIPSlashingProtectionFormat{}
 	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
-		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
 	}
 	if interchangeJSON.Data == nil {
 		log.Warn("No slashing protection data to import")
-		return nil
+		return report, nil
 	}
 
 	// We validate the `MetadataV0` field of the slashing protection JSON file.
-	if err := validateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
-		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+	if err := checkMetadata(ctx, validatorDB, interchangeJSON, !opts.DryRun); err != nil {
+		return nil, errors.Wrap(err, "slashing protection JSON metadata was incorrect")
 	}
 
 	// We need to handle duplicate public keys in the JSON file, with potentially
 	// different signing histories for both attestations and blocks.
 	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(interchangeJSON.Data)
 	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for blocks by public key")
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
 	}
 	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(interchangeJSON.Data)
 	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+	if len(opts.PublicKeys) > 0 {
+		wanted := make(map[[48]byte]bool, len(opts.PublicKeys))
+		for _, pubKey := range opts.PublicKeys {
+			wanted[pubKey] = true
+		}
+		for pubKey := range signedBlocksByPubKey {
+			if !wanted[pubKey] {
+				delete(signedBlocksByPubKey, pubKey)
+			}
+		}
+		for pubKey := range signedAttsByPubKey {
+			if !wanted[pubKey] {
+				delete(signedAttsByPubKey, pubKey)
+			}
+		}
+	}
+	if opts.Minimal {
+		for pubKey, signedBlocks := range signedBlocksByPubKey {
+			if signedBlocksByPubKey[pubKey], err = minimalSignedBlocks(signedBlocks); err != nil {
+				return nil, errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+			}
+		}
+		for pubKey, signedAtts := range signedAttsByPubKey {
+			if signedAttsByPubKey[pubKey], err = minimalSignedAttestations(signedAtts); err != nil {
+				return nil, errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+			}
+		}
 	}
 
 	attestingHistoryByPubKey := make(map[[48]byte][]*kv.AttestationRecord)
@@ -59,7 +134,7 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		// file into the internal Prysm representation of proposal history.
 		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
 		if err != nil {
-			return errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+			return nil, errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
 		}
 		proposalHistoryByPubKey[pubKey] = *proposalHistory
 	}
@@ -69,33 +144,52 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		// file into the internal Prysm representation of attesting history.
 		historicalAtt, err := transformSignedAttestations(pubKey, signedAtts)
 		if err != nil {
-			return errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+			return nil, errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
 		}
 		attestingHistoryByPubKey[pubKey] = historicalAtt
 	}
 
-	// We validate and filter out public keys parsed from JSON to ensure we are
-	// not importing those which are slashable with respect to other data within the same JSON.
-	slashableProposerKeys := filterSlashablePubKeysFromBlocks(ctx, proposalHistoryByPubKey)
-	slashableAttesterKeys, err := filterSlashablePubKeysFromAttestations(
-		ctx, validatorDB, attestingHistoryByPubKey,
-	)
+	// We validate and filter out public keys parsed from JSON to ensure we are not importing
+	// those which are slashable with respect to other data within the same JSON, or with
+	// respect to the history in our database.
+	proposerConflicts, err := proposalConflicts(ctx, validatorDB, proposalHistoryByPubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not filter slashable proposer public keys from JSON data")
+	}
+	attesterConflicts, err := attestationConflicts(ctx, validatorDB, attestingHistoryByPubKey)
 	if err != nil {
-		return errors.Wrap(err, "could not filter slashable attester public keys from JSON data")
+		return nil, errors.Wrap(err, "could not filter slashable attester public keys from JSON data")
+	}
+	report.Conflicts = append(proposerConflicts, attesterConflicts...)
+
+	slashablePublicKeys := make([][48]byte, 0, len(report.Conflicts))
+	for _, conflict := range report.Conflicts {
+		delete(proposalHistoryByPubKey, conflict.PubKey)
+		delete(attestingHistoryByPubKey, conflict.PubKey)
+		slashablePublicKeys = append(slashablePublicKeys, conflict.PubKey)
 	}
 
-	slashablePublicKeys := make([][48]byte, 0, len(slashableAttesterKeys)+len(slashableProposerKeys))
-	for _, pubKey := range slashableProposerKeys {
-		delete(proposalHistoryByPubKey, pubKey)
-		slashablePublicKeys = append(slashablePublicKeys, pubKey)
+	importedPubKeys := make(map[[48]byte]bool)
+	for pubKey, proposalHistory := range proposalHistoryByPubKey {
+		importedPubKeys[pubKey] = true
+		report.SignedBlocks += len(proposalHistory.Proposals)
+	}
+	for pubKey, attestations := range attestingHistoryByPubKey {
+		importedPubKeys[pubKey] = true
+		report.SignedAttestations += len(attestations)
 	}
-	for _, pubKey := range slashableAttesterKeys {
-		delete(attestingHistoryByPubKey, pubKey)
-		slashablePublicKeys = append(slashablePublicKeys, pubKey)
+	for pubKey := range importedPubKeys {
+		report.PublicKeys = append(report.PublicKeys, pubKey)
+	}
+	sort.Slice(report.PublicKeys, func(i, j int) bool {
+		return bytes.Compare(report.PublicKeys[i][:], report.PublicKeys[j][:]) < 0
+	})
+	if opts.DryRun {
+		return report, nil
 	}
 
 	if err := validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, slashablePublicKeys); err != nil {
-		return errors.Wrap(err, "could not save slashable public keys to database")
+		return nil, errors.Wrap(err, "could not save slashable public keys to database")
 	}
 
 	// We save the histories to disk as atomic operations, ensuring that this only occurs
@@ -111,7 +205,7 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 				log.WithError(err).Debug("Could not increase progress bar")
 			}
 			if err = validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, proposal.Slot, proposal.SigningRoot); err != nil {
-				return errors.Wrap(err, "could not save proposal history from imported JSON to database")
+				return nil, errors.Wrap(err, "could not save proposal history from imported JSON to database")
 			}
 		}
 	}
@@ -131,13 +225,70 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 			signingRoots[i] = att.SigningRoot
 		}
 		if err := validatorDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, indexedAtts); err != nil {
-			return errors.Wrap(err, "could not save attestations from imported JSON to database")
+			return nil, errors.Wrap(err, "could not save attestations from imported JSON to database")
+		}
+	}
+	if err := raiseLowestSignedWatermarks(ctx, validatorDB, proposalHistoryByPubKey, attestingHistoryByPubKey); err != nil {
+		return nil, errors.Wrap(err, "could not save lowest signed slot and epochs from imported JSON to database")
+	}
+	return report, nil
+}
+
+// raiseLowestSignedWatermarks raises the lowest signed slot and epochs of each imported public
+// key to the lowest ones of its imported history. Following EIP-3076, the validator then refuses
+// to sign below the imported history, whose gaps, such as the history dropped by a minimal
+// export, could hide slashable messages. Saving the history alone would only lower them, leaving
+// the gap between an older local history and the imported one open.
+func raiseLowestSignedWatermarks(
+	ctx context.Context,
+	validatorDB db.Database,
+	proposalHistoryByPubKey map[[48]byte]kv.ProposalHistoryForPubkey,
+	attestingHistoryByPubKey map[[48]byte][]*kv.AttestationRecord,
+) error {
+	for pubKey, proposalHistory := range proposalHistoryByPubKey {
+		if len(proposalHistory.Proposals) == 0 {
+			continue
+		}
+		lowestSlot := proposalHistory.Proposals[0].Slot
+		for _, proposal := range proposalHistory.Proposals[1:] {
+			if proposal.Slot < lowestSlot {
+				lowestSlot = proposal.Slot
+			}
+		}
+		if err := validatorDB.RaiseLowestSignedProposal(ctx, pubKey, lowestSlot); err != nil {
+			return err
+		}
+	}
+	for pubKey, attestations := range attestingHistoryByPubKey {
+		if len(attestations) == 0 {
+			continue
+		}
+		lowestSource, lowestTarget := attestations[0].Source, attestations[0].Target
+		for _, att := range attestations[1:] {
+			if att.Source < lowestSource {
+				lowestSource = att.Source
+			}
+			if att.Target < lowestTarget {
+				lowestTarget = att.Target
+			}
+		}
+		if err := validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, lowestSource, lowestTarget); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
 func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat) error {
+	return checkMetadata(ctx, validatorDB, interchangeJSON, true /* save genesis validators root */)
+}
+
+// checkMetadata validates the metadata of the slashing protection JSON file against the
+// database, saving the genesis validators root of the file if the database has none and
+// saveGenesisRoot is set.
+func checkMetadata(
+	ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat, saveGenesisRoot bool,
+) error {
 	// We need to ensure the version in the metadata field matches the one we support.
 	version := interchangeJSON.Metadata.InterchangeFormatVersion
 	if version != format.InterchangeFormatVersion {
@@ -159,6 +310,9 @@ func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJ
 		return errors.Wrap(err, "could not retrieve genesis validator root to db")
 	}
 	if dbGvr == nil {
+		if !saveGenesisRoot {
+			return nil
+		}
 		if err = validatorDB.SaveGenesisValidatorsRoot(ctx, gvr[:]); err != nil {
 			return errors.Wrap(err, "could not save genesis validator root to db")
 		}
@@ -236,26 +390,59 @@ func parseAttestationsForUniquePublicKeys(data []*format.ProtectionData) (map[[4
 }
 
 func filterSlashablePubKeysFromBlocks(ctx context.Context, historyByPubKey map[[48]byte]kv.ProposalHistoryForPubkey) [][48]byte {
+	return conflictPubKeys(proposalConflictsInHistory(historyByPubKey))
+}
+
+func proposalConflictsInHistory(historyByPubKey map[[48]byte]kv.ProposalHistoryForPubkey) []*ImportConflict {
 	// Given signing roots are optional in the EIP standard, we behave as follows:
 	// For a given block:
 	//   If we have a previous block with the same slot in our history:
 	//     If signing root is nil, we consider that proposer public key as slashable
 	//     If signing root is not nil , then we compare signing roots. If they are different,
 	//     then we consider that proposer public key as slashable.
-	slashablePubKeys := make([][48]byte, 0)
+	conflicts := make([]*ImportConflict, 0)
 	for pubKey, proposals := range historyByPubKey {
 		seenSigningRootsBySlot := make(map[types.Slot][]byte)
 		for _, blk := range proposals.Proposals {
 			if signingRoot, ok := seenSigningRootsBySlot[blk.Slot]; ok {
 				if signingRoot == nil || !bytes.Equal(signingRoot, blk.SigningRoot) {
-					slashablePubKeys = append(slashablePubKeys, pubKey)
+					conflicts = append(conflicts, &ImportConflict{
+						PubKey: pubKey,
+						Reason: fmt.Sprintf("conflicting signed blocks at slot %d in imported history", blk.Slot),
+					})
 					break
 				}
 			}
 			seenSigningRootsBySlot[blk.Slot] = blk.SigningRoot
 		}
 	}
-	return slashablePubKeys
+	return conflicts
+}
+
+// proposalConflicts finds the signed blocks which are slashable with respect to other
+// blocks within the same JSON import, or to the blocks in our database.
+func proposalConflicts(
+	ctx context.Context,
+	validatorDB db.Database,
+	historyByPubKey map[[48]byte]kv.ProposalHistoryForPubkey,
+) ([]*ImportConflict, error) {
+	conflicts := proposalConflictsInHistory(historyByPubKey)
+	for pubKey, proposals := range historyByPubKey {
+		for _, blk := range proposals.Proposals {
+			signingRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, blk.Slot)
+			if err != nil {
+				return nil, err
+			}
+			if exists && !bytes.Equal(signingRoot[:], blk.SigningRoot) {
+				conflicts = append(conflicts, &ImportConflict{
+					PubKey: pubKey,
+					Reason: fmt.Sprintf("signed block at slot %d conflicts with local history", blk.Slot),
+				})
+				break
+			}
+		}
+	}
+	return conflicts, nil
 }
 
 func filterSlashablePubKeysFromAttestations(
@@ -263,7 +450,21 @@ func filterSlashablePubKeysFromAttestations(
 	validatorDB db.Database,
 	signedAttsByPubKey map[[48]byte][]*kv.AttestationRecord,
 ) ([][48]byte, error) {
-	slashablePubKeys := make([][48]byte, 0)
+	conflicts, err := attestationConflicts(ctx, validatorDB, signedAttsByPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return conflictPubKeys(conflicts), nil
+}
+
+// attestationConflicts finds the signed attestations which are slashable with respect to
+// other attestations within the same JSON import, or to the attestations in our database.
+func attestationConflicts(
+	ctx context.Context,
+	validatorDB db.Database,
+	signedAttsByPubKey map[[48]byte][]*kv.AttestationRecord,
+) ([]*ImportConflict, error) {
+	conflicts := make([]*ImportConflict, 0)
 	// First we need to find attestations that are slashable with respect to other
 	// attestations within the same JSON import.
 	for pubKey, signedAtts := range signedAttsByPubKey {
@@ -274,7 +475,10 @@ func filterSlashablePubKeysFromAttestations(
 			// Check for double votes.
 			if sr, ok := signingRootsByTarget[att.Target]; ok {
 				if slashutil.SigningRootsDiffer(sr, att.SigningRoot) {
-					slashablePubKeys = append(slashablePubKeys, pubKey)
+					conflicts = append(conflicts, &ImportConflict{
+						PubKey: pubKey,
+						Reason: fmt.Sprintf("double vote at target epoch %d in imported history", att.Target),
+					})
 					break Loop
 				}
 			}
@@ -284,7 +488,13 @@ func filterSlashablePubKeysFromAttestations(
 					a := createAttestation(source, target)
 					b := createAttestation(att.Source, att.Target)
 					if slashutil.IsSurround(a, b) || slashutil.IsSurround(b, a) {
-						slashablePubKeys = append(slashablePubKeys, pubKey)
+						conflicts = append(conflicts, &ImportConflict{
+							PubKey: pubKey,
+							Reason: fmt.Sprintf(
+								"surround vote between (source %d, target %d) and (source %d, target %d) in imported history",
+								source, target, att.Source, att.Target,
+							),
+						})
 						break Loop
 					}
 				}
@@ -298,17 +508,28 @@ func filterSlashablePubKeysFromAttestations(
 		for _, att := range signedAtts {
 			indexedAtt := createAttestation(att.Source, att.Target)
 			slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, att.SigningRoot, indexedAtt)
-			if err != nil {
-				return nil, err
-			}
-			// Malformed data should not prevent us from completing this function.
+			// A slashable attestation is reported along with the reason it is slashable.
 			if slashable != kv.NotSlashable {
-				slashablePubKeys = append(slashablePubKeys, pubKey)
+				conflicts = append(conflicts, &ImportConflict{
+					PubKey: pubKey,
+					Reason: fmt.Sprintf("signed attestation conflicts with local history: %v", err),
+				})
 				break
 			}
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
-	return slashablePubKeys, nil
+	return conflicts, nil
+}
+
+func conflictPubKeys(conflicts []*ImportConflict) [][48]byte {
+	pubKeys := make([][48]byte, len(conflicts))
+	for i, conflict := range conflicts {
+		pubKeys[i] = conflict.PubKey
+	}
+	return pubKeys
 }
 
 func transformSignedBlocks(ctx context.Context, signedBlocks []*format.SignedBlock) (*kv.ProposalHistoryForPubkey, error) {
@@ -0,0 +1,87 @@
+package interchangeformat
+
+import (
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+)
+
+// filterPublicKeys returns the public keys which are part of the filter.
+func filterPublicKeys(pubKeys, filter [][48]byte) [][48]byte {
+	wanted := make(map[[48]byte]bool, len(filter))
+	for _, pubKey := range filter {
+		wanted[pubKey] = true
+	}
+	filtered := make([][48]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if wanted[pubKey] {
+			filtered = append(filtered, pubKey)
+		}
+	}
+	return filtered
+}
+
+// minimalSignedBlocks reduces signed blocks to the block with the highest slot, the only
+// one kept by the minimal interchange format.
+func minimalSignedBlocks(blocks []*format.SignedBlock) ([]*format.SignedBlock, error) {
+	var highest *format.SignedBlock
+	var highestSlot types.Slot
+	for _, b := range blocks {
+		slot, err := SlotFromString(b.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid slot: %w", b.Slot, err)
+		}
+		if highest == nil || slot > highestSlot {
+			highest, highestSlot = b, slot
+		}
+	}
+	if highest == nil {
+		return make([]*format.SignedBlock, 0), nil
+	}
+	return []*format.SignedBlock{highest}, nil
+}
+
+// minimalSignedAttestations reduces signed attestations to a single attestation with the
+// highest source and target epochs, the only one kept by the minimal interchange format.
+// Its signing root is only kept when an attestation has both epochs, as it does not
+// identify any attestation otherwise.
+func minimalSignedAttestations(atts []*format.SignedAttestation) ([]*format.SignedAttestation, error) {
+	if len(atts) == 0 {
+		return make([]*format.SignedAttestation, 0), nil
+	}
+	sources := make([]types.Epoch, len(atts))
+	targets := make([]types.Epoch, len(atts))
+	var highestSource, highestTarget types.Epoch
+	for i, att := range atts {
+		source, err := EpochFromString(att.SourceEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", att.SourceEpoch, err)
+		}
+		target, err := EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", att.TargetEpoch, err)
+		}
+		if source > highestSource {
+			highestSource = source
+		}
+		if target > highestTarget {
+			highestTarget = target
+		}
+		sources[i], targets[i] = source, target
+	}
+	var signingRoot string
+	for i, att := range atts {
+		if sources[i] == highestSource && targets[i] == highestTarget {
+			signingRoot = att.SigningRoot
+			break
+		}
+	}
+	return []*format.SignedAttestation{
+		{
+			SourceEpoch: fmt.Sprintf("%d", highestSource),
+			TargetEpoch: fmt.Sprintf("%d", highestTarget),
+			SigningRoot: signingRoot,
+		},
+	}, nil
+}
@@ -5,9 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
@@ -325,3 +328,144 @@ func TestStore_ImportInterchangeData_BadFormat_PreventsDBWrites(t *testing.T) {
 		)
 	}
 }
+
+// mockInterchangeJSON encodes the history of public keys into a standard JSON, one
+// attestation (source, target) pair and one block slot per value. Signing roots are
+// the value given for the pair or slot.
+func mockInterchangeJSON(
+	t *testing.T, publicKeys [][48]byte, atts [][][3]uint64, blocks [][][2]uint64,
+) *bytes.Buffer {
+	attestingHistory := make([][]*kv.AttestationRecord, len(publicKeys))
+	proposalHistory := make([]kv.ProposalHistoryForPubkey, len(publicKeys))
+	for i, pubKey := range publicKeys {
+		for _, a := range atts[i] {
+			attestingHistory[i] = append(attestingHistory[i], &kv.AttestationRecord{
+				PubKey:      pubKey,
+				Source:      types.Epoch(a[0]),
+				Target:      types.Epoch(a[1]),
+				SigningRoot: [32]byte{byte(a[2])},
+			})
+		}
+		for _, b := range blocks[i] {
+			root := [32]byte{byte(b[1])}
+			proposalHistory[i].Proposals = append(proposalHistory[i].Proposals, kv.Proposal{
+				Slot:        types.Slot(b[0]),
+				SigningRoot: root[:],
+			})
+		}
+	}
+	interchangeJSON, err := slashtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	blob, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	return bytes.NewBuffer(blob)
+}
+
+func TestExportProtectionJSON_SubsetMinimal(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][48]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	buf := mockInterchangeJSON(
+		t,
+		publicKeys,
+		[][][3]uint64{{{0, 1, 1}, {1, 2, 2}, {2, 3, 3}}, {{0, 1, 4}}},
+		[][][2]uint64{{{1, 1}, {5, 2}}, {{2, 3}}},
+	)
+	require.NoError(t, protectionFormat.ImportStandardProtectionJSON(ctx, validatorDB, buf))
+
+	eipStandard, err := protectionFormat.ExportProtectionJSON(ctx, validatorDB, &protectionFormat.ExportOptions{
+		PublicKeys: [][48]byte{{1}},
+		Minimal:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(eipStandard.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", publicKeys[0]), eipStandard.Data[0].Pubkey)
+	require.Equal(t, 1, len(eipStandard.Data[0].SignedBlocks))
+	assert.Equal(t, "5", eipStandard.Data[0].SignedBlocks[0].Slot)
+	require.Equal(t, 1, len(eipStandard.Data[0].SignedAttestations))
+	assert.DeepEqual(t, &format.SignedAttestation{
+		SourceEpoch: "2",
+		TargetEpoch: "3",
+		SigningRoot: fmt.Sprintf("%#x", [32]byte{3}),
+	}, eipStandard.Data[0].SignedAttestations[0])
+
+}
+
+func TestImportProtectionJSON_DryRunMerge(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][48]byte{{1}, {2}, {3}}
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	// The first key has a local history conflicting with the imported one.
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, publicKeys[0], [32]byte{1}, &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+	}))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, publicKeys[1], 3, bytesutil.PadTo([]byte{1}, 32)))
+	mockJSON := func() *bytes.Buffer {
+		return mockInterchangeJSON(
+			t,
+			publicKeys,
+			[][][3]uint64{{{1, 2, 2}}, {{0, 1, 1}}, {{0, 1, 1}, {1, 2, 2}}},
+			[][][2]uint64{{}, {{3, 1}, {4, 2}}, {{1, 1}, {5, 5}}},
+		)
+	}
+
+	report, err := protectionFormat.ImportProtectionJSON(ctx, validatorDB, mockJSON(), &protectionFormat.ImportOptions{
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{{2}, {3}}, report.PublicKeys)
+	assert.Equal(t, 4, report.SignedBlocks)
+	assert.Equal(t, 3, report.SignedAttestations)
+	require.Equal(t, 1, len(report.Conflicts))
+	assert.Equal(t, publicKeys[0], report.Conflicts[0].PubKey)
+	assert.Equal(t, true, strings.Contains(report.Conflicts[0].Reason, "double vote"))
+
+	// Nothing was written in the dry run.
+	gvr, err := validatorDB.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(gvr))
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blacklisted))
+	history, err := validatorDB.ProposalHistoryForPubKey(ctx, publicKeys[2])
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(history))
+
+	// Only the history of the third key is imported, in its minimal form.
+	report, err = protectionFormat.ImportProtectionJSON(ctx, validatorDB, mockJSON(), &protectionFormat.ImportOptions{
+		PublicKeys: [][48]byte{{3}},
+		Minimal:    true,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{{3}}, report.PublicKeys)
+	assert.Equal(t, 0, len(report.Conflicts))
+	history, err = validatorDB.ProposalHistoryForPubKey(ctx, publicKeys[2])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, types.Slot(5), history[0].Slot)
+	atts, err := validatorDB.AttestationHistoryForPubKey(ctx, publicKeys[2])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.Equal(t, types.Epoch(2), atts[0].Target)
+	history, err = validatorDB.ProposalHistoryForPubKey(ctx, publicKeys[1])
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(history))
+
+	// Importing everything merges the histories, except for the key in conflict.
+	report, err = protectionFormat.ImportProtectionJSON(ctx, validatorDB, mockJSON(), &protectionFormat.ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Conflicts))
+	history, err = validatorDB.ProposalHistoryForPubKey(ctx, publicKeys[1])
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(history))
+	blacklisted, err = validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{publicKeys[0]}, blacklisted)
+	atts, err = validatorDB.AttestationHistoryForPubKey(ctx, publicKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.Equal(t, [32]byte{1}, atts[0].SigningRoot)
+}
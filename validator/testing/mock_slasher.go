@@ -27,6 +27,16 @@ func (ms MockSlasher) HighestAttestations(ctx context.Context, req *slashpb.High
 	}, nil
 }
 
+// AttesterSlashingEvidence will return an empty array of evidence.
+func (ms MockSlasher) AttesterSlashingEvidence(_ context.Context, _ *slashpb.SlashingEvidenceRequest, _ ...grpc.CallOption) (*slashpb.AttesterSlashingEvidenceResponse, error) {
+	return &slashpb.AttesterSlashingEvidenceResponse{}, nil
+}
+
+// ProposerSlashingEvidence will return an empty array of evidence.
+func (ms MockSlasher) ProposerSlashingEvidence(_ context.Context, _ *slashpb.SlashingEvidenceRequest, _ ...grpc.CallOption) (*slashpb.ProposerSlashingEvidenceResponse, error) {
+	return &slashpb.ProposerSlashingEvidenceResponse{}, nil
+}
+
 // IsSlashableAttestation returns slashbale attestation if slash attestation is set to true.
 func (ms MockSlasher) IsSlashableAttestation(_ context.Context, in *eth.IndexedAttestation, _ ...grpc.CallOption) (*slashpb.AttesterSlashingResponse, error) {
 	ms.IsSlashableAttestationCalled = true